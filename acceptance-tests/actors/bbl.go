@@ -99,6 +99,7 @@ func (b BBL) CreateLB(loadBalancerType string, cert string, key string, chain st
 		"--state-dir", b.stateDirectory,
 		"--debug",
 		"create-lbs",
+		"--no-confirm",
 		"--type", loadBalancerType,
 	}
 
@@ -123,6 +124,7 @@ func (b BBL) UpdateLB(certPath, keyPath, chainPath string) *gexec.Session {
 		"--state-dir", b.stateDirectory,
 		"--debug",
 		"update-lbs",
+		"--no-confirm",
 		"--cert", certPath,
 		"--key", keyPath,
 	}
@@ -139,6 +141,7 @@ func (b BBL) DeleteLBs() *gexec.Session {
 		"--state-dir", b.stateDirectory,
 		"--debug",
 		"delete-lbs",
+		"--no-confirm",
 	}
 
 	return b.execute(args, os.Stdout, os.Stderr)
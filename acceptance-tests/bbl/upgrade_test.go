@@ -103,7 +103,7 @@ var _ = Describe("Upgrade", func() {
 		})
 
 		By("upgrading to the latest bbl", func() {
-			session := newBBL.Up()
+			session := newBBL.Up("--no-confirm")
 			Eventually(session, 60*time.Minute).Should(gexec.Exit(0))
 		})
 
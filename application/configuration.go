@@ -5,6 +5,7 @@ import "github.com/cloudfoundry/bosh-bootloader/storage"
 type GlobalConfiguration struct {
 	StateDir string
 	Debug    bool
+	Output   string
 }
 
 type StringSlice []string
@@ -26,4 +27,8 @@ type Configuration struct {
 	SubcommandFlags StringSlice
 	State           storage.State
 	ShowCommandHelp bool
+
+	// LBFlags are the create-lbs flags from bbl.yml. "bbl up" creates or
+	// updates the load balancers with them once the director is up.
+	LBFlags StringSlice
 }
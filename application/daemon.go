@@ -0,0 +1,75 @@
+package application
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// Daemon runs bbl again in the background with the same arguments, minus
+// --daemon, so long-running commands can outlive the shell that started
+// them.
+type Daemon struct {
+	executable string
+	args       []string
+}
+
+func NewDaemon(executable string, args []string) Daemon {
+	return Daemon{
+		executable: executable,
+		args:       args,
+	}
+}
+
+func (d Daemon) Start(logPath string) (int, error) {
+	logFile, err := os.OpenFile(logPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return 0, fmt.Errorf("open log file: %s", err)
+	}
+	defer logFile.Close()
+
+	args := []string{}
+	for _, arg := range d.args[1:] {
+		if arg == "--daemon" || arg == "-daemon" || strings.HasPrefix(arg, "--daemon=") || strings.HasPrefix(arg, "-daemon=") {
+			continue
+		}
+		args = append(args, arg)
+	}
+
+	cmd := exec.Command(d.executable, args...)
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	cmd.SysProcAttr = daemonSysProcAttr()
+
+	err = cmd.Start()
+	if err != nil {
+		return 0, fmt.Errorf("start %s: %s", d.executable, err)
+	}
+
+	// Reap the process when it exits, so that Running reports a process that
+	// fails while bbl is still waiting for it as no longer running.
+	go cmd.Wait()
+
+	return cmd.Process.Pid, nil
+}
+
+// Running reports whether a process with the given pid exists and can be
+// signalled by this user.
+func (Daemon) Running(pid int) bool {
+	return processRunning(pid)
+}
+
+func (Daemon) Stop(pid int) error {
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return err
+	}
+
+	err = process.Signal(os.Interrupt)
+	if err != nil {
+		return process.Kill()
+	}
+
+	return nil
+}
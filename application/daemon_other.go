@@ -0,0 +1,22 @@
+//go:build !linux && !darwin
+// +build !linux,!darwin
+
+package application
+
+import (
+	"os"
+	"syscall"
+)
+
+func daemonSysProcAttr() *syscall.SysProcAttr {
+	return nil
+}
+
+func processRunning(pid int) bool {
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	process.Release()
+	return true
+}
@@ -0,0 +1,101 @@
+package application_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/cloudfoundry/bosh-bootloader/application"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Daemon", func() {
+	var (
+		tempDir string
+		logPath string
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = ioutil.TempDir("", "daemon")
+		Expect(err).NotTo(HaveOccurred())
+
+		logPath = filepath.Join(tempDir, "daemon.log")
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tempDir)
+	})
+
+	Describe("Start", func() {
+		It("runs the executable in the background without --daemon", func() {
+			daemon := application.NewDaemon("/bin/sh", []string{"bbl", "-c", "echo started $0", "--daemon"})
+
+			pid, err := daemon.Start(logPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pid).NotTo(BeZero())
+
+			Eventually(func() (string, error) {
+				contents, err := ioutil.ReadFile(logPath)
+				return string(contents), err
+			}).Should(Equal("started /bin/sh\n"))
+		})
+
+		It("returns an error when the executable cannot be started", func() {
+			daemon := application.NewDaemon(filepath.Join(tempDir, "missing"), []string{"bbl", "tunnel", "--daemon"})
+
+			_, err := daemon.Start(logPath)
+			Expect(err).To(MatchError(ContainSubstring("start " + filepath.Join(tempDir, "missing"))))
+		})
+
+		It("returns an error when the log file cannot be opened", func() {
+			daemon := application.NewDaemon("/bin/sh", []string{"bbl"})
+
+			_, err := daemon.Start(filepath.Join(tempDir, "missing", "daemon.log"))
+			Expect(err).To(MatchError(ContainSubstring("open log file")))
+		})
+	})
+
+	Describe("Running", func() {
+		It("reports whether the process exists", func() {
+			daemon := application.NewDaemon("/bin/sh", []string{"bbl"})
+
+			Expect(daemon.Running(os.Getpid())).To(BeTrue())
+		})
+
+		It("reports a process that has exited as not running", func() {
+			daemon := application.NewDaemon("/bin/sh", []string{"bbl", "-c", "exit 0"})
+
+			pid, err := daemon.Start(logPath)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(func() bool {
+				return daemon.Running(pid)
+			}, "5s").Should(BeFalse())
+		})
+	})
+
+	Describe("Stop", func() {
+		It("interrupts the process", func() {
+			daemon := application.NewDaemon("/bin/sh", []string{"bbl", "-c", "trap 'echo interrupted; exit 0' INT; echo ready; while true; do sleep 0.1; done"})
+
+			pid, err := daemon.Start(logPath)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(func() (string, error) {
+				contents, err := ioutil.ReadFile(logPath)
+				return string(contents), err
+			}).Should(ContainSubstring("ready"))
+
+			err = daemon.Stop(pid)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(func() (string, error) {
+				contents, err := ioutil.ReadFile(logPath)
+				return string(contents), err
+			}, "5s").Should(ContainSubstring("interrupted"))
+		})
+	})
+})
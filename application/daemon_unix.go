@@ -0,0 +1,14 @@
+//go:build linux || darwin
+// +build linux darwin
+
+package application
+
+import "syscall"
+
+func daemonSysProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{Setsid: true}
+}
+
+func processRunning(pid int) bool {
+	return syscall.Kill(pid, 0) == nil
+}
@@ -6,16 +6,45 @@ import (
 	"strings"
 
 	"github.com/cloudfoundry/bosh-bootloader/aws"
+	"github.com/cloudfoundry/bosh-bootloader/aws/elbv2"
 
 	awslib "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	awselb "github.com/aws/aws-sdk-go/service/elb"
 )
 
 type EC2Client interface {
 	DescribeAvailabilityZones(*awsec2.DescribeAvailabilityZonesInput) (*awsec2.DescribeAvailabilityZonesOutput, error)
 	DescribeInstances(*awsec2.DescribeInstancesInput) (*awsec2.DescribeInstancesOutput, error)
 	DescribeVpcs(*awsec2.DescribeVpcsInput) (*awsec2.DescribeVpcsOutput, error)
+	CreateVpc(*awsec2.CreateVpcInput) (*awsec2.CreateVpcOutput, error)
+
+	DescribeSecurityGroups(*awsec2.DescribeSecurityGroupsInput) (*awsec2.DescribeSecurityGroupsOutput, error)
+	DescribeSubnets(*awsec2.DescribeSubnetsInput) (*awsec2.DescribeSubnetsOutput, error)
+	DescribeRouteTables(*awsec2.DescribeRouteTablesInput) (*awsec2.DescribeRouteTablesOutput, error)
+	DescribeInternetGateways(*awsec2.DescribeInternetGatewaysInput) (*awsec2.DescribeInternetGatewaysOutput, error)
+	TerminateInstances(*awsec2.TerminateInstancesInput) (*awsec2.TerminateInstancesOutput, error)
+	WaitUntilInstanceTerminated(*awsec2.DescribeInstancesInput) error
+	RevokeSecurityGroupIngress(*awsec2.RevokeSecurityGroupIngressInput) (*awsec2.RevokeSecurityGroupIngressOutput, error)
+	DeleteSecurityGroup(*awsec2.DeleteSecurityGroupInput) (*awsec2.DeleteSecurityGroupOutput, error)
+	DeleteSubnet(*awsec2.DeleteSubnetInput) (*awsec2.DeleteSubnetOutput, error)
+	DeleteRouteTable(*awsec2.DeleteRouteTableInput) (*awsec2.DeleteRouteTableOutput, error)
+	DetachInternetGateway(*awsec2.DetachInternetGatewayInput) (*awsec2.DetachInternetGatewayOutput, error)
+	DeleteInternetGateway(*awsec2.DeleteInternetGatewayInput) (*awsec2.DeleteInternetGatewayOutput, error)
+	DeleteVpc(*awsec2.DeleteVpcInput) (*awsec2.DeleteVpcOutput, error)
+
+	DescribeNatGateways(*awsec2.DescribeNatGatewaysInput) (*awsec2.DescribeNatGatewaysOutput, error)
+	DeleteNatGateway(*awsec2.DeleteNatGatewayInput) (*awsec2.DeleteNatGatewayOutput, error)
+	DescribeAddresses(*awsec2.DescribeAddressesInput) (*awsec2.DescribeAddressesOutput, error)
+	ReleaseAddress(*awsec2.ReleaseAddressInput) (*awsec2.ReleaseAddressOutput, error)
+	DescribeNetworkInterfaces(*awsec2.DescribeNetworkInterfacesInput) (*awsec2.DescribeNetworkInterfacesOutput, error)
+}
+
+type ELBClient interface {
+	DescribeLoadBalancers(*awselb.DescribeLoadBalancersInput) (*awselb.DescribeLoadBalancersOutput, error)
+	DeleteLoadBalancer(*awselb.DeleteLoadBalancerInput) (*awselb.DeleteLoadBalancerOutput, error)
 }
 
 type logger interface {
@@ -27,14 +56,18 @@ type AvailabilityZoneRetriever interface {
 }
 
 type Client struct {
-	ec2Client EC2Client
-	logger    logger
+	ec2Client   EC2Client
+	elbClient   ELBClient
+	elbv2Client elbv2.Client
+	logger      logger
 }
 
 func NewClient(config aws.Config, logger logger) Client {
 	return Client{
-		ec2Client: awsec2.New(session.New(config.ClientConfig())),
-		logger:    logger,
+		ec2Client:   awsec2.New(session.New(config.ClientConfig())),
+		elbClient:   awselb.New(session.New(config.ClientConfig())),
+		elbv2Client: elbv2.NewClient(config),
+		logger:      logger,
 	}
 }
 
@@ -86,6 +119,23 @@ func (c Client) CheckExists(networkName string) (bool, error) {
 	return false, nil
 }
 
+// CheckPermissions asks EC2 whether the credentials could create a VPC,
+// without creating one.
+func (c Client) CheckPermissions() error {
+	_, err := c.ec2Client.CreateVpc(&awsec2.CreateVpcInput{
+		CidrBlock: awslib.String("10.0.0.0/16"),
+		DryRun:    awslib.Bool(true),
+	})
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "DryRunOperation" {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Create vpc (dry run): %s", err)
+	}
+
+	return nil
+}
+
 func (c Client) ValidateSafeToDelete(vpcID, envID string) error {
 	output, err := c.ec2Client.DescribeInstances(&awsec2.DescribeInstancesInput{
 		Filters: []*awsec2.Filter{{
@@ -97,12 +147,7 @@ func (c Client) ValidateSafeToDelete(vpcID, envID string) error {
 		return err
 	}
 
-	vms := c.flattenVMs(output.Reservations)
-	vms = c.removeOneVM(vms, fmt.Sprintf("%s-nat", envID))
-	vms = c.removeOneVM(vms, "NAT")
-	vms = c.removeOneVM(vms, "bosh/0")
-	vms = c.removeOneVM(vms, "jumpbox/0")
-
+	vms := c.deployedVMs(output.Reservations, envID)
 	if len(vms) > 0 {
 		return fmt.Errorf("vpc %s is not safe to delete; vms still exist: [%s]", vpcID, strings.Join(vms, ", "))
 	}
@@ -110,6 +155,37 @@ func (c Client) ValidateSafeToDelete(vpcID, envID string) error {
 	return nil
 }
 
+// ValidateSafeToDeleteSubnets is ValidateSafeToDelete for a VPC bbl did not
+// create, where only the subnets bbl created in it have to be empty.
+func (c Client) ValidateSafeToDeleteSubnets(subnetIDs []string, envID string) error {
+	output, err := c.ec2Client.DescribeInstances(&awsec2.DescribeInstancesInput{
+		Filters: []*awsec2.Filter{{
+			Name:   awslib.String("subnet-id"),
+			Values: awslib.StringSlice(subnetIDs),
+		}},
+	})
+	if err != nil {
+		return err
+	}
+
+	vms := c.deployedVMs(output.Reservations, envID)
+	if len(vms) > 0 {
+		return fmt.Errorf("subnets %s are not safe to delete; vms still exist: [%s]", strings.Join(subnetIDs, ", "), strings.Join(vms, ", "))
+	}
+
+	return nil
+}
+
+// deployedVMs names the instances other than the ones bbl itself deployed.
+func (c Client) deployedVMs(reservations []*awsec2.Reservation, envID string) []string {
+	vms := c.flattenVMs(reservations)
+	vms = c.removeOneVM(vms, fmt.Sprintf("%s-nat", envID))
+	vms = c.removeOneVM(vms, "NAT")
+	vms = c.removeOneVM(vms, "bosh/0")
+	vms = c.removeOneVM(vms, "jumpbox/0")
+	return vms
+}
+
 func (c Client) flattenVMs(reservations []*awsec2.Reservation) []string {
 	vms := []string{}
 	for _, reservation := range reservations {
@@ -3,6 +3,7 @@ package ec2_test
 import (
 	"errors"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/cloudfoundry/bosh-bootloader/aws"
 	"github.com/cloudfoundry/bosh-bootloader/aws/ec2"
@@ -108,6 +109,37 @@ var _ = Describe("Client", func() {
 		})
 	})
 
+	Describe("CheckPermissions", func() {
+		var (
+			client    ec2.Client
+			ec2Client *fakes.AWSEC2Client
+		)
+
+		BeforeEach(func() {
+			ec2Client = &fakes.AWSEC2Client{}
+			client = ec2.NewClientWithInjectedEC2Client(ec2Client, &fakes.Logger{})
+		})
+
+		It("creates a vpc as a dry run", func() {
+			ec2Client.CreateVpcCall.Returns.Error = awserr.New("DryRunOperation", "Request would have succeeded, but DryRun flag is set.", nil)
+
+			err := client.CheckPermissions()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ec2Client.CreateVpcCall.Receives.Input).To(Equal(&awsec2.CreateVpcInput{
+				CidrBlock: awslib.String("10.0.0.0/16"),
+				DryRun:    awslib.Bool(true),
+			}))
+		})
+
+		It("returns an error when the credentials are not allowed to create a vpc", func() {
+			ec2Client.CreateVpcCall.Returns.Error = awserr.New("UnauthorizedOperation", "You are not authorized to perform this operation.", nil)
+
+			err := client.CheckPermissions()
+			Expect(err).To(MatchError("Create vpc (dry run): UnauthorizedOperation: You are not authorized to perform this operation."))
+		})
+	})
+
 	Describe("ValidateSafeToDelete", func() {
 		var (
 			client    ec2.Client
@@ -269,6 +301,49 @@ var _ = Describe("Client", func() {
 			})
 		})
 	})
+
+	Describe("ValidateSafeToDeleteSubnets", func() {
+		var (
+			client    ec2.Client
+			ec2Client *fakes.AWSEC2Client
+		)
+
+		BeforeEach(func() {
+			ec2Client = &fakes.AWSEC2Client{}
+			client = ec2.NewClientWithInjectedEC2Client(ec2Client, &fakes.Logger{})
+		})
+
+		It("looks for instances in the given subnets only", func() {
+			ec2Client.DescribeInstancesCall.Returns.Output = &awsec2.DescribeInstancesOutput{
+				Reservations: []*awsec2.Reservation{
+					reservationContainingInstance("some-env-id-nat"),
+					reservationContainingInstance("bosh/0"),
+				},
+			}
+
+			err := client.ValidateSafeToDeleteSubnets([]string{"subnet-1", "subnet-2"}, "some-env-id")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ec2Client.DescribeInstancesCall.Receives.Input).To(Equal(&awsec2.DescribeInstancesInput{
+				Filters: []*awsec2.Filter{{
+					Name:   awslib.String("subnet-id"),
+					Values: []*string{awslib.String("subnet-1"), awslib.String("subnet-2")},
+				}},
+			}))
+		})
+
+		It("returns an error when deployed vms are still in the subnets", func() {
+			ec2Client.DescribeInstancesCall.Returns.Output = &awsec2.DescribeInstancesOutput{
+				Reservations: []*awsec2.Reservation{
+					reservationContainingInstance("bosh/0"),
+					reservationContainingInstance("router/0"),
+				},
+			}
+
+			err := client.ValidateSafeToDeleteSubnets([]string{"subnet-1", "subnet-2"}, "some-env-id")
+			Expect(err).To(MatchError("subnets subnet-1, subnet-2 are not safe to delete; vms still exist: [router/0]"))
+		})
+	})
 })
 
 func reservationContainingInstance(tag string) *awsec2.Reservation {
@@ -1,5 +1,11 @@
 package ec2
 
+import (
+	"time"
+
+	"github.com/cloudfoundry/bosh-bootloader/aws/elbv2"
+)
+
 func NewClientWithInjectedEC2Client(ec2Client EC2Client, logger logger) Client {
 	return Client{
 		ec2Client: ec2Client,
@@ -10,3 +16,20 @@ func NewClientWithInjectedEC2Client(ec2Client EC2Client, logger logger) Client {
 func (c Client) GetEC2Client() EC2Client {
 	return c.ec2Client
 }
+
+func NewClientWithInjectedClients(ec2Client EC2Client, elbClient ELBClient, elbv2Client elbv2.Client, logger logger) Client {
+	return Client{
+		ec2Client:   ec2Client,
+		elbClient:   elbClient,
+		elbv2Client: elbv2Client,
+		logger:      logger,
+	}
+}
+
+func SetLeftoverPollInterval(interval time.Duration) {
+	leftoverPollInterval = interval
+}
+
+func ResetLeftoverPollInterval() {
+	leftoverPollInterval = 5 * time.Second
+}
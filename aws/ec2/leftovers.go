@@ -0,0 +1,410 @@
+package ec2
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	awslib "github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	awselb "github.com/aws/aws-sdk-go/service/elb"
+	"github.com/cloudfoundry/bosh-bootloader/aws/elbv2"
+	"github.com/cloudfoundry/bosh-bootloader/helpers"
+)
+
+const (
+	leftoverInstance        = "instance"
+	leftoverClassicELB      = "classic load balancer"
+	leftoverLoadBalancer    = "load balancer"
+	leftoverNATGateway      = "nat gateway"
+	leftoverElasticIP       = "elastic ip"
+	leftoverSecurityGroup   = "security group"
+	leftoverSubnet          = "subnet"
+	leftoverRouteTable      = "route table"
+	leftoverInternetGateway = "internet gateway"
+	leftoverVPC             = "vpc"
+)
+
+const (
+	leftoverPollAttempts      = 60
+	loadBalancerARNResourceID = ":loadbalancer/"
+)
+
+// leftoverPollInterval is how long DeleteLeftover waits between checks for
+// a NAT gateway or load balancer to be gone.
+var leftoverPollInterval = 5 * time.Second
+
+// ListLeftovers finds the instances, security groups, subnets and VPCs whose
+// Name tag starts with filter. bbl does not tag its load balancers, NAT
+// gateways, elastic IPs, route tables and internet gateways, so they are
+// found by name, by VPC, or through the instances and NAT gateways that use
+// them. They are returned in the order they can be deleted in.
+func (c Client) ListLeftovers(filter string) ([]helpers.Leftover, error) {
+	var leftovers []helpers.Leftover
+
+	vpcs, err := c.ec2Client.DescribeVpcs(&awsec2.DescribeVpcsInput{Filters: nameTagFilter(filter)})
+	if err != nil {
+		return nil, fmt.Errorf("Describe vpcs: %s", err)
+	}
+
+	var vpcIDs []*string
+	for _, vpc := range vpcs.Vpcs {
+		vpcIDs = append(vpcIDs, vpc.VpcId)
+	}
+
+	instances, err := c.ec2Client.DescribeInstances(&awsec2.DescribeInstancesInput{
+		Filters: append(nameTagFilter(filter), &awsec2.Filter{
+			Name:   awslib.String("instance-state-name"),
+			Values: awslib.StringSlice([]string{"pending", "running", "stopping", "stopped"}),
+		}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Describe instances: %s", err)
+	}
+	var instanceIDs []*string
+	for _, reservation := range instances.Reservations {
+		for _, instance := range reservation.Instances {
+			instanceIDs = append(instanceIDs, instance.InstanceId)
+			leftovers = append(leftovers, leftover(leftoverInstance, instance.InstanceId, instance.Tags))
+		}
+	}
+
+	loadBalancers, err := c.listLoadBalancers(filter, vpcIDs)
+	if err != nil {
+		return nil, err
+	}
+	leftovers = append(leftovers, loadBalancers...)
+
+	subnets, err := c.ec2Client.DescribeSubnets(&awsec2.DescribeSubnetsInput{Filters: nameTagFilter(filter)})
+	if err != nil {
+		return nil, fmt.Errorf("Describe subnets: %s", err)
+	}
+	var subnetIDs []*string
+	for _, subnet := range subnets.Subnets {
+		subnetIDs = append(subnetIDs, subnet.SubnetId)
+	}
+
+	var elasticIPs []helpers.Leftover
+	if len(subnetIDs) > 0 {
+		natGateways, err := c.ec2Client.DescribeNatGateways(&awsec2.DescribeNatGatewaysInput{
+			Filter: []*awsec2.Filter{
+				{Name: awslib.String("subnet-id"), Values: subnetIDs},
+				{Name: awslib.String("state"), Values: awslib.StringSlice([]string{"pending", "available", "failed"})},
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Describe nat gateways: %s", err)
+		}
+		for _, natGateway := range natGateways.NatGateways {
+			leftovers = append(leftovers, leftover(leftoverNATGateway, natGateway.NatGatewayId, natGateway.Tags))
+			for _, address := range natGateway.NatGatewayAddresses {
+				elasticIPs = append(elasticIPs, elasticIP(address.AllocationId, address.PublicIp))
+			}
+		}
+	}
+
+	if len(instanceIDs) > 0 {
+		addresses, err := c.ec2Client.DescribeAddresses(&awsec2.DescribeAddressesInput{
+			Filters: []*awsec2.Filter{{Name: awslib.String("instance-id"), Values: instanceIDs}},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Describe addresses: %s", err)
+		}
+		for _, address := range addresses.Addresses {
+			elasticIPs = append(elasticIPs, elasticIP(address.AllocationId, address.PublicIp))
+		}
+	}
+	leftovers = append(leftovers, elasticIPs...)
+
+	securityGroups, err := c.ec2Client.DescribeSecurityGroups(&awsec2.DescribeSecurityGroupsInput{Filters: nameTagFilter(filter)})
+	if err != nil {
+		return nil, fmt.Errorf("Describe security groups: %s", err)
+	}
+	for _, group := range securityGroups.SecurityGroups {
+		leftovers = append(leftovers, leftover(leftoverSecurityGroup, group.GroupId, group.Tags))
+	}
+
+	for _, subnet := range subnets.Subnets {
+		leftovers = append(leftovers, leftover(leftoverSubnet, subnet.SubnetId, subnet.Tags))
+	}
+
+	if len(vpcIDs) == 0 {
+		return leftovers, nil
+	}
+
+	routeTables, err := c.ec2Client.DescribeRouteTables(&awsec2.DescribeRouteTablesInput{
+		Filters: []*awsec2.Filter{{Name: awslib.String("vpc-id"), Values: vpcIDs}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Describe route tables: %s", err)
+	}
+	for _, routeTable := range routeTables.RouteTables {
+		if !isMainRouteTable(routeTable) {
+			leftovers = append(leftovers, leftover(leftoverRouteTable, routeTable.RouteTableId, routeTable.Tags))
+		}
+	}
+
+	internetGateways, err := c.ec2Client.DescribeInternetGateways(&awsec2.DescribeInternetGatewaysInput{
+		Filters: []*awsec2.Filter{{Name: awslib.String("attachment.vpc-id"), Values: vpcIDs}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Describe internet gateways: %s", err)
+	}
+	for _, internetGateway := range internetGateways.InternetGateways {
+		leftovers = append(leftovers, leftover(leftoverInternetGateway, internetGateway.InternetGatewayId, internetGateway.Tags))
+	}
+
+	for _, vpc := range vpcs.Vpcs {
+		leftovers = append(leftovers, leftover(leftoverVPC, vpc.VpcId, vpc.Tags))
+	}
+
+	return leftovers, nil
+}
+
+// listLoadBalancers finds the classic, application and network load
+// balancers whose name starts with filter or that are in one of vpcIDs.
+// bbl names them after a shortened env ID, so a long filter only finds them
+// through their VPC.
+func (c Client) listLoadBalancers(filter string, vpcIDs []*string) ([]helpers.Leftover, error) {
+	var leftovers []helpers.Leftover
+
+	matches := func(name, vpcID *string) bool {
+		if strings.HasPrefix(awslib.StringValue(name), filter) {
+			return true
+		}
+		for _, id := range vpcIDs {
+			if awslib.StringValue(id) == awslib.StringValue(vpcID) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var marker *string
+	for {
+		classicELBs, err := c.elbClient.DescribeLoadBalancers(&awselb.DescribeLoadBalancersInput{Marker: marker})
+		if err != nil {
+			return nil, fmt.Errorf("Describe classic load balancers: %s", err)
+		}
+		for _, lb := range classicELBs.LoadBalancerDescriptions {
+			if matches(lb.LoadBalancerName, lb.VPCId) {
+				leftovers = append(leftovers, helpers.Leftover{Type: leftoverClassicELB, ID: awslib.StringValue(lb.LoadBalancerName)})
+			}
+		}
+
+		marker = classicELBs.NextMarker
+		if marker == nil {
+			break
+		}
+	}
+
+	for {
+		loadBalancers, err := c.elbv2Client.DescribeLoadBalancers(&elbv2.DescribeLoadBalancersInput{Marker: marker})
+		if err != nil {
+			return nil, fmt.Errorf("Describe load balancers: %s", err)
+		}
+		for _, lb := range loadBalancers.LoadBalancers {
+			if matches(lb.LoadBalancerName, lb.VpcId) {
+				leftovers = append(leftovers, helpers.Leftover{
+					Type: leftoverLoadBalancer,
+					ID:   awslib.StringValue(lb.LoadBalancerArn),
+					Name: awslib.StringValue(lb.LoadBalancerName),
+				})
+			}
+		}
+
+		marker = loadBalancers.NextMarker
+		if marker == nil {
+			break
+		}
+	}
+
+	return leftovers, nil
+}
+
+func (c Client) DeleteLeftover(l helpers.Leftover) error {
+	id := awslib.String(l.ID)
+
+	var err error
+	switch l.Type {
+	case leftoverInstance:
+		_, err = c.ec2Client.TerminateInstances(&awsec2.TerminateInstancesInput{InstanceIds: []*string{id}})
+		if err == nil {
+			err = c.ec2Client.WaitUntilInstanceTerminated(&awsec2.DescribeInstancesInput{InstanceIds: []*string{id}})
+		}
+	case leftoverClassicELB:
+		_, err = c.elbClient.DeleteLoadBalancer(&awselb.DeleteLoadBalancerInput{LoadBalancerName: id})
+		if err == nil {
+			err = c.waitForNetworkInterfaces("ELB " + l.ID)
+		}
+	case leftoverLoadBalancer:
+		_, err = c.elbv2Client.DeleteLoadBalancer(&elbv2.DeleteLoadBalancerInput{LoadBalancerArn: id})
+		if err == nil {
+			err = c.waitForNetworkInterfaces("ELB " + loadBalancerResourceID(l.ID))
+		}
+	case leftoverNATGateway:
+		_, err = c.ec2Client.DeleteNatGateway(&awsec2.DeleteNatGatewayInput{NatGatewayId: id})
+		if err == nil {
+			err = c.waitForNATGatewayDeleted(id)
+		}
+	case leftoverElasticIP:
+		_, err = c.ec2Client.ReleaseAddress(&awsec2.ReleaseAddressInput{AllocationId: id})
+	case leftoverSecurityGroup:
+		err = c.deleteSecurityGroup(id)
+	case leftoverSubnet:
+		_, err = c.ec2Client.DeleteSubnet(&awsec2.DeleteSubnetInput{SubnetId: id})
+	case leftoverRouteTable:
+		_, err = c.ec2Client.DeleteRouteTable(&awsec2.DeleteRouteTableInput{RouteTableId: id})
+	case leftoverInternetGateway:
+		err = c.deleteInternetGateway(id)
+	case leftoverVPC:
+		_, err = c.ec2Client.DeleteVpc(&awsec2.DeleteVpcInput{VpcId: id})
+	default:
+		return fmt.Errorf("Unknown leftover type %q", l.Type)
+	}
+
+	return err
+}
+
+// waitForNATGatewayDeleted waits for the NAT gateway to release its network
+// interface and elastic IP, which the subnet and the elastic IP need before
+// they can be deleted.
+func (c Client) waitForNATGatewayDeleted(id *string) error {
+	return poll(fmt.Sprintf("nat gateway %s to be deleted", awslib.StringValue(id)), func() (bool, error) {
+		natGateways, err := c.ec2Client.DescribeNatGateways(&awsec2.DescribeNatGatewaysInput{NatGatewayIds: []*string{id}})
+		if err != nil {
+			return false, err
+		}
+
+		for _, natGateway := range natGateways.NatGateways {
+			if awslib.StringValue(natGateway.State) != "deleted" {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+}
+
+// waitForNetworkInterfaces waits for the network interfaces a load balancer
+// leaves behind after it is deleted, which keep its security groups and
+// subnets from being deleted.
+func (c Client) waitForNetworkInterfaces(description string) error {
+	return poll(fmt.Sprintf("the network interfaces of %q to be deleted", description), func() (bool, error) {
+		networkInterfaces, err := c.ec2Client.DescribeNetworkInterfaces(&awsec2.DescribeNetworkInterfacesInput{
+			Filters: []*awsec2.Filter{{Name: awslib.String("description"), Values: []*string{awslib.String(description)}}},
+		})
+		if err != nil {
+			return false, err
+		}
+
+		return len(networkInterfaces.NetworkInterfaces) == 0, nil
+	})
+}
+
+func poll(waitingFor string, done func() (bool, error)) error {
+	for attempt := 0; attempt < leftoverPollAttempts; attempt++ {
+		ok, err := done()
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+
+		time.Sleep(leftoverPollInterval)
+	}
+
+	return fmt.Errorf("Timed out waiting for %s", waitingFor)
+}
+
+// deleteSecurityGroup revokes the ingress rules first, because the groups
+// bbl creates refer to each other and could otherwise never be deleted.
+func (c Client) deleteSecurityGroup(id *string) error {
+	groups, err := c.ec2Client.DescribeSecurityGroups(&awsec2.DescribeSecurityGroupsInput{GroupIds: []*string{id}})
+	if err != nil {
+		return err
+	}
+
+	for _, group := range groups.SecurityGroups {
+		if len(group.IpPermissions) == 0 {
+			continue
+		}
+
+		_, err = c.ec2Client.RevokeSecurityGroupIngress(&awsec2.RevokeSecurityGroupIngressInput{
+			GroupId:       id,
+			IpPermissions: group.IpPermissions,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = c.ec2Client.DeleteSecurityGroup(&awsec2.DeleteSecurityGroupInput{GroupId: id})
+	return err
+}
+
+func (c Client) deleteInternetGateway(id *string) error {
+	internetGateways, err := c.ec2Client.DescribeInternetGateways(&awsec2.DescribeInternetGatewaysInput{
+		InternetGatewayIds: []*string{id},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, internetGateway := range internetGateways.InternetGateways {
+		for _, attachment := range internetGateway.Attachments {
+			_, err = c.ec2Client.DetachInternetGateway(&awsec2.DetachInternetGatewayInput{
+				InternetGatewayId: id,
+				VpcId:             attachment.VpcId,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err = c.ec2Client.DeleteInternetGateway(&awsec2.DeleteInternetGatewayInput{InternetGatewayId: id})
+	return err
+}
+
+func nameTagFilter(filter string) []*awsec2.Filter {
+	return []*awsec2.Filter{{
+		Name:   awslib.String("tag:Name"),
+		Values: []*string{awslib.String(filter + "*")},
+	}}
+}
+
+func leftover(leftoverType string, id *string, tags []*awsec2.Tag) helpers.Leftover {
+	name := awslib.StringValue(id)
+	for _, tag := range tags {
+		if awslib.StringValue(tag.Key) == "Name" && awslib.StringValue(tag.Value) != "" {
+			name = awslib.StringValue(tag.Value)
+		}
+	}
+
+	return helpers.Leftover{Type: leftoverType, ID: awslib.StringValue(id), Name: name}
+}
+
+func elasticIP(allocationID, publicIP *string) helpers.Leftover {
+	return helpers.Leftover{Type: leftoverElasticIP, ID: awslib.StringValue(allocationID), Name: awslib.StringValue(publicIP)}
+}
+
+// loadBalancerResourceID returns the "app/name/id" or "net/name/id" part of
+// a load balancer ARN, which AWS puts in the description of its network
+// interfaces.
+func loadBalancerResourceID(arn string) string {
+	i := strings.Index(arn, loadBalancerARNResourceID)
+	if i < 0 {
+		return arn
+	}
+	return arn[i+len(loadBalancerARNResourceID):]
+}
+
+func isMainRouteTable(routeTable *awsec2.RouteTable) bool {
+	for _, association := range routeTable.Associations {
+		if awslib.BoolValue(association.Main) {
+			return true
+		}
+	}
+	return false
+}
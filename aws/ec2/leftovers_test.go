@@ -0,0 +1,270 @@
+package ec2_test
+
+import (
+	"errors"
+
+	"github.com/cloudfoundry/bosh-bootloader/aws/ec2"
+	"github.com/cloudfoundry/bosh-bootloader/aws/elbv2"
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/helpers"
+
+	awslib "github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	awselb "github.com/aws/aws-sdk-go/service/elb"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Leftovers", func() {
+	var (
+		client      ec2.Client
+		ec2Client   *fakes.AWSEC2Client
+		elbClient   *fakes.AWSELBClient
+		elbv2Client *fakes.AWSELBV2Client
+	)
+
+	nameTag := func(name string) []*awsec2.Tag {
+		return []*awsec2.Tag{{Key: awslib.String("Name"), Value: awslib.String(name)}}
+	}
+
+	BeforeEach(func() {
+		ec2Client = &fakes.AWSEC2Client{}
+		elbClient = &fakes.AWSELBClient{}
+		elbv2Client = &fakes.AWSELBV2Client{}
+		client = ec2.NewClientWithInjectedClients(ec2Client, elbClient, elbv2Client, &fakes.Logger{})
+	})
+
+	Describe("ListLeftovers", func() {
+		BeforeEach(func() {
+			ec2Client.DescribeInstancesCall.Returns.Output = &awsec2.DescribeInstancesOutput{
+				Reservations: []*awsec2.Reservation{{
+					Instances: []*awsec2.Instance{{InstanceId: awslib.String("i-1"), Tags: nameTag("bbl-env-jumpbox")}},
+				}},
+			}
+			ec2Client.DescribeSecurityGroupsCall.Returns.Output = &awsec2.DescribeSecurityGroupsOutput{
+				SecurityGroups: []*awsec2.SecurityGroup{{GroupId: awslib.String("sg-1"), Tags: nameTag("bbl-env-bosh-security-group")}},
+			}
+			ec2Client.DescribeSubnetsCall.Returns.Output = &awsec2.DescribeSubnetsOutput{
+				Subnets: []*awsec2.Subnet{{SubnetId: awslib.String("subnet-1"), Tags: nameTag("bbl-env-bosh-subnet")}},
+			}
+			ec2Client.DescribeVpcsCall.Returns.Output = &awsec2.DescribeVpcsOutput{
+				Vpcs: []*awsec2.Vpc{{VpcId: awslib.String("vpc-1"), Tags: nameTag("bbl-env-vpc")}},
+			}
+			ec2Client.DescribeRouteTablesCall.Returns.Output = &awsec2.DescribeRouteTablesOutput{
+				RouteTables: []*awsec2.RouteTable{
+					{RouteTableId: awslib.String("rtb-main"), Associations: []*awsec2.RouteTableAssociation{{Main: awslib.Bool(true)}}},
+					{RouteTableId: awslib.String("rtb-1")},
+				},
+			}
+			ec2Client.DescribeInternetGatewaysCall.Returns.Output = &awsec2.DescribeInternetGatewaysOutput{
+				InternetGateways: []*awsec2.InternetGateway{{InternetGatewayId: awslib.String("igw-1")}},
+			}
+			ec2Client.DescribeNatGatewaysCall.Returns.Output = &awsec2.DescribeNatGatewaysOutput{
+				NatGateways: []*awsec2.NatGateway{{
+					NatGatewayId:        awslib.String("nat-1"),
+					NatGatewayAddresses: []*awsec2.NatGatewayAddress{{AllocationId: awslib.String("eipalloc-nat"), PublicIp: awslib.String("1.2.3.4")}},
+				}},
+			}
+			ec2Client.DescribeAddressesCall.Returns.Output = &awsec2.DescribeAddressesOutput{
+				Addresses: []*awsec2.Address{{AllocationId: awslib.String("eipalloc-jumpbox"), PublicIp: awslib.String("5.6.7.8")}},
+			}
+			elbClient.DescribeLoadBalancersCall.Returns.Output = &awselb.DescribeLoadBalancersOutput{
+				LoadBalancerDescriptions: []*awselb.LoadBalancerDescription{
+					{LoadBalancerName: awslib.String("bbl-env-cf-ssh-lb"), VPCId: awslib.String("vpc-other")},
+					{LoadBalancerName: awslib.String("other-env-cf-ssh-lb"), VPCId: awslib.String("vpc-other")},
+				},
+			}
+			elbv2Client.DescribeLoadBalancersCall.Returns.Output = &elbv2.DescribeLoadBalancersOutput{
+				LoadBalancers: []*elbv2.LoadBalancer{
+					{LoadBalancerArn: awslib.String("arn-router"), LoadBalancerName: awslib.String("bbl-e-a1b2c3-cf-router-lb"), VpcId: awslib.String("vpc-1")},
+					{LoadBalancerArn: awslib.String("arn-other"), LoadBalancerName: awslib.String("other-env-cf-router-lb"), VpcId: awslib.String("vpc-other")},
+				},
+			}
+		})
+
+		It("returns the resources named after the filter in deletion order", func() {
+			leftovers, err := client.ListLeftovers("bbl-env")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(leftovers).To(Equal([]helpers.Leftover{
+				{Type: "instance", ID: "i-1", Name: "bbl-env-jumpbox"},
+				{Type: "classic load balancer", ID: "bbl-env-cf-ssh-lb"},
+				{Type: "load balancer", ID: "arn-router", Name: "bbl-e-a1b2c3-cf-router-lb"},
+				{Type: "nat gateway", ID: "nat-1", Name: "nat-1"},
+				{Type: "elastic ip", ID: "eipalloc-nat", Name: "1.2.3.4"},
+				{Type: "elastic ip", ID: "eipalloc-jumpbox", Name: "5.6.7.8"},
+				{Type: "security group", ID: "sg-1", Name: "bbl-env-bosh-security-group"},
+				{Type: "subnet", ID: "subnet-1", Name: "bbl-env-bosh-subnet"},
+				{Type: "route table", ID: "rtb-1", Name: "rtb-1"},
+				{Type: "internet gateway", ID: "igw-1", Name: "igw-1"},
+				{Type: "vpc", ID: "vpc-1", Name: "bbl-env-vpc"},
+			}))
+
+			Expect(ec2Client.DescribeSubnetsCall.Receives.Input.Filters).To(Equal([]*awsec2.Filter{{
+				Name:   awslib.String("tag:Name"),
+				Values: []*string{awslib.String("bbl-env*")},
+			}}))
+			Expect(ec2Client.DescribeInstancesCall.Receives.Input.Filters).To(HaveLen(2))
+			Expect(ec2Client.DescribeRouteTablesCall.Receives.Input.Filters).To(Equal([]*awsec2.Filter{{
+				Name:   awslib.String("vpc-id"),
+				Values: []*string{awslib.String("vpc-1")},
+			}}))
+			Expect(ec2Client.DescribeInternetGatewaysCall.Receives.Input.Filters).To(Equal([]*awsec2.Filter{{
+				Name:   awslib.String("attachment.vpc-id"),
+				Values: []*string{awslib.String("vpc-1")},
+			}}))
+			Expect(ec2Client.DescribeNatGatewaysCall.Receives.Input.Filter).To(ContainElement(&awsec2.Filter{
+				Name:   awslib.String("subnet-id"),
+				Values: []*string{awslib.String("subnet-1")},
+			}))
+			Expect(ec2Client.DescribeAddressesCall.Receives.Input.Filters).To(Equal([]*awsec2.Filter{{
+				Name:   awslib.String("instance-id"),
+				Values: []*string{awslib.String("i-1")},
+			}}))
+		})
+
+		Context("when there is no vpc", func() {
+			It("does not look for route tables or internet gateways", func() {
+				ec2Client.DescribeVpcsCall.Returns.Output = &awsec2.DescribeVpcsOutput{}
+
+				leftovers, err := client.ListLeftovers("bbl-env")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(leftovers).To(HaveLen(7))
+				Expect(ec2Client.DescribeRouteTablesCall.CallCount).To(Equal(0))
+				Expect(ec2Client.DescribeInternetGatewaysCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("when describing fails", func() {
+			It("returns an error", func() {
+				ec2Client.DescribeSubnetsCall.Returns.Error = errors.New("throttled")
+
+				_, err := client.ListLeftovers("bbl-env")
+				Expect(err).To(MatchError("Describe subnets: throttled"))
+			})
+		})
+	})
+
+	Describe("DeleteLeftover", func() {
+		BeforeEach(func() {
+			ec2Client.DescribeNetworkInterfacesCall.Returns.Output = &awsec2.DescribeNetworkInterfacesOutput{}
+			ec2Client.DescribeNatGatewaysCall.Returns.Output = &awsec2.DescribeNatGatewaysOutput{
+				NatGateways: []*awsec2.NatGateway{{NatGatewayId: awslib.String("nat-1"), State: awslib.String("deleted")}},
+			}
+			ec2.SetLeftoverPollInterval(0)
+		})
+
+		AfterEach(func() {
+			ec2.ResetLeftoverPollInterval()
+		})
+
+		It("deletes classic load balancers and waits for their network interfaces", func() {
+			err := client.DeleteLeftover(helpers.Leftover{Type: "classic load balancer", ID: "bbl-env-cf-ssh-lb"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(elbClient.DeleteLoadBalancerCall.Receives.Input.LoadBalancerName).To(Equal(awslib.String("bbl-env-cf-ssh-lb")))
+			Expect(ec2Client.DescribeNetworkInterfacesCall.Receives.Input.Filters).To(Equal([]*awsec2.Filter{{
+				Name:   awslib.String("description"),
+				Values: []*string{awslib.String("ELB bbl-env-cf-ssh-lb")},
+			}}))
+		})
+
+		It("deletes application and network load balancers and waits for their network interfaces", func() {
+			arn := "arn:aws:elasticloadbalancing:us-east-1:123:loadbalancer/net/bbl-env-cf-ssh-lb/abc"
+			err := client.DeleteLeftover(helpers.Leftover{Type: "load balancer", ID: arn})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(elbv2Client.DeleteLoadBalancerCall.Receives.Input.LoadBalancerArn).To(Equal(awslib.String(arn)))
+			Expect(ec2Client.DescribeNetworkInterfacesCall.Receives.Input.Filters[0].Values).To(Equal([]*string{awslib.String("ELB net/bbl-env-cf-ssh-lb/abc")}))
+		})
+
+		It("deletes nat gateways and waits until they are deleted", func() {
+			err := client.DeleteLeftover(helpers.Leftover{Type: "nat gateway", ID: "nat-1"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ec2Client.DeleteNatGatewayCall.Receives.Input.NatGatewayId).To(Equal(awslib.String("nat-1")))
+			Expect(ec2Client.DescribeNatGatewaysCall.Receives.Input.NatGatewayIds).To(Equal([]*string{awslib.String("nat-1")}))
+		})
+
+		It("gives up when a nat gateway is not deleted in time", func() {
+			ec2Client.DescribeNatGatewaysCall.Returns.Output.NatGateways[0].State = awslib.String("deleting")
+
+			err := client.DeleteLeftover(helpers.Leftover{Type: "nat gateway", ID: "nat-1"})
+			Expect(err).To(MatchError("Timed out waiting for nat gateway nat-1 to be deleted"))
+			Expect(ec2Client.DescribeNatGatewaysCall.CallCount).To(Equal(60))
+		})
+
+		It("releases elastic ips", func() {
+			err := client.DeleteLeftover(helpers.Leftover{Type: "elastic ip", ID: "eipalloc-1"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ec2Client.ReleaseAddressCall.Receives.Input.AllocationId).To(Equal(awslib.String("eipalloc-1")))
+		})
+
+		It("terminates instances and waits for them", func() {
+			err := client.DeleteLeftover(helpers.Leftover{Type: "instance", ID: "i-1"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ec2Client.TerminateInstancesCall.Receives.Input.InstanceIds).To(Equal([]*string{awslib.String("i-1")}))
+			Expect(ec2Client.WaitUntilInstanceTerminatedCall.Receives.Input.InstanceIds).To(Equal([]*string{awslib.String("i-1")}))
+		})
+
+		It("revokes the ingress rules of security groups before deleting them", func() {
+			permissions := []*awsec2.IpPermission{{IpProtocol: awslib.String("-1")}}
+			ec2Client.DescribeSecurityGroupsCall.Returns.Output = &awsec2.DescribeSecurityGroupsOutput{
+				SecurityGroups: []*awsec2.SecurityGroup{{GroupId: awslib.String("sg-1"), IpPermissions: permissions}},
+			}
+
+			err := client.DeleteLeftover(helpers.Leftover{Type: "security group", ID: "sg-1"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ec2Client.RevokeSecurityGroupIngressCall.Receives.Input).To(Equal(&awsec2.RevokeSecurityGroupIngressInput{
+				GroupId:       awslib.String("sg-1"),
+				IpPermissions: permissions,
+			}))
+			Expect(ec2Client.DeleteSecurityGroupCall.Receives.Input.GroupId).To(Equal(awslib.String("sg-1")))
+		})
+
+		It("detaches internet gateways before deleting them", func() {
+			ec2Client.DescribeInternetGatewaysCall.Returns.Output = &awsec2.DescribeInternetGatewaysOutput{
+				InternetGateways: []*awsec2.InternetGateway{{
+					InternetGatewayId: awslib.String("igw-1"),
+					Attachments:       []*awsec2.InternetGatewayAttachment{{VpcId: awslib.String("vpc-1")}},
+				}},
+			}
+
+			err := client.DeleteLeftover(helpers.Leftover{Type: "internet gateway", ID: "igw-1"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ec2Client.DetachInternetGatewayCall.Receives.Input).To(Equal(&awsec2.DetachInternetGatewayInput{
+				InternetGatewayId: awslib.String("igw-1"),
+				VpcId:             awslib.String("vpc-1"),
+			}))
+			Expect(ec2Client.DeleteInternetGatewayCall.Receives.Input.InternetGatewayId).To(Equal(awslib.String("igw-1")))
+		})
+
+		It("deletes subnets, route tables and vpcs", func() {
+			Expect(client.DeleteLeftover(helpers.Leftover{Type: "subnet", ID: "subnet-1"})).To(Succeed())
+			Expect(client.DeleteLeftover(helpers.Leftover{Type: "route table", ID: "rtb-1"})).To(Succeed())
+			Expect(client.DeleteLeftover(helpers.Leftover{Type: "vpc", ID: "vpc-1"})).To(Succeed())
+
+			Expect(ec2Client.DeleteSubnetCall.Receives.Input.SubnetId).To(Equal(awslib.String("subnet-1")))
+			Expect(ec2Client.DeleteRouteTableCall.Receives.Input.RouteTableId).To(Equal(awslib.String("rtb-1")))
+			Expect(ec2Client.DeleteVpcCall.Receives.Input.VpcId).To(Equal(awslib.String("vpc-1")))
+		})
+
+		It("returns the error from aws", func() {
+			ec2Client.DeleteVpcCall.Returns.Error = errors.New("DependencyViolation")
+
+			err := client.DeleteLeftover(helpers.Leftover{Type: "vpc", ID: "vpc-1"})
+			Expect(err).To(MatchError("DependencyViolation"))
+		})
+
+		It("returns an error for unknown types", func() {
+			err := client.DeleteLeftover(helpers.Leftover{Type: "key pair", ID: "some-key"})
+			Expect(err).To(MatchError(`Unknown leftover type "key pair"`))
+		})
+	})
+})
@@ -0,0 +1,110 @@
+// Package elbv2 is a client for the two Elastic Load Balancing v2 calls that
+// bbl needs to find and delete application and network load balancers. The
+// elbv2 package of aws-sdk-go is not vendored, so the client is built on the
+// SDK's query protocol the same way the generated service clients are.
+package elbv2
+
+import (
+	"github.com/cloudfoundry/bosh-bootloader/aws"
+
+	awslib "github.com/aws/aws-sdk-go/aws"
+	awsclient "github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/aws/aws-sdk-go/private/protocol/query"
+)
+
+const (
+	serviceName = "elasticloadbalancing"
+	apiVersion  = "2015-12-01"
+)
+
+type Client interface {
+	DescribeLoadBalancers(*DescribeLoadBalancersInput) (*DescribeLoadBalancersOutput, error)
+	DeleteLoadBalancer(*DeleteLoadBalancerInput) (*DeleteLoadBalancerOutput, error)
+}
+
+type DescribeLoadBalancersInput struct {
+	_ struct{} `type:"structure"`
+
+	LoadBalancerArns []*string `type:"list"`
+	Marker           *string   `type:"string"`
+}
+
+type DescribeLoadBalancersOutput struct {
+	_ struct{} `type:"structure"`
+
+	LoadBalancers []*LoadBalancer `type:"list"`
+	NextMarker    *string         `type:"string"`
+}
+
+type LoadBalancer struct {
+	_ struct{} `type:"structure"`
+
+	LoadBalancerArn  *string `type:"string"`
+	LoadBalancerName *string `type:"string"`
+	Type             *string `type:"string"`
+	VpcId            *string `type:"string"`
+}
+
+type DeleteLoadBalancerInput struct {
+	_ struct{} `type:"structure"`
+
+	LoadBalancerArn *string `type:"string" required:"true"`
+}
+
+type DeleteLoadBalancerOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+type client struct {
+	*awsclient.Client
+}
+
+func NewClient(config aws.Config) Client {
+	return newClient(config.ClientConfig())
+}
+
+func newClient(config *awslib.Config) client {
+	c := session.New(config).ClientConfig(serviceName)
+
+	svc := client{
+		Client: awsclient.New(*c.Config, metadata.ClientInfo{
+			ServiceName:   serviceName,
+			SigningName:   c.SigningName,
+			SigningRegion: c.SigningRegion,
+			Endpoint:      c.Endpoint,
+			APIVersion:    apiVersion,
+		}, c.Handlers),
+	}
+
+	svc.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	svc.Handlers.Build.PushBackNamed(query.BuildHandler)
+	svc.Handlers.Unmarshal.PushBackNamed(query.UnmarshalHandler)
+	svc.Handlers.UnmarshalMeta.PushBackNamed(query.UnmarshalMetaHandler)
+	svc.Handlers.UnmarshalError.PushBackNamed(query.UnmarshalErrorHandler)
+
+	return svc
+}
+
+func (c client) DescribeLoadBalancers(input *DescribeLoadBalancersInput) (*DescribeLoadBalancersOutput, error) {
+	output := &DescribeLoadBalancersOutput{}
+	err := c.send("DescribeLoadBalancers", input, output)
+	return output, err
+}
+
+func (c client) DeleteLoadBalancer(input *DeleteLoadBalancerInput) (*DeleteLoadBalancerOutput, error) {
+	output := &DeleteLoadBalancerOutput{}
+	err := c.send("DeleteLoadBalancer", input, output)
+	return output, err
+}
+
+func (c client) send(operation string, input, output interface{}) error {
+	return c.NewRequest(&request.Operation{
+		Name:       operation,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}, input, output).Send()
+}
@@ -0,0 +1,92 @@
+package elbv2_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	"github.com/cloudfoundry/bosh-bootloader/aws/elbv2"
+
+	awslib "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Client", func() {
+	var (
+		server   *httptest.Server
+		client   elbv2.Client
+		form     url.Values
+		response string
+	)
+
+	BeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := ioutil.ReadAll(r.Body)
+			Expect(err).NotTo(HaveOccurred())
+			form, err = url.ParseQuery(string(body))
+			Expect(err).NotTo(HaveOccurred())
+
+			w.Write([]byte(response))
+		}))
+
+		client = elbv2.NewClientWithAWSConfig(&awslib.Config{
+			Credentials: credentials.NewStaticCredentials("some-access-key-id", "some-secret-access-key", ""),
+			Region:      awslib.String("some-region"),
+			Endpoint:    awslib.String(server.URL),
+		})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("DescribeLoadBalancers", func() {
+		It("lists the load balancers", func() {
+			response = `<DescribeLoadBalancersResponse xmlns="http://elasticloadbalancing.amazonaws.com/doc/2015-12-01/">
+  <DescribeLoadBalancersResult>
+    <LoadBalancers>
+      <member>
+        <LoadBalancerArn>some-arn</LoadBalancerArn>
+        <LoadBalancerName>bbl-env-cf-ssh-lb</LoadBalancerName>
+        <Type>network</Type>
+        <VpcId>vpc-1</VpcId>
+      </member>
+    </LoadBalancers>
+    <NextMarker>some-marker</NextMarker>
+  </DescribeLoadBalancersResult>
+</DescribeLoadBalancersResponse>`
+
+			output, err := client.DescribeLoadBalancers(&elbv2.DescribeLoadBalancersInput{Marker: awslib.String("previous-marker")})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(form.Get("Action")).To(Equal("DescribeLoadBalancers"))
+			Expect(form.Get("Version")).To(Equal("2015-12-01"))
+			Expect(form.Get("Marker")).To(Equal("previous-marker"))
+
+			Expect(output.LoadBalancers).To(HaveLen(1))
+			Expect(output.LoadBalancers[0].LoadBalancerArn).To(Equal(awslib.String("some-arn")))
+			Expect(output.LoadBalancers[0].LoadBalancerName).To(Equal(awslib.String("bbl-env-cf-ssh-lb")))
+			Expect(output.LoadBalancers[0].Type).To(Equal(awslib.String("network")))
+			Expect(output.LoadBalancers[0].VpcId).To(Equal(awslib.String("vpc-1")))
+			Expect(output.NextMarker).To(Equal(awslib.String("some-marker")))
+		})
+	})
+
+	Describe("DeleteLoadBalancer", func() {
+		It("deletes the load balancer", func() {
+			response = `<DeleteLoadBalancerResponse xmlns="http://elasticloadbalancing.amazonaws.com/doc/2015-12-01/">
+  <DeleteLoadBalancerResult/>
+</DeleteLoadBalancerResponse>`
+
+			_, err := client.DeleteLoadBalancer(&elbv2.DeleteLoadBalancerInput{LoadBalancerArn: awslib.String("some-arn")})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(form.Get("Action")).To(Equal("DeleteLoadBalancer"))
+			Expect(form.Get("LoadBalancerArn")).To(Equal("some-arn"))
+		})
+	})
+})
@@ -0,0 +1,7 @@
+package elbv2
+
+import awslib "github.com/aws/aws-sdk-go/aws"
+
+func NewClientWithAWSConfig(config *awslib.Config) Client {
+	return newClient(config)
+}
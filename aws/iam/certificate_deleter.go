@@ -1,10 +1,16 @@
 package iam
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/aws/aws-sdk-go/aws"
 	awsiam "github.com/aws/aws-sdk-go/service/iam"
+	"github.com/cloudfoundry/bosh-bootloader/helpers"
 )
 
+const leftoverServerCertificate = "server certificate"
+
 type CertificateDeleter struct {
 	client Client
 }
@@ -25,3 +31,38 @@ func (c CertificateDeleter) Delete(certificateName string) error {
 	})
 	return err
 }
+
+// ListLeftovers finds the server certificates whose name starts with filter.
+// Load balancer certificates are named after a shortened env ID, so a long
+// filter may miss them.
+func (c CertificateDeleter) ListLeftovers(filter string) ([]helpers.Leftover, error) {
+	var leftovers []helpers.Leftover
+
+	input := &awsiam.ListServerCertificatesInput{}
+	for {
+		output, err := c.client.ListServerCertificates(input)
+		if err != nil {
+			return nil, fmt.Errorf("List server certificates: %s", err)
+		}
+
+		for _, certificate := range output.ServerCertificateMetadataList {
+			name := aws.StringValue(certificate.ServerCertificateName)
+			if strings.HasPrefix(name, filter) {
+				leftovers = append(leftovers, helpers.Leftover{Type: leftoverServerCertificate, ID: name, Name: name})
+			}
+		}
+
+		if !aws.BoolValue(output.IsTruncated) {
+			return leftovers, nil
+		}
+		input.Marker = output.Marker
+	}
+}
+
+func (c CertificateDeleter) DeleteLeftover(leftover helpers.Leftover) error {
+	if leftover.Type != leftoverServerCertificate {
+		return fmt.Errorf("Unknown leftover type %q", leftover.Type)
+	}
+
+	return c.Delete(leftover.ID)
+}
@@ -7,6 +7,7 @@ import (
 	awsiam "github.com/aws/aws-sdk-go/service/iam"
 	"github.com/cloudfoundry/bosh-bootloader/aws/iam"
 	"github.com/cloudfoundry/bosh-bootloader/aws/iam/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/helpers"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -46,4 +47,47 @@ var _ = Describe("CertificateDeleter", func() {
 			})
 		})
 	})
+
+	Describe("ListLeftovers", func() {
+		It("returns the certificates starting with the filter from every page", func() {
+			iamClient.ListServerCertificatesReturnsOnCall(0, &awsiam.ListServerCertificatesOutput{
+				ServerCertificateMetadataList: []*awsiam.ServerCertificateMetadata{
+					{ServerCertificateName: aws.String("some-env-abc")},
+					{ServerCertificateName: aws.String("other-env-abc")},
+				},
+				IsTruncated: aws.Bool(true),
+				Marker:      aws.String("some-marker"),
+			}, nil)
+			iamClient.ListServerCertificatesReturnsOnCall(1, &awsiam.ListServerCertificatesOutput{
+				ServerCertificateMetadataList: []*awsiam.ServerCertificateMetadata{
+					{ServerCertificateName: aws.String("some-env-def")},
+				},
+			}, nil)
+
+			leftovers, err := deleter.ListLeftovers("some-env")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(leftovers).To(Equal([]helpers.Leftover{
+				{Type: "server certificate", ID: "some-env-abc", Name: "some-env-abc"},
+				{Type: "server certificate", ID: "some-env-def", Name: "some-env-def"},
+			}))
+			Expect(iamClient.ListServerCertificatesArgsForCall(1).Marker).To(Equal(aws.String("some-marker")))
+		})
+
+		It("returns an error when listing fails", func() {
+			iamClient.ListServerCertificatesReturns(nil, errors.New("access denied"))
+
+			_, err := deleter.ListLeftovers("some-env")
+			Expect(err).To(MatchError("List server certificates: access denied"))
+		})
+	})
+
+	Describe("DeleteLeftover", func() {
+		It("deletes the certificate", func() {
+			err := deleter.DeleteLeftover(helpers.Leftover{Type: "server certificate", ID: "some-env-abc", Name: "some-env-abc"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(iamClient.DeleteServerCertificateArgsForCall(0).ServerCertificateName).To(Equal(aws.String("some-env-abc")))
+		})
+	})
 })
@@ -48,6 +48,19 @@ type Client struct {
 		result1 *awsiam.DeleteUserPolicyOutput
 		result2 error
 	}
+	ListServerCertificatesStub        func(*awsiam.ListServerCertificatesInput) (*awsiam.ListServerCertificatesOutput, error)
+	listServerCertificatesMutex       sync.RWMutex
+	listServerCertificatesArgsForCall []struct {
+		arg1 *awsiam.ListServerCertificatesInput
+	}
+	listServerCertificatesReturns struct {
+		result1 *awsiam.ListServerCertificatesOutput
+		result2 error
+	}
+	listServerCertificatesReturnsOnCall map[int]struct {
+		result1 *awsiam.ListServerCertificatesOutput
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -205,6 +218,57 @@ func (fake *Client) DeleteUserPolicyReturnsOnCall(i int, result1 *awsiam.DeleteU
 	}{result1, result2}
 }
 
+func (fake *Client) ListServerCertificates(arg1 *awsiam.ListServerCertificatesInput) (*awsiam.ListServerCertificatesOutput, error) {
+	fake.listServerCertificatesMutex.Lock()
+	ret, specificReturn := fake.listServerCertificatesReturnsOnCall[len(fake.listServerCertificatesArgsForCall)]
+	fake.listServerCertificatesArgsForCall = append(fake.listServerCertificatesArgsForCall, struct {
+		arg1 *awsiam.ListServerCertificatesInput
+	}{arg1})
+	fake.recordInvocation("ListServerCertificates", []interface{}{arg1})
+	fake.listServerCertificatesMutex.Unlock()
+	if fake.ListServerCertificatesStub != nil {
+		return fake.ListServerCertificatesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.listServerCertificatesReturns.result1, fake.listServerCertificatesReturns.result2
+}
+
+func (fake *Client) ListServerCertificatesCallCount() int {
+	fake.listServerCertificatesMutex.RLock()
+	defer fake.listServerCertificatesMutex.RUnlock()
+	return len(fake.listServerCertificatesArgsForCall)
+}
+
+func (fake *Client) ListServerCertificatesArgsForCall(i int) *awsiam.ListServerCertificatesInput {
+	fake.listServerCertificatesMutex.RLock()
+	defer fake.listServerCertificatesMutex.RUnlock()
+	return fake.listServerCertificatesArgsForCall[i].arg1
+}
+
+func (fake *Client) ListServerCertificatesReturns(result1 *awsiam.ListServerCertificatesOutput, result2 error) {
+	fake.ListServerCertificatesStub = nil
+	fake.listServerCertificatesReturns = struct {
+		result1 *awsiam.ListServerCertificatesOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Client) ListServerCertificatesReturnsOnCall(i int, result1 *awsiam.ListServerCertificatesOutput, result2 error) {
+	fake.ListServerCertificatesStub = nil
+	if fake.listServerCertificatesReturnsOnCall == nil {
+		fake.listServerCertificatesReturnsOnCall = make(map[int]struct {
+			result1 *awsiam.ListServerCertificatesOutput
+			result2 error
+		})
+	}
+	fake.listServerCertificatesReturnsOnCall[i] = struct {
+		result1 *awsiam.ListServerCertificatesOutput
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *Client) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -214,6 +278,8 @@ func (fake *Client) Invocations() map[string][][]interface{} {
 	defer fake.deleteServerCertificateMutex.RUnlock()
 	fake.deleteUserPolicyMutex.RLock()
 	defer fake.deleteUserPolicyMutex.RUnlock()
+	fake.listServerCertificatesMutex.RLock()
+	defer fake.listServerCertificatesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/Azure/azure-sdk-for-go/arm/compute"
+	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
 	"github.com/Azure/go-autorest/autorest"
 )
 
@@ -19,6 +20,9 @@ type AzureVMsClient interface {
 
 type AzureGroupsClient interface {
 	CheckExistence(resourceGroupName string) (autorest.Response, error)
+	List(filter string, top *int32) (resources.GroupListResult, error)
+	ListNextResults(lastResults resources.GroupListResult) (resources.GroupListResult, error)
+	Delete(resourceGroupName string, cancel <-chan struct{}) (<-chan autorest.Response, <-chan error)
 }
 
 func (c Client) CheckExists(envID string) (bool, error) {
@@ -36,6 +40,20 @@ func (c Client) CheckExists(envID string) (bool, error) {
 	return false, nil
 }
 
+// CheckPermissions looks up a resource group. It only shows that the service
+// principal authenticates and can read resources in the subscription;
+// whether it may create them is not checked.
+func (c Client) CheckPermissions() error {
+	resourceGroupName := "bbl-permission-check"
+
+	_, err := c.azureGroupsClient.CheckExistence(resourceGroupName)
+	if err != nil {
+		return fmt.Errorf("Check existence for resource group %s: %s", resourceGroupName, err)
+	}
+
+	return nil
+}
+
 func (c Client) ValidateSafeToDelete(networkName string, envID string) error {
 	resourceGroupName := fmt.Sprintf("%s-bosh", envID)
 
@@ -65,6 +83,13 @@ func (c Client) ValidateSafeToDelete(networkName string, envID string) error {
 	return nil
 }
 
+// ValidateSafeToDeleteSubnets checks the VMs in the environment's resource
+// group, like ValidateSafeToDelete. bbl puts its VMs there even when the
+// virtual network belongs to someone else.
+func (c Client) ValidateSafeToDeleteSubnets(subnets []string, envID string) error {
+	return c.ValidateSafeToDelete("", envID)
+}
+
 func getOrEmpty(value *string) string {
 	if value == nil {
 		return ""
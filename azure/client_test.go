@@ -62,6 +62,34 @@ var _ = Describe("Client", func() {
 		})
 	})
 
+	Describe("CheckPermissions", func() {
+		var (
+			azureClient *fakes.AzureGroupsClient
+			client      azure.Client
+		)
+
+		BeforeEach(func() {
+			azureClient = &fakes.AzureGroupsClient{}
+			client = azure.NewClientWithInjectedGroupsClient(azureClient)
+
+			azureClient.CheckExistenceCall.Returns.Response = autorest.Response{
+				Response: mocks.NewResponseWithStatus("some-message", 404),
+			}
+		})
+
+		It("looks up a resource group", func() {
+			err := client.CheckPermissions()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns an error when the resource group cannot be looked up", func() {
+			azureClient.CheckExistenceCall.Returns.Error = errors.New("forbidden")
+
+			err := client.CheckPermissions()
+			Expect(err).To(MatchError("Check existence for resource group bbl-permission-check: forbidden"))
+		})
+	})
+
 	Describe("ValidateSafeToDelete", func() {
 		var (
 			azureClient *fakes.AzureVMsClient
@@ -0,0 +1,52 @@
+package azure
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cloudfoundry/bosh-bootloader/helpers"
+)
+
+const leftoverResourceGroup = "resource group"
+
+// ListLeftovers finds the resource groups whose name starts with filter.
+// Everything bbl creates on Azure lives in the environment's resource group,
+// so deleting the group deletes the rest.
+func (c Client) ListLeftovers(filter string) ([]helpers.Leftover, error) {
+	groups, err := c.azureGroupsClient.List("", nil)
+	if err != nil {
+		return nil, fmt.Errorf("List resource groups: %s", err)
+	}
+
+	var leftovers []helpers.Leftover
+	for {
+		if groups.Value != nil {
+			for _, group := range *groups.Value {
+				name := getOrEmpty(group.Name)
+				if strings.HasPrefix(name, filter) {
+					leftovers = append(leftovers, helpers.Leftover{Type: leftoverResourceGroup, ID: name, Name: name})
+				}
+			}
+		}
+
+		if getOrEmpty(groups.NextLink) == "" {
+			break
+		}
+
+		groups, err = c.azureGroupsClient.ListNextResults(groups)
+		if err != nil {
+			return nil, fmt.Errorf("List resource groups: %s", err)
+		}
+	}
+
+	return leftovers, nil
+}
+
+func (c Client) DeleteLeftover(leftover helpers.Leftover) error {
+	if leftover.Type != leftoverResourceGroup {
+		return fmt.Errorf("Unknown leftover type %q", leftover.Type)
+	}
+
+	_, errs := c.azureGroupsClient.Delete(leftover.ID, nil)
+	return <-errs
+}
@@ -0,0 +1,78 @@
+package azure_test
+
+import (
+	"errors"
+
+	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
+	"github.com/cloudfoundry/bosh-bootloader/azure"
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/helpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Leftovers", func() {
+	var (
+		azureClient *fakes.AzureGroupsClient
+		client      azure.Client
+	)
+
+	group := func(name string) resources.Group {
+		return resources.Group{Name: &name}
+	}
+
+	BeforeEach(func() {
+		azureClient = &fakes.AzureGroupsClient{}
+		client = azure.NewClientWithInjectedGroupsClient(azureClient)
+	})
+
+	Describe("ListLeftovers", func() {
+		It("returns the resource groups named after the filter across pages", func() {
+			nextLink := "some-next-link"
+			azureClient.ListCall.Returns.Result = resources.GroupListResult{
+				Value:    &[]resources.Group{group("bbl-env-bosh"), group("other-group")},
+				NextLink: &nextLink,
+			}
+			azureClient.ListNextResultsCall.Returns.Result = resources.GroupListResult{
+				Value: &[]resources.Group{group("bbl-env-storage")},
+			}
+
+			leftovers, err := client.ListLeftovers("bbl-env")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(leftovers).To(Equal([]helpers.Leftover{
+				{Type: "resource group", ID: "bbl-env-bosh", Name: "bbl-env-bosh"},
+				{Type: "resource group", ID: "bbl-env-storage", Name: "bbl-env-storage"},
+			}))
+			Expect(azureClient.ListNextResultsCall.CallCount).To(Equal(1))
+		})
+
+		It("returns an error when listing fails", func() {
+			azureClient.ListCall.Returns.Error = errors.New("unauthorized")
+
+			_, err := client.ListLeftovers("bbl-env")
+			Expect(err).To(MatchError("List resource groups: unauthorized"))
+		})
+	})
+
+	Describe("DeleteLeftover", func() {
+		It("deletes the resource group and waits for it", func() {
+			err := client.DeleteLeftover(helpers.Leftover{Type: "resource group", ID: "bbl-env-bosh"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(azureClient.DeleteCall.Receives.ResourceGroup).To(Equal("bbl-env-bosh"))
+		})
+
+		It("returns the error from azure", func() {
+			azureClient.DeleteCall.Returns.Error = errors.New("conflict")
+
+			err := client.DeleteLeftover(helpers.Leftover{Type: "resource group", ID: "bbl-env-bosh"})
+			Expect(err).To(MatchError("conflict"))
+		})
+
+		It("returns an error for unknown types", func() {
+			err := client.DeleteLeftover(helpers.Leftover{Type: "vm", ID: "some-vm"})
+			Expect(err).To(MatchError(`Unknown leftover type "vm"`))
+		})
+	})
+})
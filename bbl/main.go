@@ -11,6 +11,7 @@ import (
 	"github.com/cloudfoundry/bosh-bootloader/application"
 	"github.com/cloudfoundry/bosh-bootloader/aws"
 	"github.com/cloudfoundry/bosh-bootloader/aws/ec2"
+	"github.com/cloudfoundry/bosh-bootloader/aws/iam"
 	"github.com/cloudfoundry/bosh-bootloader/azure"
 	"github.com/cloudfoundry/bosh-bootloader/bosh"
 	"github.com/cloudfoundry/bosh-bootloader/certs"
@@ -19,6 +20,9 @@ import (
 	"github.com/cloudfoundry/bosh-bootloader/config"
 	"github.com/cloudfoundry/bosh-bootloader/gcp"
 	"github.com/cloudfoundry/bosh-bootloader/helpers"
+	"github.com/cloudfoundry/bosh-bootloader/openstack"
+	"github.com/cloudfoundry/bosh-bootloader/runtimeconfig"
+	"github.com/cloudfoundry/bosh-bootloader/ssh"
 	"github.com/cloudfoundry/bosh-bootloader/storage"
 	"github.com/cloudfoundry/bosh-bootloader/terraform"
 	proxy "github.com/cloudfoundry/socks5-proxy"
@@ -26,9 +30,13 @@ import (
 	awscloudconfig "github.com/cloudfoundry/bosh-bootloader/cloudconfig/aws"
 	azurecloudconfig "github.com/cloudfoundry/bosh-bootloader/cloudconfig/azure"
 	gcpcloudconfig "github.com/cloudfoundry/bosh-bootloader/cloudconfig/gcp"
+	openstackcloudconfig "github.com/cloudfoundry/bosh-bootloader/cloudconfig/openstack"
+	vspherecloudconfig "github.com/cloudfoundry/bosh-bootloader/cloudconfig/vsphere"
 	awsterraform "github.com/cloudfoundry/bosh-bootloader/terraform/aws"
 	azureterraform "github.com/cloudfoundry/bosh-bootloader/terraform/azure"
 	gcpterraform "github.com/cloudfoundry/bosh-bootloader/terraform/gcp"
+	openstackterraform "github.com/cloudfoundry/bosh-bootloader/terraform/openstack"
+	vsphereterraform "github.com/cloudfoundry/bosh-bootloader/terraform/vsphere"
 )
 
 var Version string
@@ -58,6 +66,7 @@ func main() {
 	stateStore := storage.NewStore(appConfig.Global.StateDir)
 	stateValidator := application.NewStateValidator(appConfig.Global.StateDir)
 	certificateValidator := certs.NewValidator()
+	certificateStatusReporter := certs.NewStatusReporter()
 
 	// Terraform
 	terraformOutputBuffer := bytes.NewBuffer([]byte{})
@@ -67,20 +76,25 @@ func main() {
 	var (
 		networkClient            helpers.NetworkClient
 		networkDeletionValidator commands.NetworkDeletionValidator
+		permissionChecker        commands.PermissionChecker
+		leftoverCleaners         []commands.LeftoverCleaner
 
 		gcpClient                 gcp.Client
 		availabilityZoneRetriever ec2.AvailabilityZoneRetriever
 	)
 	if appConfig.State.IAAS == "aws" && needsIAASCreds {
-		awsClient := ec2.NewClient(aws.Config{
+		awsConfig := aws.Config{
 			AccessKeyID:     appConfig.State.AWS.AccessKeyID,
 			SecretAccessKey: appConfig.State.AWS.SecretAccessKey,
 			Region:          appConfig.State.AWS.Region,
-		}, logger)
+		}
+		awsClient := ec2.NewClient(awsConfig, logger)
 
 		availabilityZoneRetriever = awsClient
 		networkDeletionValidator = awsClient
+		permissionChecker = awsClient
 		networkClient = awsClient
+		leftoverCleaners = []commands.LeftoverCleaner{awsClient, iam.NewCertificateDeleter(iam.NewClient(awsConfig))}
 	} else if appConfig.State.IAAS == "gcp" && needsIAASCreds {
 		gcpClient, err = gcp.NewClient(appConfig.State.GCP, "")
 		if err != nil {
@@ -88,7 +102,9 @@ func main() {
 		}
 
 		networkDeletionValidator = gcpClient
+		permissionChecker = gcpClient
 		networkClient = gcpClient
+		leftoverCleaners = []commands.LeftoverCleaner{gcpClient}
 
 		gcpZonerHack := config.NewGCPZonerHack(gcpClient)
 		stateWithZones, err := gcpZonerHack.SetZones(appConfig.State)
@@ -103,7 +119,23 @@ func main() {
 		}
 
 		networkDeletionValidator = azureClient
+		permissionChecker = azureClient
 		networkClient = azureClient
+		leftoverCleaners = []commands.LeftoverCleaner{azureClient}
+	} else if appConfig.State.IAAS == "openstack" && needsIAASCreds {
+		openstackClient, err := openstack.NewClient(appConfig.State.OpenStack)
+		if err != nil {
+			log.Fatalf("\n\n%s\n", err)
+		}
+
+		networkDeletionValidator = openstackClient
+		permissionChecker = openstackClient
+		networkClient = openstackClient
+	}
+
+	var credentialsErr error
+	if appConfig.Command == "doctor" && !appConfig.ShowCommandHelp {
+		permissionChecker, credentialsErr = doctorPermissionChecker(appConfig.State, logger)
 	}
 
 	var (
@@ -121,6 +153,12 @@ func main() {
 	case "gcp":
 		templateGenerator = gcpterraform.NewTemplateGenerator()
 		inputGenerator = gcpterraform.NewInputGenerator()
+	case "openstack":
+		templateGenerator = openstackterraform.NewTemplateGenerator()
+		inputGenerator = openstackterraform.NewInputGenerator()
+	case "vsphere":
+		templateGenerator = vsphereterraform.NewTemplateGenerator()
+		inputGenerator = vsphereterraform.NewInputGenerator()
 	}
 
 	terraformManager := terraform.NewManager(terraform.NewManagerArgs{
@@ -140,6 +178,7 @@ func main() {
 	boshManager := bosh.NewManager(boshExecutor, logger, socks5Proxy, stateStore)
 	boshClientProvider := bosh.NewClientProvider(socks5Proxy)
 	sshKeyGetter := bosh.NewSSHKeyGetter()
+	sshClient := ssh.NewClient(os.Stdin, os.Stdout, os.Stderr)
 	environmentValidator := application.NewEnvironmentValidator(boshClientProvider)
 
 	var cloudConfigOpsGenerator cloudconfig.OpsGenerator
@@ -150,8 +189,13 @@ func main() {
 		cloudConfigOpsGenerator = gcpcloudconfig.NewOpsGenerator(terraformManager)
 	case "azure":
 		cloudConfigOpsGenerator = azurecloudconfig.NewOpsGenerator(terraformManager)
+	case "openstack":
+		cloudConfigOpsGenerator = openstackcloudconfig.NewOpsGenerator(terraformManager)
+	case "vsphere":
+		cloudConfigOpsGenerator = vspherecloudconfig.NewOpsGenerator(terraformManager)
 	}
 	cloudConfigManager := cloudconfig.NewManager(logger, boshCommand, stateStore, cloudConfigOpsGenerator, boshClientProvider, terraformManager, sshKeyGetter)
+	runtimeConfigManager := runtimeconfig.NewManager(logger, boshCommand, stateStore, boshClientProvider)
 
 	// Subcommands
 	var (
@@ -160,11 +204,14 @@ func main() {
 	)
 	switch appConfig.State.IAAS {
 	case "aws":
-		createLBsCmd = commands.NewAWSCreateLBs(cloudConfigManager, stateStore, terraformManager, environmentValidator)
-		lbsCmd = commands.NewAWSLBs(terraformManager, logger)
+		createLBsCmd = commands.NewAWSCreateLBs(cloudConfigManager, stateStore, terraformManager, environmentValidator, logger, os.Stdin)
+		lbsCmd = commands.NewAWSLBs(terraformManager, logger, appConfig.Global.Output)
 	case "gcp":
-		createLBsCmd = commands.NewGCPCreateLBs(terraformManager, cloudConfigManager, stateStore, environmentValidator)
-		lbsCmd = commands.NewGCPLBs(terraformManager, logger)
+		createLBsCmd = commands.NewGCPCreateLBs(terraformManager, cloudConfigManager, stateStore, environmentValidator, logger, os.Stdin)
+		lbsCmd = commands.NewGCPLBs(terraformManager, logger, appConfig.Global.Output)
+	case "azure":
+		createLBsCmd = commands.NewAzureCreateLBs(terraformManager, cloudConfigManager, stateStore, environmentValidator, logger, os.Stdin)
+		lbsCmd = commands.NewAzureLBs(terraformManager, logger, appConfig.Global.Output)
 	}
 
 	// Commands
@@ -172,35 +219,52 @@ func main() {
 	if appConfig.State.IAAS != "" {
 		envIDManager = helpers.NewEnvIDManager(envIDGenerator, networkClient)
 	}
-	up := commands.NewUp(boshManager, cloudConfigManager, stateStore, envIDManager, terraformManager)
+	up := commands.NewUp(boshManager, cloudConfigManager, runtimeConfigManager, stateStore, envIDManager, terraformManager, logger, os.Stdin, certificateStatusReporter)
 	usage := commands.NewUsage(logger)
 
 	commandSet := application.CommandSet{}
 	commandSet["help"] = usage
-	commandSet["version"] = commands.NewVersion(Version, logger)
+	commandSet["version"] = commands.NewVersion(Version, logger, appConfig.Global.Output)
 	commandSet["up"] = up
 	commandSet["plan"] = commands.NewPlan(up, boshManager, cloudConfigManager, stateStore, envIDManager, terraformManager)
 	sshKeyDeleter := bosh.NewSSHKeyDeleter()
 	commandSet["rotate"] = commands.NewRotate(stateValidator, sshKeyDeleter, up)
 	commandSet["destroy"] = commands.NewDestroy(logger, os.Stdin, boshManager, stateStore, stateValidator, terraformManager, networkDeletionValidator)
 	commandSet["down"] = commandSet["destroy"]
+	commandSet["cleanup-leftovers"] = commands.NewCleanupLeftovers(logger, os.Stdin, leftoverCleaners)
 	commandSet["create-lbs"] = commands.NewCreateLBs(createLBsCmd, logger, stateValidator, certificateValidator, boshManager)
 	commandSet["update-lbs"] = commandSet["create-lbs"]
-	commandSet["delete-lbs"] = commands.NewDeleteLBs(logger, stateValidator, boshManager, cloudConfigManager, stateStore, environmentValidator, terraformManager)
+	if len(appConfig.LBFlags) > 0 {
+		commandSet["up"] = commands.NewConverge(up, commandSet["create-lbs"], appConfig.LBFlags, stateBootstrap, appConfig.Global.StateDir)
+	}
+	commandSet["delete-lbs"] = commands.NewDeleteLBs(logger, os.Stdin, stateValidator, boshManager, cloudConfigManager, stateStore, environmentValidator, terraformManager)
 	commandSet["lbs"] = commands.NewLBs(lbsCmd, stateValidator)
-	commandSet["jumpbox-address"] = commands.NewStateQuery(logger, stateValidator, terraformManager, commands.JumpboxAddressPropertyName)
-	commandSet["director-address"] = commands.NewStateQuery(logger, stateValidator, terraformManager, commands.DirectorAddressPropertyName)
-	commandSet["director-username"] = commands.NewStateQuery(logger, stateValidator, terraformManager, commands.DirectorUsernamePropertyName)
-	commandSet["director-password"] = commands.NewStateQuery(logger, stateValidator, terraformManager, commands.DirectorPasswordPropertyName)
-	commandSet["director-ca-cert"] = commands.NewStateQuery(logger, stateValidator, terraformManager, commands.DirectorCACertPropertyName)
-	commandSet["ssh-key"] = commands.NewSSHKey(logger, stateValidator, sshKeyGetter)
-	commandSet["director-ssh-key"] = commands.NewDirectorSSHKey(logger, stateValidator, sshKeyGetter)
-	commandSet["env-id"] = commands.NewStateQuery(logger, stateValidator, terraformManager, commands.EnvIDPropertyName)
-	commandSet["latest-error"] = commands.NewLatestError(logger, stateValidator)
-	commandSet["print-env"] = commands.NewPrintEnv(logger, stateValidator, terraformManager)
+	commandSet["jumpbox-address"] = commands.NewStateQuery(logger, stateValidator, terraformManager, commands.JumpboxAddressPropertyName, appConfig.Global.Output)
+	commandSet["director-address"] = commands.NewStateQuery(logger, stateValidator, terraformManager, commands.DirectorAddressPropertyName, appConfig.Global.Output)
+	commandSet["director-username"] = commands.NewStateQuery(logger, stateValidator, terraformManager, commands.DirectorUsernamePropertyName, appConfig.Global.Output)
+	commandSet["director-password"] = commands.NewStateQuery(logger, stateValidator, terraformManager, commands.DirectorPasswordPropertyName, appConfig.Global.Output)
+	commandSet["director-ca-cert"] = commands.NewStateQuery(logger, stateValidator, terraformManager, commands.DirectorCACertPropertyName, appConfig.Global.Output)
+	commandSet["ssh-key"] = commands.NewSSHKey(logger, stateValidator, sshKeyGetter, appConfig.Global.Output)
+	commandSet["director-ssh-key"] = commands.NewDirectorSSHKey(logger, stateValidator, sshKeyGetter, appConfig.Global.Output)
+	commandSet["env-id"] = commands.NewStateQuery(logger, stateValidator, terraformManager, commands.EnvIDPropertyName, appConfig.Global.Output)
+	commandSet["outputs"] = commands.NewOutputs(logger, stateValidator, terraformManager, appConfig.Global.Output)
+	commandSet["status"] = commands.NewStatus(logger, stateValidator, sshKeyGetter, sshClient, boshClientProvider, appConfig.Global.Output)
+	commandSet["latest-error"] = commands.NewLatestError(logger, stateValidator, appConfig.Global.Output)
+	commandSet["doctor"] = commands.NewDoctor(logger, boshManager, terraformManager, helpers.NewClockSkewChecker(), permissionChecker, credentialsErr, appConfig.Global.StateDir)
+	commandSet["print-env"] = commands.NewPrintEnv(logger, stateValidator, terraformManager, stateStore)
+	commandSet["ssh"] = commands.NewSSH(stateValidator, stateStore, sshKeyGetter, sshClient)
+	executable, err := os.Executable()
+	if err != nil {
+		executable = os.Args[0]
+	}
+	daemon := application.NewDaemon(executable, os.Args)
+	commandSet["tunnel"] = commands.NewTunnel(logger, stateValidator, stateStore, sshKeyGetter, ssh.NewTunnel(os.Stderr), daemon)
 	commandSet["cloud-config"] = commands.NewCloudConfig(logger, stateValidator, cloudConfigManager)
+	commandSet["runtime-config"] = commands.NewRuntimeConfig(logger, stateValidator, runtimeConfigManager)
+	commandSet["cert-status"] = commands.NewCertStatus(logger, stateValidator, certificateStatusReporter, appConfig.Global.Output)
 	commandSet["jumpbox-deployment-vars"] = commands.NewJumpboxDeploymentVars(logger, boshManager, stateValidator, terraformManager)
 	commandSet["bosh-deployment-vars"] = commands.NewBOSHDeploymentVars(logger, boshManager, stateValidator, terraformManager)
+	commandSet["completion"] = commands.NewCompletion(logger, commandSet, config.GlobalFlags())
 
 	app := application.New(commandSet, appConfig, usage)
 
@@ -209,3 +273,38 @@ func main() {
 		log.Fatalf("\n\n%s\n", err)
 	}
 }
+
+// doctorPermissionChecker builds the IaaS client used by doctor. Missing
+// credentials are returned as an error so doctor can skip the check and
+// still run its local checks; a client that cannot be built is reported as
+// a failed check instead.
+func doctorPermissionChecker(state storage.State, logger *application.Logger) (commands.PermissionChecker, error) {
+	err := config.ValidateIAAS(state)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		checker   commands.PermissionChecker
+		clientErr error
+	)
+	switch state.IAAS {
+	case "aws":
+		checker = ec2.NewClient(aws.Config{
+			AccessKeyID:     state.AWS.AccessKeyID,
+			SecretAccessKey: state.AWS.SecretAccessKey,
+			Region:          state.AWS.Region,
+		}, logger)
+	case "gcp":
+		checker, clientErr = gcp.NewClient(state.GCP, "")
+	case "azure":
+		checker, clientErr = azure.NewClient(state.Azure)
+	case "openstack":
+		checker, clientErr = openstack.NewClient(state.OpenStack)
+	}
+	if clientErr != nil {
+		return commands.NewFailedPermissionChecker(clientErr), nil
+	}
+
+	return checker, nil
+}
@@ -49,3 +49,22 @@ func (c CIDRBlock) GetFirstIP() IP {
 func (c CIDRBlock) GetLastIP() IP {
 	return c.firstIP.Add(c.CIDRSize - 1)
 }
+
+// Subnet splits the block into 2^newBits subnets and returns the netNum-th
+// one, like terraform's cidrsubnet function.
+func (c CIDRBlock) Subnet(newBits, netNum int) CIDRBlock {
+	size := c.CIDRSize >> uint(newBits)
+	first := c.firstIP.ip &^ (c.CIDRSize - 1)
+	return CIDRBlock{
+		CIDRSize: size,
+		firstIP:  IP{ip: first + netNum*size},
+	}
+}
+
+func (c CIDRBlock) String() string {
+	maskBits := 32
+	for size := c.CIDRSize; size > 1; size >>= 1 {
+		maskBits--
+	}
+	return fmt.Sprintf("%s/%d", c.firstIP, maskBits)
+}
@@ -32,6 +32,27 @@ var _ = Describe("CIDRBlock", func() {
 		})
 	})
 
+	Describe("Subnet", func() {
+		It("returns the numbered subnet of the given size, like terraform's cidrsubnet", func() {
+			Expect(cidrBlock.Subnet(4, 0).String()).To(Equal("10.0.16.0/24"))
+			Expect(cidrBlock.Subnet(4, 2).String()).To(Equal("10.0.18.0/24"))
+			Expect(cidrBlock.Subnet(8, 6).String()).To(Equal("10.0.16.96/28"))
+		})
+
+		It("starts from the network address when the block has host bits set", func() {
+			block, err := bosh.ParseCIDRBlock("10.0.17.5/20")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(block.Subnet(4, 1).String()).To(Equal("10.0.17.0/24"))
+		})
+	})
+
+	Describe("String", func() {
+		It("returns the cidr block in prefix notation", func() {
+			Expect(cidrBlock.String()).To(Equal("10.0.16.0/20"))
+		})
+	})
+
 	Describe("ParseCIDRBlock", func() {
 		Context("failure cases", func() {
 			Context("when input string is not a valid CIDR block", func() {
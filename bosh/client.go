@@ -17,7 +17,14 @@ import (
 
 type Client interface {
 	UpdateCloudConfig(yaml []byte) error
+	UpdateRuntimeConfig(name string, yaml []byte) error
+	ListConfigs(configType string) ([]Config, error)
+	GetConfig(configType, name string) (Config, error)
+	CreateConfig(configType, name string, content []byte) error
+	DeleteConfig(configType, name string) error
+	DiffConfig(configType, name string, content []byte) (ConfigDiff, error)
 	Info() (Info, error)
+	Ping(timeout time.Duration) error
 }
 
 type Info struct {
@@ -72,6 +79,30 @@ func (c client) Info() (Info, error) {
 	return info, nil
 }
 
+// Ping makes a single request to the director, bounded by timeout, for
+// callers that report whether it is reachable instead of waiting for it.
+func (c client) Ping(timeout time.Duration) error {
+	request, err := http.NewRequest("GET", fmt.Sprintf("%s/info", c.directorAddress), strings.NewReader(""))
+	if err != nil {
+		return err
+	}
+
+	httpClient := *c.httpClient
+	httpClient.Timeout = timeout
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected http response %d %s", response.StatusCode, http.StatusText(response.StatusCode))
+	}
+
+	return nil
+}
+
 func (c client) UpdateCloudConfig(yaml []byte) error {
 	request, err := http.NewRequest("POST", fmt.Sprintf("%s/cloud_configs", c.directorAddress), bytes.NewBuffer(yaml))
 	if err != nil {
@@ -79,27 +110,159 @@ func (c client) UpdateCloudConfig(yaml []byte) error {
 	}
 	request.Header.Set("Content-Type", "text/yaml")
 
-	urlParts, err := url.Parse(c.directorAddress)
+	return c.postConfig(request)
+}
+
+func (c client) UpdateRuntimeConfig(name string, yaml []byte) error {
+	request, err := http.NewRequest("POST", fmt.Sprintf("%s/runtime_configs?name=%s", c.directorAddress, url.QueryEscape(name)), bytes.NewBuffer(yaml))
 	if err != nil {
-		return err //not tested
+		return err
 	}
+	request.Header.Set("Content-Type", "text/yaml")
 
-	boshHost, _, err := net.SplitHostPort(urlParts.Host)
+	return c.postConfig(request)
+}
+
+func (c client) ListConfigs(configType string) ([]Config, error) {
+	query := url.Values{}
+	query.Set("type", configType)
+	query.Set("latest", "true")
+
+	return c.listConfigs(query)
+}
+
+func (c client) GetConfig(configType, name string) (Config, error) {
+	query := url.Values{}
+	query.Set("type", configType)
+	query.Set("name", name)
+	query.Set("latest", "true")
+
+	configs, err := c.listConfigs(query)
+	if err != nil {
+		return Config{}, err
+	}
+
+	if len(configs) == 0 {
+		return Config{}, ConfigNotFoundError{Type: configType, Name: name}
+	}
+
+	return configs[0], nil
+}
+
+func (c client) CreateConfig(configType, name string, content []byte) error {
+	body, err := json.Marshal(configRequest{
+		Type:    configType,
+		Name:    name,
+		Content: string(content),
+	})
 	if err != nil {
 		return err //not tested
 	}
 
-	ctx := context.Background()
-	ctx = context.WithValue(ctx, oauth2.HTTPClient, c.httpClient)
+	request, err := http.NewRequest("POST", fmt.Sprintf("%s/configs", c.directorAddress), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
 
-	conf := &clientcredentials.Config{
-		ClientID:     c.username,
-		ClientSecret: c.password,
-		TokenURL:     fmt.Sprintf("https://%s:8443/oauth/token", boshHost),
+	response, err := c.authenticatedRequest(request)
+	if err != nil {
+		return err
+	}
+
+	// The director responds with 200 instead of 201 when the content is
+	// unchanged from the latest config with the same type and name.
+	if response.StatusCode != http.StatusCreated && response.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected http response %d %s", response.StatusCode, http.StatusText(response.StatusCode))
+	}
+
+	return nil
+}
+
+func (c client) DeleteConfig(configType, name string) error {
+	query := url.Values{}
+	query.Set("type", configType)
+	query.Set("name", name)
+
+	request, err := http.NewRequest("DELETE", fmt.Sprintf("%s/configs?%s", c.directorAddress, query.Encode()), strings.NewReader(""))
+	if err != nil {
+		return err
+	}
+
+	response, err := c.authenticatedRequest(request)
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode != http.StatusNoContent && response.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected http response %d %s", response.StatusCode, http.StatusText(response.StatusCode))
+	}
+
+	return nil
+}
+
+func (c client) DiffConfig(configType, name string, content []byte) (ConfigDiff, error) {
+	body, err := json.Marshal(configRequest{
+		Type:    configType,
+		Name:    name,
+		Content: string(content),
+	})
+	if err != nil {
+		return ConfigDiff{}, err //not tested
+	}
+
+	request, err := http.NewRequest("POST", fmt.Sprintf("%s/configs/diff", c.directorAddress), bytes.NewBuffer(body))
+	if err != nil {
+		return ConfigDiff{}, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := c.authenticatedRequest(request)
+	if err != nil {
+		return ConfigDiff{}, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return ConfigDiff{}, fmt.Errorf("unexpected http response %d %s", response.StatusCode, http.StatusText(response.StatusCode))
+	}
+
+	var diff ConfigDiff
+	if err := json.NewDecoder(response.Body).Decode(&diff); err != nil {
+		return ConfigDiff{}, err
+	}
+
+	return diff, nil
+}
+
+func (c client) listConfigs(query url.Values) ([]Config, error) {
+	request, err := http.NewRequest("GET", fmt.Sprintf("%s/configs?%s", c.directorAddress, query.Encode()), strings.NewReader(""))
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.authenticatedRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode == http.StatusNotFound {
+		return nil, ConfigsNotSupportedError{}
 	}
 
-	httpClient := conf.Client(ctx)
-	response, err := makeRequests(httpClient, request)
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected http response %d %s", response.StatusCode, http.StatusText(response.StatusCode))
+	}
+
+	configs := []Config{}
+	if err := json.NewDecoder(response.Body).Decode(&configs); err != nil {
+		return nil, err
+	}
+
+	return configs, nil
+}
+
+func (c client) postConfig(request *http.Request) error {
+	response, err := c.authenticatedRequest(request)
 	if err != nil {
 		return err
 	}
@@ -111,6 +274,38 @@ func (c client) UpdateCloudConfig(yaml []byte) error {
 	return nil
 }
 
+func (c client) authenticatedRequest(request *http.Request) (*http.Response, error) {
+	httpClient, err := c.uaaClient()
+	if err != nil {
+		return nil, err //not tested
+	}
+
+	return makeRequests(httpClient, request)
+}
+
+func (c client) uaaClient() (*http.Client, error) {
+	urlParts, err := url.Parse(c.directorAddress)
+	if err != nil {
+		return nil, err //not tested
+	}
+
+	boshHost, _, err := net.SplitHostPort(urlParts.Host)
+	if err != nil {
+		return nil, err //not tested
+	}
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, c.httpClient)
+
+	conf := &clientcredentials.Config{
+		ClientID:     c.username,
+		ClientSecret: c.password,
+		TokenURL:     fmt.Sprintf("https://%s:8443/oauth/token", boshHost),
+	}
+
+	return conf.Client(ctx), nil
+}
+
 func makeRequests(httpClient *http.Client, request *http.Request) (*http.Response, error) {
 	var (
 		response *http.Response
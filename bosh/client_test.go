@@ -29,8 +29,14 @@ var _ = Describe("Client", func() {
 		username               string
 		password               string
 		cloudConfigContentType string
+		runtimeConfig          []byte
+		runtimeConfigName      string
+		configsQuery           url.Values
+		configsBody            []byte
+		configsResponse        string
 		httpClient             *http.Client
 		failStatus             int
+		infoDelay              time.Duration
 	)
 
 	BeforeEach(func() {
@@ -67,6 +73,8 @@ var _ = Describe("Client", func() {
 				          "expires_in": 3600
 		                }`))
 			case "/info":
+				time.Sleep(infoDelay)
+
 				if failStatus != 0 {
 					w.WriteHeader(failStatus)
 					w.Write([]byte("%%%%%%%%%%%%%%%%"))
@@ -94,6 +102,63 @@ var _ = Describe("Client", func() {
 				var err error
 				cloudConfig, err = ioutil.ReadAll(req.Body)
 				Expect(err).NotTo(HaveOccurred())
+			case "/runtime_configs":
+				if failStatus != 0 {
+					w.WriteHeader(failStatus)
+					return
+				}
+
+				token = req.Header.Get("Authorization")
+				runtimeConfigName = req.URL.Query().Get("name")
+
+				w.WriteHeader(http.StatusCreated)
+
+				var err error
+				runtimeConfig, err = ioutil.ReadAll(req.Body)
+				Expect(err).NotTo(HaveOccurred())
+			case "/configs":
+				if failStatus != 0 {
+					w.WriteHeader(failStatus)
+					return
+				}
+
+				token = req.Header.Get("Authorization")
+
+				if req.Method == "GET" {
+					configsQuery = req.URL.Query()
+					w.Write([]byte(configsResponse))
+					return
+				}
+
+				if req.Method == "DELETE" {
+					configsQuery = req.URL.Query()
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+
+				var err error
+				configsBody, err = ioutil.ReadAll(req.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				if configsResponse != "" {
+					w.Write([]byte(configsResponse))
+					return
+				}
+
+				w.WriteHeader(http.StatusCreated)
+			case "/configs/diff":
+				if failStatus != 0 {
+					w.WriteHeader(failStatus)
+					return
+				}
+
+				token = req.Header.Get("Authorization")
+
+				var err error
+				configsBody, err = ioutil.ReadAll(req.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				w.Write([]byte(configsResponse))
 			default:
 				dump, err := httputil.DumpRequest(req, true)
 				Expect(err).NotTo(HaveOccurred())
@@ -118,6 +183,47 @@ var _ = Describe("Client", func() {
 
 	AfterEach(func() {
 		failStatus = 0
+		configsResponse = ""
+		infoDelay = 0
+	})
+
+	Describe("Ping", func() {
+		It("requests the director info", func() {
+			fakeBOSH.StartTLS()
+
+			client := bosh.NewClient(httpClient, fakeBOSH.URL, "some-username", "some-password", string(ca))
+			err := client.Ping(time.Second)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("failure cases", func() {
+			It("returns an error when the response is not StatusOK", func() {
+				failStatus = http.StatusNotFound
+				fakeBOSH.StartTLS()
+
+				client := bosh.NewClient(httpClient, fakeBOSH.URL, "some-username", "some-password", string(ca))
+				err := client.Ping(time.Second)
+				Expect(err).To(MatchError("unexpected http response 404 Not Found"))
+			})
+
+			It("does not retry when the request fails", func() {
+				bosh.MAX_RETRIES = 5
+				bosh.RETRY_DELAY = time.Minute
+
+				client := bosh.NewClient(httpClient, "fake://some-url", "some-username", "some-password", string(ca))
+				err := client.Ping(time.Second)
+				Expect(err).To(MatchError(ContainSubstring(`unsupported protocol scheme "fake"`)))
+			})
+
+			It("gives up when the director does not respond within the timeout", func() {
+				infoDelay = 200 * time.Millisecond
+				fakeBOSH.StartTLS()
+
+				client := bosh.NewClient(httpClient, fakeBOSH.URL, "some-username", "some-password", string(ca))
+				err := client.Ping(10 * time.Millisecond)
+				Expect(err).To(MatchError(ContainSubstring("Client.Timeout exceeded")))
+			})
+		})
 	})
 
 	Describe("Info", func() {
@@ -223,4 +329,261 @@ var _ = Describe("Client", func() {
 			})
 		})
 	})
+
+	Describe("UpdateRuntimeConfig", func() {
+		BeforeEach(func() {
+			dialer := &fakes.Socks5Client{}
+			dialer.DialCall.Stub = func(network, addr string) (net.Conn, error) {
+				u, _ := url.Parse(fakeBOSH.URL)
+				return net.Dial(network, u.Host)
+			}
+
+			httpClient = &http.Client{
+				Transport: &http.Transport{
+					Dial:            dialer.Dial,
+					TLSClientConfig: tlsConfig,
+				},
+			}
+		})
+
+		It("uses UAA to get a token in order to upload the named runtime-config", func() {
+			fakeBOSH.StartTLS()
+
+			client := bosh.NewClient(httpClient, fakeBOSH.URL, "some-username", "some-password", string(ca))
+
+			err := client.UpdateRuntimeConfig("some-name", []byte("runtime: config"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(token).To(Equal("Bearer some-uaa-token"))
+			Expect(runtimeConfigName).To(Equal("some-name"))
+			Expect(runtimeConfig).To(Equal([]byte("runtime: config")))
+		})
+
+		Context("when the director does not respond with a 201", func() {
+			It("returns an error", func() {
+				failStatus = http.StatusBadRequest
+				fakeBOSH.StartTLS()
+
+				client := bosh.NewClient(httpClient, fakeBOSH.URL, "some-username", "some-password", string(ca))
+
+				err := client.UpdateRuntimeConfig("some-name", []byte("runtime: config"))
+				Expect(err).To(MatchError("unexpected http response 400 Bad Request"))
+			})
+		})
+	})
+
+	Describe("configs", func() {
+		BeforeEach(func() {
+			dialer := &fakes.Socks5Client{}
+			dialer.DialCall.Stub = func(network, addr string) (net.Conn, error) {
+				u, _ := url.Parse(fakeBOSH.URL)
+				return net.Dial(network, u.Host)
+			}
+
+			httpClient = &http.Client{
+				Transport: &http.Transport{
+					Dial:            dialer.Dial,
+					TLSClientConfig: tlsConfig,
+				},
+			}
+		})
+
+		startClient := func() bosh.Client {
+			fakeBOSH.StartTLS()
+			return bosh.NewClient(httpClient, fakeBOSH.URL, "some-username", "some-password", string(ca))
+		}
+
+		Describe("ListConfigs", func() {
+			It("returns the latest configs of the given type", func() {
+				configsResponse = `[
+					{"id": "1", "name": "default", "type": "cloud", "content": "some-content"},
+					{"id": "2", "name": "bbl", "type": "cloud", "content": "other-content"}
+				]`
+				client := startClient()
+
+				configs, err := client.ListConfigs("cloud")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(token).To(Equal("Bearer some-uaa-token"))
+				Expect(configsQuery.Get("type")).To(Equal("cloud"))
+				Expect(configsQuery.Get("latest")).To(Equal("true"))
+				Expect(configs).To(Equal([]bosh.Config{
+					{ID: "1", Name: "default", Type: "cloud", Content: "some-content"},
+					{ID: "2", Name: "bbl", Type: "cloud", Content: "other-content"},
+				}))
+			})
+
+			Context("failure cases", func() {
+				It("returns an error when the director does not respond with a 200", func() {
+					failStatus = http.StatusBadRequest
+					client := startClient()
+
+					_, err := client.ListConfigs("cloud")
+					Expect(err).To(MatchError("unexpected http response 400 Bad Request"))
+				})
+
+				It("returns a configs not supported error when the director has no configs endpoint", func() {
+					failStatus = http.StatusNotFound
+					client := startClient()
+
+					_, err := client.ListConfigs("cloud")
+					Expect(err).To(MatchError(bosh.ConfigsNotSupportedError{}))
+				})
+
+				It("returns an error when the response cannot be parsed", func() {
+					configsResponse = "%%%"
+					client := startClient()
+
+					_, err := client.ListConfigs("cloud")
+					Expect(err).To(MatchError(ContainSubstring("invalid character")))
+				})
+			})
+		})
+
+		Describe("GetConfig", func() {
+			It("returns the latest config with the given type and name", func() {
+				configsResponse = `[{"id": "2", "name": "bbl", "type": "cloud", "content": "some-content"}]`
+				client := startClient()
+
+				config, err := client.GetConfig("cloud", "bbl")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(token).To(Equal("Bearer some-uaa-token"))
+				Expect(configsQuery.Get("type")).To(Equal("cloud"))
+				Expect(configsQuery.Get("name")).To(Equal("bbl"))
+				Expect(configsQuery.Get("latest")).To(Equal("true"))
+				Expect(config).To(Equal(bosh.Config{ID: "2", Name: "bbl", Type: "cloud", Content: "some-content"}))
+			})
+
+			Context("when the config does not exist", func() {
+				It("returns a config not found error", func() {
+					configsResponse = `[]`
+					client := startClient()
+
+					_, err := client.GetConfig("cloud", "bbl")
+					Expect(err).To(MatchError(bosh.ConfigNotFoundError{Type: "cloud", Name: "bbl"}))
+					Expect(err).To(MatchError(`cloud config "bbl" not found`))
+				})
+			})
+
+			Context("when the director has no configs endpoint", func() {
+				It("returns a configs not supported error", func() {
+					failStatus = http.StatusNotFound
+					client := startClient()
+
+					_, err := client.GetConfig("cloud", "bbl")
+					Expect(err).To(MatchError(bosh.ConfigsNotSupportedError{}))
+				})
+			})
+
+			Context("when the director does not respond with a 200", func() {
+				It("returns an error", func() {
+					failStatus = http.StatusBadRequest
+					client := startClient()
+
+					_, err := client.GetConfig("cloud", "bbl")
+					Expect(err).To(MatchError("unexpected http response 400 Bad Request"))
+				})
+			})
+		})
+
+		Describe("CreateConfig", func() {
+			It("uploads a named config of the given type", func() {
+				client := startClient()
+
+				err := client.CreateConfig("cloud", "bbl", []byte("some: config"))
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(token).To(Equal("Bearer some-uaa-token"))
+				Expect(configsBody).To(MatchJSON(`{"type": "cloud", "name": "bbl", "content": "some: config"}`))
+			})
+
+			Context("when the content is unchanged and the director responds with a 200", func() {
+				It("does not return an error", func() {
+					configsResponse = `{"id": "2", "name": "bbl", "type": "cloud", "content": "some: config"}`
+					client := startClient()
+
+					err := client.CreateConfig("cloud", "bbl", []byte("some: config"))
+					Expect(err).NotTo(HaveOccurred())
+				})
+			})
+
+			Context("when the director does not respond with a 200 or 201", func() {
+				It("returns an error", func() {
+					failStatus = http.StatusBadRequest
+					client := startClient()
+
+					err := client.CreateConfig("cloud", "bbl", []byte("some: config"))
+					Expect(err).To(MatchError("unexpected http response 400 Bad Request"))
+				})
+			})
+		})
+
+		Describe("DeleteConfig", func() {
+			It("deletes the config with the given type and name", func() {
+				client := startClient()
+
+				err := client.DeleteConfig("cloud", "default")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(token).To(Equal("Bearer some-uaa-token"))
+				Expect(configsQuery.Get("type")).To(Equal("cloud"))
+				Expect(configsQuery.Get("name")).To(Equal("default"))
+			})
+
+			Context("when the director does not respond with a 204", func() {
+				It("returns an error", func() {
+					failStatus = http.StatusBadRequest
+					client := startClient()
+
+					err := client.DeleteConfig("cloud", "default")
+					Expect(err).To(MatchError("unexpected http response 400 Bad Request"))
+				})
+			})
+		})
+
+		Describe("DiffConfig", func() {
+			It("returns the director's diff against the named config", func() {
+				configsResponse = `{"diff": [["azs:", null], ["- name: z1", "removed"], ["- name: z2", "added"]]}`
+				client := startClient()
+
+				diff, err := client.DiffConfig("cloud", "bbl", []byte("some: config"))
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(token).To(Equal("Bearer some-uaa-token"))
+				Expect(configsBody).To(MatchJSON(`{"type": "cloud", "name": "bbl", "content": "some: config"}`))
+				Expect(diff.HasChanges()).To(BeTrue())
+				Expect(diff.String()).To(Equal("  azs:\n- - name: z1\n+ - name: z2"))
+			})
+
+			Context("when nothing has changed", func() {
+				It("reports no changes", func() {
+					configsResponse = `{"diff": [["azs:", null]]}`
+					client := startClient()
+
+					diff, err := client.DiffConfig("cloud", "bbl", []byte("some: config"))
+					Expect(err).NotTo(HaveOccurred())
+					Expect(diff.HasChanges()).To(BeFalse())
+				})
+			})
+
+			Context("failure cases", func() {
+				It("returns an error when the director does not respond with a 200", func() {
+					failStatus = http.StatusBadRequest
+					client := startClient()
+
+					_, err := client.DiffConfig("cloud", "bbl", []byte("some: config"))
+					Expect(err).To(MatchError("unexpected http response 400 Bad Request"))
+				})
+
+				It("returns an error when the response cannot be parsed", func() {
+					configsResponse = "%%%"
+					client := startClient()
+
+					_, err := client.DiffConfig("cloud", "bbl", []byte("some: config"))
+					Expect(err).To(MatchError(ContainSubstring("invalid character")))
+				})
+			})
+		})
+	})
 })
@@ -0,0 +1,76 @@
+package bosh
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Config struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Content string `json:"content"`
+}
+
+type configRequest struct {
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Content string `json:"content"`
+}
+
+// ConfigDiff is the director's line-by-line diff of a config. Each line is a
+// pair of the line's text and its state: "added", "removed" or null.
+type ConfigDiff struct {
+	Diff [][]interface{} `json:"diff"`
+}
+
+func (d ConfigDiff) HasChanges() bool {
+	for _, line := range d.Diff {
+		if lineState(line) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func (d ConfigDiff) String() string {
+	lines := []string{}
+	for _, line := range d.Diff {
+		text, _ := line[0].(string)
+
+		switch lineState(line) {
+		case "added":
+			lines = append(lines, fmt.Sprintf("+ %s", text))
+		case "removed":
+			lines = append(lines, fmt.Sprintf("- %s", text))
+		default:
+			lines = append(lines, fmt.Sprintf("  %s", text))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func lineState(line []interface{}) string {
+	if len(line) < 2 {
+		return ""
+	}
+	state, _ := line[1].(string)
+	return state
+}
+
+// ConfigsNotSupportedError is returned by directors that predate the generic
+// configs API and only accept the legacy, unnamed cloud config.
+type ConfigsNotSupportedError struct{}
+
+func (e ConfigsNotSupportedError) Error() string {
+	return "director does not support named configs"
+}
+
+type ConfigNotFoundError struct {
+	Type string
+	Name string
+}
+
+func (e ConfigNotFoundError) Error() string {
+	return fmt.Sprintf("%s config %q not found", e.Type, e.Name)
+}
@@ -21,14 +21,15 @@ type Executor struct {
 }
 
 type InterpolateInput struct {
-	DeploymentDir  string
-	StateDir       string
-	VarsDir        string
-	IAAS           string
-	DeploymentVars string
-	BOSHState      map[string]interface{}
-	Variables      string
-	OpsFile        string
+	DeploymentDir   string
+	StateDir        string
+	VarsDir         string
+	IAAS            string
+	DeploymentVars  string
+	BOSHState       map[string]interface{}
+	Variables       string
+	OpsFile         string
+	PrivateDirector bool
 }
 
 type CreateEnvInput struct {
@@ -97,6 +98,24 @@ func (e Executor) JumpboxCreateEnvArgs(input InterpolateInput) error {
 		"-o", setupFiles["cpi"].path,
 	}
 
+	if input.IAAS == "vsphere" {
+		noExternalIP := filepath.Join(input.DeploymentDir, "no-external-ip.yml")
+		err := e.writeFile(noExternalIP, MustAsset("vendor/github.com/cppforlife/jumpbox-deployment/no-external-ip.yml"), os.ModePerm)
+		if err != nil {
+			return fmt.Errorf("Jumpbox write setup file: %s", err) //not tested
+		}
+		sharedArgs = append(sharedArgs, "-o", noExternalIP)
+	}
+
+	if input.IAAS == "openstack" {
+		keystoneV3 := filepath.Join(input.DeploymentDir, "keystone-v3.yml")
+		err := e.writeFile(keystoneV3, []byte(OpenStackJumpboxKeystoneV3Ops), os.ModePerm)
+		if err != nil {
+			return fmt.Errorf("Jumpbox write setup file: %s", err) //not tested
+		}
+		sharedArgs = append(sharedArgs, "-o", keystoneV3)
+	}
+
 	jumpboxState := filepath.Join(input.VarsDir, "jumpbox-state.json")
 	if input.BOSHState != nil {
 		stateJSON, err := e.marshalJSON(input.BOSHState)
@@ -178,16 +197,20 @@ func (e Executor) DirectorCreateEnvArgs(input InterpolateInput) error {
 
 	switch input.IAAS {
 	case "gcp":
-		opsFiles = append(opsFiles, setupFile{
-			path:     filepath.Join(input.DeploymentDir, "gcp-bosh-director-ephemeral-ip-ops.yml"),
-			contents: []byte(GCPBoshDirectorEphemeralIPOps),
-		})
+		if !input.PrivateDirector {
+			opsFiles = append(opsFiles, setupFile{
+				path:     filepath.Join(input.DeploymentDir, "gcp-bosh-director-ephemeral-ip-ops.yml"),
+				contents: []byte(GCPBoshDirectorEphemeralIPOps),
+			})
+		}
 	case "aws":
-		opsFiles = append(opsFiles,
-			setupFile{
+		if !input.PrivateDirector {
+			opsFiles = append(opsFiles, setupFile{
 				path:     filepath.Join(input.DeploymentDir, "aws-bosh-director-ephemeral-ip-ops.yml"),
 				contents: []byte(AWSBoshDirectorEphemeralIPOps),
-			},
+			})
+		}
+		opsFiles = append(opsFiles,
 			setupFile{
 				path:     filepath.Join(input.DeploymentDir, "iam-instance-profile.yml"),
 				contents: MustAsset("vendor/github.com/cloudfoundry/bosh-deployment/aws/iam-instance-profile.yml"),
@@ -99,6 +99,64 @@ var _ = Describe("Executor", func() {
 			})
 		})
 
+		Context("when the iaas is vsphere", func() {
+			BeforeEach(func() {
+				interpolateInput.IAAS = "vsphere"
+				interpolateInput.OpsFile = ""
+			})
+
+			It("removes the external ip from the jumpbox", func() {
+				err := executor.JumpboxCreateEnvArgs(interpolateInput)
+				Expect(err).NotTo(HaveOccurred())
+
+				noExternalIP, err := ioutil.ReadFile(filepath.Join(stateDir, "deployment", "no-external-ip.yml"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(noExternalIP)).To(ContainSubstring("path: /instance_groups/name=jumpbox/networks/name=public"))
+
+				expectedArgs := []string{
+					fmt.Sprintf("%s/jumpbox.yml", relativeDeploymentDir),
+					"--state", fmt.Sprintf("%s/jumpbox-state.json", relativeVarsDir),
+					"--vars-store", fmt.Sprintf("%s/jumpbox-variables.yml", relativeVarsDir),
+					"--vars-file", fmt.Sprintf("%s/jumpbox-deployment-vars.yml", relativeVarsDir),
+					"-o", fmt.Sprintf("%s/cpi.yml", relativeDeploymentDir),
+					"-o", fmt.Sprintf("%s/no-external-ip.yml", relativeDeploymentDir),
+				}
+
+				shellScript, err := ioutil.ReadFile(fmt.Sprintf("%s/create-jumpbox.sh", stateDir))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(shellScript)).To(Equal(formatScript("create-env", stateDir, expectedArgs)))
+			})
+		})
+
+		Context("when the iaas is openstack", func() {
+			BeforeEach(func() {
+				interpolateInput.IAAS = "openstack"
+				interpolateInput.OpsFile = ""
+			})
+
+			It("authenticates the jumpbox cpi with keystone v3", func() {
+				err := executor.JumpboxCreateEnvArgs(interpolateInput)
+				Expect(err).NotTo(HaveOccurred())
+
+				keystoneV3, err := ioutil.ReadFile(filepath.Join(stateDir, "deployment", "keystone-v3.yml"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(keystoneV3)).To(Equal(bosh.OpenStackJumpboxKeystoneV3Ops))
+
+				expectedArgs := []string{
+					fmt.Sprintf("%s/jumpbox.yml", relativeDeploymentDir),
+					"--state", fmt.Sprintf("%s/jumpbox-state.json", relativeVarsDir),
+					"--vars-store", fmt.Sprintf("%s/jumpbox-variables.yml", relativeVarsDir),
+					"--vars-file", fmt.Sprintf("%s/jumpbox-deployment-vars.yml", relativeVarsDir),
+					"-o", fmt.Sprintf("%s/cpi.yml", relativeDeploymentDir),
+					"-o", fmt.Sprintf("%s/keystone-v3.yml", relativeDeploymentDir),
+				}
+
+				shellScript, err := ioutil.ReadFile(fmt.Sprintf("%s/create-jumpbox.sh", stateDir))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(shellScript)).To(Equal(formatScript("create-env", stateDir, expectedArgs)))
+			})
+		})
+
 		Context("when a create-env script already exists", func() {
 			var (
 				createEnvPath     string
@@ -298,6 +356,46 @@ var _ = Describe("Executor", func() {
 					Expect(string(shellScript)).To(Equal(deleteEnvContents))
 				})
 			})
+
+			It("gives the director an ephemeral external ip", func() {
+				err := executor.DirectorCreateEnvArgs(gcpInterpolateInput)
+				Expect(err).NotTo(HaveOccurred())
+
+				shellScript, err := ioutil.ReadFile(fmt.Sprintf("%s/create-director.sh", stateDir))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(shellScript)).To(ContainSubstring("gcp-bosh-director-ephemeral-ip-ops.yml"))
+			})
+
+			Context("when the director is private", func() {
+				It("does not give the director an external ip", func() {
+					gcpInterpolateInput.PrivateDirector = true
+
+					err := executor.DirectorCreateEnvArgs(gcpInterpolateInput)
+					Expect(err).NotTo(HaveOccurred())
+
+					shellScript, err := ioutil.ReadFile(fmt.Sprintf("%s/create-director.sh", stateDir))
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(shellScript)).NotTo(ContainSubstring("ephemeral-ip-ops.yml"))
+				})
+			})
+		})
+
+		Context("aws", func() {
+			Context("when the director is private", func() {
+				It("does not give the director a public ip", func() {
+					awsInterpolateInput := interpolateInput
+					awsInterpolateInput.IAAS = "aws"
+					awsInterpolateInput.PrivateDirector = true
+
+					err := executor.DirectorCreateEnvArgs(awsInterpolateInput)
+					Expect(err).NotTo(HaveOccurred())
+
+					shellScript, err := ioutil.ReadFile(fmt.Sprintf("%s/create-director.sh", stateDir))
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(shellScript)).NotTo(ContainSubstring("ephemeral-ip-ops.yml"))
+					Expect(string(shellScript)).To(ContainSubstring("iam-instance-profile.yml"))
+				})
+			})
 		})
 	})
 
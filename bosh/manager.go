@@ -18,7 +18,7 @@ var (
 
 const (
 	DIRECTOR_USERNAME    = "admin"
-	DIRECTOR_INTERNAL_IP = "10.0.0.6"
+	DEFAULT_NETWORK_CIDR = "10.0.0.0/16"
 )
 
 type Manager struct {
@@ -55,27 +55,29 @@ type deploymentVariables struct {
 }
 
 type sharedDeploymentVarsYAML struct {
-	InternalCIDR string    `yaml:"internal_cidr,omitempty"`
-	InternalGW   string    `yaml:"internal_gw,omitempty"`
-	InternalIP   string    `yaml:"internal_ip,omitempty"`
-	DirectorName string    `yaml:"director_name,omitempty"`
-	ExternalIP   string    `yaml:"external_ip,omitempty"`
-	PrivateKey   string    `yaml:"private_key,flow,omitempty"`
-	AWSYAML      AWSYAML   `yaml:",inline"`
-	GCPYAML      GCPYAML   `yaml:",inline"`
-	AzureYAML    AzureYAML `yaml:",inline"`
+	InternalCIDR          string        `yaml:"internal_cidr,omitempty"`
+	InternalGW            string        `yaml:"internal_gw,omitempty"`
+	InternalIP            string        `yaml:"internal_ip,omitempty"`
+	DirectorName          string        `yaml:"director_name,omitempty"`
+	ExternalIP            string        `yaml:"external_ip,omitempty"`
+	PrivateKey            string        `yaml:"private_key,flow,omitempty"`
+	AZ                    string        `yaml:"az,omitempty"`
+	DefaultKeyName        string        `yaml:"default_key_name,omitempty"`
+	DefaultSecurityGroups []string      `yaml:"default_security_groups,omitempty"`
+	Region                string        `yaml:"region,omitempty"`
+	AWSYAML               AWSYAML       `yaml:",inline"`
+	GCPYAML               GCPYAML       `yaml:",inline"`
+	AzureYAML             AzureYAML     `yaml:",inline"`
+	OpenStackYAML         OpenStackYAML `yaml:",inline"`
+	VSphereYAML           VSphereYAML   `yaml:",inline"`
 }
 
 type AWSYAML struct {
-	AZ                    string   `yaml:"az,omitempty"`
-	SubnetID              string   `yaml:"subnet_id,omitempty"`
-	AccessKeyID           string   `yaml:"access_key_id,omitempty"`
-	SecretAccessKey       string   `yaml:"secret_access_key,omitempty"`
-	IAMInstanceProfile    string   `yaml:"iam_instance_profile,omitempty"`
-	DefaultKeyName        string   `yaml:"default_key_name,omitempty"`
-	DefaultSecurityGroups []string `yaml:"default_security_groups,omitempty"`
-	Region                string   `yaml:"region,omitempty"`
-	KMSKeyARN             string   `yaml:"kms_key_arn,omitempty"`
+	SubnetID           string `yaml:"subnet_id,omitempty"`
+	AccessKeyID        string `yaml:"access_key_id,omitempty"`
+	SecretAccessKey    string `yaml:"secret_access_key,omitempty"`
+	IAMInstanceProfile string `yaml:"iam_instance_profile,omitempty"`
+	KMSKeyARN          string `yaml:"kms_key_arn,omitempty"`
 }
 
 type GCPYAML struct {
@@ -100,6 +102,28 @@ type AzureYAML struct {
 	PublicKey            string `yaml:"public_key,flow,omitempty"`
 }
 
+type OpenStackYAML struct {
+	NetID    string `yaml:"net_id,omitempty"`
+	AuthURL  string `yaml:"auth_url,omitempty"`
+	Username string `yaml:"openstack_username,omitempty"`
+	Password string `yaml:"openstack_password,omitempty"`
+	Domain   string `yaml:"openstack_domain,omitempty"`
+	Project  string `yaml:"openstack_project,omitempty"`
+}
+
+type VSphereYAML struct {
+	NetworkName      string `yaml:"network_name,omitempty"`
+	VCenterIP        string `yaml:"vcenter_ip,omitempty"`
+	VCenterUser      string `yaml:"vcenter_user,omitempty"`
+	VCenterPassword  string `yaml:"vcenter_password,omitempty"`
+	VCenterDC        string `yaml:"vcenter_dc,omitempty"`
+	VCenterCluster   string `yaml:"vcenter_cluster,omitempty"`
+	VCenterDS        string `yaml:"vcenter_ds,omitempty"`
+	VCenterVMs       string `yaml:"vcenter_vms,omitempty"`
+	VCenterTemplates string `yaml:"vcenter_templates,omitempty"`
+	VCenterDisks     string `yaml:"vcenter_disks,omitempty"`
+}
+
 type executor interface {
 	DirectorCreateEnvArgs(InterpolateInput) error
 	JumpboxCreateEnvArgs(InterpolateInput) error
@@ -242,14 +266,15 @@ func (m *Manager) InitializeDirector(state storage.State, terraformOutputs terra
 	}
 
 	iaasInputs := InterpolateInput{
-		DeploymentDir:  directorDeploymentDir,
-		StateDir:       stateDir,
-		VarsDir:        varsDir,
-		IAAS:           state.IAAS,
-		DeploymentVars: m.GetDirectorDeploymentVars(state, terraformOutputs),
-		Variables:      state.BOSH.Variables,
-		OpsFile:        state.BOSH.UserOpsFile,
-		BOSHState:      state.BOSH.State,
+		DeploymentDir:   directorDeploymentDir,
+		StateDir:        stateDir,
+		VarsDir:         varsDir,
+		IAAS:            state.IAAS,
+		DeploymentVars:  m.GetDirectorDeploymentVars(state, terraformOutputs),
+		Variables:       state.BOSH.Variables,
+		OpsFile:         state.BOSH.UserOpsFile,
+		BOSHState:       state.BOSH.State,
+		PrivateDirector: state.PrivateDirector,
 	}
 
 	err = m.executor.DirectorCreateEnvArgs(iaasInputs)
@@ -295,7 +320,7 @@ func (m *Manager) CreateDirector(state storage.State) (storage.State, error) {
 
 	state.BOSH = storage.BOSH{
 		DirectorName:           fmt.Sprintf("bosh-%s", state.EnvID),
-		DirectorAddress:        fmt.Sprintf("https://%s:25555", DIRECTOR_INTERNAL_IP),
+		DirectorAddress:        fmt.Sprintf("https://%s:25555", directorInternalIP(state)),
 		DirectorUsername:       DIRECTOR_USERNAME,
 		DirectorPassword:       directorVars.directorPassword,
 		DirectorSSLCA:          directorVars.directorSSLCA,
@@ -419,10 +444,11 @@ func (m *Manager) DeleteJumpbox(state storage.State, terraformOutputs terraform.
 }
 
 func (m *Manager) GetJumpboxDeploymentVars(state storage.State, terraformOutputs terraform.Outputs) string {
+	boshSubnet := NetworkCIDRBlock(state).Subnet(8, 0)
 	vars := sharedDeploymentVarsYAML{
-		InternalCIDR: "10.0.0.0/24",
-		InternalGW:   "10.0.0.1",
-		InternalIP:   "10.0.0.5",
+		InternalCIDR: boshSubnet.String(),
+		InternalGW:   boshSubnet.GetFirstIP().Add(1).String(),
+		InternalIP:   boshSubnet.GetFirstIP().Add(5).String(),
 		DirectorName: fmt.Sprintf("bosh-%s", state.EnvID),
 		ExternalIP:   terraformOutputs.GetString("external_ip"),
 	}
@@ -439,15 +465,15 @@ func (m *Manager) GetJumpboxDeploymentVars(state storage.State, terraformOutputs
 		}
 	case "aws":
 		vars.AWSYAML = AWSYAML{
-			AZ:                    terraformOutputs.GetString("bosh_subnet_availability_zone"),
-			SubnetID:              terraformOutputs.GetString("bosh_subnet_id"),
-			AccessKeyID:           state.AWS.AccessKeyID,
-			SecretAccessKey:       state.AWS.SecretAccessKey,
-			IAMInstanceProfile:    terraformOutputs.GetString("bosh_iam_instance_profile"),
-			DefaultKeyName:        terraformOutputs.GetString("bosh_vms_key_name"),
-			DefaultSecurityGroups: []string{terraformOutputs.GetString("jumpbox_security_group")},
-			Region:                state.AWS.Region,
+			SubnetID:           terraformOutputs.GetString("bosh_subnet_id"),
+			AccessKeyID:        state.AWS.AccessKeyID,
+			SecretAccessKey:    state.AWS.SecretAccessKey,
+			IAMInstanceProfile: terraformOutputs.GetString("bosh_iam_instance_profile"),
 		}
+		vars.AZ = terraformOutputs.GetString("bosh_subnet_availability_zone")
+		vars.DefaultKeyName = terraformOutputs.GetString("bosh_vms_key_name")
+		vars.DefaultSecurityGroups = []string{terraformOutputs.GetString("jumpbox_security_group")}
+		vars.Region = state.AWS.Region
 		vars.PrivateKey = terraformOutputs.GetString("bosh_vms_private_key")
 	case "azure":
 		vars.AzureYAML = AzureYAML{
@@ -463,6 +489,25 @@ func (m *Manager) GetJumpboxDeploymentVars(state storage.State, terraformOutputs
 			PublicKey:            terraformOutputs.GetString("bosh_vms_public_key"),
 		}
 		vars.PrivateKey = terraformOutputs.GetString("bosh_vms_private_key")
+	case "openstack":
+		vars.OpenStackYAML = OpenStackYAML{
+			NetID:    terraformOutputs.GetString("network_id"),
+			AuthURL:  state.OpenStack.AuthURL,
+			Username: state.OpenStack.Username,
+			Password: state.OpenStack.Password,
+			Domain:   state.OpenStack.Domain,
+			Project:  state.OpenStack.Project,
+		}
+		vars.AZ = state.OpenStack.AZ
+		vars.DefaultKeyName = terraformOutputs.GetString("bosh_vms_key_name")
+		vars.DefaultSecurityGroups = []string{terraformOutputs.GetString("jumpbox_security_group")}
+		vars.Region = state.OpenStack.Region
+		vars.PrivateKey = terraformOutputs.GetString("bosh_vms_private_key")
+	case "vsphere":
+		vars.InternalCIDR = terraformOutputs.GetString("internal_cidr")
+		vars.InternalGW = terraformOutputs.GetString("internal_gw")
+		vars.InternalIP = terraformOutputs.GetString("jumpbox_internal_ip")
+		vars.VSphereYAML = vsphereYAML(state, terraformOutputs)
 	}
 
 	return string(mustMarshal(vars))
@@ -485,10 +530,11 @@ func getTerraformOutput(key string, outputs terraform.Outputs) string {
 }
 
 func (m *Manager) GetDirectorDeploymentVars(state storage.State, terraformOutputs terraform.Outputs) string {
+	directorSubnet := directorSubnet(state)
 	vars := sharedDeploymentVarsYAML{
-		InternalCIDR: "10.0.0.0/24",
-		InternalGW:   "10.0.0.1",
-		InternalIP:   DIRECTOR_INTERNAL_IP,
+		InternalCIDR: directorSubnet.String(),
+		InternalGW:   directorSubnet.GetFirstIP().Add(1).String(),
+		InternalIP:   directorSubnet.GetFirstIP().Add(6).String(),
 		DirectorName: fmt.Sprintf("bosh-%s", state.EnvID),
 	}
 
@@ -504,17 +550,21 @@ func (m *Manager) GetDirectorDeploymentVars(state storage.State, terraformOutput
 		}
 	case "aws":
 		vars.AWSYAML = AWSYAML{
-			AZ:                    terraformOutputs.GetString("bosh_subnet_availability_zone"),
-			SubnetID:              terraformOutputs.GetString("bosh_subnet_id"),
-			AccessKeyID:           state.AWS.AccessKeyID,
-			SecretAccessKey:       state.AWS.SecretAccessKey,
-			IAMInstanceProfile:    terraformOutputs.GetString("bosh_iam_instance_profile"),
-			DefaultKeyName:        terraformOutputs.GetString("bosh_vms_key_name"),
-			DefaultSecurityGroups: []string{terraformOutputs.GetString("bosh_security_group")},
-			Region:                state.AWS.Region,
-			KMSKeyARN:             terraformOutputs.GetString("kms_key_arn"),
+			SubnetID:           terraformOutputs.GetString("bosh_subnet_id"),
+			AccessKeyID:        state.AWS.AccessKeyID,
+			SecretAccessKey:    state.AWS.SecretAccessKey,
+			IAMInstanceProfile: terraformOutputs.GetString("bosh_iam_instance_profile"),
+			KMSKeyARN:          terraformOutputs.GetString("kms_key_arn"),
 		}
+		vars.AZ = terraformOutputs.GetString("bosh_subnet_availability_zone")
+		vars.DefaultKeyName = terraformOutputs.GetString("bosh_vms_key_name")
+		vars.DefaultSecurityGroups = []string{terraformOutputs.GetString("bosh_security_group")}
+		vars.Region = state.AWS.Region
 		vars.PrivateKey = terraformOutputs.GetString("bosh_vms_private_key")
+
+		if state.PrivateDirector {
+			vars.AWSYAML.SubnetID = terraformOutputs.GetString("director_subnet_id")
+		}
 	case "azure":
 		vars.AzureYAML = AzureYAML{
 			VNetName:             terraformOutputs.GetString("bosh_network_name"),
@@ -527,11 +577,81 @@ func (m *Manager) GetDirectorDeploymentVars(state storage.State, terraformOutput
 			StorageAccountName:   terraformOutputs.GetString("bosh_storage_account_name"),
 			DefaultSecurityGroup: terraformOutputs.GetString("bosh_default_security_group"),
 		}
+	case "openstack":
+		vars.OpenStackYAML = OpenStackYAML{
+			NetID:    terraformOutputs.GetString("network_id"),
+			AuthURL:  state.OpenStack.AuthURL,
+			Username: state.OpenStack.Username,
+			Password: state.OpenStack.Password,
+			Domain:   state.OpenStack.Domain,
+			Project:  state.OpenStack.Project,
+		}
+		vars.AZ = state.OpenStack.AZ
+		vars.DefaultKeyName = terraformOutputs.GetString("bosh_vms_key_name")
+		vars.DefaultSecurityGroups = []string{terraformOutputs.GetString("bosh_security_group")}
+		vars.Region = state.OpenStack.Region
+		vars.PrivateKey = terraformOutputs.GetString("bosh_vms_private_key")
+	case "vsphere":
+		vars.InternalCIDR = terraformOutputs.GetString("internal_cidr")
+		vars.InternalGW = terraformOutputs.GetString("internal_gw")
+		vars.InternalIP = terraformOutputs.GetString("director_internal_ip")
+		vars.VSphereYAML = vsphereYAML(state, terraformOutputs)
 	}
 
 	return string(mustMarshal(vars))
 }
 
+func vsphereYAML(state storage.State, terraformOutputs terraform.Outputs) VSphereYAML {
+	return VSphereYAML{
+		NetworkName:      terraformOutputs.GetString("network_name"),
+		VCenterIP:        state.VSphere.VCenterIP,
+		VCenterUser:      state.VSphere.VCenterUser,
+		VCenterPassword:  state.VSphere.VCenterPassword,
+		VCenterDC:        state.VSphere.VCenterDC,
+		VCenterCluster:   state.VSphere.VCenterCluster,
+		VCenterDS:        state.VSphere.VCenterDS,
+		VCenterVMs:       fmt.Sprintf("%s_vms", state.EnvID),
+		VCenterTemplates: fmt.Sprintf("%s_templates", state.EnvID),
+		VCenterDisks:     fmt.Sprintf("%s_disks", state.EnvID),
+	}
+}
+
+// NetworkCIDRBlock returns the range bbl lays its subnets out in, which is
+// 10.0.0.0/16 unless --network-cidr was given. The jumpbox and director sit in
+// the first 1/256th of it, a /24 for a /16, and the cloud config subnets take
+// the sixteenths after the first.
+func NetworkCIDRBlock(state storage.State) CIDRBlock {
+	if state.NetworkCIDR != "" {
+		cidr, err := ParseCIDRBlock(state.NetworkCIDR)
+		if err == nil {
+			return cidr
+		}
+	}
+	cidr, _ := ParseCIDRBlock(DEFAULT_NETWORK_CIDR)
+	return cidr
+}
+
+// Private AWS directors live in their own subnet, the second /24 of the
+// network, routed through the NAT.
+func directorSubnet(state storage.State) CIDRBlock {
+	if state.IAAS == "aws" && state.PrivateDirector {
+		return NetworkCIDRBlock(state).Subnet(8, 1)
+	}
+	return NetworkCIDRBlock(state).Subnet(8, 0)
+}
+
+// vSphere environments use an existing network, so the director is placed at
+// the same host offset within the user's subnet.
+func directorInternalIP(state storage.State) string {
+	if state.IAAS == "vsphere" {
+		cidr, err := ParseCIDRBlock(state.VSphere.Subnet)
+		if err == nil {
+			return cidr.GetFirstIP().Add(6).String()
+		}
+	}
+	return directorSubnet(state).GetFirstIP().Add(6).String()
+}
+
 func getJumpboxPrivateKey(v string) (string, error) {
 	variables := map[string]interface{}{}
 
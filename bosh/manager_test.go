@@ -126,6 +126,16 @@ gcp_credentials_json: some-credential-json
 				Expect(boshExecutor.CreateEnvCall.CallCount).To(Equal(0))
 			})
 
+			Context("when the director is private", func() {
+				It("passes the private director flag to the executor", func() {
+					state.PrivateDirector = true
+
+					err := boshManager.InitializeDirector(state, terraformOutputs)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(boshExecutor.DirectorCreateEnvArgsCall.Receives.InterpolateInput.PrivateDirector).To(BeTrue())
+				})
+			})
+
 			Context("when create env args fails", func() {
 				BeforeEach(func() {
 					boshExecutor.DirectorCreateEnvArgsCall.Returns.Error = errors.New("failed to interpolate")
@@ -184,6 +194,18 @@ gcp_credentials_json: some-credential-json
 				}))
 			})
 
+			Context("when the iaas is vsphere", func() {
+				It("uses the director address within the vsphere subnet", func() {
+					state.IAAS = "vsphere"
+					state.VSphere = storage.VSphere{Subnet: "192.168.1.0/24"}
+
+					stateWithDirector, err := boshManager.CreateDirector(state)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(stateWithDirector.BOSH.DirectorAddress).To(Equal("https://192.168.1.6:25555"))
+				})
+			})
+
 			Context("when an error occurs", func() {
 				Context("when get vars dir fails", func() {
 					It("returns an error", func() {
@@ -644,14 +666,70 @@ director_name: bosh-some-env-id
 external_ip: some-external-ip
 private_key: some-private-key
 az: some-zone
+default_key_name: some-key-name
+default_security_groups:
+- some-security-group
+region: some-region
 subnet_id: some-subnetwork
 access_key_id: some-access-key-id
 secret_access_key: some-secret-access-key
 iam_instance_profile: some-instance-profile
+`))
+			})
+
+			Context("when a network cidr is set", func() {
+				It("places the jumpbox in the first block of that range", func() {
+					incomingState.NetworkCIDR = "172.16.32.0/19"
+
+					vars := boshManager.GetJumpboxDeploymentVars(incomingState, terraform.Outputs{})
+					Expect(vars).To(ContainSubstring("internal_cidr: 172.16.32.0/27\ninternal_gw: 172.16.32.1\ninternal_ip: 172.16.32.5\n"))
+				})
+			})
+		})
+
+		Context("openstack", func() {
+			var incomingState storage.State
+			BeforeEach(func() {
+				incomingState = storage.State{
+					IAAS:  "openstack",
+					EnvID: "some-env-id",
+					OpenStack: storage.OpenStack{
+						AuthURL:  "some-auth-url",
+						AZ:       "some-az",
+						Project:  "some-project",
+						Domain:   "some-domain",
+						Region:   "some-region",
+						Username: "some-username",
+						Password: "some-password",
+					},
+				}
+			})
+
+			It("returns a correct yaml string of bosh deployment variables", func() {
+				vars := boshManager.GetJumpboxDeploymentVars(incomingState, terraform.Outputs{Map: map[string]interface{}{
+					"network_id":             "some-network-id",
+					"bosh_vms_key_name":      "some-key-name",
+					"bosh_vms_private_key":   "some-private-key",
+					"jumpbox_security_group": "some-jumpbox-security-group",
+					"external_ip":            "some-external-ip",
+				}})
+				Expect(vars).To(Equal(`internal_cidr: 10.0.0.0/24
+internal_gw: 10.0.0.1
+internal_ip: 10.0.0.5
+director_name: bosh-some-env-id
+external_ip: some-external-ip
+private_key: some-private-key
+az: some-az
 default_key_name: some-key-name
 default_security_groups:
-- some-security-group
+- some-jumpbox-security-group
 region: some-region
+net_id: some-network-id
+auth_url: some-auth-url
+openstack_username: some-username
+openstack_password: some-password
+openstack_domain: some-domain
+openstack_project: some-project
 `))
 			})
 		})
@@ -692,6 +770,44 @@ tags:
 - some-jumpbox-fw-tag
 project_id: some-project-id
 gcp_credentials_json: some-credential-json
+`))
+			})
+		})
+		Context("vsphere", func() {
+			It("returns a correct yaml string of bosh deployment variables", func() {
+				vars := boshManager.GetJumpboxDeploymentVars(storage.State{
+					IAAS:  "vsphere",
+					EnvID: "some-env-id",
+					VSphere: storage.VSphere{
+						VCenterIP:       "some-vcenter-ip",
+						VCenterUser:     "some-vcenter-user",
+						VCenterPassword: "some-vcenter-password",
+						VCenterDC:       "some-vcenter-dc",
+						VCenterCluster:  "some-vcenter-cluster",
+						VCenterDS:       "some-vcenter-ds",
+					},
+				}, terraform.Outputs{Map: map[string]interface{}{
+					"network_name":        "some-network",
+					"internal_cidr":       "192.168.1.0/24",
+					"internal_gw":         "192.168.1.1",
+					"jumpbox_internal_ip": "192.168.1.5",
+					"external_ip":         "192.168.1.5",
+				}})
+				Expect(vars).To(Equal(`internal_cidr: 192.168.1.0/24
+internal_gw: 192.168.1.1
+internal_ip: 192.168.1.5
+director_name: bosh-some-env-id
+external_ip: 192.168.1.5
+network_name: some-network
+vcenter_ip: some-vcenter-ip
+vcenter_user: some-vcenter-user
+vcenter_password: some-vcenter-password
+vcenter_dc: some-vcenter-dc
+vcenter_cluster: some-vcenter-cluster
+vcenter_ds: some-vcenter-ds
+vcenter_vms: some-env-id_vms
+vcenter_templates: some-env-id_templates
+vcenter_disks: some-env-id_disks
 `))
 			})
 		})
@@ -795,14 +911,14 @@ internal_ip: 10.0.0.6
 director_name: bosh-some-env-id
 private_key: some-private-key
 az: some-bosh-subnet-az
-subnet_id: some-bosh-subnet
-access_key_id: some-access-key-id
-secret_access_key: some-secret-access-key
-iam_instance_profile: some-bosh-iam-instance-profile
 default_key_name: some-keypair-name
 default_security_groups:
 - some-bosh-security-group
 region: some-region
+subnet_id: some-bosh-subnet
+access_key_id: some-access-key-id
+secret_access_key: some-secret-access-key
+iam_instance_profile: some-bosh-iam-instance-profile
 kms_key_arn: some-kms-arn
 `))
 				})
@@ -815,14 +931,117 @@ kms_key_arn: some-kms-arn
 internal_gw: 10.0.0.1
 internal_ip: 10.0.0.6
 director_name: bosh-some-env-id
-access_key_id: some-access-key-id
-secret_access_key: some-secret-access-key
 default_security_groups:
 - ""
 region: some-region
+access_key_id: some-access-key-id
+secret_access_key: some-secret-access-key
 `))
 				})
 			})
+
+			Context("when the director is private", func() {
+				It("places the director in the private director subnet", func() {
+					incomingState.PrivateDirector = true
+
+					vars := boshManager.GetDirectorDeploymentVars(incomingState, terraform.Outputs{Map: map[string]interface{}{
+						"bosh_subnet_id":     "some-bosh-subnet",
+						"director_subnet_id": "some-director-subnet",
+					}})
+					Expect(vars).To(ContainSubstring("internal_cidr: 10.0.1.0/24\ninternal_gw: 10.0.1.1\ninternal_ip: 10.0.1.6\n"))
+					Expect(vars).To(ContainSubstring("subnet_id: some-director-subnet\n"))
+				})
+			})
+
+			Context("when a network cidr is set", func() {
+				It("derives the director subnet from that range", func() {
+					incomingState.NetworkCIDR = "172.16.0.0/16"
+
+					vars := boshManager.GetDirectorDeploymentVars(incomingState, terraform.Outputs{})
+					Expect(vars).To(ContainSubstring("internal_cidr: 172.16.0.0/24\ninternal_gw: 172.16.0.1\ninternal_ip: 172.16.0.6\n"))
+
+					incomingState.PrivateDirector = true
+
+					vars = boshManager.GetDirectorDeploymentVars(incomingState, terraform.Outputs{})
+					Expect(vars).To(ContainSubstring("internal_cidr: 172.16.1.0/24\ninternal_gw: 172.16.1.1\ninternal_ip: 172.16.1.6\n"))
+				})
+			})
+		})
+
+		Context("openstack", func() {
+			It("returns a correct yaml string of bosh deployment variables", func() {
+				vars := boshManager.GetDirectorDeploymentVars(storage.State{
+					IAAS:  "openstack",
+					EnvID: "some-env-id",
+					OpenStack: storage.OpenStack{
+						AuthURL:  "some-auth-url",
+						AZ:       "some-az",
+						Project:  "some-project",
+						Domain:   "some-domain",
+						Region:   "some-region",
+						Username: "some-username",
+						Password: "some-password",
+					},
+				}, terraform.Outputs{Map: map[string]interface{}{
+					"network_id":           "some-network-id",
+					"bosh_vms_key_name":    "some-key-name",
+					"bosh_vms_private_key": "some-private-key",
+					"bosh_security_group":  "some-bosh-security-group",
+				}})
+				Expect(vars).To(Equal(`internal_cidr: 10.0.0.0/24
+internal_gw: 10.0.0.1
+internal_ip: 10.0.0.6
+director_name: bosh-some-env-id
+private_key: some-private-key
+az: some-az
+default_key_name: some-key-name
+default_security_groups:
+- some-bosh-security-group
+region: some-region
+net_id: some-network-id
+auth_url: some-auth-url
+openstack_username: some-username
+openstack_password: some-password
+openstack_domain: some-domain
+openstack_project: some-project
+`))
+			})
+		})
+		Context("vsphere", func() {
+			It("returns a correct yaml string of bosh deployment variables", func() {
+				vars := boshManager.GetDirectorDeploymentVars(storage.State{
+					IAAS:  "vsphere",
+					EnvID: "some-env-id",
+					VSphere: storage.VSphere{
+						VCenterIP:       "some-vcenter-ip",
+						VCenterUser:     "some-vcenter-user",
+						VCenterPassword: "some-vcenter-password",
+						VCenterDC:       "some-vcenter-dc",
+						VCenterCluster:  "some-vcenter-cluster",
+						VCenterDS:       "some-vcenter-ds",
+					},
+				}, terraform.Outputs{Map: map[string]interface{}{
+					"network_name":         "some-network",
+					"internal_cidr":        "192.168.1.0/24",
+					"internal_gw":          "192.168.1.1",
+					"director_internal_ip": "192.168.1.6",
+				}})
+				Expect(vars).To(Equal(`internal_cidr: 192.168.1.0/24
+internal_gw: 192.168.1.1
+internal_ip: 192.168.1.6
+director_name: bosh-some-env-id
+network_name: some-network
+vcenter_ip: some-vcenter-ip
+vcenter_user: some-vcenter-user
+vcenter_password: some-vcenter-password
+vcenter_dc: some-vcenter-dc
+vcenter_cluster: some-vcenter-cluster
+vcenter_ds: some-vcenter-ds
+vcenter_vms: some-env-id_vms
+vcenter_templates: some-env-id_templates
+vcenter_disks: some-env-id_disks
+`))
+			})
 		})
 	})
 
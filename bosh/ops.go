@@ -20,3 +20,19 @@ const AWSEncryptDiskOps = `---
     encrypted: true
     kms_key_arn: ((kms_key_arn))
 `
+
+// OpenStackJumpboxKeystoneV3Ops authenticates the jumpbox CPI with keystone
+// v3, as the director CPI does, instead of the keystone v2 tenant of the
+// jumpbox-deployment openstack cpi.yml.
+const OpenStackJumpboxKeystoneV3Ops = `---
+- type: remove
+  path: /cloud_provider/properties/openstack/tenant
+
+- type: replace
+  path: /cloud_provider/properties/openstack/domain?
+  value: ((openstack_domain))
+
+- type: replace
+  path: /cloud_provider/properties/openstack/project?
+  value: ((openstack_project))
+`
@@ -4,6 +4,7 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"time"
 )
 
 func SetReadAll(f func(r io.Reader) ([]byte, error)) {
@@ -21,3 +22,11 @@ func SetStat(f func(name string) (os.FileInfo, error)) {
 func ResetStat() {
 	stat = os.Stat
 }
+
+func SetTimeNow(f func() time.Time) {
+	timeNow = f
+}
+
+func ResetTimeNow() {
+	timeNow = time.Now
+}
@@ -0,0 +1,150 @@
+package certs
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+)
+
+var timeNow func() time.Time = time.Now
+
+type Status struct {
+	Name          string    `json:"name"`
+	Subject       string    `json:"subject"`
+	Issuer        string    `json:"issuer"`
+	SANs          []string  `json:"sans"`
+	NotAfter      time.Time `json:"notAfter"`
+	DaysRemaining int       `json:"daysRemaining"`
+}
+
+func (s Status) ExpiresWithin(days int) bool {
+	return s.DaysRemaining < days
+}
+
+type StatusReporter struct{}
+
+func NewStatusReporter() StatusReporter {
+	return StatusReporter{}
+}
+
+// Report returns the status of every certificate bbl manages in the given
+// state: the director CA, the certificates generated into the director and
+// jumpbox variables, and the load balancer certificate.
+func (s StatusReporter) Report(state storage.State) ([]Status, error) {
+	statuses := []Status{}
+
+	if state.BOSH.DirectorSSLCA != "" {
+		status, err := parseStatus("director_ssl_ca", state.BOSH.DirectorSSLCA)
+		if err != nil {
+			return []Status{}, err
+		}
+		statuses = append(statuses, status)
+	}
+
+	boshStatuses, err := parseVariables("bosh", state.BOSH.Variables)
+	if err != nil {
+		return []Status{}, err
+	}
+	statuses = append(statuses, boshStatuses...)
+
+	jumpboxStatuses, err := parseVariables("jumpbox", state.Jumpbox.Variables)
+	if err != nil {
+		return []Status{}, err
+	}
+	statuses = append(statuses, jumpboxStatuses...)
+
+	if state.LB.Cert != "" {
+		status, err := parseStatus("lb_cert", state.LB.Cert)
+		if err != nil {
+			return []Status{}, err
+		}
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+func parseVariables(prefix, variables string) ([]Status, error) {
+	vars := map[string]interface{}{}
+	err := yaml.Unmarshal([]byte(variables), &vars)
+	if err != nil {
+		return []Status{}, fmt.Errorf("unmarshal %s variables: %s", prefix, err)
+	}
+
+	names := []string{}
+	for name := range vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	statuses := []Status{}
+	for _, name := range names {
+		variable, ok := vars[name].(map[interface{}]interface{})
+		if !ok {
+			continue
+		}
+
+		certificate, ok := variable["certificate"].(string)
+		if !ok || certificate == "" {
+			continue
+		}
+
+		status, err := parseStatus(fmt.Sprintf("%s/%s", prefix, name), certificate)
+		if err != nil {
+			return []Status{}, err
+		}
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+func parseStatus(name, certificate string) (Status, error) {
+	block, _ := pem.Decode([]byte(certificate))
+	if block == nil {
+		return Status{}, fmt.Errorf("%s is not PEM encoded", name)
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return Status{}, fmt.Errorf("failed to parse %s: %s", name, err)
+	}
+
+	sans := []string{}
+	sans = append(sans, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+
+	return Status{
+		Name:          name,
+		Subject:       formatName(cert.Subject),
+		Issuer:        formatName(cert.Issuer),
+		SANs:          sans,
+		NotAfter:      cert.NotAfter,
+		DaysRemaining: int(math.Floor(cert.NotAfter.Sub(timeNow()).Hours() / 24)),
+	}, nil
+}
+
+func formatName(name pkix.Name) string {
+	parts := []string{}
+	if name.CommonName != "" {
+		parts = append(parts, fmt.Sprintf("CN=%s", name.CommonName))
+	}
+	for _, organization := range name.Organization {
+		parts = append(parts, fmt.Sprintf("O=%s", organization))
+	}
+	for _, country := range name.Country {
+		parts = append(parts, fmt.Sprintf("C=%s", country))
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,156 @@
+package certs_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/cloudfoundry/bosh-bootloader/certs"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("StatusReporter", func() {
+	var (
+		reporter certs.StatusReporter
+		now      time.Time
+	)
+
+	BeforeEach(func() {
+		now = time.Date(2017, time.July, 1, 0, 0, 0, 0, time.UTC)
+		certs.SetTimeNow(func() time.Time { return now })
+
+		reporter = certs.NewStatusReporter()
+	})
+
+	AfterEach(func() {
+		certs.ResetTimeNow()
+	})
+
+	Describe("Report", func() {
+		It("returns the status of every certificate in the state", func() {
+			directorCA := generateCertificate("some-ca", now.Add(365*24*time.Hour))
+			directorCert := generateCertificate("some-director", now.Add(10*24*time.Hour), "10.0.0.6", "director.example.com")
+			jumpboxCert := generateCertificate("some-jumpbox", now.Add(40*24*time.Hour))
+			lbCert := generateCertificate("some-lb", now.Add(-2*24*time.Hour))
+
+			statuses, err := reporter.Report(storage.State{
+				BOSH: storage.BOSH{
+					DirectorSSLCA: directorCA,
+					Variables: fmt.Sprintf(`admin_password: some-password
+director_ssl:
+  ca: some-ca
+  certificate: |
+%s
+  private_key: some-key
+`, indent(directorCert)),
+				},
+				Jumpbox: storage.Jumpbox{
+					Variables: fmt.Sprintf(`jumpbox_ssh:
+  private_key: some-key
+jumpbox_tls:
+  certificate: |
+%s
+`, indent(jumpboxCert)),
+				},
+				LB: storage.LB{
+					Cert: lbCert,
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(statuses).To(HaveLen(4))
+
+			Expect(statuses[0].Name).To(Equal("director_ssl_ca"))
+			Expect(statuses[0].Subject).To(Equal("CN=some-ca,O=bbl"))
+			Expect(statuses[0].Issuer).To(Equal("CN=some-ca,O=bbl"))
+			Expect(statuses[0].DaysRemaining).To(Equal(365))
+
+			Expect(statuses[1].Name).To(Equal("bosh/director_ssl"))
+			Expect(statuses[1].Subject).To(Equal("CN=some-director,O=bbl"))
+			Expect(statuses[1].SANs).To(Equal([]string{"director.example.com", "10.0.0.6"}))
+			Expect(statuses[1].DaysRemaining).To(Equal(10))
+			Expect(statuses[1].ExpiresWithin(30)).To(BeTrue())
+
+			Expect(statuses[2].Name).To(Equal("jumpbox/jumpbox_tls"))
+			Expect(statuses[2].DaysRemaining).To(Equal(40))
+			Expect(statuses[2].ExpiresWithin(30)).To(BeFalse())
+
+			Expect(statuses[3].Name).To(Equal("lb_cert"))
+			Expect(statuses[3].DaysRemaining).To(Equal(-2))
+		})
+
+		It("returns no statuses for an empty state", func() {
+			statuses, err := reporter.Report(storage.State{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(statuses).To(BeEmpty())
+		})
+
+		Context("failure cases", func() {
+			It("returns an error when the variables cannot be unmarshaled", func() {
+				_, err := reporter.Report(storage.State{
+					BOSH: storage.BOSH{Variables: "%%%"},
+				})
+				Expect(err).To(MatchError(ContainSubstring("unmarshal bosh variables")))
+			})
+
+			It("returns an error when a certificate is not PEM encoded", func() {
+				_, err := reporter.Report(storage.State{
+					LB: storage.LB{Cert: "not-a-cert"},
+				})
+				Expect(err).To(MatchError("lb_cert is not PEM encoded"))
+			})
+
+			It("returns an error when a certificate cannot be parsed", func() {
+				_, err := reporter.Report(storage.State{
+					BOSH: storage.BOSH{DirectorSSLCA: "-----BEGIN CERTIFICATE-----\nZm9v\n-----END CERTIFICATE-----\n"},
+				})
+				Expect(err).To(MatchError(ContainSubstring("failed to parse director_ssl_ca")))
+			})
+		})
+	})
+})
+
+func generateCertificate(commonName string, notAfter time.Time, sans ...string) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	Expect(err).NotTo(HaveOccurred())
+
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject: pkix.Name{
+			CommonName:   commonName,
+			Organization: []string{"bbl"},
+		},
+		NotBefore: notAfter.Add(-1000 * 24 * time.Hour),
+		NotAfter:  notAfter,
+	}
+	for _, san := range sans {
+		if ip := net.ParseIP(san); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, san)
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	Expect(err).NotTo(HaveOccurred())
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func indent(contents string) string {
+	lines := strings.Split(strings.TrimSpace(contents), "\n")
+	for i := range lines {
+		lines[i] = "    " + lines[i]
+	}
+	return strings.Join(lines, "\n")
+}
@@ -62,7 +62,8 @@ type lb struct {
 }
 
 type lbCloudProperties struct {
-	ELBs           []string
+	ELBs           []string `yaml:"elbs,omitempty"`
+	LBTargetGroups []string `yaml:"lb_target_groups,omitempty"`
 	SecurityGroups []string `yaml:"security_groups"`
 }
 
@@ -169,61 +170,77 @@ func (a OpsGenerator) generateOps(state storage.State) ([]op, error) {
 	switch state.LB.Type {
 	case "cf":
 		lbSecurityGroups := []map[string]string{
-			map[string]string{"name": "cf-router-network-properties", "lb": "cf_router_lb_name", "group": "cf_router_lb_internal_security_group"},
-			map[string]string{"name": "diego-ssh-proxy-network-properties", "lb": "cf_ssh_lb_name", "group": "cf_ssh_lb_internal_security_group"},
+			map[string]string{"name": "cf-router-network-properties", "lb": "cf_router_lb_name", "targetGroups": "cf_router_lb_target_groups", "group": "cf_router_lb_internal_security_group"},
+			map[string]string{"name": "diego-ssh-proxy-network-properties", "lb": "cf_ssh_lb_name", "targetGroups": "cf_ssh_lb_target_groups", "group": "cf_ssh_lb_internal_security_group"},
 			map[string]string{"name": "cf-tcp-router-network-properties", "lb": "cf_tcp_lb_name", "group": "cf_tcp_lb_internal_security_group"},
-			map[string]string{"name": "router-lb", "lb": "cf_router_lb_name", "group": "cf_router_lb_internal_security_group"},
-			map[string]string{"name": "ssh-proxy-lb", "lb": "cf_ssh_lb_name", "group": "cf_ssh_lb_internal_security_group"},
+			map[string]string{"name": "router-lb", "lb": "cf_router_lb_name", "targetGroups": "cf_router_lb_target_groups", "group": "cf_router_lb_internal_security_group"},
+			map[string]string{"name": "ssh-proxy-lb", "lb": "cf_ssh_lb_name", "targetGroups": "cf_ssh_lb_target_groups", "group": "cf_ssh_lb_internal_security_group"},
 		}
 
 		for _, details := range lbSecurityGroups {
-			elb := terraformOutputs.GetString(details["lb"])
-			if elb == "" {
-				return []op{}, fmt.Errorf("missing %s terraform output", details["lb"])
+			cloudProperties, err := lbExtensionCloudProperties(terraformOutputs, state.LB.Flavor, details["lb"], details["targetGroups"])
+			if err != nil {
+				return []op{}, err
 			}
 
 			grp := terraformOutputs.GetString(details["group"])
 			if grp == "" {
 				return []op{}, fmt.Errorf("missing %s terraform output", details["group"])
 			}
+			cloudProperties.SecurityGroups = []string{
+				grp,
+				internalSecurityGroup,
+			}
 
 			ops = append(ops, createOp("replace", "/vm_extensions/-", lb{
-				Name: details["name"],
-				CloudProperties: lbCloudProperties{
-					ELBs: []string{elb},
-					SecurityGroups: []string{
-						grp,
-						internalSecurityGroup,
-					},
-				},
+				Name:            details["name"],
+				CloudProperties: cloudProperties,
 			}))
 		}
 	case "concourse":
-		concourseLoadBalancer := terraformOutputs.GetString("concourse_lb_name")
-		if concourseLoadBalancer == "" {
-			return []op{}, errors.New("missing concourse_lb_name terraform output")
+		cloudProperties, err := lbExtensionCloudProperties(terraformOutputs, state.LB.Flavor, "concourse_lb_name", "concourse_lb_target_groups")
+		if err != nil {
+			return []op{}, err
 		}
 
 		concourseInternalSecurityGroup := terraformOutputs.GetString("concourse_lb_internal_security_group")
 		if concourseInternalSecurityGroup == "" {
 			return []op{}, errors.New("missing concourse_lb_internal_security_group terraform output")
 		}
+		cloudProperties.SecurityGroups = []string{
+			concourseInternalSecurityGroup,
+			internalSecurityGroup,
+		}
 
 		ops = append(ops, createOp("replace", "/vm_extensions/-", lb{
-			Name: "lb",
-			CloudProperties: lbCloudProperties{
-				ELBs: []string{concourseLoadBalancer},
-				SecurityGroups: []string{
-					concourseInternalSecurityGroup,
-					internalSecurityGroup,
-				},
-			},
+			Name:            "lb",
+			CloudProperties: cloudProperties,
 		}))
 	}
 
 	return ops, nil
 }
 
+// lbExtensionCloudProperties attaches a vm extension to its load balancer.
+// Classic ELBs are referenced by name, while ALBs and NLBs are attached
+// through their target groups. The cf tcp router always stays on a classic
+// ELB, so it has no target groups output.
+func lbExtensionCloudProperties(terraformOutputs terraform.Outputs, flavor, lbOutput, targetGroupsOutput string) (lbCloudProperties, error) {
+	if flavor != "" && targetGroupsOutput != "" {
+		targetGroups := terraformOutputs.GetStringSlice(targetGroupsOutput)
+		if len(targetGroups) == 0 {
+			return lbCloudProperties{}, fmt.Errorf("missing %s terraform output", targetGroupsOutput)
+		}
+		return lbCloudProperties{LBTargetGroups: targetGroups}, nil
+	}
+
+	elb := terraformOutputs.GetString(lbOutput)
+	if elb == "" {
+		return lbCloudProperties{}, fmt.Errorf("missing %s terraform output", lbOutput)
+	}
+	return lbCloudProperties{ELBs: []string{elb}}, nil
+}
+
 func generateNetworkSubnet(az, cidr, subnet, securityGroup string) (networkSubnet, error) {
 	parsedCidr, err := bosh.ParseCIDRBlock(cidr)
 	if err != nil {
@@ -49,6 +49,9 @@ var _ = Describe("OpsGenerator", func() {
 				"cf_tcp_lb_internal_security_group":    "some-cf-tcp-lb-internal-security-group",
 				"concourse_lb_name":                    "some-concourse-lb-name",
 				"concourse_lb_internal_security_group": "some-concourse-lb-internal-security-group",
+				"cf_router_lb_target_groups":           []interface{}{"some-cf-router-lb-target-group"},
+				"cf_ssh_lb_target_groups":              []interface{}{"some-cf-ssh-lb-target-group"},
+				"concourse_lb_target_groups":           []interface{}{"some-concourse-web-target-group", "some-concourse-tsa-target-group"},
 				"internal_az_subnet_id_mapping": map[string]interface{}{
 					"us-east-1c": "some-internal-subnet-ids-3",
 					"us-east-1a": "some-internal-subnet-ids-1",
@@ -122,6 +125,44 @@ var _ = Describe("OpsGenerator", func() {
 			})
 		})
 
+		Context("when there are cf lbs with an alb or nlb flavor", func() {
+			BeforeEach(func() {
+				baseOpsYAMLContents, err := ioutil.ReadFile(filepath.Join("fixtures", "aws-ops.yml"))
+				Expect(err).NotTo(HaveOccurred())
+				lbsOpsYAMLContents, err := ioutil.ReadFile(filepath.Join("fixtures", "aws-cf-target-groups-ops.yml"))
+				Expect(err).NotTo(HaveOccurred())
+				expectedOpsYAML = strings.Join([]string{string(baseOpsYAMLContents), string(lbsOpsYAMLContents)}, "\n")
+			})
+
+			It("attaches the router and ssh proxy through their target groups", func() {
+				incomingState.LB.Type = "cf"
+				incomingState.LB.Flavor = "alb"
+				opsYAML, err := opsGenerator.Generate(incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(opsYAML).To(gomegamatchers.MatchYAML(expectedOpsYAML))
+			})
+		})
+
+		Context("when there is a concourse lb with an alb or nlb flavor", func() {
+			BeforeEach(func() {
+				baseOpsYAMLContents, err := ioutil.ReadFile(filepath.Join("fixtures", "aws-ops.yml"))
+				Expect(err).NotTo(HaveOccurred())
+				lbsOpsYAMLContents, err := ioutil.ReadFile(filepath.Join("fixtures", "aws-concourse-target-groups-ops.yml"))
+				Expect(err).NotTo(HaveOccurred())
+				expectedOpsYAML = strings.Join([]string{string(baseOpsYAMLContents), string(lbsOpsYAMLContents)}, "\n")
+			})
+
+			It("attaches the concourse web and tsa through their target groups", func() {
+				incomingState.LB.Type = "concourse"
+				incomingState.LB.Flavor = "nlb"
+				opsYAML, err := opsGenerator.Generate(incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(opsYAML).To(gomegamatchers.MatchYAML(expectedOpsYAML))
+			})
+		})
+
 		Context("when an error occurs", func() {
 			Context("when terraform fails to get outputs", func() {
 				It("returns an error", func() {
@@ -152,28 +193,33 @@ var _ = Describe("OpsGenerator", func() {
 				})
 			})
 
-			DescribeTable("when a terraform output is missing", func(outputKey, lbType string) {
+			DescribeTable("when a terraform output is missing", func(outputKey, lbType, lbFlavor string) {
 				delete(terraformManager.GetOutputsCall.Returns.Outputs.Map, outputKey)
 				_, err := opsGenerator.Generate(storage.State{
 					LB: storage.LB{
-						Type: lbType,
+						Type:   lbType,
+						Flavor: lbFlavor,
 					},
 				})
 				Expect(err).To(MatchError(fmt.Sprintf("missing %s terraform output", outputKey)))
 			},
-				Entry("when internal_security_group is missing", "internal_security_group", ""),
-
-				Entry("when internal_az_subnet_id_mapping is missing", "internal_az_subnet_id_mapping", "cf"),
-				Entry("when internal_az_subnet_cidr_mapping is missing", "internal_az_subnet_cidr_mapping", "cf"),
-				Entry("when cf_router_lb_name is missing", "cf_router_lb_name", "cf"),
-				Entry("when cf_router_lb_internal_security_group is missing", "cf_router_lb_internal_security_group", "cf"),
-				Entry("when cf_ssh_lb_name is missing", "cf_ssh_lb_name", "cf"),
-				Entry("when cf_ssh_lb_internal_security_group is missing", "cf_ssh_lb_internal_security_group", "cf"),
-				Entry("when cf_tcp_lb_name", "cf_tcp_lb_name", "cf"),
-				Entry("when cf_tcp_lb_internal_security_group is missing", "cf_tcp_lb_internal_security_group", "cf"),
-
-				Entry("when concourse_lb_name is missing", "concourse_lb_name", "concourse"),
-				Entry("when concourse_lb_internal_security_group is missing", "concourse_lb_internal_security_group", "concourse"),
+				Entry("when internal_security_group is missing", "internal_security_group", "", ""),
+
+				Entry("when internal_az_subnet_id_mapping is missing", "internal_az_subnet_id_mapping", "cf", ""),
+				Entry("when internal_az_subnet_cidr_mapping is missing", "internal_az_subnet_cidr_mapping", "cf", ""),
+				Entry("when cf_router_lb_name is missing", "cf_router_lb_name", "cf", ""),
+				Entry("when cf_router_lb_internal_security_group is missing", "cf_router_lb_internal_security_group", "cf", ""),
+				Entry("when cf_ssh_lb_name is missing", "cf_ssh_lb_name", "cf", ""),
+				Entry("when cf_ssh_lb_internal_security_group is missing", "cf_ssh_lb_internal_security_group", "cf", ""),
+				Entry("when cf_tcp_lb_name", "cf_tcp_lb_name", "cf", ""),
+				Entry("when cf_tcp_lb_internal_security_group is missing", "cf_tcp_lb_internal_security_group", "cf", ""),
+
+				Entry("when concourse_lb_name is missing", "concourse_lb_name", "concourse", ""),
+				Entry("when concourse_lb_internal_security_group is missing", "concourse_lb_internal_security_group", "concourse", ""),
+
+				Entry("when cf_router_lb_target_groups is missing", "cf_router_lb_target_groups", "cf", "alb"),
+				Entry("when cf_ssh_lb_target_groups is missing", "cf_ssh_lb_target_groups", "cf", "nlb"),
+				Entry("when concourse_lb_target_groups is missing", "concourse_lb_target_groups", "concourse", "alb"),
 			)
 		})
 	})
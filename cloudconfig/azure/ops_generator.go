@@ -46,6 +46,16 @@ type subnetCloudProperties struct {
 	SecurityGroup      string `yaml:"security_group,omitempty"`
 }
 
+type vmExtension struct {
+	Name            string
+	CloudProperties vmExtensionCloudProperties `yaml:"cloud_properties"`
+}
+
+type vmExtensionCloudProperties struct {
+	LoadBalancer  string `yaml:"load_balancer"`
+	SecurityGroup string `yaml:"security_group,omitempty"`
+}
+
 var marshal func(interface{}) ([]byte, error) = yaml.Marshal
 
 func NewOpsGenerator(terraformManager terraformManager) OpsGenerator {
@@ -63,7 +73,7 @@ func (o OpsGenerator) Generate(state storage.State) (string, error) {
 	zones := []string{"z1", "z2", "z3"}
 	var subnets []networkSubnet
 	for i, _ := range zones {
-		cidr := fmt.Sprintf("10.0.%d.0/20", 16*(i+1))
+		cidr := bosh.NetworkCIDRBlock(state).Subnet(4, i+1).String()
 		subnet, err := generateNetworkSubnet(
 			fmt.Sprintf("z%d", i+1),
 			cidr,
@@ -100,6 +110,8 @@ func (o OpsGenerator) Generate(state storage.State) (string, error) {
 		},
 	}
 
+	cloudConfigOps = append(cloudConfigOps, lbExtensionOps(state.LB.Type, terraformOutputs)...)
+
 	cloudConfigOpsYAML, err := marshal(cloudConfigOps)
 	if err != nil {
 		return "", err
@@ -114,6 +126,38 @@ func (o OpsGenerator) Generate(state storage.State) (string, error) {
 	), nil
 }
 
+func lbExtensionOps(lbType string, terraformOutputs terraform.Outputs) []op {
+	switch lbType {
+	case "cf":
+		cfSecurityGroup := terraformOutputs.GetString("cf_security_group")
+		return []op{
+			vmExtensionOp("cf-router-network-properties", terraformOutputs.GetString("router_lb_name"), cfSecurityGroup),
+			vmExtensionOp("diego-ssh-proxy-network-properties", terraformOutputs.GetString("ssh_proxy_lb_name"), cfSecurityGroup),
+			vmExtensionOp("cf-tcp-router-network-properties", terraformOutputs.GetString("tcp_router_lb_name"), cfSecurityGroup),
+		}
+	case "concourse":
+		return []op{
+			vmExtensionOp("lb", terraformOutputs.GetString("concourse_lb_name"), terraformOutputs.GetString("concourse_security_group")),
+		}
+	}
+
+	return nil
+}
+
+func vmExtensionOp(name, loadBalancer, securityGroup string) op {
+	return op{
+		Type: "replace",
+		Path: "/vm_extensions/-",
+		Value: vmExtension{
+			Name: name,
+			CloudProperties: vmExtensionCloudProperties{
+				LoadBalancer:  loadBalancer,
+				SecurityGroup: securityGroup,
+			},
+		},
+	}
+}
+
 func generateNetworkSubnet(az, cidr, networkName, subnetName, securityGroup string) (networkSubnet, error) {
 	parsedCidr, err := bosh.ParseCIDRBlock(cidr)
 	if err != nil {
@@ -2,14 +2,17 @@ package azure_test
 
 import (
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"path/filepath"
+	"strings"
 
 	"github.com/cloudfoundry/bosh-bootloader/cloudconfig/azure"
 	"github.com/cloudfoundry/bosh-bootloader/fakes"
 	"github.com/cloudfoundry/bosh-bootloader/storage"
 	"github.com/cloudfoundry/bosh-bootloader/terraform"
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	"github.com/pivotal-cf-experimental/gomegamatchers"
 )
@@ -53,6 +56,38 @@ var _ = Describe("AzureOpsGenerator", func() {
 			Expect(opsYAML).To(gomegamatchers.MatchYAML(expectedOpsFile))
 		})
 
+		DescribeTable("returns an ops file with additional vm extensions to support lb",
+			func(lbType string, lbOutputs map[string]interface{}) {
+				incomingState.LB.Type = lbType
+
+				expectedLBOpsFile, err := ioutil.ReadFile(filepath.Join("fixtures", fmt.Sprintf("azure-%s-lb-ops.yml", lbType)))
+				Expect(err).NotTo(HaveOccurred())
+
+				expectedOps := strings.Join([]string{string(expectedOpsFile), string(expectedLBOpsFile)}, "\n")
+
+				for name, value := range lbOutputs {
+					terraformManager.GetOutputsCall.Returns.Outputs.Map[name] = value
+				}
+
+				opsYAML, err := opsGenerator.Generate(incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(opsYAML).To(gomegamatchers.MatchYAML(expectedOps))
+			},
+			Entry("cf load balancer exists", "cf",
+				map[string]interface{}{
+					"cf_security_group":  "some-cf-security-group",
+					"router_lb_name":     "some-router-lb",
+					"ssh_proxy_lb_name":  "some-ssh-proxy-lb",
+					"tcp_router_lb_name": "some-tcp-router-lb",
+				}),
+			Entry("concourse load balancer exists", "concourse",
+				map[string]interface{}{
+					"concourse_security_group": "some-concourse-security-group",
+					"concourse_lb_name":        "some-concourse-lb",
+				}),
+		)
+
 		Context("failure cases", func() {
 			Context("when terraform output provider fails to retrieve", func() {
 				BeforeEach(func() {
@@ -121,13 +121,14 @@ func (o *OpsGenerator) generateGCPOps(state storage.State) ([]op, error) {
 
 	var subnets []networkSubnet
 	for i, _ := range state.GCP.Zones {
-		cidr := fmt.Sprintf("10.0.%d.0/20", 16*(i+1))
+		cidr := bosh.NetworkCIDRBlock(state).Subnet(4, i+1).String()
 		subnet, err := generateNetworkSubnet(
 			fmt.Sprintf("z%d", i+1),
 			cidr,
 			terraformOutputs.GetString("network_name"),
 			terraformOutputs.GetString("subnetwork_name"),
 			terraformOutputs.GetString("internal_tag_name"),
+			!state.PrivateDirector,
 		)
 		if err != nil {
 			return []op{}, fmt.Errorf("Generating network subnet: %s", err)
@@ -148,6 +149,10 @@ func (o *OpsGenerator) generateGCPOps(state storage.State) ([]op, error) {
 		Type:    "manual",
 	}))
 
+	if state.PrivateDirector {
+		ops = append(ops, createOp("replace", "/vm_extensions/name=internet-required/cloud_properties/ephemeral_external_ip", false))
+	}
+
 	if state.LB.Type == "concourse" {
 		ops = append(ops, createOp("replace", "/vm_extensions/-", lb{
 			Name: "lb",
@@ -204,7 +209,7 @@ func (o *OpsGenerator) generateGCPOps(state storage.State) ([]op, error) {
 	return ops, nil
 }
 
-func generateNetworkSubnet(az, cidr, networkName, subnetworkName, internalTag string) (networkSubnet, error) {
+func generateNetworkSubnet(az, cidr, networkName, subnetworkName, internalTag string, ephemeralExternalIP bool) (networkSubnet, error) {
 	parsedCidr, err := bosh.ParseCIDRBlock(cidr)
 	if err != nil {
 		return networkSubnet{}, err
@@ -229,7 +234,7 @@ func generateNetworkSubnet(az, cidr, networkName, subnetworkName, internalTag st
 			fmt.Sprintf("%s-%s", firstStatic, lastStatic),
 		},
 		CloudProperties: subnetCloudProperties{
-			EphemeralExternalIP: true,
+			EphemeralExternalIP: ephemeralExternalIP,
 			NetworkName:         networkName,
 			SubnetworkName:      subnetworkName,
 			Tags:                []string{internalTag},
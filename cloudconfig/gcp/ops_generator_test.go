@@ -63,6 +63,32 @@ var _ = Describe("GCPOpsGenerator", func() {
 			Expect(opsYAML).To(gomegamatchers.MatchYAML(expectedOpsFile))
 		})
 
+		Context("when the director is private", func() {
+			It("does not give any vm an ephemeral external ip", func() {
+				incomingState.PrivateDirector = true
+
+				opsYAML, err := opsGenerator.Generate(incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				generatedOps := strings.TrimPrefix(opsYAML, gcp.BaseOps)
+				Expect(generatedOps).NotTo(ContainSubstring("ephemeral_external_ip: true"))
+				Expect(generatedOps).To(ContainSubstring("path: /vm_extensions/name=internet-required/cloud_properties/ephemeral_external_ip\n  value: false"))
+			})
+		})
+
+		Context("when a network cidr is set", func() {
+			It("lays the subnets out in that range", func() {
+				incomingState.NetworkCIDR = "172.16.0.0/16"
+
+				opsYAML, err := opsGenerator.Generate(incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(opsYAML).To(ContainSubstring("range: 172.16.16.0/20"))
+				Expect(opsYAML).To(ContainSubstring("range: 172.16.48.0/20"))
+				Expect(opsYAML).NotTo(ContainSubstring("10.0."))
+			})
+		})
+
 		DescribeTable("returns an ops file with additional vm extensions to support lb",
 			func(lbType string, lbOutputs map[string]interface{}) {
 				incomingState.LB.Type = lbType
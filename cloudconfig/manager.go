@@ -12,6 +12,14 @@ import (
 	"github.com/cloudfoundry/bosh-bootloader/terraform"
 )
 
+// Name is the name of the cloud config bbl publishes to the director. Other
+// named cloud configs are left untouched and merged in by the director.
+const Name = "bbl"
+
+// legacyName is the name the director gives the unnamed cloud config that
+// bbl published before it named its own.
+const legacyName = "default"
+
 var (
 	tempDir   func(string, string) (string, error)    = ioutil.TempDir
 	writeFile func(string, []byte, os.FileMode) error = ioutil.WriteFile
@@ -53,6 +61,7 @@ type sshKeyGetter interface {
 
 type stateStore interface {
 	GetCloudConfigDir() (string, error)
+	GetCloudConfigOpsDir() string
 }
 
 func NewManager(logger logger, cmd command, stateStore stateStore, opsGenerator OpsGenerator, boshClientProvider boshClientProvider,
@@ -94,6 +103,15 @@ func (m Manager) Generate(state storage.State) (string, error) {
 		"-o", filepath.Join(cloudConfigDir, "ops.yml"),
 	}
 
+	userOpsFiles, err := m.userOpsFiles()
+	if err != nil {
+		return "", err
+	}
+
+	for _, userOpsFile := range userOpsFiles {
+		args = append(args, "-o", userOpsFile)
+	}
+
 	buf := bytes.NewBuffer([]byte{})
 	err = m.command.Run(buf, cloudConfigDir, args)
 	if err != nil {
@@ -103,6 +121,35 @@ func (m Manager) Generate(state storage.State) (string, error) {
 	return buf.String(), nil
 }
 
+// userOpsFiles returns the ops files in the state dir's cloud-config
+// directory, in lexical order so users can control the order they apply in.
+// The directory is optional.
+func (m Manager) userOpsFiles() ([]string, error) {
+	opsDir := m.stateStore.GetCloudConfigOpsDir()
+
+	files, err := ioutil.ReadDir(opsDir)
+	switch {
+	case os.IsNotExist(err):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+
+	opsFiles := []string{}
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+
+		switch filepath.Ext(file.Name()) {
+		case ".yml", ".yaml":
+			opsFiles = append(opsFiles, filepath.Join(opsDir, file.Name()))
+		}
+	}
+
+	return opsFiles, nil
+}
+
 func (m Manager) Update(state storage.State) error {
 	boshClient, err := m.boshClientProvider.Client(state.Jumpbox, state.BOSH.DirectorAddress, state.BOSH.DirectorUsername, state.BOSH.DirectorPassword, state.BOSH.DirectorSSLCA)
 	if err != nil {
@@ -115,11 +162,93 @@ func (m Manager) Update(state storage.State) error {
 		return err
 	}
 
+	named, err := hasConfig(boshClient, Name)
+	switch err.(type) {
+	case bosh.ConfigsNotSupportedError:
+		m.logger.Step("applying cloud config")
+		return boshClient.UpdateCloudConfig([]byte(cloudConfig))
+	case error:
+		return err
+	}
+
+	// Environments created before bbl named its cloud config still have the
+	// unnamed one it used to publish. The director would merge the two and
+	// fail on the duplicate networks, azs and vm types, so it is removed
+	// the first time the named config is published.
+	legacy := false
+	if !named {
+		legacy, err = hasConfig(boshClient, legacyName)
+		if err != nil {
+			return err
+		}
+	}
+
 	m.logger.Step("applying cloud config")
-	err = boshClient.UpdateCloudConfig([]byte(cloudConfig))
+	err = boshClient.CreateConfig("cloud", Name, []byte(cloudConfig))
 	if err != nil {
 		return err
 	}
 
+	if legacy {
+		m.logger.Step("removing unnamed cloud config")
+		err = boshClient.DeleteConfig("cloud", legacyName)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+// Diff returns the changes Update would make to the cloud config bbl
+// previously published, named or unnamed. The diff is empty when bbl has not
+// published a cloud config to the director yet, or when the director does
+// not support named configs and so cannot diff them.
+func (m Manager) Diff(state storage.State) (bosh.ConfigDiff, error) {
+	boshClient, err := m.boshClientProvider.Client(state.Jumpbox, state.BOSH.DirectorAddress, state.BOSH.DirectorUsername, state.BOSH.DirectorPassword, state.BOSH.DirectorSSLCA)
+	if err != nil {
+		return bosh.ConfigDiff{}, err // not tested
+	}
+
+	name := Name
+	named, err := hasConfig(boshClient, Name)
+	switch err.(type) {
+	case bosh.ConfigsNotSupportedError:
+		return bosh.ConfigDiff{}, nil
+	case error:
+		return bosh.ConfigDiff{}, err
+	}
+
+	if !named {
+		name = legacyName
+		legacy, err := hasConfig(boshClient, legacyName)
+		if err != nil {
+			return bosh.ConfigDiff{}, err
+		}
+
+		if !legacy {
+			return bosh.ConfigDiff{}, nil
+		}
+	}
+
+	cloudConfig, err := m.Generate(state)
+	if err != nil {
+		return bosh.ConfigDiff{}, err
+	}
+
+	return boshClient.DiffConfig("cloud", name, []byte(cloudConfig))
+}
+
+// hasConfig reports whether the director has a cloud config with the given
+// name.
+func hasConfig(boshClient bosh.Client, name string) (bool, error) {
+	_, err := boshClient.GetConfig("cloud", name)
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case bosh.ConfigNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
@@ -6,8 +6,10 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 
+	"github.com/cloudfoundry/bosh-bootloader/bosh"
 	"github.com/cloudfoundry/bosh-bootloader/cloudconfig"
 	"github.com/cloudfoundry/bosh-bootloader/fakes"
 	"github.com/cloudfoundry/bosh-bootloader/storage"
@@ -29,9 +31,11 @@ var _ = Describe("Manager", func() {
 		manager            cloudconfig.Manager
 
 		tempDir       string
+		userOpsDir    string
 		incomingState storage.State
 
-		baseCloudConfig []byte
+		baseCloudConfig  []byte
+		publishedConfigs []bosh.Config
 	)
 
 	BeforeEach(func() {
@@ -46,12 +50,30 @@ var _ = Describe("Manager", func() {
 
 		boshClientProvider.ClientCall.Returns.Client = boshClient
 
+		publishedConfigs = []bosh.Config{}
+		boshClient.GetConfigCall.Stub = func(configType, name string) (bosh.Config, error) {
+			if boshClient.GetConfigCall.Returns.Error != nil {
+				return bosh.Config{}, boshClient.GetConfigCall.Returns.Error
+			}
+			for _, config := range publishedConfigs {
+				if config.Type == configType && config.Name == name {
+					return config, nil
+				}
+			}
+			return bosh.Config{}, bosh.ConfigNotFoundError{Type: configType, Name: name}
+		}
+
 		var err error
 		tempDir, err = ioutil.TempDir("", "")
 		Expect(err).NotTo(HaveOccurred())
 
 		stateStore.GetCloudConfigDirCall.Returns.Directory = tempDir
 
+		userOpsDir, err = ioutil.TempDir("", "")
+		Expect(err).NotTo(HaveOccurred())
+
+		stateStore.GetCloudConfigOpsDirCall.Returns.Directory = userOpsDir
+
 		cmd.RunStub = func(stdout io.Writer, workingDirectory string, args []string) error {
 			stdout.Write([]byte("some-cloud-config"))
 			return nil
@@ -102,6 +124,57 @@ var _ = Describe("Manager", func() {
 			Expect(cloudConfigYAML).To(Equal("some-cloud-config"))
 		})
 
+		Context("when the state dir has no cloud-config directory", func() {
+			BeforeEach(func() {
+				stateStore.GetCloudConfigOpsDirCall.Returns.Directory = filepath.Join(userOpsDir, "missing")
+			})
+
+			It("applies only ops.yml and does not create the directory", func() {
+				_, err := manager.Generate(incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, _, args := cmd.RunArgsForCall(0)
+				Expect(args).To(Equal([]string{
+					"interpolate", fmt.Sprintf("%s/cloud-config.yml", tempDir),
+					"-o", fmt.Sprintf("%s/ops.yml", tempDir),
+				}))
+
+				_, err = os.Stat(filepath.Join(userOpsDir, "missing"))
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+
+		Context("when the state dir has user ops files in its cloud-config directory", func() {
+			BeforeEach(func() {
+				err := ioutil.WriteFile(filepath.Join(userOpsDir, "vm-types.yml"), []byte("some-vm-types"), os.ModePerm)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = ioutil.WriteFile(filepath.Join(userOpsDir, "disk-types.yaml"), []byte("some-disk-types"), os.ModePerm)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = ioutil.WriteFile(filepath.Join(userOpsDir, "README.md"), []byte("some-readme"), os.ModePerm)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = os.Mkdir(filepath.Join(userOpsDir, "some-dir.yml"), os.ModePerm)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("applies them in lexical order after ops.yml", func() {
+				_, err := manager.Generate(incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(stateStore.GetCloudConfigOpsDirCall.CallCount).To(Equal(1))
+
+				_, _, args := cmd.RunArgsForCall(0)
+				Expect(args).To(Equal([]string{
+					"interpolate", fmt.Sprintf("%s/cloud-config.yml", tempDir),
+					"-o", fmt.Sprintf("%s/ops.yml", tempDir),
+					"-o", filepath.Join(userOpsDir, "disk-types.yaml"),
+					"-o", filepath.Join(userOpsDir, "vm-types.yml"),
+				}))
+			})
+		})
+
 		Context("failure cases", func() {
 			Context("when getting cloud config dir fails", func() {
 				BeforeEach(func() {
@@ -165,6 +238,21 @@ var _ = Describe("Manager", func() {
 				})
 			})
 
+			Context("when the cloud config ops dir cannot be read", func() {
+				BeforeEach(func() {
+					opsDir := filepath.Join(userOpsDir, "not-a-dir")
+					err := ioutil.WriteFile(opsDir, []byte{}, os.ModePerm)
+					Expect(err).NotTo(HaveOccurred())
+
+					stateStore.GetCloudConfigOpsDirCall.Returns.Directory = opsDir
+				})
+
+				It("returns an error", func() {
+					_, err := manager.Generate(storage.State{})
+					Expect(err).To(MatchError(ContainSubstring("not a directory")))
+				})
+			})
+
 			Context("when command fails to run", func() {
 				BeforeEach(func() {
 					cmd.RunReturns(errors.New("failed to run"))
@@ -188,7 +276,7 @@ var _ = Describe("Manager", func() {
 			}))
 		})
 
-		It("updates the bosh director with a cloud config provided a valid bbl state", func() {
+		It("updates the bosh director with a named cloud config provided a valid bbl state", func() {
 			err := manager.Update(incomingState)
 			Expect(err).NotTo(HaveOccurred())
 
@@ -196,7 +284,61 @@ var _ = Describe("Manager", func() {
 			Expect(boshClientProvider.ClientCall.Receives.DirectorUsername).To(Equal("some-director-username"))
 			Expect(boshClientProvider.ClientCall.Receives.DirectorPassword).To(Equal("some-director-password"))
 
-			Expect(boshClient.UpdateCloudConfigCall.Receives.Yaml).To(Equal([]byte("some-cloud-config")))
+			Expect(boshClient.GetConfigCall.Receives.Type).To(Equal("cloud"))
+			Expect(boshClient.UpdateCloudConfigCall.CallCount).To(Equal(0))
+			Expect(boshClient.CreateConfigCall.Receives.Type).To(Equal("cloud"))
+			Expect(boshClient.CreateConfigCall.Receives.Name).To(Equal("bbl"))
+			Expect(boshClient.CreateConfigCall.Receives.Content).To(Equal([]byte("some-cloud-config")))
+			Expect(boshClient.DeleteConfigCall.CallCount).To(Equal(0))
+		})
+
+		Context("when the director has the unnamed cloud config bbl published before it named its own", func() {
+			BeforeEach(func() {
+				publishedConfigs = []bosh.Config{
+					{ID: "1", Name: "default", Type: "cloud", Content: "old-cloud-config"},
+				}
+			})
+
+			It("publishes the named cloud config and removes the unnamed one", func() {
+				err := manager.Update(incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(boshClient.CreateConfigCall.Receives.Name).To(Equal("bbl"))
+				Expect(boshClient.DeleteConfigCall.CallCount).To(Equal(1))
+				Expect(boshClient.DeleteConfigCall.Receives.Type).To(Equal("cloud"))
+				Expect(boshClient.DeleteConfigCall.Receives.Name).To(Equal("default"))
+				Expect(logger.StepCall.Messages).To(ContainElement("removing unnamed cloud config"))
+			})
+
+			Context("when bbl has already published its named cloud config", func() {
+				BeforeEach(func() {
+					publishedConfigs = append(publishedConfigs,
+						bosh.Config{ID: "2", Name: "bbl", Type: "cloud", Content: "some-cloud-config"})
+				})
+
+				It("leaves the unnamed cloud config alone", func() {
+					err := manager.Update(incomingState)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(boshClient.GetConfigCall.CallCount).To(Equal(1))
+					Expect(boshClient.DeleteConfigCall.CallCount).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the director does not support named configs", func() {
+			BeforeEach(func() {
+				boshClient.GetConfigCall.Returns.Error = bosh.ConfigsNotSupportedError{}
+			})
+
+			It("updates the unnamed cloud config", func() {
+				err := manager.Update(incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(boshClient.CreateConfigCall.CallCount).To(Equal(0))
+				Expect(boshClient.UpdateCloudConfigCall.CallCount).To(Equal(1))
+				Expect(boshClient.UpdateCloudConfigCall.Receives.Yaml).To(Equal([]byte("some-cloud-config")))
+			})
 		})
 
 		Context("failure cases", func() {
@@ -211,8 +353,31 @@ var _ = Describe("Manager", func() {
 				})
 			})
 
+			Context("when bosh client fails to get the cloud config", func() {
+				BeforeEach(func() {
+					boshClient.GetConfigCall.Returns.Error = errors.New("failed to get")
+				})
+
+				It("returns an error", func() {
+					err := manager.Update(storage.State{})
+					Expect(err).To(MatchError("failed to get"))
+				})
+			})
+
 			Context("when bosh client fails to update cloud config", func() {
 				BeforeEach(func() {
+					boshClient.CreateConfigCall.Returns.Error = errors.New("failed to update")
+				})
+
+				It("returns an error", func() {
+					err := manager.Update(storage.State{})
+					Expect(err).To(MatchError("failed to update"))
+				})
+			})
+
+			Context("when bosh client fails to update the unnamed cloud config", func() {
+				BeforeEach(func() {
+					boshClient.GetConfigCall.Returns.Error = bosh.ConfigsNotSupportedError{}
 					boshClient.UpdateCloudConfigCall.Returns.Error = errors.New("failed to update")
 				})
 
@@ -221,6 +386,114 @@ var _ = Describe("Manager", func() {
 					Expect(err).To(MatchError("failed to update"))
 				})
 			})
+
+			Context("when bosh client fails to remove the unnamed cloud config", func() {
+				BeforeEach(func() {
+					publishedConfigs = []bosh.Config{{Name: "default", Type: "cloud"}}
+					boshClient.DeleteConfigCall.Returns.Error = errors.New("failed to delete")
+				})
+
+				It("returns an error", func() {
+					err := manager.Update(storage.State{})
+					Expect(err).To(MatchError("failed to delete"))
+				})
+			})
+		})
+	})
+
+	Describe("Diff", func() {
+		var diff bosh.ConfigDiff
+
+		BeforeEach(func() {
+			diff = bosh.ConfigDiff{Diff: [][]interface{}{{"vm_types:", nil}, {"- name: default", "added"}}}
+			boshClient.DiffConfigCall.Returns.Diff = diff
+			publishedConfigs = []bosh.Config{
+				{ID: "2", Name: "bbl", Type: "cloud", Content: "old-cloud-config"},
+			}
+		})
+
+		It("returns the director's diff against the previously published cloud config", func() {
+			returnedDiff, err := manager.Diff(incomingState)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(boshClientProvider.ClientCall.Receives.DirectorAddress).To(Equal("some-director-address"))
+
+			Expect(boshClient.GetConfigCall.CallCount).To(Equal(1))
+			Expect(boshClient.GetConfigCall.Receives.Type).To(Equal("cloud"))
+			Expect(boshClient.GetConfigCall.Receives.Name).To(Equal("bbl"))
+
+			Expect(boshClient.DiffConfigCall.Receives.Type).To(Equal("cloud"))
+			Expect(boshClient.DiffConfigCall.Receives.Name).To(Equal("bbl"))
+			Expect(boshClient.DiffConfigCall.Receives.Content).To(Equal([]byte("some-cloud-config")))
+
+			Expect(returnedDiff).To(Equal(diff))
+		})
+
+		Context("when the director only has the unnamed cloud config bbl published before it named its own", func() {
+			BeforeEach(func() {
+				publishedConfigs = []bosh.Config{
+					{ID: "1", Name: "default", Type: "cloud", Content: "old-cloud-config"},
+				}
+			})
+
+			It("returns the diff against the unnamed cloud config", func() {
+				returnedDiff, err := manager.Diff(incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(boshClient.DiffConfigCall.Receives.Name).To(Equal("default"))
+				Expect(returnedDiff).To(Equal(diff))
+			})
+		})
+
+		Context("when bbl has not published a cloud config yet", func() {
+			BeforeEach(func() {
+				publishedConfigs = []bosh.Config{}
+			})
+
+			It("returns an empty diff", func() {
+				returnedDiff, err := manager.Diff(incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(returnedDiff.HasChanges()).To(BeFalse())
+				Expect(boshClient.DiffConfigCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("when the director does not support named configs", func() {
+			BeforeEach(func() {
+				boshClient.GetConfigCall.Returns.Error = bosh.ConfigsNotSupportedError{}
+			})
+
+			It("returns an empty diff", func() {
+				returnedDiff, err := manager.Diff(incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(returnedDiff.HasChanges()).To(BeFalse())
+				Expect(boshClient.DiffConfigCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("failure cases", func() {
+			It("returns an error when the current cloud config cannot be fetched", func() {
+				boshClient.GetConfigCall.Returns.Error = errors.New("failed to get config")
+
+				_, err := manager.Diff(incomingState)
+				Expect(err).To(MatchError("failed to get config"))
+			})
+
+			It("returns an error when generate fails", func() {
+				cmd.RunReturns(errors.New("failed to run"))
+
+				_, err := manager.Diff(incomingState)
+				Expect(err).To(MatchError("failed to run"))
+			})
+
+			It("returns an error when the diff fails", func() {
+				boshClient.DiffConfigCall.Returns.Error = errors.New("failed to diff")
+
+				_, err := manager.Diff(incomingState)
+				Expect(err).To(MatchError("failed to diff"))
+			})
 		})
 	})
 })
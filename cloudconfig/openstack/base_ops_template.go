@@ -0,0 +1,96 @@
+package openstack
+
+const (
+	BaseOps = `
+- type: replace
+  path: /vm_types/name=default/cloud_properties?
+  value:
+    instance_type: m1.small
+    root_disk:
+      size: 10
+
+- type: replace
+  path: /vm_types/name=minimal/cloud_properties?
+  value:
+    instance_type: m1.small
+    root_disk:
+      size: 10
+
+- type: replace
+  path: /vm_types/name=sharedcpu/cloud_properties?
+  value:
+    instance_type: m1.small
+    root_disk:
+      size: 10
+
+- type: replace
+  path: /vm_types/name=small/cloud_properties?
+  value:
+    instance_type: m1.medium
+    root_disk:
+      size: 10
+
+- type: replace
+  path: /vm_types/name=medium/cloud_properties?
+  value:
+    instance_type: m1.large
+    root_disk:
+      size: 10
+
+- type: replace
+  path: /vm_types/name=large/cloud_properties?
+  value:
+    instance_type: m1.xlarge
+    root_disk:
+      size: 10
+
+- type: replace
+  path: /vm_types/name=extra-large/cloud_properties?
+  value:
+    instance_type: m1.xlarge
+    root_disk:
+      size: 10
+
+- type: replace
+  path: /vm_extensions/name=1GB_ephemeral_disk/cloud_properties?
+  value:
+    root_disk:
+      size: 1
+
+- type: replace
+  path: /vm_extensions/name=5GB_ephemeral_disk/cloud_properties?
+  value:
+    root_disk:
+      size: 5
+
+- type: replace
+  path: /vm_extensions/name=10GB_ephemeral_disk/cloud_properties?
+  value:
+    root_disk:
+      size: 10
+
+- type: replace
+  path: /vm_extensions/name=50GB_ephemeral_disk/cloud_properties?
+  value:
+    root_disk:
+      size: 50
+
+- type: replace
+  path: /vm_extensions/name=100GB_ephemeral_disk/cloud_properties?
+  value:
+    root_disk:
+      size: 100
+
+- type: replace
+  path: /vm_extensions/name=500GB_ephemeral_disk/cloud_properties?
+  value:
+    root_disk:
+      size: 500
+
+- type: replace
+  path: /vm_extensions/name=1TB_ephemeral_disk/cloud_properties?
+  value:
+    root_disk:
+      size: 1000
+`
+)
@@ -0,0 +1,13 @@
+package openstack
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestOpenStack(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cloudconfig/openstack")
+}
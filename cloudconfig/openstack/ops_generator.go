@@ -0,0 +1,153 @@
+package openstack
+
+import (
+	"fmt"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/cloudfoundry/bosh-bootloader/bosh"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/cloudfoundry/bosh-bootloader/terraform"
+)
+
+type OpsGenerator struct {
+	terraformManager terraformManager
+}
+
+type terraformManager interface {
+	GetOutputs(storage.State) (terraform.Outputs, error)
+}
+
+type op struct {
+	Type  string
+	Path  string
+	Value interface{}
+}
+
+type az struct {
+	Name            string
+	CloudProperties azCloudProperties `yaml:"cloud_properties"`
+}
+
+type azCloudProperties struct {
+	AvailabilityZone string `yaml:"availability_zone"`
+}
+
+type network struct {
+	Name    string
+	Subnets []networkSubnet
+	Type    string
+}
+
+type networkSubnet struct {
+	AZ              string
+	Gateway         string
+	Range           string
+	Reserved        []string
+	Static          []string
+	CloudProperties subnetCloudProperties `yaml:"cloud_properties"`
+}
+
+type subnetCloudProperties struct {
+	NetID          string   `yaml:"net_id"`
+	SecurityGroups []string `yaml:"security_groups"`
+}
+
+var marshal func(interface{}) ([]byte, error) = yaml.Marshal
+
+func NewOpsGenerator(terraformManager terraformManager) OpsGenerator {
+	return OpsGenerator{
+		terraformManager: terraformManager,
+	}
+}
+
+func (o OpsGenerator) Generate(state storage.State) (string, error) {
+	terraformOutputs, err := o.terraformManager.GetOutputs(state)
+	if err != nil {
+		return "", err
+	}
+
+	var cloudConfigOps []op
+	var subnets []networkSubnet
+	for i := 0; i < 3; i++ {
+		zone := fmt.Sprintf("z%d", i+1)
+
+		cloudConfigOps = append(cloudConfigOps, op{
+			Type: "replace",
+			Path: "/azs/-",
+			Value: az{
+				Name:            zone,
+				CloudProperties: azCloudProperties{AvailabilityZone: state.OpenStack.AZ},
+			},
+		})
+
+		subnet, err := generateNetworkSubnet(
+			zone,
+			fmt.Sprintf("10.0.%d.0/20", 16*(i+1)),
+			terraformOutputs.GetString("network_id"),
+			terraformOutputs.GetString("bosh_security_group"),
+		)
+		if err != nil {
+			return "", err
+		}
+
+		subnets = append(subnets, subnet)
+	}
+
+	for _, networkName := range []string{"default", "private"} {
+		cloudConfigOps = append(cloudConfigOps, op{
+			Type: "replace",
+			Path: "/networks/-",
+			Value: network{
+				Name:    networkName,
+				Subnets: subnets,
+				Type:    "manual",
+			},
+		})
+	}
+
+	cloudConfigOpsYAML, err := marshal(cloudConfigOps)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join(
+		[]string{
+			BaseOps,
+			string(cloudConfigOpsYAML),
+		},
+		"\n",
+	), nil
+}
+
+func generateNetworkSubnet(az, cidr, netID, securityGroup string) (networkSubnet, error) {
+	parsedCidr, err := bosh.ParseCIDRBlock(cidr)
+	if err != nil {
+		return networkSubnet{}, err
+	}
+
+	gateway := parsedCidr.GetFirstIP().Add(1).String()
+	firstReserved := parsedCidr.GetFirstIP().Add(2).String()
+	secondReserved := parsedCidr.GetFirstIP().Add(3).String()
+	lastReserved := parsedCidr.GetLastIP().String()
+	lastStatic := parsedCidr.GetLastIP().Subtract(1).String()
+	firstStatic := parsedCidr.GetLastIP().Subtract(65).String()
+
+	return networkSubnet{
+		AZ:      az,
+		Gateway: gateway,
+		Range:   cidr,
+		Reserved: []string{
+			fmt.Sprintf("%s-%s", firstReserved, secondReserved),
+			lastReserved,
+		},
+		Static: []string{
+			fmt.Sprintf("%s-%s", firstStatic, lastStatic),
+		},
+		CloudProperties: subnetCloudProperties{
+			NetID:          netID,
+			SecurityGroups: []string{securityGroup},
+		},
+	}, nil
+}
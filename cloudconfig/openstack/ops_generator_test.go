@@ -0,0 +1,88 @@
+package openstack_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/cloudfoundry/bosh-bootloader/cloudconfig/openstack"
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/cloudfoundry/bosh-bootloader/terraform"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf-experimental/gomegamatchers"
+)
+
+var _ = Describe("OpenStackOpsGenerator", func() {
+	Describe("Generate", func() {
+		var (
+			terraformManager *fakes.TerraformManager
+			opsGenerator     openstack.OpsGenerator
+
+			incomingState   storage.State
+			expectedOpsFile []byte
+		)
+
+		BeforeEach(func() {
+			terraformManager = &fakes.TerraformManager{}
+
+			incomingState = storage.State{
+				IAAS: "openstack",
+				OpenStack: storage.OpenStack{
+					AZ: "some-az",
+				},
+			}
+
+			terraformManager.GetOutputsCall.Returns.Outputs = terraform.Outputs{Map: map[string]interface{}{
+				"network_id":          "some-network-id",
+				"bosh_security_group": "some-bosh-security-group",
+			}}
+
+			var err error
+			expectedOpsFile, err = ioutil.ReadFile(filepath.Join("fixtures", "openstack-ops.yml"))
+			Expect(err).NotTo(HaveOccurred())
+
+			opsGenerator = openstack.NewOpsGenerator(terraformManager)
+		})
+
+		It("returns an ops file to transform the base cloud config into openstack specific cloud config", func() {
+			opsYAML, err := opsGenerator.Generate(incomingState)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(terraformManager.GetOutputsCall.Receives.BBLState).To(Equal(incomingState))
+
+			Expect(opsYAML).To(gomegamatchers.MatchYAML(expectedOpsFile))
+		})
+
+		Context("failure cases", func() {
+			Context("when terraform output provider fails to retrieve", func() {
+				BeforeEach(func() {
+					terraformManager.GetOutputsCall.Returns.Error = errors.New("failed to output")
+				})
+
+				It("returns an error", func() {
+					_, err := opsGenerator.Generate(storage.State{})
+					Expect(err).To(MatchError("failed to output"))
+				})
+			})
+
+			Context("when ops fail to marshal", func() {
+				BeforeEach(func() {
+					openstack.SetMarshal(func(interface{}) ([]byte, error) {
+						return []byte{}, errors.New("failed to marshal")
+					})
+				})
+
+				AfterEach(func() {
+					openstack.ResetMarshal()
+				})
+
+				It("returns an error", func() {
+					_, err := opsGenerator.Generate(storage.State{})
+					Expect(err).To(MatchError("failed to marshal"))
+				})
+			})
+		})
+	})
+})
@@ -7,16 +7,20 @@ import (
 )
 
 type OpsGeneratorWrapper struct {
-	awsOpsGenerator   OpsGenerator
-	gcpOpsGenerator   OpsGenerator
-	azureOpsGenerator OpsGenerator
+	awsOpsGenerator       OpsGenerator
+	gcpOpsGenerator       OpsGenerator
+	azureOpsGenerator     OpsGenerator
+	openstackOpsGenerator OpsGenerator
+	vsphereOpsGenerator   OpsGenerator
 }
 
-func NewOpsGenerator(awsOpsGenerator OpsGenerator, gcpOpsGenerator OpsGenerator, azureOpsGenerator OpsGenerator) OpsGeneratorWrapper {
+func NewOpsGenerator(awsOpsGenerator OpsGenerator, gcpOpsGenerator OpsGenerator, azureOpsGenerator OpsGenerator, openstackOpsGenerator OpsGenerator, vsphereOpsGenerator OpsGenerator) OpsGeneratorWrapper {
 	return OpsGeneratorWrapper{
-		awsOpsGenerator:   awsOpsGenerator,
-		gcpOpsGenerator:   gcpOpsGenerator,
-		azureOpsGenerator: azureOpsGenerator,
+		awsOpsGenerator:       awsOpsGenerator,
+		gcpOpsGenerator:       gcpOpsGenerator,
+		azureOpsGenerator:     azureOpsGenerator,
+		openstackOpsGenerator: openstackOpsGenerator,
+		vsphereOpsGenerator:   vsphereOpsGenerator,
 	}
 }
 
@@ -28,6 +32,10 @@ func (o OpsGeneratorWrapper) Generate(state storage.State) (string, error) {
 		return o.awsOpsGenerator.Generate(state)
 	case "azure":
 		return o.azureOpsGenerator.Generate(state)
+	case "openstack":
+		return o.openstackOpsGenerator.Generate(state)
+	case "vsphere":
+		return o.vsphereOpsGenerator.Generate(state)
 	default:
 		return "", errors.New("invalid iaas type")
 	}
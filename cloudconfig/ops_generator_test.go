@@ -15,10 +15,12 @@ import (
 var _ = Describe("OpsGenerator", func() {
 	Describe("Generate", func() {
 		var (
-			awsOpsGenerator   *fakes.CloudConfigOpsGenerator
-			gcpOpsGenerator   *fakes.CloudConfigOpsGenerator
-			azureOpsGenerator *fakes.CloudConfigOpsGenerator
-			opsGenerator      cloudconfig.OpsGenerator
+			awsOpsGenerator       *fakes.CloudConfigOpsGenerator
+			gcpOpsGenerator       *fakes.CloudConfigOpsGenerator
+			azureOpsGenerator     *fakes.CloudConfigOpsGenerator
+			openstackOpsGenerator *fakes.CloudConfigOpsGenerator
+			vsphereOpsGenerator   *fakes.CloudConfigOpsGenerator
+			opsGenerator          cloudconfig.OpsGenerator
 
 			incomingState storage.State
 		)
@@ -27,12 +29,16 @@ var _ = Describe("OpsGenerator", func() {
 			awsOpsGenerator = &fakes.CloudConfigOpsGenerator{}
 			gcpOpsGenerator = &fakes.CloudConfigOpsGenerator{}
 			azureOpsGenerator = &fakes.CloudConfigOpsGenerator{}
+			openstackOpsGenerator = &fakes.CloudConfigOpsGenerator{}
+			vsphereOpsGenerator = &fakes.CloudConfigOpsGenerator{}
 
 			awsOpsGenerator.GenerateCall.Returns.OpsYAML = "some-aws-ops"
 			gcpOpsGenerator.GenerateCall.Returns.OpsYAML = "some-gcp-ops"
 			azureOpsGenerator.GenerateCall.Returns.OpsYAML = "some-azure-ops"
+			openstackOpsGenerator.GenerateCall.Returns.OpsYAML = "some-openstack-ops"
+			vsphereOpsGenerator.GenerateCall.Returns.OpsYAML = "some-vsphere-ops"
 
-			opsGenerator = cloudconfig.NewOpsGenerator(awsOpsGenerator, gcpOpsGenerator, azureOpsGenerator)
+			opsGenerator = cloudconfig.NewOpsGenerator(awsOpsGenerator, gcpOpsGenerator, azureOpsGenerator, openstackOpsGenerator, vsphereOpsGenerator)
 		})
 
 		DescribeTable("returns an ops file to transform base cloud config to iaas specific cloud config", func(incomingState storage.State, expectedOpsYAML string) {
@@ -50,6 +56,12 @@ var _ = Describe("OpsGenerator", func() {
 			Entry("when iaas is azure", storage.State{
 				IAAS: "azure",
 			}, "some-azure-ops"),
+			Entry("when iaas is openstack", storage.State{
+				IAAS: "openstack",
+			}, "some-openstack-ops"),
+			Entry("when iaas is vsphere", storage.State{
+				IAAS: "vsphere",
+			}, "some-vsphere-ops"),
 		)
 
 		Context("failure cases", func() {
@@ -83,6 +95,16 @@ var _ = Describe("OpsGenerator", func() {
 				}, func() *fakes.CloudConfigOpsGenerator {
 					return azureOpsGenerator
 				}),
+				Entry("when iaas is openstack", storage.State{
+					IAAS: "openstack",
+				}, func() *fakes.CloudConfigOpsGenerator {
+					return openstackOpsGenerator
+				}),
+				Entry("when iaas is vsphere", storage.State{
+					IAAS: "vsphere",
+				}, func() *fakes.CloudConfigOpsGenerator {
+					return vsphereOpsGenerator
+				}),
 			)
 		})
 	})
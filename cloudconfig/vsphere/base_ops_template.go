@@ -0,0 +1,89 @@
+package vsphere
+
+const (
+	BaseOps = `
+- type: replace
+  path: /vm_types/name=default/cloud_properties?
+  value:
+    cpu: 1
+    ram: 2048
+    disk: 10240
+
+- type: replace
+  path: /vm_types/name=minimal/cloud_properties?
+  value:
+    cpu: 1
+    ram: 1024
+    disk: 8192
+
+- type: replace
+  path: /vm_types/name=sharedcpu/cloud_properties?
+  value:
+    cpu: 1
+    ram: 1024
+    disk: 8192
+
+- type: replace
+  path: /vm_types/name=small/cloud_properties?
+  value:
+    cpu: 1
+    ram: 2048
+    disk: 10240
+
+- type: replace
+  path: /vm_types/name=medium/cloud_properties?
+  value:
+    cpu: 2
+    ram: 4096
+    disk: 20480
+
+- type: replace
+  path: /vm_types/name=large/cloud_properties?
+  value:
+    cpu: 2
+    ram: 8192
+    disk: 40960
+
+- type: replace
+  path: /vm_types/name=extra-large/cloud_properties?
+  value:
+    cpu: 4
+    ram: 16384
+    disk: 65536
+
+- type: replace
+  path: /vm_extensions/name=1GB_ephemeral_disk/cloud_properties?
+  value:
+    disk: 1024
+
+- type: replace
+  path: /vm_extensions/name=5GB_ephemeral_disk/cloud_properties?
+  value:
+    disk: 5120
+
+- type: replace
+  path: /vm_extensions/name=10GB_ephemeral_disk/cloud_properties?
+  value:
+    disk: 10240
+
+- type: replace
+  path: /vm_extensions/name=50GB_ephemeral_disk/cloud_properties?
+  value:
+    disk: 51200
+
+- type: replace
+  path: /vm_extensions/name=100GB_ephemeral_disk/cloud_properties?
+  value:
+    disk: 102400
+
+- type: replace
+  path: /vm_extensions/name=500GB_ephemeral_disk/cloud_properties?
+  value:
+    disk: 512000
+
+- type: replace
+  path: /vm_extensions/name=1TB_ephemeral_disk/cloud_properties?
+  value:
+    disk: 1048576
+`
+)
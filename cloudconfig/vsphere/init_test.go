@@ -0,0 +1,13 @@
+package vsphere
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestVSphere(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cloudconfig/vsphere")
+}
@@ -0,0 +1,161 @@
+package vsphere
+
+import (
+	"fmt"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/cloudfoundry/bosh-bootloader/bosh"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/cloudfoundry/bosh-bootloader/terraform"
+)
+
+type OpsGenerator struct {
+	terraformManager terraformManager
+}
+
+type terraformManager interface {
+	GetOutputs(storage.State) (terraform.Outputs, error)
+}
+
+type op struct {
+	Type  string
+	Path  string
+	Value interface{}
+}
+
+type az struct {
+	Name            string
+	CloudProperties azCloudProperties `yaml:"cloud_properties"`
+}
+
+type azCloudProperties struct {
+	Datacenters []datacenter
+}
+
+type datacenter struct {
+	Name     string
+	Clusters []map[string]interface{}
+}
+
+type network struct {
+	Name    string
+	Subnets []networkSubnet
+	Type    string
+}
+
+type networkSubnet struct {
+	AZs             []string `yaml:"azs"`
+	Gateway         string
+	Range           string
+	Reserved        []string
+	Static          []string
+	CloudProperties subnetCloudProperties `yaml:"cloud_properties"`
+}
+
+type subnetCloudProperties struct {
+	Name string
+}
+
+var marshal func(interface{}) ([]byte, error) = yaml.Marshal
+
+func NewOpsGenerator(terraformManager terraformManager) OpsGenerator {
+	return OpsGenerator{
+		terraformManager: terraformManager,
+	}
+}
+
+func (o OpsGenerator) Generate(state storage.State) (string, error) {
+	terraformOutputs, err := o.terraformManager.GetOutputs(state)
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		cloudConfigOps []op
+		azs            []string
+	)
+	for i := 0; i < 3; i++ {
+		zone := fmt.Sprintf("z%d", i+1)
+		azs = append(azs, zone)
+
+		cloudConfigOps = append(cloudConfigOps, op{
+			Type: "replace",
+			Path: "/azs/-",
+			Value: az{
+				Name: zone,
+				CloudProperties: azCloudProperties{
+					Datacenters: []datacenter{{
+						Name:     state.VSphere.VCenterDC,
+						Clusters: []map[string]interface{}{{state.VSphere.VCenterCluster: map[string]interface{}{}}},
+					}},
+				},
+			},
+		})
+	}
+
+	subnet, err := generateNetworkSubnet(
+		azs,
+		terraformOutputs.GetString("internal_cidr"),
+		terraformOutputs.GetString("internal_gw"),
+		terraformOutputs.GetString("network_name"),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	for _, networkName := range []string{"default", "private"} {
+		cloudConfigOps = append(cloudConfigOps, op{
+			Type: "replace",
+			Path: "/networks/-",
+			Value: network{
+				Name:    networkName,
+				Subnets: []networkSubnet{subnet},
+				Type:    "manual",
+			},
+		})
+	}
+
+	cloudConfigOpsYAML, err := marshal(cloudConfigOps)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join(
+		[]string{
+			BaseOps,
+			string(cloudConfigOpsYAML),
+		},
+		"\n",
+	), nil
+}
+
+func generateNetworkSubnet(azs []string, cidr, gateway, networkName string) (networkSubnet, error) {
+	parsedCidr, err := bosh.ParseCIDRBlock(cidr)
+	if err != nil {
+		return networkSubnet{}, err
+	}
+
+	firstReserved := parsedCidr.GetFirstIP().Add(1).String()
+	secondReserved := parsedCidr.GetFirstIP().Add(10).String()
+	lastReserved := parsedCidr.GetLastIP().String()
+	lastStatic := parsedCidr.GetLastIP().Subtract(1).String()
+	firstStatic := parsedCidr.GetLastIP().Subtract(65).String()
+
+	return networkSubnet{
+		AZs:     azs,
+		Gateway: gateway,
+		Range:   cidr,
+		Reserved: []string{
+			fmt.Sprintf("%s-%s", firstReserved, secondReserved),
+			lastReserved,
+		},
+		Static: []string{
+			fmt.Sprintf("%s-%s", firstStatic, lastStatic),
+		},
+		CloudProperties: subnetCloudProperties{
+			Name: networkName,
+		},
+	}, nil
+}
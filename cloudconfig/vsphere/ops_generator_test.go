@@ -0,0 +1,103 @@
+package vsphere_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/cloudfoundry/bosh-bootloader/cloudconfig/vsphere"
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/cloudfoundry/bosh-bootloader/terraform"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf-experimental/gomegamatchers"
+)
+
+var _ = Describe("VSphereOpsGenerator", func() {
+	Describe("Generate", func() {
+		var (
+			terraformManager *fakes.TerraformManager
+			opsGenerator     vsphere.OpsGenerator
+
+			incomingState   storage.State
+			expectedOpsFile []byte
+		)
+
+		BeforeEach(func() {
+			terraformManager = &fakes.TerraformManager{}
+
+			incomingState = storage.State{
+				IAAS: "vsphere",
+				VSphere: storage.VSphere{
+					VCenterDC:      "some-dc",
+					VCenterCluster: "some-cluster",
+				},
+			}
+
+			terraformManager.GetOutputsCall.Returns.Outputs = terraform.Outputs{Map: map[string]interface{}{
+				"network_name":  "some-network",
+				"internal_cidr": "10.0.0.0/24",
+				"internal_gw":   "10.0.0.1",
+			}}
+
+			var err error
+			expectedOpsFile, err = ioutil.ReadFile(filepath.Join("fixtures", "vsphere-ops.yml"))
+			Expect(err).NotTo(HaveOccurred())
+
+			opsGenerator = vsphere.NewOpsGenerator(terraformManager)
+		})
+
+		It("returns an ops file to transform the base cloud config into vsphere specific cloud config", func() {
+			opsYAML, err := opsGenerator.Generate(incomingState)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(terraformManager.GetOutputsCall.Receives.BBLState).To(Equal(incomingState))
+
+			Expect(opsYAML).To(gomegamatchers.MatchYAML(expectedOpsFile))
+		})
+
+		Context("failure cases", func() {
+			Context("when terraform output provider fails to retrieve", func() {
+				BeforeEach(func() {
+					terraformManager.GetOutputsCall.Returns.Error = errors.New("failed to output")
+				})
+
+				It("returns an error", func() {
+					_, err := opsGenerator.Generate(storage.State{})
+					Expect(err).To(MatchError("failed to output"))
+				})
+			})
+
+			Context("when the internal cidr is invalid", func() {
+				BeforeEach(func() {
+					terraformManager.GetOutputsCall.Returns.Outputs = terraform.Outputs{Map: map[string]interface{}{
+						"internal_cidr": "not-a-cidr",
+					}}
+				})
+
+				It("returns an error", func() {
+					_, err := opsGenerator.Generate(storage.State{})
+					Expect(err).To(HaveOccurred())
+				})
+			})
+
+			Context("when ops fail to marshal", func() {
+				BeforeEach(func() {
+					vsphere.SetMarshal(func(interface{}) ([]byte, error) {
+						return []byte{}, errors.New("failed to marshal")
+					})
+				})
+
+				AfterEach(func() {
+					vsphere.ResetMarshal()
+				})
+
+				It("returns an error", func() {
+					_, err := opsGenerator.Generate(storage.State{})
+					Expect(err).To(MatchError("failed to marshal"))
+				})
+			})
+		})
+	})
+})
@@ -1,13 +1,14 @@
 package commands
 
 import (
+	"io"
 	"io/ioutil"
 
 	"github.com/cloudfoundry/bosh-bootloader/storage"
 )
 
 type AWSCreateLBs struct {
-	cloudConfigManager   cloudConfigManager
+	cloudConfigUpdater   cloudConfigUpdater
 	stateStore           stateStore
 	stateValidator       stateValidator
 	terraformManager     terraformManager
@@ -15,11 +16,13 @@ type AWSCreateLBs struct {
 }
 
 type AWSCreateLBsConfig struct {
-	LBType    string
-	CertPath  string
-	KeyPath   string
-	ChainPath string
-	Domain    string
+	LBType            string
+	CertPath          string
+	KeyPath           string
+	ChainPath         string
+	Domain            string
+	Flavor            string
+	ACMCertificateARN string
 }
 
 type EnvironmentValidator interface {
@@ -27,9 +30,9 @@ type EnvironmentValidator interface {
 }
 
 func NewAWSCreateLBs(cloudConfigManager cloudConfigManager, stateStore stateStore,
-	terraformManager terraformManager, environmentValidator EnvironmentValidator) AWSCreateLBs {
+	terraformManager terraformManager, environmentValidator EnvironmentValidator, logger logger, stdin io.Reader) AWSCreateLBs {
 	return AWSCreateLBs{
-		cloudConfigManager:   cloudConfigManager,
+		cloudConfigUpdater:   newCloudConfigUpdater(logger, stdin, cloudConfigManager),
 		stateStore:           stateStore,
 		terraformManager:     terraformManager,
 		environmentValidator: environmentValidator,
@@ -45,32 +48,50 @@ func (c AWSCreateLBs) Execute(config CreateLBsConfig, state storage.State) error
 		if config.AWS.LBType == "" {
 			config.AWS.LBType = state.LB.Type
 		}
-	}
 
-	if err := c.environmentValidator.Validate(state); err != nil {
-		return err
+		if config.AWS.Flavor == "" {
+			config.AWS.Flavor = state.LB.Flavor
+		}
 	}
 
-	certContents, err := ioutil.ReadFile(config.AWS.CertPath)
-	if err != nil {
-		return err
+	// Classic ELBs are saved without a flavor, so asking for them clears the
+	// flavor an earlier create-lbs saved in the state.
+	if isClassicLBFlavor(config.AWS.Flavor) {
+		config.AWS.Flavor = ""
 	}
 
-	keyContents, err := ioutil.ReadFile(config.AWS.KeyPath)
-	if err != nil {
+	if err := c.environmentValidator.Validate(state); err != nil {
 		return err
 	}
 
-	state.LB.Cert = string(certContents)
-	state.LB.Key = string(keyContents)
+	if config.AWS.ACMCertificateARN != "" {
+		state.LB.ACMCertificateARN = config.AWS.ACMCertificateARN
+		state.LB.Cert = ""
+		state.LB.Key = ""
+		state.LB.Chain = ""
+	} else {
+		certContents, err := ioutil.ReadFile(config.AWS.CertPath)
+		if err != nil {
+			return err
+		}
 
-	if config.AWS.ChainPath != "" {
-		chainContents, err := ioutil.ReadFile(config.AWS.ChainPath)
+		keyContents, err := ioutil.ReadFile(config.AWS.KeyPath)
 		if err != nil {
 			return err
 		}
 
-		state.LB.Chain = string(chainContents)
+		state.LB.ACMCertificateARN = ""
+		state.LB.Cert = string(certContents)
+		state.LB.Key = string(keyContents)
+
+		if config.AWS.ChainPath != "" {
+			chainContents, err := ioutil.ReadFile(config.AWS.ChainPath)
+			if err != nil {
+				return err
+			}
+
+			state.LB.Chain = string(chainContents)
+		}
 	}
 
 	if config.AWS.Domain != "" {
@@ -78,6 +99,11 @@ func (c AWSCreateLBs) Execute(config CreateLBsConfig, state storage.State) error
 	}
 
 	state.LB.Type = config.AWS.LBType
+	state.LB.Flavor = config.AWS.Flavor
+
+	if config.CloudConfigDiffOnly {
+		return c.cloudConfigUpdater.PrintDiff(state)
+	}
 
 	if err := c.stateStore.Set(state); err != nil {
 		return err
@@ -87,7 +113,7 @@ func (c AWSCreateLBs) Execute(config CreateLBsConfig, state storage.State) error
 		return err
 	}
 
-	state, err = c.terraformManager.Apply(state)
+	state, err := c.terraformManager.Apply(state)
 	if err != nil {
 		return handleTerraformError(err, c.stateStore)
 	}
@@ -97,11 +123,15 @@ func (c AWSCreateLBs) Execute(config CreateLBsConfig, state storage.State) error
 	}
 
 	if !state.NoDirector {
-		err = c.cloudConfigManager.Update(state)
+		err = c.cloudConfigUpdater.Update(state, config.NoConfirm)
 		if err != nil {
-			return err
+			return cloudConfigUpdateError(err)
 		}
 	}
 
 	return nil
 }
+
+func isClassicLBFlavor(flavor string) bool {
+	return flavor == "classic" || flavor == "elb"
+}
@@ -1,11 +1,13 @@
 package commands_test
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
 
+	"github.com/cloudfoundry/bosh-bootloader/bosh"
 	"github.com/cloudfoundry/bosh-bootloader/commands"
 	"github.com/cloudfoundry/bosh-bootloader/fakes"
 	"github.com/cloudfoundry/bosh-bootloader/storage"
@@ -23,6 +25,8 @@ var _ = Describe("AWS Create LBs", func() {
 			cloudConfigManager   *fakes.CloudConfigManager
 			stateStore           *fakes.StateStore
 			environmentValidator *fakes.EnvironmentValidator
+			logger               *fakes.Logger
+			stdin                *bytes.Buffer
 			incomingState        storage.State
 
 			certPath  string
@@ -35,6 +39,8 @@ var _ = Describe("AWS Create LBs", func() {
 			cloudConfigManager = &fakes.CloudConfigManager{}
 			stateStore = &fakes.StateStore{}
 			environmentValidator = &fakes.EnvironmentValidator{}
+			logger = &fakes.Logger{}
+			stdin = bytes.NewBuffer([]byte{})
 
 			incomingState = storage.State{}
 
@@ -56,7 +62,7 @@ var _ = Describe("AWS Create LBs", func() {
 			err = ioutil.WriteFile(chainPath, []byte("some-chain"), os.ModePerm)
 			Expect(err).NotTo(HaveOccurred())
 
-			command = commands.NewAWSCreateLBs(cloudConfigManager, stateStore, terraformManager, environmentValidator)
+			command = commands.NewAWSCreateLBs(cloudConfigManager, stateStore, terraformManager, environmentValidator, logger, stdin)
 		})
 
 		Context("when lb type desired is cf", func() {
@@ -258,6 +264,98 @@ var _ = Describe("AWS Create LBs", func() {
 			})
 		})
 
+		Context("when an lb flavor is provided", func() {
+			It("saves the flavor in the state", func() {
+				err := command.Execute(
+					commands.CreateLBsConfig{
+						AWS: commands.AWSCreateLBsConfig{
+							LBType:   "cf",
+							CertPath: certPath,
+							KeyPath:  keyPath,
+							Flavor:   "alb",
+						},
+					},
+					incomingState,
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(terraformManager.ApplyCall.Receives.BBLState.LB.Flavor).To(Equal("alb"))
+			})
+
+			Context("when the flavor is omitted for an existing lb", func() {
+				It("keeps the flavor from the state", func() {
+					incomingState.LB = storage.LB{
+						Type:   "cf",
+						Flavor: "nlb",
+					}
+
+					err := command.Execute(
+						commands.CreateLBsConfig{
+							AWS: commands.AWSCreateLBsConfig{
+								CertPath: certPath,
+								KeyPath:  keyPath,
+							},
+						},
+						incomingState,
+					)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(terraformManager.ApplyCall.Receives.BBLState.LB.Flavor).To(Equal("nlb"))
+				})
+			})
+
+			Context("when the classic flavor is provided for an existing lb", func() {
+				It("goes back to classic ELBs by clearing the flavor from the state", func() {
+					for _, flavor := range []string{"classic", "elb"} {
+						incomingState.LB = storage.LB{
+							Type:   "cf",
+							Flavor: "alb",
+						}
+
+						err := command.Execute(
+							commands.CreateLBsConfig{
+								AWS: commands.AWSCreateLBsConfig{
+									CertPath: certPath,
+									KeyPath:  keyPath,
+									Flavor:   flavor,
+								},
+							},
+							incomingState,
+						)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(terraformManager.ApplyCall.Receives.BBLState.LB.Flavor).To(BeEmpty())
+					}
+				})
+			})
+		})
+
+		Context("when an acm certificate arn is provided", func() {
+			It("references the certificate instead of uploading one", func() {
+				incomingState.LB = storage.LB{
+					Type: "cf",
+					Cert: "some-old-cert",
+					Key:  "some-old-key",
+				}
+
+				err := command.Execute(
+					commands.CreateLBsConfig{
+						AWS: commands.AWSCreateLBsConfig{
+							LBType:            "cf",
+							ACMCertificateARN: "some-certificate-arn",
+						},
+					},
+					incomingState,
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(terraformManager.ApplyCall.Receives.BBLState.LB).To(Equal(storage.LB{
+					Type:              "cf",
+					ACMCertificateARN: "some-certificate-arn",
+				}))
+			})
+		})
+
 		Context("when the bbl environment does not have a BOSH director", func() {
 			It("does not call cloudConfigManager", func() {
 				terraformManager.ApplyCall.Returns.BBLState = storage.State{
@@ -279,6 +377,68 @@ var _ = Describe("AWS Create LBs", func() {
 			})
 		})
 
+		Context("when the cloud config would change", func() {
+			var config commands.CreateLBsConfig
+
+			BeforeEach(func() {
+				cloudConfigManager.DiffCall.Returns.Diff = bosh.ConfigDiff{
+					Diff: [][]interface{}{{"vm_extensions:", nil}, {"- name: cf-router-network-properties", "added"}},
+				}
+				config = commands.CreateLBsConfig{
+					AWS: commands.AWSCreateLBsConfig{
+						LBType:   "cf",
+						CertPath: certPath,
+						KeyPath:  keyPath,
+					},
+				}
+			})
+
+			It("prints the diff and applies the cloud config when the user confirms", func() {
+				stdin.Write([]byte("yes\n"))
+
+				err := command.Execute(config, incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PrintlnCall.Messages).To(ContainElement("  vm_extensions:\n+ - name: cf-router-network-properties"))
+				Expect(logger.PromptCall.Receives.Message).To(Equal("Are you sure you want to apply these changes to the cloud config?"))
+				Expect(cloudConfigManager.UpdateCall.CallCount).To(Equal(1))
+			})
+
+			It("stops without updating the cloud config when the user declines", func() {
+				stdin.Write([]byte("no\n"))
+
+				err := command.Execute(config, incomingState)
+				Expect(err).To(MatchError("Cloud config update declined. The IAAS changes were applied, but the cloud config was left as it is. Run the command again to update it."))
+
+				Expect(cloudConfigManager.UpdateCall.CallCount).To(Equal(0))
+			})
+
+			It("applies the cloud config without prompting when --no-confirm is passed", func() {
+				config.NoConfirm = true
+
+				err := command.Execute(config, incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(cloudConfigManager.DiffCall.CallCount).To(Equal(0))
+				Expect(logger.PromptCall.CallCount).To(Equal(0))
+				Expect(cloudConfigManager.UpdateCall.CallCount).To(Equal(1))
+			})
+
+			It("prints the diff without touching the IAAS when --cloud-config-diff-only is passed", func() {
+				config.CloudConfigDiffOnly = true
+				incomingState.BOSH = storage.BOSH{DirectorName: "some-director"}
+
+				err := command.Execute(config, incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(cloudConfigManager.DiffCall.Receives.State.LB.Type).To(Equal("cf"))
+				Expect(logger.PrintlnCall.Messages).To(ContainElement("  vm_extensions:\n+ - name: cf-router-network-properties"))
+				Expect(terraformManager.ApplyCall.CallCount).To(Equal(0))
+				Expect(stateStore.SetCall.CallCount).To(Equal(0))
+				Expect(cloudConfigManager.UpdateCall.CallCount).To(Equal(0))
+			})
+		})
+
 		Context("when the environment validator fails", func() {
 			BeforeEach(func() {
 				environmentValidator.ValidateCall.Returns.Error = errors.New("environment not found")
@@ -520,7 +680,7 @@ var _ = Describe("AWS Create LBs", func() {
 						},
 						storage.State{},
 					)
-					Expect(err).To(MatchError("failed to update cloud config"))
+					Expect(err).To(MatchError("Update cloud config: failed to update cloud config"))
 				})
 			})
 
@@ -1,7 +1,6 @@
 package commands
 
 import (
-	"encoding/json"
 	"errors"
 	"strings"
 
@@ -11,12 +10,30 @@ import (
 type AWSLBs struct {
 	terraformManager terraformOutputter
 	logger           logger
+	output           string
 }
 
-func NewAWSLBs(terraformManager terraformOutputter, logger logger) AWSLBs {
+type awsCFLBsOutput struct {
+	RouterLBName           string   `json:"cf_router_lb,omitempty"              yaml:"cf_router_lb,omitempty"`
+	RouterLBURL            string   `json:"cf_router_lb_url,omitempty"          yaml:"cf_router_lb_url,omitempty"`
+	SSHProxyLBName         string   `json:"cf_ssh_proxy_lb,omitempty"           yaml:"cf_ssh_proxy_lb,omitempty"`
+	SSHProxyLBURL          string   `json:"cf_ssh_proxy_lb_url,omitempty"       yaml:"cf_ssh_proxy_lb_url,omitempty"`
+	TCPRouterLBName        string   `json:"cf_tcp_lb,omitempty"                 yaml:"cf_tcp_lb,omitempty"`
+	TCPRouterLBURL         string   `json:"cf_tcp_lb_url,omitempty"             yaml:"cf_tcp_lb_url,omitempty"`
+	SystemDomainDNSServers []string `json:"env_dns_zone_name_servers,omitempty" yaml:"env_dns_zone_name_servers,omitempty"`
+}
+
+type awsConcourseLBsOutput struct {
+	ConcourseLBName   string `json:"concourse_lb,omitempty"         yaml:"concourse_lb,omitempty"`
+	ConcourseLBURL    string `json:"concourse_lb_url,omitempty"     yaml:"concourse_lb_url,omitempty"`
+	ConcourseTSALBURL string `json:"concourse_tsa_lb_url,omitempty" yaml:"concourse_tsa_lb_url,omitempty"`
+}
+
+func NewAWSLBs(terraformManager terraformOutputter, logger logger, output string) AWSLBs {
 	return AWSLBs{
 		terraformManager: terraformManager,
 		logger:           logger,
+		output:           output,
 	}
 }
 
@@ -27,18 +44,12 @@ func (l AWSLBs) Execute(subcommandFlags []string, state storage.State) error {
 			return err
 		}
 
+		output := lbsOutput(l.output, subcommandFlags)
+
 		switch state.LB.Type {
 		case "cf":
-			if len(subcommandFlags) > 0 && subcommandFlags[0] == "--json" {
-				lbOutput, err := json.Marshal(struct {
-					RouterLBName           string   `json:"cf_router_lb,omitempty"`
-					RouterLBURL            string   `json:"cf_router_lb_url,omitempty"`
-					SSHProxyLBName         string   `json:"cf_ssh_proxy_lb,omitempty"`
-					SSHProxyLBURL          string   `json:"cf_ssh_proxy_lb_url,omitempty"`
-					TCPRouterLBName        string   `json:"cf_tcp_lb,omitempty"`
-					TCPRouterLBURL         string   `json:"cf_tcp_lb_url,omitempty"`
-					SystemDomainDNSServers []string `json:"env_dns_zone_name_servers,omitempty"`
-				}{
+			if structuredOutput(output) {
+				return printFormatted(l.logger, output, awsCFLBsOutput{
 					RouterLBName:           terraformOutputs.GetString("cf_router_lb_name"),
 					RouterLBURL:            terraformOutputs.GetString("cf_router_lb_url"),
 					SSHProxyLBName:         terraformOutputs.GetString("cf_ssh_lb_name"),
@@ -47,25 +58,31 @@ func (l AWSLBs) Execute(subcommandFlags []string, state storage.State) error {
 					TCPRouterLBURL:         terraformOutputs.GetString("cf_tcp_lb_url"),
 					SystemDomainDNSServers: terraformOutputs.GetStringSlice("env_dns_zone_name_servers"),
 				})
-				if err != nil {
-					// not tested
-					return err
-				}
+			}
 
-				l.logger.Println(string(lbOutput))
-			} else {
-				l.logger.Printf("CF Router LB: %s [%s]\n", terraformOutputs.GetString("cf_router_lb_name"), terraformOutputs.GetString("cf_router_lb_url"))
-				l.logger.Printf("CF SSH Proxy LB: %s [%s]\n", terraformOutputs.GetString("cf_ssh_lb_name"), terraformOutputs.GetString("cf_ssh_lb_url"))
-				l.logger.Printf("CF TCP Router LB: %s [%s]\n", terraformOutputs.GetString("cf_tcp_lb_name"), terraformOutputs.GetString("cf_tcp_lb_url"))
+			l.logger.Printf("CF Router LB: %s [%s]\n", terraformOutputs.GetString("cf_router_lb_name"), terraformOutputs.GetString("cf_router_lb_url"))
+			l.logger.Printf("CF SSH Proxy LB: %s [%s]\n", terraformOutputs.GetString("cf_ssh_lb_name"), terraformOutputs.GetString("cf_ssh_lb_url"))
+			l.logger.Printf("CF TCP Router LB: %s [%s]\n", terraformOutputs.GetString("cf_tcp_lb_name"), terraformOutputs.GetString("cf_tcp_lb_url"))
 
-				dnsServers := terraformOutputs.GetStringSlice("env_dns_zone_name_servers")
+			dnsServers := terraformOutputs.GetStringSlice("env_dns_zone_name_servers")
 
-				if len(dnsServers) > 0 {
-					l.logger.Printf("CF System Domain DNS servers: %s\n", strings.Join(dnsServers, " "))
-				}
+			if len(dnsServers) > 0 {
+				l.logger.Printf("CF System Domain DNS servers: %s\n", strings.Join(dnsServers, " "))
 			}
 		case "concourse":
+			if structuredOutput(output) {
+				return printFormatted(l.logger, output, awsConcourseLBsOutput{
+					ConcourseLBName:   terraformOutputs.GetString("concourse_lb_name"),
+					ConcourseLBURL:    terraformOutputs.GetString("concourse_lb_url"),
+					ConcourseTSALBURL: terraformOutputs.GetString("concourse_tsa_lb_url"),
+				})
+			}
+
 			l.logger.Printf("Concourse LB: %s [%s]\n", terraformOutputs.GetString("concourse_lb_name"), terraformOutputs.GetString("concourse_lb_url"))
+
+			if tsaURL := terraformOutputs.GetString("concourse_tsa_lb_url"); tsaURL != "" {
+				l.logger.Printf("Concourse TSA LB: [%s]\n", tsaURL)
+			}
 		default:
 			return errors.New("no lbs found")
 		}
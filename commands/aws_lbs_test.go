@@ -26,7 +26,7 @@ var _ = Describe("AWSLBs", func() {
 		terraformManager = &fakes.TerraformManager{}
 		logger = &fakes.Logger{}
 
-		command = commands.NewAWSLBs(terraformManager, logger)
+		command = commands.NewAWSLBs(terraformManager, logger, commands.TextOutput)
 	})
 
 	Describe("Execute", func() {
@@ -141,6 +141,35 @@ var _ = Describe("AWSLBs", func() {
 			})
 		})
 
+		Context("when the lb type is concourse with an alb flavor", func() {
+			BeforeEach(func() {
+				incomingState = storage.State{
+					IAAS:    "aws",
+					TFState: "some-tf-state",
+					LB: storage.LB{
+						Type:   "concourse",
+						Flavor: "alb",
+					},
+				}
+				terraformManager.GetOutputsCall.Returns.Outputs = terraform.Outputs{Map: map[string]interface{}{
+					"concourse_lb_name":    "some-concourse-lb-name",
+					"concourse_lb_url":     "some-concourse-lb-url",
+					"concourse_tsa_lb_url": "some-concourse-tsa-lb-url",
+				}}
+			})
+
+			It("also prints the TSA LB URL", func() {
+				err := command.Execute([]string{}, incomingState)
+
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PrintfCall.Messages).To(ConsistOf([]string{
+					"Concourse LB: some-concourse-lb-name [some-concourse-lb-url]\n",
+					"Concourse TSA LB: [some-concourse-tsa-lb-url]\n",
+				}))
+			})
+		})
+
 		Context("when lb type is not cf or concourse", func() {
 			BeforeEach(func() {
 				incomingState = storage.State{
@@ -0,0 +1,81 @@
+package commands
+
+import (
+	"io"
+
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+)
+
+type AzureCreateLBs struct {
+	terraformManager     terraformManager
+	cloudConfigUpdater   cloudConfigUpdater
+	stateStore           stateStore
+	environmentValidator EnvironmentValidator
+}
+
+type AzureCreateLBsConfig struct {
+	LBType string
+	Domain string
+}
+
+func NewAzureCreateLBs(terraformManager terraformManager,
+	cloudConfigManager cloudConfigManager,
+	stateStore stateStore, environmentValidator EnvironmentValidator,
+	logger logger, stdin io.Reader,
+) AzureCreateLBs {
+	return AzureCreateLBs{
+		terraformManager:     terraformManager,
+		cloudConfigUpdater:   newCloudConfigUpdater(logger, stdin, cloudConfigManager),
+		stateStore:           stateStore,
+		environmentValidator: environmentValidator,
+	}
+}
+
+func (c AzureCreateLBs) Execute(config CreateLBsConfig, state storage.State) error {
+	if state.LB.Type != "" {
+		if config.Azure.Domain == "" {
+			config.Azure.Domain = state.LB.Domain
+		}
+	}
+
+	err := c.terraformManager.ValidateVersion()
+	if err != nil {
+		return err
+	}
+
+	if err := c.environmentValidator.Validate(state); err != nil {
+		return err
+	}
+
+	state.LB.Type = config.Azure.LBType
+
+	if config.Azure.LBType == "cf" {
+		state.LB.Domain = config.Azure.Domain
+	}
+
+	if config.CloudConfigDiffOnly {
+		return c.cloudConfigUpdater.PrintDiff(state)
+	}
+
+	if err := c.terraformManager.Init(state); err != nil {
+		return err
+	}
+
+	state, err = c.terraformManager.Apply(state)
+	if err != nil {
+		return handleTerraformError(err, c.stateStore)
+	}
+
+	if err := c.stateStore.Set(state); err != nil {
+		return err
+	}
+
+	if !state.NoDirector {
+		err = c.cloudConfigUpdater.Update(state, config.NoConfirm)
+		if err != nil {
+			return cloudConfigUpdateError(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,203 @@
+package commands_test
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/cloudfoundry/bosh-bootloader/application"
+	"github.com/cloudfoundry/bosh-bootloader/bosh"
+	"github.com/cloudfoundry/bosh-bootloader/commands"
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/cloudfoundry/bosh-bootloader/terraform"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AzureCreateLBs", func() {
+	var (
+		terraformManager       *fakes.TerraformManager
+		cloudConfigManager     *fakes.CloudConfigManager
+		stateStore             *fakes.StateStore
+		environmentValidator   *fakes.EnvironmentValidator
+		logger                 *fakes.Logger
+		stdin                  *bytes.Buffer
+		terraformExecutorError *fakes.TerraformExecutorError
+
+		bblState storage.State
+		command  commands.AzureCreateLBs
+	)
+
+	BeforeEach(func() {
+		terraformManager = &fakes.TerraformManager{}
+		cloudConfigManager = &fakes.CloudConfigManager{}
+		stateStore = &fakes.StateStore{}
+		environmentValidator = &fakes.EnvironmentValidator{}
+		logger = &fakes.Logger{}
+		stdin = bytes.NewBuffer([]byte{})
+		terraformExecutorError = &fakes.TerraformExecutorError{}
+
+		command = commands.NewAzureCreateLBs(terraformManager, cloudConfigManager, stateStore, environmentValidator, logger, stdin)
+
+		bblState = storage.State{
+			IAAS: "azure",
+			Azure: storage.Azure{
+				Location: "some-location",
+			},
+			TFState: "some-tfstate",
+		}
+	})
+
+	Describe("Execute", func() {
+		It("applies terraform with the lb type", func() {
+			expectedState := bblState
+			expectedState.LB = storage.LB{Type: "cf"}
+
+			err := command.Execute(commands.CreateLBsConfig{Azure: commands.AzureCreateLBsConfig{
+				LBType: "cf",
+			}}, bblState)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(terraformManager.ValidateVersionCall.CallCount).To(Equal(1))
+			Expect(environmentValidator.ValidateCall.Receives.State).To(Equal(bblState))
+			Expect(terraformManager.InitCall.Receives.BBLState).To(Equal(expectedState))
+			Expect(terraformManager.ApplyCall.Receives.BBLState).To(Equal(expectedState))
+		})
+
+		Context("when a domain is provided", func() {
+			It("applies terraform with the domain", func() {
+				err := command.Execute(commands.CreateLBsConfig{Azure: commands.AzureCreateLBsConfig{
+					LBType: "cf",
+					Domain: "some-domain",
+				}}, bblState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(terraformManager.ApplyCall.Receives.BBLState.LB).To(Equal(storage.LB{
+					Type:   "cf",
+					Domain: "some-domain",
+				}))
+			})
+
+			It("keeps the existing domain when updating the lbs without one", func() {
+				bblState.LB = storage.LB{Type: "cf", Domain: "some-existing-domain"}
+
+				err := command.Execute(commands.CreateLBsConfig{Azure: commands.AzureCreateLBsConfig{
+					LBType: "cf",
+				}}, bblState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(terraformManager.ApplyCall.Receives.BBLState.LB.Domain).To(Equal("some-existing-domain"))
+			})
+		})
+
+		It("saves the updated state and uploads a new cloud-config to the bosh director", func() {
+			terraformManager.ApplyCall.Returns.BBLState = storage.State{
+				LB:      storage.LB{Type: "concourse"},
+				TFState: "some-new-tfstate",
+			}
+
+			err := command.Execute(commands.CreateLBsConfig{Azure: commands.AzureCreateLBsConfig{
+				LBType: "concourse",
+			}}, bblState)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(stateStore.SetCall.CallCount).To(Equal(1))
+			Expect(stateStore.SetCall.Receives[0].State).To(Equal(storage.State{
+				LB:      storage.LB{Type: "concourse"},
+				TFState: "some-new-tfstate",
+			}))
+
+			Expect(cloudConfigManager.UpdateCall.CallCount).To(Equal(1))
+			Expect(cloudConfigManager.UpdateCall.Receives.State.TFState).To(Equal("some-new-tfstate"))
+		})
+
+		Context("when there is no BOSH director", func() {
+			It("does not update the cloud config", func() {
+				terraformManager.ApplyCall.Returns.BBLState.NoDirector = true
+
+				err := command.Execute(commands.CreateLBsConfig{Azure: commands.AzureCreateLBsConfig{
+					LBType: "cf",
+				}}, storage.State{NoDirector: true})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cloudConfigManager.UpdateCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("when the cloud config would change", func() {
+			BeforeEach(func() {
+				cloudConfigManager.DiffCall.Returns.Diff = bosh.ConfigDiff{
+					Diff: [][]interface{}{{"vm_extensions:", nil}, {"- name: lb", "added"}},
+				}
+			})
+
+			It("prints the diff and applies the cloud config when the user confirms", func() {
+				stdin.Write([]byte("yes\n"))
+
+				err := command.Execute(commands.CreateLBsConfig{Azure: commands.AzureCreateLBsConfig{LBType: "concourse"}}, bblState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PrintlnCall.Messages).To(ContainElement("  vm_extensions:\n+ - name: lb"))
+				Expect(logger.PromptCall.Receives.Message).To(Equal("Are you sure you want to apply these changes to the cloud config?"))
+				Expect(cloudConfigManager.UpdateCall.CallCount).To(Equal(1))
+			})
+
+			It("stops without updating the cloud config when the user declines", func() {
+				stdin.Write([]byte("no\n"))
+
+				err := command.Execute(commands.CreateLBsConfig{Azure: commands.AzureCreateLBsConfig{LBType: "concourse"}}, bblState)
+				Expect(err).To(MatchError("Cloud config update declined. The IAAS changes were applied, but the cloud config was left as it is. Run the command again to update it."))
+
+				Expect(cloudConfigManager.UpdateCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("failure cases", func() {
+			It("returns an error when terraform manager version validator fails", func() {
+				terraformManager.ValidateVersionCall.Returns.Error = errors.New("cannot validate version")
+
+				err := command.Execute(commands.CreateLBsConfig{}, bblState)
+				Expect(err).To(MatchError("cannot validate version"))
+			})
+
+			It("returns an error when the environment validator fails", func() {
+				environmentValidator.ValidateCall.Returns.Error = application.DirectorNotReachable
+
+				err := command.Execute(commands.CreateLBsConfig{}, bblState)
+				Expect(err).To(MatchError(application.DirectorNotReachable))
+			})
+
+			It("returns an error when terraform manager fails to init", func() {
+				terraformManager.InitCall.Returns.Error = errors.New("apple")
+
+				err := command.Execute(commands.CreateLBsConfig{}, bblState)
+				Expect(err).To(MatchError("apple"))
+			})
+
+			It("saves the tf state when the applier fails", func() {
+				terraformExecutorError.TFStateCall.Returns.TFState = "some-updated-tf-state"
+				terraformExecutorError.ErrorCall.Returns = "failed to apply"
+				terraformManager.ApplyCall.Returns.Error = terraform.NewManagerError(bblState, terraformExecutorError)
+
+				err := command.Execute(commands.CreateLBsConfig{}, bblState)
+				Expect(err).To(MatchError("failed to apply"))
+				Expect(stateStore.SetCall.CallCount).To(Equal(1))
+				Expect(stateStore.SetCall.Receives[0].State.TFState).To(Equal("some-updated-tf-state"))
+			})
+
+			It("returns an error when the state fails to save", func() {
+				stateStore.SetCall.Returns = []fakes.SetCallReturn{{Error: errors.New("failed to save state")}}
+
+				err := command.Execute(commands.CreateLBsConfig{}, bblState)
+				Expect(err).To(MatchError("failed to save state"))
+			})
+
+			It("returns an error when the cloud config fails to update", func() {
+				cloudConfigManager.UpdateCall.Returns.Error = errors.New("failed to update cloud config")
+
+				err := command.Execute(commands.CreateLBsConfig{}, bblState)
+				Expect(err).To(MatchError("Update cloud config: failed to update cloud config"))
+			})
+		})
+	})
+})
@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+)
+
+type AzureLBs struct {
+	terraformManager terraformOutputter
+	logger           logger
+	output           string
+}
+
+type azureCFLBsOutput struct {
+	RouterLBIP             string   `json:"cf_router_lb,omitempty"                 yaml:"cf_router_lb,omitempty"`
+	SSHProxyLBIP           string   `json:"cf_ssh_proxy_lb,omitempty"              yaml:"cf_ssh_proxy_lb,omitempty"`
+	TCPRouterLBIP          string   `json:"cf_tcp_router_lb,omitempty"             yaml:"cf_tcp_router_lb,omitempty"`
+	SystemDomainDNSServers []string `json:"cf_system_domain_dns_servers,omitempty" yaml:"cf_system_domain_dns_servers,omitempty"`
+}
+
+func NewAzureLBs(terraformManager terraformOutputter, logger logger, output string) AzureLBs {
+	return AzureLBs{
+		terraformManager: terraformManager,
+		logger:           logger,
+		output:           output,
+	}
+}
+
+func (l AzureLBs) Execute(subcommandFlags []string, state storage.State) error {
+	terraformOutputs, err := l.terraformManager.GetOutputs(state)
+	if err != nil {
+		return err
+	}
+
+	output := lbsOutput(l.output, subcommandFlags)
+
+	switch state.LB.Type {
+	case "cf":
+		if structuredOutput(output) {
+			return printFormatted(l.logger, output, azureCFLBsOutput{
+				RouterLBIP:             terraformOutputs.GetString("router_lb_ip"),
+				SSHProxyLBIP:           terraformOutputs.GetString("ssh_proxy_lb_ip"),
+				TCPRouterLBIP:          terraformOutputs.GetString("tcp_router_lb_ip"),
+				SystemDomainDNSServers: terraformOutputs.GetStringSlice("system_domain_dns_servers"),
+			})
+		}
+
+		l.logger.Printf("CF Router LB: %s\n", terraformOutputs.GetString("router_lb_ip"))
+		l.logger.Printf("CF SSH Proxy LB: %s\n", terraformOutputs.GetString("ssh_proxy_lb_ip"))
+		l.logger.Printf("CF TCP Router LB: %s\n", terraformOutputs.GetString("tcp_router_lb_ip"))
+		dnsServers := terraformOutputs.GetStringSlice("system_domain_dns_servers")
+		if len(dnsServers) > 0 {
+			l.logger.Printf("CF System Domain DNS servers: %s\n", strings.Join(dnsServers, " "))
+		}
+	case "concourse":
+		if structuredOutput(output) {
+			return printFormatted(l.logger, output, concourseLBOutput{
+				ConcourseLBIP: terraformOutputs.GetString("concourse_lb_ip"),
+			})
+		}
+
+		l.logger.Printf("Concourse LB: %s\n", terraformOutputs.GetString("concourse_lb_ip"))
+	default:
+		return errors.New("no lbs found")
+	}
+
+	return nil
+}
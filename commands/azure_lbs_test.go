@@ -0,0 +1,138 @@
+package commands_test
+
+import (
+	"errors"
+
+	"github.com/cloudfoundry/bosh-bootloader/commands"
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/cloudfoundry/bosh-bootloader/terraform"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AzureLBs", func() {
+	var (
+		command commands.AzureLBs
+
+		terraformManager *fakes.TerraformManager
+		logger           *fakes.Logger
+
+		incomingState storage.State
+	)
+
+	BeforeEach(func() {
+		terraformManager = &fakes.TerraformManager{}
+		terraformManager.GetOutputsCall.Returns.Outputs = terraform.Outputs{Map: map[string]interface{}{
+			"router_lb_ip":     "some-router-lb-ip",
+			"ssh_proxy_lb_ip":  "some-ssh-proxy-lb-ip",
+			"tcp_router_lb_ip": "some-tcp-router-lb-ip",
+			"concourse_lb_ip":  "some-concourse-lb-ip",
+		}}
+		logger = &fakes.Logger{}
+
+		incomingState = storage.State{}
+
+		command = commands.NewAzureLBs(terraformManager, logger, commands.TextOutput)
+	})
+
+	Describe("Execute", func() {
+		It("prints LB ips for lb type cf", func() {
+			incomingState.LB = storage.LB{
+				Type: "cf",
+			}
+			err := command.Execute([]string{}, incomingState)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(terraformManager.GetOutputsCall.Receives.BBLState).To(Equal(incomingState))
+			Expect(logger.PrintfCall.Messages).To(ConsistOf([]string{
+				"CF Router LB: some-router-lb-ip\n",
+				"CF SSH Proxy LB: some-ssh-proxy-lb-ip\n",
+				"CF TCP Router LB: some-tcp-router-lb-ip\n",
+			}))
+		})
+
+		Context("when the environment has a system domain", func() {
+			BeforeEach(func() {
+				terraformManager.GetOutputsCall.Returns.Outputs.Map["system_domain_dns_servers"] = []interface{}{
+					"some-name-server-1",
+					"some-name-server-2",
+				}
+				incomingState.LB = storage.LB{
+					Type:   "cf",
+					Domain: "some-domain",
+				}
+			})
+
+			It("prints the system domain dns servers", func() {
+				err := command.Execute([]string{}, incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PrintfCall.Messages).To(ContainElement(
+					"CF System Domain DNS servers: some-name-server-1 some-name-server-2\n",
+				))
+			})
+
+			It("includes the system domain dns servers in json format", func() {
+				err := command.Execute([]string{"--json"}, incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PrintlnCall.Receives.Message).To(MatchJSON(`{
+					"cf_router_lb": "some-router-lb-ip",
+					"cf_ssh_proxy_lb": "some-ssh-proxy-lb-ip",
+					"cf_tcp_router_lb": "some-tcp-router-lb-ip",
+					"cf_system_domain_dns_servers": ["some-name-server-1", "some-name-server-2"]
+				}`))
+			})
+		})
+
+		Context("when the json flag is provided", func() {
+			It("prints LB ips for lb type cf in json format", func() {
+				incomingState.LB = storage.LB{
+					Type: "cf",
+				}
+				err := command.Execute([]string{"--json"}, incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PrintlnCall.Receives.Message).To(MatchJSON(`{
+					"cf_router_lb": "some-router-lb-ip",
+					"cf_ssh_proxy_lb": "some-ssh-proxy-lb-ip",
+					"cf_tcp_router_lb": "some-tcp-router-lb-ip"
+				}`))
+			})
+		})
+
+		It("prints LB ips for lb type concourse", func() {
+			incomingState.LB = storage.LB{
+				Type: "concourse",
+			}
+			err := command.Execute([]string{}, incomingState)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(logger.PrintfCall.Messages).To(ConsistOf([]string{
+				"Concourse LB: some-concourse-lb-ip\n",
+			}))
+		})
+
+		Context("failure cases", func() {
+			Context("when terraform output provider fails", func() {
+				BeforeEach(func() {
+					terraformManager.GetOutputsCall.Returns.Error = errors.New("failed to return terraform output")
+				})
+
+				It("returns an error", func() {
+					err := command.Execute([]string{}, incomingState)
+					Expect(err).To(MatchError("failed to return terraform output"))
+				})
+			})
+
+			Context("when no lb type is found", func() {
+				It("returns an nice error message", func() {
+					err := command.Execute([]string{}, incomingState)
+					Expect(err).To(MatchError("no lbs found"))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,152 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cloudfoundry/bosh-bootloader/certs"
+	"github.com/cloudfoundry/bosh-bootloader/flags"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+)
+
+const (
+	CertStatusCommand = "cert-status"
+
+	defaultCertWarnDays = 30
+)
+
+type CertStatus struct {
+	logger                    logger
+	stateValidator            stateValidator
+	certificateStatusReporter certificateStatusReporter
+	output                    string
+}
+
+type certificateOutput struct {
+	Name          string   `json:"name"           yaml:"name"`
+	Subject       string   `json:"subject"        yaml:"subject"`
+	Issuer        string   `json:"issuer"         yaml:"issuer"`
+	SANs          []string `json:"sans"           yaml:"sans"`
+	NotAfter      string   `json:"not_after"      yaml:"not_after"`
+	DaysRemaining int      `json:"days_remaining" yaml:"days_remaining"`
+}
+
+type certStatusConfig struct {
+	warnDays int
+}
+
+type certificateStatusReporter interface {
+	Report(state storage.State) ([]certs.Status, error)
+}
+
+func NewCertStatus(logger logger, stateValidator stateValidator, certificateStatusReporter certificateStatusReporter, output string) CertStatus {
+	return CertStatus{
+		logger:                    logger,
+		stateValidator:            stateValidator,
+		certificateStatusReporter: certificateStatusReporter,
+		output:                    output,
+	}
+}
+
+func (c CertStatus) CheckFastFails(subcommandFlags []string, state storage.State) error {
+	_, err := c.parseFlags(subcommandFlags)
+	if err != nil {
+		return err
+	}
+
+	err = c.stateValidator.Validate()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c CertStatus) Execute(subcommandFlags []string, state storage.State) error {
+	config, err := c.parseFlags(subcommandFlags)
+	if err != nil {
+		return err
+	}
+
+	statuses, err := c.certificateStatusReporter.Report(state)
+	if err != nil {
+		return err
+	}
+
+	if structuredOutput(c.output) {
+		certificates := []certificateOutput{}
+		for _, status := range statuses {
+			certificates = append(certificates, certificateOutput{
+				Name:          status.Name,
+				Subject:       status.Subject,
+				Issuer:        status.Issuer,
+				SANs:          status.SANs,
+				NotAfter:      status.NotAfter.Format("2006-01-02"),
+				DaysRemaining: status.DaysRemaining,
+			})
+		}
+
+		err = printFormatted(c.logger, c.output, certificates)
+		if err != nil {
+			return err // not tested
+		}
+	}
+
+	expiring := []string{}
+	for _, status := range statuses {
+		if !structuredOutput(c.output) {
+			c.logger.Println(fmt.Sprintf("%s:", status.Name))
+			c.logger.Println(fmt.Sprintf("  subject:        %s", status.Subject))
+			c.logger.Println(fmt.Sprintf("  issuer:         %s", status.Issuer))
+			c.logger.Println(fmt.Sprintf("  sans:           %s", strings.Join(status.SANs, ", ")))
+			c.logger.Println(fmt.Sprintf("  not after:      %s", status.NotAfter.Format("2006-01-02")))
+			c.logger.Println(fmt.Sprintf("  days remaining: %d", status.DaysRemaining))
+		}
+
+		if status.ExpiresWithin(config.warnDays) {
+			expiring = append(expiring, status.Name)
+		}
+	}
+
+	if len(expiring) > 0 {
+		return fmt.Errorf("%d certificate(s) expire within %d days: %s", len(expiring), config.warnDays, strings.Join(expiring, ", "))
+	}
+
+	return nil
+}
+
+func (CertStatus) Flags() []flags.Flag {
+	return newCertStatusFlags(&certStatusConfig{}).Registered()
+}
+
+func (c CertStatus) parseFlags(subcommandFlags []string) (certStatusConfig, error) {
+	config := certStatusConfig{}
+	certStatusFlags := newCertStatusFlags(&config)
+
+	err := certStatusFlags.Parse(subcommandFlags)
+	if err != nil {
+		return config, err
+	}
+
+	return config, nil
+}
+
+func newCertStatusFlags(config *certStatusConfig) flags.Flags {
+	certStatusFlags := flags.New("cert-status")
+	certStatusFlags.Int(&config.warnDays, "warn-days", defaultCertWarnDays)
+	return certStatusFlags
+}
+
+func warnExpiringCertificates(logger logger, certificateStatusReporter certificateStatusReporter, state storage.State) {
+	statuses, err := certificateStatusReporter.Report(state)
+	if err != nil {
+		logger.Println(fmt.Sprintf("warning: could not check certificate expiry: %s", err))
+		return
+	}
+
+	for _, status := range statuses {
+		if status.ExpiresWithin(defaultCertWarnDays) {
+			logger.Println(fmt.Sprintf("warning: certificate %s expires in %d days (%s)", status.Name, status.DaysRemaining, status.NotAfter.Format("2006-01-02")))
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package commands_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/cloudfoundry/bosh-bootloader/certs"
+	"github.com/cloudfoundry/bosh-bootloader/commands"
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CertStatus", func() {
+	var (
+		logger             *fakes.Logger
+		stateValidator     *fakes.StateValidator
+		certStatusReporter *fakes.CertificateStatusReporter
+
+		command commands.CertStatus
+		state   storage.State
+	)
+
+	BeforeEach(func() {
+		logger = &fakes.Logger{}
+		stateValidator = &fakes.StateValidator{}
+		certStatusReporter = &fakes.CertificateStatusReporter{}
+
+		state = storage.State{EnvID: "some-env-id"}
+
+		certStatusReporter.ReportCall.Returns.Statuses = []certs.Status{
+			{
+				Name:          "director_ssl_ca",
+				Subject:       "CN=ca,O=bbl",
+				Issuer:        "CN=ca,O=bbl",
+				SANs:          []string{},
+				NotAfter:      time.Date(2018, time.July, 1, 0, 0, 0, 0, time.UTC),
+				DaysRemaining: 365,
+			},
+			{
+				Name:          "bosh/director_ssl",
+				Subject:       "CN=10.0.0.6",
+				Issuer:        "CN=ca,O=bbl",
+				SANs:          []string{"10.0.0.6", "director.example.com"},
+				NotAfter:      time.Date(2017, time.July, 21, 0, 0, 0, 0, time.UTC),
+				DaysRemaining: 20,
+			},
+		}
+
+		command = commands.NewCertStatus(logger, stateValidator, certStatusReporter, commands.TextOutput)
+	})
+
+	Describe("CheckFastFails", func() {
+		Context("when the state does not exist", func() {
+			BeforeEach(func() {
+				stateValidator.ValidateCall.Returns.Error = errors.New("failed to validate state")
+			})
+
+			It("returns an error", func() {
+				err := command.CheckFastFails([]string{}, state)
+				Expect(err).To(MatchError("failed to validate state"))
+			})
+		})
+
+		Context("when the flags cannot be parsed", func() {
+			It("returns an error", func() {
+				err := command.CheckFastFails([]string{"--warn-days", "soon"}, state)
+				Expect(err).To(MatchError(ContainSubstring("invalid value \"soon\" for flag -warn-days")))
+			})
+		})
+	})
+
+	Describe("Execute", func() {
+		It("prints the status of every certificate", func() {
+			err := command.Execute([]string{"--warn-days", "10"}, state)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(certStatusReporter.ReportCall.Receives.State).To(Equal(state))
+			Expect(logger.PrintlnCall.Messages).To(Equal([]string{
+				"director_ssl_ca:",
+				"  subject:        CN=ca,O=bbl",
+				"  issuer:         CN=ca,O=bbl",
+				"  sans:           ",
+				"  not after:      2018-07-01",
+				"  days remaining: 365",
+				"bosh/director_ssl:",
+				"  subject:        CN=10.0.0.6",
+				"  issuer:         CN=ca,O=bbl",
+				"  sans:           10.0.0.6, director.example.com",
+				"  not after:      2017-07-21",
+				"  days remaining: 20",
+			}))
+		})
+
+		Context("when a certificate expires within the default threshold", func() {
+			It("returns an error", func() {
+				err := command.Execute([]string{}, state)
+				Expect(err).To(MatchError("1 certificate(s) expire within 30 days: bosh/director_ssl"))
+			})
+		})
+
+		Context("when a certificate expires within --warn-days", func() {
+			It("returns an error", func() {
+				err := command.Execute([]string{"--warn-days", "400"}, state)
+				Expect(err).To(MatchError("2 certificate(s) expire within 400 days: director_ssl_ca, bosh/director_ssl"))
+			})
+		})
+
+		Context("failure cases", func() {
+			It("returns an error when the reporter fails", func() {
+				certStatusReporter.ReportCall.Returns.Error = errors.New("failed to parse lb_cert")
+
+				err := command.Execute([]string{}, state)
+				Expect(err).To(MatchError("failed to parse lb_cert"))
+			})
+		})
+	})
+})
@@ -0,0 +1,211 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cloudfoundry/bosh-bootloader/flags"
+	"github.com/cloudfoundry/bosh-bootloader/helpers"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+)
+
+type CleanupLeftovers struct {
+	logger   logger
+	stdin    io.Reader
+	cleaners []LeftoverCleaner
+}
+
+// minLeftoverFilterLength keeps a filter like "b" from matching every
+// resource in the account.
+const minLeftoverFilterLength = 3
+
+type cleanupLeftoversConfig struct {
+	filter            string
+	dryRun            bool
+	noConfirm         bool
+	includeCurrentEnv bool
+}
+
+// LeftoverCleaner finds and deletes the IaaS resources of an environment by
+// its env ID, without needing its bbl state or terraform state.
+type LeftoverCleaner interface {
+	ListLeftovers(filter string) ([]helpers.Leftover, error)
+	DeleteLeftover(leftover helpers.Leftover) error
+}
+
+type cleanableLeftover struct {
+	helpers.Leftover
+	cleaner LeftoverCleaner
+}
+
+// NewCleanupLeftovers returns the cleanup-leftovers command. The cleaners are
+// asked in order and their leftovers deleted in that order, so cleaners for
+// resources that others depend on go last.
+func NewCleanupLeftovers(logger logger, stdin io.Reader, cleaners []LeftoverCleaner) CleanupLeftovers {
+	return CleanupLeftovers{
+		logger:   logger,
+		stdin:    stdin,
+		cleaners: cleaners,
+	}
+}
+
+func (c CleanupLeftovers) CheckFastFails(subcommandFlags []string, state storage.State) error {
+	config, err := parseCleanupLeftoversFlags(subcommandFlags)
+	if err != nil {
+		return err
+	}
+
+	if len(c.cleaners) == 0 {
+		return fmt.Errorf("cleanup-leftovers is not supported on %s.", state.IAAS)
+	}
+
+	// The environment in the state dir may still be up, and a filter that
+	// matches its env ID would delete it without its bbl state knowing.
+	if state.EnvID != "" && strings.HasPrefix(state.EnvID, config.filter) && !config.includeCurrentEnv {
+		return fmt.Errorf("--filter %q matches %s, the environment in the state directory. Run \"bbl destroy\" to delete it, or pass --include-current-env if destroy cannot finish.", config.filter, state.EnvID)
+	}
+
+	return nil
+}
+
+func (c CleanupLeftovers) Execute(subcommandFlags []string, state storage.State) error {
+	config, err := parseCleanupLeftoversFlags(subcommandFlags)
+	if err != nil {
+		return err
+	}
+
+	var leftovers []cleanableLeftover
+	for _, cleaner := range c.cleaners {
+		found, err := cleaner.ListLeftovers(config.filter)
+		if err != nil {
+			return err
+		}
+
+		for _, leftover := range found {
+			leftovers = append(leftovers, cleanableLeftover{Leftover: leftover, cleaner: cleaner})
+		}
+	}
+
+	if len(leftovers) == 0 {
+		c.logger.Printf("No leftovers found matching %q.\n", config.filter)
+		return nil
+	}
+
+	c.printLeftovers(config.filter, leftovers)
+
+	if config.dryRun {
+		return nil
+	}
+
+	if !config.noConfirm {
+		c.logger.Prompt(fmt.Sprintf("Are you sure you want to delete these %d resources? This operation cannot be undone!", len(leftovers)))
+
+		var proceed string
+		fmt.Fscanln(c.stdin, &proceed)
+
+		proceed = strings.ToLower(proceed)
+		if proceed != "yes" && proceed != "y" {
+			c.logger.Step("exiting")
+			return nil
+		}
+	}
+
+	// A resource can fail to delete because something deleted later in the
+	// same pass still depended on it, so failures get a second try.
+	failed, _ := c.deleteLeftovers(leftovers)
+	if len(failed) == 0 {
+		return nil
+	}
+
+	failed, errs := c.deleteLeftovers(failed)
+	if len(failed) == 0 {
+		return nil
+	}
+
+	var messages []string
+	for i, leftover := range failed {
+		messages = append(messages, fmt.Sprintf("  %s %s: %s", leftover.Type, describeLeftover(leftover.Leftover), errs[i]))
+	}
+
+	return fmt.Errorf("Failed to delete %d leftovers:\n%s", len(failed), strings.Join(messages, "\n"))
+}
+
+func (CleanupLeftovers) Flags() []flags.Flag {
+	return newCleanupLeftoversFlags(&cleanupLeftoversConfig{}).Registered()
+}
+
+func (c CleanupLeftovers) printLeftovers(filter string, leftovers []cleanableLeftover) {
+	var types []string
+	byType := map[string][]helpers.Leftover{}
+	for _, leftover := range leftovers {
+		if _, ok := byType[leftover.Type]; !ok {
+			types = append(types, leftover.Type)
+		}
+		byType[leftover.Type] = append(byType[leftover.Type], leftover.Leftover)
+	}
+
+	c.logger.Printf("Found %d leftovers matching %q:\n", len(leftovers), filter)
+	for _, leftoverType := range types {
+		c.logger.Printf("%s:\n", leftoverType)
+		for _, leftover := range byType[leftoverType] {
+			c.logger.Printf("  %s\n", describeLeftover(leftover))
+		}
+	}
+}
+
+func (c CleanupLeftovers) deleteLeftovers(leftovers []cleanableLeftover) ([]cleanableLeftover, []error) {
+	var (
+		failed []cleanableLeftover
+		errs   []error
+	)
+
+	for _, leftover := range leftovers {
+		c.logger.Step("deleting %s %s", leftover.Type, describeLeftover(leftover.Leftover))
+
+		err := leftover.cleaner.DeleteLeftover(leftover.Leftover)
+		if err != nil {
+			failed = append(failed, leftover)
+			errs = append(errs, err)
+		}
+	}
+
+	return failed, errs
+}
+
+func describeLeftover(leftover helpers.Leftover) string {
+	if leftover.Name == "" || leftover.Name == leftover.ID {
+		return leftover.ID
+	}
+	return fmt.Sprintf("%s (%s)", leftover.Name, leftover.ID)
+}
+
+func parseCleanupLeftoversFlags(subcommandFlags []string) (cleanupLeftoversConfig, error) {
+	var config cleanupLeftoversConfig
+	cleanupFlags := newCleanupLeftoversFlags(&config)
+
+	err := cleanupFlags.Parse(subcommandFlags)
+	if err != nil {
+		return cleanupLeftoversConfig{}, err
+	}
+
+	if config.filter == "" {
+		return cleanupLeftoversConfig{}, errors.New("--filter is required")
+	}
+
+	if len(config.filter) < minLeftoverFilterLength {
+		return cleanupLeftoversConfig{}, fmt.Errorf("--filter must be at least %d characters long", minLeftoverFilterLength)
+	}
+
+	return config, nil
+}
+
+func newCleanupLeftoversFlags(config *cleanupLeftoversConfig) flags.Flags {
+	cleanupFlags := flags.New("cleanup-leftovers")
+	cleanupFlags.String(&config.filter, "filter", "")
+	cleanupFlags.Bool(&config.dryRun, "", "dry-run", false)
+	cleanupFlags.Bool(&config.noConfirm, "n", "no-confirm", false)
+	cleanupFlags.Bool(&config.includeCurrentEnv, "", "include-current-env", false)
+	return cleanupFlags
+}
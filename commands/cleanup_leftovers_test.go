@@ -0,0 +1,213 @@
+package commands_test
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/cloudfoundry/bosh-bootloader/commands"
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/helpers"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CleanupLeftovers", func() {
+	var (
+		cleanup commands.CleanupLeftovers
+
+		logger         *fakes.Logger
+		stdin          *bytes.Buffer
+		networkCleaner *fakes.LeftoverCleaner
+		certCleaner    *fakes.LeftoverCleaner
+	)
+
+	BeforeEach(func() {
+		logger = &fakes.Logger{}
+		stdin = bytes.NewBuffer([]byte{})
+
+		networkCleaner = &fakes.LeftoverCleaner{}
+		networkCleaner.ListLeftoversCall.Returns.Leftovers = []helpers.Leftover{
+			{Type: "instance", ID: "i-1", Name: "bbl-env-jumpbox"},
+			{Type: "subnet", ID: "subnet-1", Name: "bbl-env-bosh-subnet"},
+			{Type: "vpc", ID: "vpc-1", Name: "bbl-env-vpc"},
+		}
+		certCleaner = &fakes.LeftoverCleaner{}
+		certCleaner.ListLeftoversCall.Returns.Leftovers = []helpers.Leftover{
+			{Type: "server certificate", ID: "bbl-env-cert", Name: "bbl-env-cert"},
+		}
+
+		cleanup = commands.NewCleanupLeftovers(logger, stdin, []commands.LeftoverCleaner{networkCleaner, certCleaner})
+	})
+
+	Describe("CheckFastFails", func() {
+		It("returns an error when no filter is given", func() {
+			err := cleanup.CheckFastFails([]string{}, storage.State{})
+			Expect(err).To(MatchError("--filter is required"))
+		})
+
+		It("returns an error for unknown flags", func() {
+			err := cleanup.CheckFastFails([]string{"--filter", "bbl-env", "--force"}, storage.State{})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("returns an error when the filter is too short", func() {
+			err := cleanup.CheckFastFails([]string{"--filter", "bb"}, storage.State{})
+			Expect(err).To(MatchError("--filter must be at least 3 characters long"))
+		})
+
+		Context("when the filter matches the env ID in the state dir", func() {
+			var state storage.State
+
+			BeforeEach(func() {
+				state = storage.State{EnvID: "bbl-env-lake"}
+			})
+
+			It("returns an error", func() {
+				err := cleanup.CheckFastFails([]string{"--filter", "bbl-env"}, state)
+				Expect(err).To(MatchError(`--filter "bbl-env" matches bbl-env-lake, the environment in the state directory. Run "bbl destroy" to delete it, or pass --include-current-env if destroy cannot finish.`))
+			})
+
+			It("returns no error with --include-current-env", func() {
+				err := cleanup.CheckFastFails([]string{"--filter", "bbl-env", "--include-current-env"}, state)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns no error when the filter does not match it", func() {
+				err := cleanup.CheckFastFails([]string{"--filter", "bbl-env-river"}, state)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		It("returns an error when the iaas has no cleaners", func() {
+			cleanup = commands.NewCleanupLeftovers(logger, stdin, nil)
+
+			err := cleanup.CheckFastFails([]string{"--filter", "bbl-env"}, storage.State{IAAS: "vsphere"})
+			Expect(err).To(MatchError("cleanup-leftovers is not supported on vsphere."))
+		})
+	})
+
+	Describe("Execute", func() {
+		It("lists the leftovers grouped by type", func() {
+			err := cleanup.Execute([]string{"--filter", "bbl-env", "--dry-run"}, storage.State{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(networkCleaner.ListLeftoversCall.Receives.Filter).To(Equal("bbl-env"))
+			Expect(certCleaner.ListLeftoversCall.Receives.Filter).To(Equal("bbl-env"))
+			Expect(logger.PrintfCall.Messages).To(Equal([]string{
+				"Found 4 leftovers matching \"bbl-env\":\n",
+				"instance:\n",
+				"  bbl-env-jumpbox (i-1)\n",
+				"subnet:\n",
+				"  bbl-env-bosh-subnet (subnet-1)\n",
+				"vpc:\n",
+				"  bbl-env-vpc (vpc-1)\n",
+				"server certificate:\n",
+				"  bbl-env-cert\n",
+			}))
+		})
+
+		Context("with --dry-run", func() {
+			It("does not delete anything", func() {
+				err := cleanup.Execute([]string{"--filter", "bbl-env", "--dry-run"}, storage.State{})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptCall.CallCount).To(Equal(0))
+				Expect(networkCleaner.DeleteLeftoverCall.CallCount).To(Equal(0))
+				Expect(certCleaner.DeleteLeftoverCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("when the user confirms", func() {
+			It("deletes the leftovers in order", func() {
+				stdin.WriteString("yes\n")
+
+				err := cleanup.Execute([]string{"--filter", "bbl-env"}, storage.State{})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptCall.Receives.Message).To(Equal("Are you sure you want to delete these 4 resources? This operation cannot be undone!"))
+				Expect(networkCleaner.DeleteLeftoverCall.Receives.Leftovers).To(Equal(networkCleaner.ListLeftoversCall.Returns.Leftovers))
+				Expect(certCleaner.DeleteLeftoverCall.Receives.Leftovers).To(Equal(certCleaner.ListLeftoversCall.Returns.Leftovers))
+				Expect(logger.StepCall.Messages).To(ContainElement("deleting vpc bbl-env-vpc (vpc-1)"))
+			})
+		})
+
+		Context("when the user does not confirm", func() {
+			It("does not delete anything", func() {
+				stdin.WriteString("no\n")
+
+				err := cleanup.Execute([]string{"--filter", "bbl-env"}, storage.State{})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.StepCall.Messages).To(Equal([]string{"exiting"}))
+				Expect(networkCleaner.DeleteLeftoverCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("with --no-confirm", func() {
+			It("deletes without prompting", func() {
+				err := cleanup.Execute([]string{"--filter", "bbl-env", "-n"}, storage.State{})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptCall.CallCount).To(Equal(0))
+				Expect(networkCleaner.DeleteLeftoverCall.CallCount).To(Equal(3))
+			})
+		})
+
+		Context("when a deletion fails the first time", func() {
+			It("retries it after the others", func() {
+				failures := 0
+				networkCleaner.DeleteLeftoverCall.Stub = func(leftover helpers.Leftover) error {
+					if leftover.Type == "subnet" && failures == 0 {
+						failures++
+						return errors.New("DependencyViolation")
+					}
+					return nil
+				}
+
+				err := cleanup.Execute([]string{"--filter", "bbl-env", "-n"}, storage.State{})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(networkCleaner.DeleteLeftoverCall.Receives.Leftovers).To(HaveLen(4))
+				Expect(networkCleaner.DeleteLeftoverCall.Receives.Leftovers[3].ID).To(Equal("subnet-1"))
+			})
+		})
+
+		Context("when a deletion keeps failing", func() {
+			It("returns an error naming what is left", func() {
+				networkCleaner.DeleteLeftoverCall.Stub = func(leftover helpers.Leftover) error {
+					if leftover.Type == "vpc" {
+						return errors.New("DependencyViolation")
+					}
+					return nil
+				}
+
+				err := cleanup.Execute([]string{"--filter", "bbl-env", "-n"}, storage.State{})
+				Expect(err).To(MatchError("Failed to delete 1 leftovers:\n  vpc bbl-env-vpc (vpc-1): DependencyViolation"))
+			})
+		})
+
+		Context("when nothing matches", func() {
+			It("says so", func() {
+				networkCleaner.ListLeftoversCall.Returns.Leftovers = nil
+				certCleaner.ListLeftoversCall.Returns.Leftovers = nil
+
+				err := cleanup.Execute([]string{"--filter", "bbl-env"}, storage.State{})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PrintfCall.Messages).To(Equal([]string{"No leftovers found matching \"bbl-env\".\n"}))
+				Expect(logger.PromptCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("when listing fails", func() {
+			It("returns the error", func() {
+				certCleaner.ListLeftoversCall.Returns.Error = errors.New("List server certificates: denied")
+
+				err := cleanup.Execute([]string{"--filter", "bbl-env"}, storage.State{})
+				Expect(err).To(MatchError("List server certificates: denied"))
+			})
+		})
+	})
+})
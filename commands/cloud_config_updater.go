@@ -0,0 +1,107 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+)
+
+// CloudConfigUpdateDeclined is returned when the cloud config diff is not
+// confirmed, so that the command stops instead of carrying on with a cloud
+// config that does not match the IAAS.
+var CloudConfigUpdateDeclined error = errors.New("Cloud config update declined.")
+
+// cloudConfigUpdater applies the cloud config for every command that changes
+// it, printing the director's diff and asking for confirmation first unless
+// noConfirm is set.
+type cloudConfigUpdater struct {
+	logger             logger
+	stdin              io.Reader
+	cloudConfigManager cloudConfigManager
+}
+
+func newCloudConfigUpdater(logger logger, stdin io.Reader, cloudConfigManager cloudConfigManager) cloudConfigUpdater {
+	return cloudConfigUpdater{
+		logger:             logger,
+		stdin:              stdin,
+		cloudConfigManager: cloudConfigManager,
+	}
+}
+
+func (c cloudConfigUpdater) Update(state storage.State, noConfirm bool) error {
+	proceed, err := c.confirm(state, noConfirm)
+	if err != nil {
+		return err
+	}
+
+	if !proceed {
+		return CloudConfigUpdateDeclined
+	}
+
+	err = c.cloudConfigManager.Update(state)
+	if err != nil {
+		return fmt.Errorf("Update cloud config: %s", err)
+	}
+
+	return nil
+}
+
+// cloudConfigUpdateError explains a declined cloud config update to the
+// commands that ask for confirmation only after terraform has run.
+func cloudConfigUpdateError(err error) error {
+	if err == CloudConfigUpdateDeclined {
+		return fmt.Errorf("%s The IAAS changes were applied, but the cloud config was left as it is. Run the command again to update it.", err)
+	}
+	return err
+}
+
+// PrintDiff prints the difference between the cloud config on the director
+// and the one bbl would apply, without applying it.
+func (c cloudConfigUpdater) PrintDiff(state storage.State) error {
+	if state.BOSH.IsEmpty() {
+		return errors.New("Cannot diff cloud config: a bosh director has not been created yet")
+	}
+
+	diff, err := c.cloudConfigManager.Diff(state)
+	if err != nil {
+		return fmt.Errorf("Diff cloud config: %s", err)
+	}
+
+	if !diff.HasChanges() {
+		c.logger.Println("no changes to cloud config")
+		return nil
+	}
+
+	c.logger.Println(diff.String())
+	return nil
+}
+
+func (c cloudConfigUpdater) confirm(state storage.State, noConfirm bool) (bool, error) {
+	if noConfirm {
+		return true, nil
+	}
+
+	diff, err := c.cloudConfigManager.Diff(state)
+	if err != nil {
+		return false, fmt.Errorf("Diff cloud config: %s", err)
+	}
+
+	if !diff.HasChanges() {
+		return true, nil
+	}
+
+	c.logger.Println(diff.String())
+	c.logger.Prompt("Are you sure you want to apply these changes to the cloud config?")
+
+	var proceed string
+	_, err = fmt.Fscanln(c.stdin, &proceed)
+	if err == io.EOF {
+		return false, errors.New("Cannot confirm cloud config changes: no answer could be read from stdin. Pass --no-confirm to apply them without confirmation.")
+	}
+
+	proceed = strings.ToLower(proceed)
+	return proceed == "yes" || proceed == "y", nil
+}
@@ -17,24 +17,41 @@ const (
 	certKeyRequirements = `
 
   --cert/--key requirements:
-  ------------------------------
-  |     | cf       | concourse |
-  ------------------------------
-  | aws | required | required  |
-  ------------------------------
-  | gcp | required | n/a       |
-  ------------------------------`
+  --------------------------------
+  |       | cf       | concourse |
+  --------------------------------
+  | aws   | required*| required* |
+  --------------------------------
+  | gcp   | required | n/a       |
+  --------------------------------
+  | azure | n/a      | n/a       |
+  --------------------------------
+  * not required when --acm-certificate-arn is given`
 
 	UpCommandUsage = `Deploys BOSH director on an IAAS
 
-  --iaas                     IAAS to deploy your BOSH director onto. Valid options: "aws", "azure", "gcp" (Defaults to environment variable BBL_IAAS)
+  --iaas                     IAAS to deploy your BOSH director onto. Valid options: "aws", "azure", "gcp", "openstack", "vsphere" (Defaults to environment variable BBL_IAAS)
   [--name]                   Name to assign to your BOSH director (optional, will be randomly generated)
   [--ops-file]               Path to BOSH ops file (optional)
   [--no-director]            Skips creating BOSH environment
+  [--existing-network-id]    ID of an existing VPC (aws), network name (gcp) or virtual network resource ID (azure) to deploy into instead of creating one (optional)
+  [--network-cidr]           IPv4 range of at least a /19 that bbl creates its subnets in; must be free when using --existing-network-id (optional, defaults to 10.0.0.0/16; aws, gcp and azure only)
+  [--allowed-ingress-cidr]   CIDR allowed to reach the jumpbox and director on ports 22, 6868 and 25555; repeat for several ranges (optional, defaults to 0.0.0.0/0)
+  [--clear-allowed-ingress-cidrs] Removes the saved --allowed-ingress-cidr ranges, allowing 0.0.0.0/0 again (optional)
+  [--azs]                    Comma separated availability zones to create subnets in; zones can only be appended once the environment exists (optional, aws and gcp only)
+  [--private-director]       Gives no VM except the jumpbox a public IP and reaches the director only through the jumpbox (optional, aws and gcp only)
+  [--no-confirm]             Applies cloud config changes without asking for confirmation (optional)
+  [--cloud-config-diff-only] Prints changes to the cloud config without applying them or updating the environment (optional)
+
+  [--runtime-config-ops-file] Path to ops file applied to the generated runtime config (optional)
+  [--syslog-address]         Forwards VM logs to the given syslog address via the runtime config (optional)
+  [--syslog-port]            Port of the syslog address (optional, defaults to 514)
+  [--syslog-transport]       Transport used to forward logs to syslog (optional, defaults to udp)
 
   --aws-access-key-id        AWS Access Key ID to use (Defaults to environment variable BBL_AWS_ACCESS_KEY_ID)
   --aws-secret-access-key    AWS Secret Access Key to use (Defaults to environment variable BBL_AWS_SECRET_ACCESS_KEY)
   --aws-region               AWS Region to use (Defaults to environment variable BBL_AWS_REGION)
+  [--aws-nat-gateway]        Uses a managed NAT gateway per availability zone instead of a NAT instance; =false switches back (Defaults to environment variable BBL_AWS_NAT_GATEWAY)
   [--aws-bosh-az]            AWS Availability Zone to use for BOSH director (Defaults to environment variable BBL_AWS_BOSH_AZ)
 
   --gcp-service-account-key  GCP Service Access Key to use (Defaults to environment variable BBL_GCP_SERVICE_ACCOUNT_KEY)
@@ -44,7 +61,26 @@ const (
   --azure-tenant-id          Azure Tenant ID to use (Defaults to environment variable BBL_AZURE_TENANT_ID)
   --azure-client-id          Azure Client ID to use (Defaults to environment variable BBL_AZURE_CLIENT_ID)
   --azure-client-secret      Azure Client Secret to use (Defaults to environment variable BBL_AZURE_CLIENT_SECRET)
-  --azure-location           Azure Location to use (Defaults to environment variable BBL_AZURE_LOCATION)`
+  --azure-location           Azure Location to use (Defaults to environment variable BBL_AZURE_LOCATION)
+
+  --openstack-auth-url       OpenStack Keystone auth URL to use (Defaults to environment variable BBL_OPENSTACK_AUTH_URL)
+  --openstack-az             OpenStack Availability Zone to use (Defaults to environment variable BBL_OPENSTACK_AZ)
+  --openstack-network-name   OpenStack external network name to allocate floating IPs from (Defaults to environment variable BBL_OPENSTACK_NETWORK_NAME)
+  --openstack-project        OpenStack Project to use (Defaults to environment variable BBL_OPENSTACK_PROJECT)
+  --openstack-domain         OpenStack Domain to use (Defaults to environment variable BBL_OPENSTACK_DOMAIN)
+  --openstack-region         OpenStack Region to use (Defaults to environment variable BBL_OPENSTACK_REGION)
+  --openstack-username       OpenStack Username to use (Defaults to environment variable BBL_OPENSTACK_USERNAME)
+  --openstack-password       OpenStack Password to use (Defaults to environment variable BBL_OPENSTACK_PASSWORD)
+
+  --vsphere-vcenter-ip       vSphere vCenter IP to use (Defaults to environment variable BBL_VSPHERE_VCENTER_IP)
+  --vsphere-vcenter-user     vSphere vCenter User to use (Defaults to environment variable BBL_VSPHERE_VCENTER_USER)
+  --vsphere-vcenter-password vSphere vCenter Password to use (Defaults to environment variable BBL_VSPHERE_VCENTER_PASSWORD)
+  --vsphere-vcenter-dc       vSphere vCenter Datacenter to use (Defaults to environment variable BBL_VSPHERE_VCENTER_DC)
+  --vsphere-vcenter-cluster  vSphere vCenter Cluster to use (Defaults to environment variable BBL_VSPHERE_VCENTER_CLUSTER)
+  --vsphere-vcenter-ds       vSphere vCenter Datastore to use (Defaults to environment variable BBL_VSPHERE_VCENTER_DS)
+  --vsphere-network          Name of the existing vSphere network to deploy onto (Defaults to environment variable BBL_VSPHERE_NETWORK)
+  --vsphere-subnet           CIDR of the existing vSphere network (Defaults to environment variable BBL_VSPHERE_SUBNET)
+  --vsphere-gateway          Gateway of the existing vSphere network (Defaults to environment variable BBL_VSPHERE_GATEWAY)`
 
 	DestroyCommandUsage = `Tears down BOSH director infrastructure
 
@@ -57,11 +93,23 @@ const (
   [--cert]            Path to SSL certificate (conditionally required; refer to table below)
   [--key]             Path to SSL certificate key (conditionally required; refer to table below)
   [--chain]           Path to SSL certificate chain (optional; only supported on aws)
-  [--domain]          Creates a DNS zone and records for the given domain (supported when type="cf")` + requiresCredentials + certKeyRequirements
+  [--domain]          Creates a DNS zone and records for the given domain (supported when type="cf")
+  [--lb-flavor]       Uses "alb" or "nlb" load balancers with target groups instead of classic ELBs, or "classic" to go back to them (optional; only supported on aws)
+  [--acm-certificate-arn] ARN of an ACM certificate to use instead of --cert and --key (optional; only supported on aws)
+  [--no-confirm]      Applies cloud config changes without asking for confirmation (optional)
+  [--cloud-config-diff-only] Prints changes to the cloud config without applying them or updating the environment (optional)` + requiresCredentials + certKeyRequirements
 
 	DeleteLBsCommandUsage = `Deletes load balancer(s)
 
-  [--skip-if-missing]  Skips deleting load balancer(s) if it is not attached (optional)` + requiresCredentials
+  [--skip-if-missing]  Skips deleting load balancer(s) if it is not attached (optional)
+  [--no-confirm]       Applies cloud config changes without asking for confirmation (optional)` + requiresCredentials
+
+	CleanupLeftoversCommandUsage = `Deletes IAAS resources whose names start with an env ID, e.g. after a failed destroy
+
+  --filter                 Env ID or env ID prefix that the resources are named after, at least 3 characters
+  [--dry-run]              Lists the resources without deleting them (optional)
+  [--no-confirm]           Do not ask for confirmation (optional)
+  [--include-current-env]  Allows a filter that matches the env ID in the state directory (optional)` + requiresCredentials
 
 	LBsCommandUsage = "Prints attached load balancer(s)"
 
@@ -75,6 +123,11 @@ const (
 
 	DirectorSSHKeyCommandUsage = "Prints SSH private key for the director."
 
+	SSHCommandUsage = `Opens an SSH session on the jumpbox, or on the director through the jumpbox
+
+  [--director]  Connects to the director instead of the jumpbox (optional)
+  [--cmd]       Runs a single command instead of opening an interactive shell (optional)`
+
 	RotateCommandUsage = "Rotates SSH key for the jumpbox user." + requiresCredentials
 
 	JumpboxAddressCommandUsage = "Prints BOSH jumpbox address"
@@ -87,21 +140,55 @@ const (
 
 	DirectorCACertCommandUsage = "Prints BOSH director CA certificate"
 
-	PrintEnvCommandUsage = "Prints required BOSH environment variables"
+	PrintEnvCommandUsage = `Prints required BOSH environment variables
+
+  [--tunnel]  Points BOSH_ALL_PROXY at the proxy started by "bbl tunnel" instead of printing an ssh command (optional)
+  [--shell]   Output format: bash (default), zsh, fish, powershell, cmd, json or dotenv (optional)`
+
+	StatusCommandUsage = `Prints an overview of the environment and checks that the jumpbox and director are reachable
+
+  [--json]  Prints the overview as JSON (optional)`
+
+	TunnelCommandUsage = `Runs a SOCKS5 proxy to the director through the jumpbox, reconnecting when the ssh connection drops
+
+  [--port]    Local port to listen on (optional, defaults to a random free port)
+  [--daemon]  Runs the proxy in the background, writing its pid and log to the .bbl directory (optional)
+  [--stop]    Stops a proxy started with --daemon (optional)`
+
+	OutputsCommandUsage = `Prints the terraform outputs of the environment, or only the named one
+
+  [name]              Prints only this output (optional)
+  [--json]            Prints the outputs as JSON (optional)
+  [--yaml]            Prints the outputs as YAML (optional)
+  [--show-sensitive]  Prints outputs marked sensitive instead of redacting them (optional)`
+
+	CompletionCommandUsage = `Prints a shell completion script for bbl commands and flags
+
+  <shell>  One of bash, zsh or fish. Load it with: source <(bbl completion bash)`
 
 	LatestErrorCommandUsage = "Prints the output from the latest call to terraform"
 
+	DoctorCommandUsage = "Checks the bosh and terraform binaries, the state directory, the clock and the IAAS credentials"
+
 	BOSHDeploymentVarsCommandUsage = "Prints required variables for BOSH deployment"
 
 	JumpboxDeploymentVarsCommandUsage = "Prints required variables for jumpbox deployment"
 
 	CloudConfigUsage = "Prints suggested cloud configuration for BOSH environment"
+
+	RuntimeConfigUsage = "Prints runtime configuration applied to BOSH environment"
+
+	CertStatusCommandUsage = `Prints subject, issuer, SANs and days remaining for every certificate managed by bbl
+
+  [--warn-days]  Exits non-zero if any certificate expires within this many days (optional, defaults to 30)`
 )
 
 func (Up) Usage() string { return UpCommandUsage }
 
 func (Plan) Usage() string { return "" }
 
+func (c Converge) Usage() string { return c.up.Usage() }
+
 func (Destroy) Usage() string { return DestroyCommandUsage }
 
 func (CreateLBs) Usage() string { return CreateLBsCommandUsage }
@@ -116,10 +203,28 @@ func (Usage) Usage() string { return UsageCommandUsage }
 
 func (PrintEnv) Usage() string { return PrintEnvCommandUsage }
 
+func (SSH) Usage() string { return SSHCommandUsage }
+
+func (Tunnel) Usage() string { return TunnelCommandUsage }
+
+func (Status) Usage() string { return StatusCommandUsage }
+
+func (Outputs) Usage() string { return OutputsCommandUsage }
+
+func (Completion) Usage() string { return CompletionCommandUsage }
+
+func (CleanupLeftovers) Usage() string { return CleanupLeftoversCommandUsage }
+
 func (LatestError) Usage() string { return LatestErrorCommandUsage }
 
+func (Doctor) Usage() string { return DoctorCommandUsage }
+
 func (CloudConfig) Usage() string { return CloudConfigUsage }
 
+func (RuntimeConfig) Usage() string { return RuntimeConfigUsage }
+
+func (CertStatus) Usage() string { return CertStatusCommandUsage }
+
 func (BOSHDeploymentVars) Usage() string { return BOSHDeploymentVarsCommandUsage }
 
 func (JumpboxDeploymentVars) Usage() string { return JumpboxDeploymentVarsCommandUsage }
@@ -16,14 +16,28 @@ var _ = Describe("Commands Usage", func() {
 				usageText := upCmd.Usage()
 				Expect(usageText).To(Equal(`Deploys BOSH director on an IAAS
 
-  --iaas                     IAAS to deploy your BOSH director onto. Valid options: "aws", "azure", "gcp" (Defaults to environment variable BBL_IAAS)
+  --iaas                     IAAS to deploy your BOSH director onto. Valid options: "aws", "azure", "gcp", "openstack", "vsphere" (Defaults to environment variable BBL_IAAS)
   [--name]                   Name to assign to your BOSH director (optional, will be randomly generated)
   [--ops-file]               Path to BOSH ops file (optional)
   [--no-director]            Skips creating BOSH environment
+  [--existing-network-id]    ID of an existing VPC (aws), network name (gcp) or virtual network resource ID (azure) to deploy into instead of creating one (optional)
+  [--network-cidr]           IPv4 range of at least a /19 that bbl creates its subnets in; must be free when using --existing-network-id (optional, defaults to 10.0.0.0/16; aws, gcp and azure only)
+  [--allowed-ingress-cidr]   CIDR allowed to reach the jumpbox and director on ports 22, 6868 and 25555; repeat for several ranges (optional, defaults to 0.0.0.0/0)
+  [--clear-allowed-ingress-cidrs] Removes the saved --allowed-ingress-cidr ranges, allowing 0.0.0.0/0 again (optional)
+  [--azs]                    Comma separated availability zones to create subnets in; zones can only be appended once the environment exists (optional, aws and gcp only)
+  [--private-director]       Gives no VM except the jumpbox a public IP and reaches the director only through the jumpbox (optional, aws and gcp only)
+  [--no-confirm]             Applies cloud config changes without asking for confirmation (optional)
+  [--cloud-config-diff-only] Prints changes to the cloud config without applying them or updating the environment (optional)
+
+  [--runtime-config-ops-file] Path to ops file applied to the generated runtime config (optional)
+  [--syslog-address]         Forwards VM logs to the given syslog address via the runtime config (optional)
+  [--syslog-port]            Port of the syslog address (optional, defaults to 514)
+  [--syslog-transport]       Transport used to forward logs to syslog (optional, defaults to udp)
 
   --aws-access-key-id        AWS Access Key ID to use (Defaults to environment variable BBL_AWS_ACCESS_KEY_ID)
   --aws-secret-access-key    AWS Secret Access Key to use (Defaults to environment variable BBL_AWS_SECRET_ACCESS_KEY)
   --aws-region               AWS Region to use (Defaults to environment variable BBL_AWS_REGION)
+  [--aws-nat-gateway]        Uses a managed NAT gateway per availability zone instead of a NAT instance; =false switches back (Defaults to environment variable BBL_AWS_NAT_GATEWAY)
   [--aws-bosh-az]            AWS Availability Zone to use for BOSH director (Defaults to environment variable BBL_AWS_BOSH_AZ)
 
   --gcp-service-account-key  GCP Service Access Key to use (Defaults to environment variable BBL_GCP_SERVICE_ACCOUNT_KEY)
@@ -33,7 +47,26 @@ var _ = Describe("Commands Usage", func() {
   --azure-tenant-id          Azure Tenant ID to use (Defaults to environment variable BBL_AZURE_TENANT_ID)
   --azure-client-id          Azure Client ID to use (Defaults to environment variable BBL_AZURE_CLIENT_ID)
   --azure-client-secret      Azure Client Secret to use (Defaults to environment variable BBL_AZURE_CLIENT_SECRET)
-  --azure-location           Azure Location to use (Defaults to environment variable BBL_AZURE_LOCATION)`))
+  --azure-location           Azure Location to use (Defaults to environment variable BBL_AZURE_LOCATION)
+
+  --openstack-auth-url       OpenStack Keystone auth URL to use (Defaults to environment variable BBL_OPENSTACK_AUTH_URL)
+  --openstack-az             OpenStack Availability Zone to use (Defaults to environment variable BBL_OPENSTACK_AZ)
+  --openstack-network-name   OpenStack external network name to allocate floating IPs from (Defaults to environment variable BBL_OPENSTACK_NETWORK_NAME)
+  --openstack-project        OpenStack Project to use (Defaults to environment variable BBL_OPENSTACK_PROJECT)
+  --openstack-domain         OpenStack Domain to use (Defaults to environment variable BBL_OPENSTACK_DOMAIN)
+  --openstack-region         OpenStack Region to use (Defaults to environment variable BBL_OPENSTACK_REGION)
+  --openstack-username       OpenStack Username to use (Defaults to environment variable BBL_OPENSTACK_USERNAME)
+  --openstack-password       OpenStack Password to use (Defaults to environment variable BBL_OPENSTACK_PASSWORD)
+
+  --vsphere-vcenter-ip       vSphere vCenter IP to use (Defaults to environment variable BBL_VSPHERE_VCENTER_IP)
+  --vsphere-vcenter-user     vSphere vCenter User to use (Defaults to environment variable BBL_VSPHERE_VCENTER_USER)
+  --vsphere-vcenter-password vSphere vCenter Password to use (Defaults to environment variable BBL_VSPHERE_VCENTER_PASSWORD)
+  --vsphere-vcenter-dc       vSphere vCenter Datacenter to use (Defaults to environment variable BBL_VSPHERE_VCENTER_DC)
+  --vsphere-vcenter-cluster  vSphere vCenter Cluster to use (Defaults to environment variable BBL_VSPHERE_VCENTER_CLUSTER)
+  --vsphere-vcenter-ds       vSphere vCenter Datastore to use (Defaults to environment variable BBL_VSPHERE_VCENTER_DS)
+  --vsphere-network          Name of the existing vSphere network to deploy onto (Defaults to environment variable BBL_VSPHERE_NETWORK)
+  --vsphere-subnet           CIDR of the existing vSphere network (Defaults to environment variable BBL_VSPHERE_SUBNET)
+  --vsphere-gateway          Gateway of the existing vSphere network (Defaults to environment variable BBL_VSPHERE_GATEWAY)`))
 			})
 		})
 	})
@@ -50,6 +83,10 @@ var _ = Describe("Commands Usage", func() {
   [--key]             Path to SSL certificate key (conditionally required; refer to table below)
   [--chain]           Path to SSL certificate chain (optional; only supported on aws)
   [--domain]          Creates a DNS zone and records for the given domain (supported when type="cf")
+  [--lb-flavor]       Uses "alb" or "nlb" load balancers with target groups instead of classic ELBs, or "classic" to go back to them (optional; only supported on aws)
+  [--acm-certificate-arn] ARN of an ACM certificate to use instead of --cert and --key (optional; only supported on aws)
+  [--no-confirm]      Applies cloud config changes without asking for confirmation (optional)
+  [--cloud-config-diff-only] Prints changes to the cloud config without applying them or updating the environment (optional)
 
   Credentials for your IaaS are required:
   --aws-access-key-id        AWS Access Key ID to use (Defaults to environment variable BBL_AWS_ACCESS_KEY_ID)
@@ -63,13 +100,16 @@ var _ = Describe("Commands Usage", func() {
   --azure-client-secret      Azure Client Secret to use (Defaults to environment variable BBL_AZURE_CLIENT_SECRET)
 
   --cert/--key requirements:
-  ------------------------------
-  |     | cf       | concourse |
-  ------------------------------
-  | aws | required | required  |
-  ------------------------------
-  | gcp | required | n/a       |
-  ------------------------------`))
+  --------------------------------
+  |       | cf       | concourse |
+  --------------------------------
+  | aws   | required*| required* |
+  --------------------------------
+  | gcp   | required | n/a       |
+  --------------------------------
+  | azure | n/a      | n/a       |
+  --------------------------------
+  * not required when --acm-certificate-arn is given`))
 			})
 		})
 	})
@@ -82,6 +122,7 @@ var _ = Describe("Commands Usage", func() {
 				Expect(usageText).To(Equal(`Deletes load balancer(s)
 
   [--skip-if-missing]  Skips deleting load balancer(s) if it is not attached (optional)
+  [--no-confirm]       Applies cloud config changes without asking for confirmation (optional)
 
   Credentials for your IaaS are required:
   --aws-access-key-id        AWS Access Key ID to use (Defaults to environment variable BBL_AWS_ACCESS_KEY_ID)
@@ -165,15 +206,24 @@ var _ = Describe("Commands Usage", func() {
 		Entry("env-id", newStateQuery("environment id"), "Prints environment ID"),
 		Entry("ssh-key", commands.SSHKey{}, "Prints SSH private key for the jumpbox."),
 		Entry("director-ssh-key", commands.SSHKey{Director: true}, "Prints SSH private key for the director."),
-		Entry("print-env", commands.PrintEnv{}, "Prints required BOSH environment variables"),
+		Entry("print-env", commands.PrintEnv{}, commands.PrintEnvCommandUsage),
+		Entry("ssh", commands.SSH{}, commands.SSHCommandUsage),
+		Entry("tunnel", commands.Tunnel{}, commands.TunnelCommandUsage),
+		Entry("status", commands.Status{}, commands.StatusCommandUsage),
+		Entry("outputs", commands.Outputs{}, commands.OutputsCommandUsage),
+		Entry("doctor", commands.Doctor{}, commands.DoctorCommandUsage),
+		Entry("completion", commands.Completion{}, commands.CompletionCommandUsage),
+		Entry("cleanup-leftovers", commands.CleanupLeftovers{}, commands.CleanupLeftoversCommandUsage),
 		Entry("latest-error", commands.LatestError{}, "Prints the output from the latest call to terraform"),
 		Entry("bosh-deployment-vars", commands.BOSHDeploymentVars{}, "Prints required variables for BOSH deployment"),
 		Entry("jumpbox-deployment-vars", commands.JumpboxDeploymentVars{}, "Prints required variables for jumpbox deployment"),
 		Entry("version", commands.Version{}, "Prints version"),
 		Entry("cloud-config", commands.CloudConfig{}, "Prints suggested cloud configuration for BOSH environment"),
+		Entry("runtime-config", commands.RuntimeConfig{}, "Prints runtime configuration applied to BOSH environment"),
+		Entry("cert-status", commands.CertStatus{}, commands.CertStatusCommandUsage),
 	)
 })
 
 func newStateQuery(propertyName string) commands.StateQuery {
-	return commands.NewStateQuery(nil, nil, nil, propertyName)
+	return commands.NewStateQuery(nil, nil, nil, propertyName, commands.TextOutput)
 }
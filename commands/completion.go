@@ -0,0 +1,289 @@
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/cloudfoundry/bosh-bootloader/flags"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+)
+
+var completionShells = []string{"bash", "zsh", "fish"}
+
+// FlagLister is implemented by commands that take subcommand flags, so that
+// bbl completion can complete them.
+type FlagLister interface {
+	Flags() []flags.Flag
+}
+
+type Completion struct {
+	logger      logger
+	commands    map[string]Command
+	globalFlags []flags.Flag
+}
+
+type completionData struct {
+	Commands        []string
+	GlobalFlags     []string
+	FishGlobalFlags []string
+	ValueFlags      []string
+	CommandFlags    []commandCompletion
+}
+
+type commandCompletion struct {
+	Name      string
+	Flags     []string
+	FishFlags []string
+}
+
+func NewCompletion(logger logger, commands map[string]Command, globalFlags []flags.Flag) Completion {
+	return Completion{
+		logger:      logger,
+		commands:    commands,
+		globalFlags: globalFlags,
+	}
+}
+
+func (c Completion) CheckFastFails(subcommandFlags []string, state storage.State) error {
+	_, err := completionShell(subcommandFlags)
+	return err
+}
+
+func (c Completion) Execute(subcommandFlags []string, state storage.State) error {
+	shell, err := completionShell(subcommandFlags)
+	if err != nil {
+		return err
+	}
+
+	var script bytes.Buffer
+	err = completionTemplates.ExecuteTemplate(&script, shell, c.completionData())
+	if err != nil {
+		return err // not tested
+	}
+
+	c.logger.Printf("%s", script.String())
+	return nil
+}
+
+func completionShell(subcommandFlags []string) (string, error) {
+	switch {
+	case len(subcommandFlags) == 0:
+		return "", fmt.Errorf("Missing shell, must be one of: %s.", strings.Join(completionShells, ", "))
+	case len(subcommandFlags) > 1:
+		return "", fmt.Errorf("Unexpected arguments: %s.", strings.Join(subcommandFlags[1:], " "))
+	}
+
+	shell := subcommandFlags[0]
+	for _, s := range completionShells {
+		if shell == s {
+			return shell, nil
+		}
+	}
+
+	return "", fmt.Errorf("Unknown shell %q, must be one of: %s.", shell, strings.Join(completionShells, ", "))
+}
+
+func (c Completion) completionData() completionData {
+	var data completionData
+	valueFlags := map[string]bool{}
+
+	for _, flag := range c.globalFlags {
+		data.GlobalFlags = append(data.GlobalFlags, dashedFlag(flag))
+		data.FishGlobalFlags = append(data.FishGlobalFlags, fishFlag(flag))
+		if flag.TakesValue {
+			valueFlags[dashedFlag(flag)] = true
+		}
+	}
+
+	for name, command := range c.commands {
+		data.Commands = append(data.Commands, name)
+
+		flagLister, ok := command.(FlagLister)
+		if !ok {
+			continue
+		}
+
+		commandFlags := commandCompletion{Name: name}
+		for _, flag := range flagLister.Flags() {
+			commandFlags.Flags = append(commandFlags.Flags, dashedFlag(flag))
+			commandFlags.FishFlags = append(commandFlags.FishFlags, fishFlag(flag))
+			if flag.TakesValue {
+				valueFlags[dashedFlag(flag)] = true
+			}
+		}
+		data.CommandFlags = append(data.CommandFlags, commandFlags)
+	}
+
+	for flag := range valueFlags {
+		data.ValueFlags = append(data.ValueFlags, flag)
+	}
+
+	sort.Strings(data.Commands)
+	sort.Strings(data.ValueFlags)
+	sort.Slice(data.CommandFlags, func(i, j int) bool {
+		return data.CommandFlags[i].Name < data.CommandFlags[j].Name
+	})
+
+	return data
+}
+
+func dashedFlag(flag flags.Flag) string {
+	if len(flag.Name) == 1 {
+		return "-" + flag.Name
+	}
+	return "--" + flag.Name
+}
+
+// fishFlag returns the options of fish's complete builtin for flag. Flags that
+// take a value complete file names.
+func fishFlag(flag flags.Flag) string {
+	option := "-l " + flag.Name
+	if len(flag.Name) == 1 {
+		option = "-s " + flag.Name
+	}
+
+	if flag.TakesValue {
+		option += " -r -F"
+	}
+
+	return option
+}
+
+var completionTemplates = template.Must(template.New("completion").Funcs(template.FuncMap{
+	"join": strings.Join,
+}).Parse(`
+{{- define "bash" -}}
+# bash completion for bbl
+#
+# Load it in the current shell with:
+#   source <(bbl completion bash)
+
+_bbl() {
+  local cur="${COMP_WORDS[COMP_CWORD]}"
+  local prev="${COMP_WORDS[COMP_CWORD-1]}"
+  local command="" flags="{{join .GlobalFlags " "}}"
+  local i
+
+  for ((i = 1; i < COMP_CWORD; i++)); do
+    case "${COMP_WORDS[i]}" in
+      {{join .ValueFlags "|"}})
+        ((i++))
+        ;;
+      -*)
+        ;;
+      *)
+        command="${COMP_WORDS[i]}"
+        break
+        ;;
+    esac
+  done
+
+  case "$prev" in
+    {{join .ValueFlags "|"}})
+      return
+      ;;
+  esac
+
+  case "$command" in
+    "")
+      if [[ "$cur" != -* ]]; then
+        COMPREPLY=($(compgen -W "{{join .Commands " "}}" -- "$cur"))
+        return
+      fi
+      ;;
+{{- range .CommandFlags}}
+    {{.Name}})
+      flags="$flags {{join .Flags " "}}"
+      ;;
+{{- end}}
+  esac
+
+  if [[ "$cur" == -* ]]; then
+    COMPREPLY=($(compgen -W "$flags" -- "$cur"))
+  fi
+}
+
+complete -o default -F _bbl bbl
+{{end}}
+
+{{- define "zsh" -}}
+#compdef bbl
+# zsh completion for bbl
+#
+# Load it in the current shell with:
+#   source <(bbl completion zsh)
+# or save it as _bbl in a directory on $fpath.
+
+_bbl() {
+  local command="" i
+  local -a flags
+  flags=({{join .GlobalFlags " "}})
+
+  for ((i = 2; i < CURRENT; i++)); do
+    case "${words[i]}" in
+      ({{join .ValueFlags "|"}})
+        ((i++))
+        ;;
+      (-*)
+        ;;
+      (*)
+        command="${words[i]}"
+        break
+        ;;
+    esac
+  done
+
+  case "${words[CURRENT-1]}" in
+    ({{join .ValueFlags "|"}})
+      _files
+      return
+      ;;
+  esac
+
+  case "$command" in
+    ("")
+      if [[ "$PREFIX" != -* ]]; then
+        compadd -- {{join .Commands " "}}
+        return
+      fi
+      ;;
+{{- range .CommandFlags}}
+    ({{.Name}})
+      flags+=({{join .Flags " "}})
+      ;;
+{{- end}}
+  esac
+
+  if [[ "$PREFIX" == -* ]]; then
+    compadd -- "${flags[@]}"
+  else
+    _files
+  fi
+}
+
+if [[ "${funcstack[1]}" == "_bbl" ]]; then
+  _bbl "$@"
+else
+  compdef _bbl bbl
+fi
+{{end}}
+
+{{- define "fish" -}}
+# fish completion for bbl
+#
+# Load it in the current shell with:
+#   bbl completion fish | source
+# or save it as ~/.config/fish/completions/bbl.fish.
+
+complete -c bbl -f
+complete -c bbl -n __fish_use_subcommand -a '{{join .Commands " "}}'
+{{- range .FishGlobalFlags}}
+complete -c bbl {{.}}
+{{- end}}
+{{- range .CommandFlags}}{{$name := .Name}}{{range .FishFlags}}
+complete -c bbl -n '__fish_seen_subcommand_from {{$name}}' {{.}}
+{{- end}}{{end}}
+{{end}}`))
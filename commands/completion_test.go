@@ -0,0 +1,115 @@
+package commands_test
+
+import (
+	"strings"
+
+	"github.com/cloudfoundry/bosh-bootloader/commands"
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/flags"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Completion", func() {
+	var (
+		completion commands.Completion
+		logger     *fakes.Logger
+	)
+
+	BeforeEach(func() {
+		logger = &fakes.Logger{}
+
+		commandSet := map[string]commands.Command{
+			"destroy":    commands.Destroy{},
+			"create-lbs": commands.CreateLBs{},
+			"version":    commands.Version{},
+		}
+		globalFlags := []flags.Flag{
+			{Name: "s", TakesValue: true},
+			{Name: "state-dir", TakesValue: true},
+			{Name: "debug"},
+		}
+
+		completion = commands.NewCompletion(logger, commandSet, globalFlags)
+	})
+
+	script := func() string {
+		return strings.Join(logger.PrintfCall.Messages, "")
+	}
+
+	Describe("CheckFastFails", func() {
+		It("returns an error when the shell is missing", func() {
+			err := completion.CheckFastFails([]string{}, storage.State{})
+			Expect(err).To(MatchError("Missing shell, must be one of: bash, zsh, fish."))
+		})
+
+		It("returns an error for an unknown shell", func() {
+			err := completion.CheckFastFails([]string{"tcsh"}, storage.State{})
+			Expect(err).To(MatchError(`Unknown shell "tcsh", must be one of: bash, zsh, fish.`))
+		})
+
+		It("returns an error for extra arguments", func() {
+			err := completion.CheckFastFails([]string{"bash", "zsh"}, storage.State{})
+			Expect(err).To(MatchError("Unexpected arguments: zsh."))
+		})
+	})
+
+	Describe("Execute", func() {
+		It("prints a bash script completing commands and their flags", func() {
+			err := completion.Execute([]string{"bash"}, storage.State{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(script()).To(HavePrefix("# bash completion for bbl\n"))
+			Expect(script()).To(ContainSubstring(`local command="" flags="-s --state-dir --debug"`))
+			Expect(script()).To(ContainSubstring(`compgen -W "create-lbs destroy version" -- "$cur"`))
+			Expect(script()).To(ContainSubstring(`
+    create-lbs)
+      flags="$flags --acm-certificate-arn --cert --chain --cloud-config-diff-only --domain --key --lb-flavor -n --no-confirm --type"
+      ;;
+    destroy)
+      flags="$flags -n --no-confirm --skip-if-missing"
+      ;;
+`))
+			Expect(script()).To(ContainSubstring(`
+  case "$prev" in
+    --acm-certificate-arn|--cert|--chain|--domain|--key|--lb-flavor|--state-dir|--type|-s)
+      return
+`))
+			Expect(script()).To(HaveSuffix("complete -o default -F _bbl bbl\n"))
+		})
+
+		It("prints a zsh script", func() {
+			err := completion.Execute([]string{"zsh"}, storage.State{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(script()).To(HavePrefix("#compdef bbl\n"))
+			Expect(script()).To(ContainSubstring("flags=(-s --state-dir --debug)"))
+			Expect(script()).To(ContainSubstring("compadd -- create-lbs destroy version"))
+			Expect(script()).To(ContainSubstring(`
+    (destroy)
+      flags+=(-n --no-confirm --skip-if-missing)
+`))
+			Expect(script()).To(ContainSubstring("compdef _bbl bbl"))
+		})
+
+		It("prints a fish script", func() {
+			err := completion.Execute([]string{"fish"}, storage.State{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(script()).To(HavePrefix("# fish completion for bbl\n"))
+			Expect(script()).To(ContainSubstring(`
+complete -c bbl -f
+complete -c bbl -n __fish_use_subcommand -a 'create-lbs destroy version'
+complete -c bbl -s s -r -F
+complete -c bbl -l state-dir -r -F
+complete -c bbl -l debug
+`))
+			Expect(script()).To(ContainSubstring(`
+complete -c bbl -n '__fish_seen_subcommand_from destroy' -s n
+complete -c bbl -n '__fish_seen_subcommand_from destroy' -l no-confirm
+complete -c bbl -n '__fish_seen_subcommand_from destroy' -l skip-if-missing
+`))
+		})
+	})
+})
@@ -0,0 +1,88 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/cloudfoundry/bosh-bootloader/flags"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+)
+
+type stateBootstrap interface {
+	GetState(dir string) (storage.State, error)
+}
+
+// Converge runs up and then creates or updates the load balancers declared
+// in bbl.yml, so that a single "bbl up" recreates the whole environment.
+type Converge struct {
+	up             Command
+	createLBs      Command
+	lbFlags        []string
+	stateBootstrap stateBootstrap
+	stateDir       string
+}
+
+func NewConverge(up Command, createLBs Command, lbFlags []string, stateBootstrap stateBootstrap, stateDir string) Converge {
+	return Converge{
+		up:             up,
+		createLBs:      createLBs,
+		lbFlags:        lbFlags,
+		stateBootstrap: stateBootstrap,
+		stateDir:       stateDir,
+	}
+}
+
+func (c Converge) CheckFastFails(subcommandFlags []string, state storage.State) error {
+	return c.up.CheckFastFails(subcommandFlags, state)
+}
+
+func (c Converge) Execute(subcommandFlags []string, state storage.State) error {
+	err := c.up.Execute(subcommandFlags, state)
+	if err != nil {
+		return err
+	}
+
+	// up saves the state as it goes, so the load balancers need the new one.
+	state, err = c.stateBootstrap.GetState(c.stateDir)
+	if err != nil {
+		return fmt.Errorf("Reload state after up: %s", err)
+	}
+
+	lbFlags, err := c.createLBsFlags(subcommandFlags, state)
+	if err != nil {
+		return err // not tested
+	}
+
+	err = c.createLBs.CheckFastFails(lbFlags, state)
+	if err != nil {
+		return fmt.Errorf("Create load balancers from bbl.yml: %s", err)
+	}
+
+	err = c.createLBs.Execute(lbFlags, state)
+	if err != nil {
+		return fmt.Errorf("Create load balancers from bbl.yml: %s", err)
+	}
+
+	return nil
+}
+
+// createLBsFlags passes --no-confirm on to create-lbs so that the cloud
+// config changes for the load balancers are not confirmed separately.
+func (c Converge) createLBsFlags(subcommandFlags []string, state storage.State) ([]string, error) {
+	var (
+		config UpConfig
+		azs    string
+	)
+	if err := newUpFlags(&config, &azs, "", state).Parse(subcommandFlags); err != nil {
+		return nil, err
+	}
+
+	if !config.NoConfirm {
+		return c.lbFlags, nil
+	}
+
+	return append(append([]string{}, c.lbFlags...), "--no-confirm"), nil
+}
+
+func (Converge) Flags() []flags.Flag {
+	return upFlagNames()
+}
@@ -0,0 +1,109 @@
+package commands_test
+
+import (
+	"errors"
+
+	"github.com/cloudfoundry/bosh-bootloader/commands"
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Converge", func() {
+	var (
+		converge commands.Converge
+
+		up             *fakes.Command
+		createLBs      *fakes.Command
+		stateBootstrap *fakes.StateBootstrap
+
+		lbFlags []string
+	)
+
+	BeforeEach(func() {
+		up = &fakes.Command{}
+		createLBs = &fakes.Command{}
+		stateBootstrap = &fakes.StateBootstrap{}
+		stateBootstrap.GetStateCall.Returns.State = storage.State{EnvID: "some-env-id", IAAS: "aws"}
+
+		lbFlags = []string{"--type", "cf", "--cert", "/some/cert", "--key", "/some/key"}
+
+		converge = commands.NewConverge(up, createLBs, lbFlags, stateBootstrap, "/some/state-dir")
+	})
+
+	Describe("CheckFastFails", func() {
+		It("checks up", func() {
+			up.CheckFastFailsCall.Returns.Error = errors.New("up failed")
+
+			err := converge.CheckFastFails([]string{"--name", "some-name"}, storage.State{})
+			Expect(err).To(MatchError("up failed"))
+
+			Expect(up.CheckFastFailsCall.Receives.SubcommandFlags).To(Equal([]string{"--name", "some-name"}))
+			Expect(createLBs.CheckFastFailsCall.CallCount).To(Equal(0))
+		})
+	})
+
+	Describe("Execute", func() {
+		It("runs up and then create-lbs with the state up saved", func() {
+			err := converge.Execute([]string{"--name", "some-name"}, storage.State{IAAS: "aws"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(up.ExecuteCall.Receives.SubcommandFlags).To(Equal([]string{"--name", "some-name"}))
+			Expect(up.ExecuteCall.Receives.State).To(Equal(storage.State{IAAS: "aws"}))
+
+			Expect(stateBootstrap.GetStateCall.Receives.Dir).To(Equal("/some/state-dir"))
+
+			Expect(createLBs.CheckFastFailsCall.Receives.SubcommandFlags).To(Equal(lbFlags))
+			Expect(createLBs.ExecuteCall.Receives.SubcommandFlags).To(Equal(lbFlags))
+			Expect(createLBs.ExecuteCall.Receives.State).To(Equal(storage.State{EnvID: "some-env-id", IAAS: "aws"}))
+		})
+
+		It("passes --no-confirm on to create-lbs", func() {
+			err := converge.Execute([]string{"--no-confirm"}, storage.State{IAAS: "aws"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(createLBs.ExecuteCall.Receives.SubcommandFlags).To(Equal(append(lbFlags, "--no-confirm")))
+		})
+
+		Context("when up fails", func() {
+			It("does not create load balancers", func() {
+				up.ExecuteCall.Returns.Error = errors.New("up failed")
+
+				err := converge.Execute([]string{}, storage.State{})
+				Expect(err).To(MatchError("up failed"))
+
+				Expect(createLBs.ExecuteCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("when the state cannot be reloaded", func() {
+			It("returns an error", func() {
+				stateBootstrap.GetStateCall.Returns.Error = errors.New("bad state")
+
+				err := converge.Execute([]string{}, storage.State{})
+				Expect(err).To(MatchError("Reload state after up: bad state"))
+			})
+		})
+
+		Context("when the load balancer flags are invalid", func() {
+			It("returns an error", func() {
+				createLBs.CheckFastFailsCall.Returns.Error = errors.New("--type is required")
+
+				err := converge.Execute([]string{}, storage.State{})
+				Expect(err).To(MatchError("Create load balancers from bbl.yml: --type is required"))
+
+				Expect(createLBs.ExecuteCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("when creating the load balancers fails", func() {
+			It("returns an error", func() {
+				createLBs.ExecuteCall.Returns.Error = errors.New("terraform failed")
+
+				err := converge.Execute([]string{}, storage.State{})
+				Expect(err).To(MatchError("Create load balancers from bbl.yml: terraform failed"))
+			})
+		})
+	})
+})
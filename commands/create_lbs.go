@@ -21,8 +21,11 @@ type CreateLBsCmd interface {
 }
 
 type CreateLBsConfig struct {
-	AWS AWSCreateLBsConfig
-	GCP GCPCreateLBsConfig
+	AWS                 AWSCreateLBsConfig
+	GCP                 GCPCreateLBsConfig
+	Azure               AzureCreateLBsConfig
+	NoConfirm           bool
+	CloudConfigDiffOnly bool
 }
 
 var LBNotFound error = errors.New("no load balancer has been found for this bbl environment")
@@ -38,6 +41,14 @@ func NewCreateLBs(createLBsCmd CreateLBsCmd, logger logger, stateValidator state
 }
 
 func (c CreateLBs) CheckFastFails(subcommandFlags []string, state storage.State) error {
+	if state.IAAS == "openstack" {
+		return errors.New("Load balancers are not supported on OpenStack.")
+	}
+
+	if state.IAAS == "vsphere" {
+		return errors.New("Load balancers are not supported on vSphere.")
+	}
+
 	config, err := parseFlags(subcommandFlags, state.IAAS, state.LB.Type)
 	if err != nil {
 		return err
@@ -51,7 +62,17 @@ func (c CreateLBs) CheckFastFails(subcommandFlags []string, state storage.State)
 		return errors.New("--type is required")
 	}
 
-	if !(state.IAAS == "gcp" && getLBType(config) == "concourse") {
+	switch {
+	case config.AWS.Flavor == "", config.AWS.Flavor == "alb", config.AWS.Flavor == "nlb", isClassicLBFlavor(config.AWS.Flavor):
+	default:
+		return errors.New(`--lb-flavor must be "alb", "nlb" or "classic"`)
+	}
+
+	if config.AWS.ACMCertificateARN != "" && (config.AWS.CertPath != "" || config.AWS.KeyPath != "") {
+		return errors.New("--acm-certificate-arn cannot be used with --cert and --key")
+	}
+
+	if requiresCertificate(state.IAAS, getLBType(config)) && config.AWS.ACMCertificateARN == "" {
 		err = c.certificateValidator.Validate("create-lbs", getCertPath(config), getKeyPath(config), getChainPath(config))
 		if err != nil {
 			return fmt.Errorf("Validate certificate: %s", err)
@@ -86,10 +107,26 @@ func (c CreateLBs) Execute(args []string, state storage.State) error {
 	return nil
 }
 
+// Flags lists the AWS flags, which are a superset of the flags on the other
+// IAASes, because completion scripts are not tied to an environment.
+func (CreateLBs) Flags() []flags.Flag {
+	return newCreateLBsFlags(&CreateLBsConfig{}, "aws", "").Registered()
+}
+
 func parseFlags(subcommandFlags []string, iaas string, existingLBType string) (CreateLBsConfig, error) {
+	config := CreateLBsConfig{}
+	lbFlags := newCreateLBsFlags(&config, iaas, existingLBType)
+
+	if err := lbFlags.Parse(subcommandFlags); err != nil {
+		return config, err
+	}
+
+	return config, nil
+}
+
+func newCreateLBsFlags(config *CreateLBsConfig, iaas string, existingLBType string) flags.Flags {
 	lbFlags := flags.New("create-lbs")
 
-	config := CreateLBsConfig{}
 	switch iaas {
 	case "aws":
 		lbFlags.String(&config.AWS.LBType, "type", existingLBType)
@@ -97,18 +134,22 @@ func parseFlags(subcommandFlags []string, iaas string, existingLBType string) (C
 		lbFlags.String(&config.AWS.KeyPath, "key", "")
 		lbFlags.String(&config.AWS.ChainPath, "chain", "")
 		lbFlags.String(&config.AWS.Domain, "domain", "")
+		lbFlags.String(&config.AWS.Flavor, "lb-flavor", "")
+		lbFlags.String(&config.AWS.ACMCertificateARN, "acm-certificate-arn", "")
 	case "gcp":
 		lbFlags.String(&config.GCP.LBType, "type", existingLBType)
 		lbFlags.String(&config.GCP.CertPath, "cert", "")
 		lbFlags.String(&config.GCP.KeyPath, "key", "")
 		lbFlags.String(&config.GCP.Domain, "domain", "")
+	case "azure":
+		lbFlags.String(&config.Azure.LBType, "type", existingLBType)
+		lbFlags.String(&config.Azure.Domain, "domain", "")
 	}
 
-	if err := lbFlags.Parse(subcommandFlags); err != nil {
-		return config, err
-	}
+	lbFlags.Bool(&config.NoConfirm, "n", "no-confirm", false)
+	lbFlags.Bool(&config.CloudConfigDiffOnly, "", "cloud-config-diff-only", false)
 
-	return config, nil
+	return lbFlags
 }
 
 func getLBType(config CreateLBsConfig) string {
@@ -118,9 +159,25 @@ func getLBType(config CreateLBsConfig) string {
 	if config.GCP.LBType != "" {
 		return config.GCP.LBType
 	}
+	if config.Azure.LBType != "" {
+		return config.Azure.LBType
+	}
 	return ""
 }
 
+// requiresCertificate reports whether the load balancer terminates TLS and
+// so needs a certificate and key. Azure load balancers forward TCP to the
+// instances, which hold the certificates themselves.
+func requiresCertificate(iaas, lbType string) bool {
+	switch {
+	case iaas == "azure":
+		return false
+	case iaas == "gcp" && lbType == "concourse":
+		return false
+	}
+	return true
+}
+
 func getCertPath(config CreateLBsConfig) string {
 	if config.AWS.CertPath != "" {
 		return config.AWS.CertPath
@@ -155,5 +212,8 @@ func getDomain(config CreateLBsConfig) string {
 	if config.GCP.Domain != "" {
 		return config.GCP.Domain
 	}
+	if config.Azure.Domain != "" {
+		return config.Azure.Domain
+	}
 	return ""
 }
@@ -46,6 +46,20 @@ var _ = Describe("create-lbs", func() {
 			})
 		})
 
+		Context("when the iaas is openstack", func() {
+			It("returns an error", func() {
+				err := command.CheckFastFails([]string{}, storage.State{IAAS: "openstack"})
+				Expect(err).To(MatchError("Load balancers are not supported on OpenStack."))
+			})
+		})
+
+		Context("when the iaas is vsphere", func() {
+			It("returns an error", func() {
+				err := command.CheckFastFails([]string{}, storage.State{IAAS: "vsphere"})
+				Expect(err).To(MatchError("Load balancers are not supported on vSphere."))
+			})
+		})
+
 		Context("if there is no lb type", func() {
 			It("returns an error", func() {
 				err := command.CheckFastFails([]string{}, storage.State{})
@@ -111,6 +125,61 @@ var _ = Describe("create-lbs", func() {
 			})
 		})
 
+		Context("when iaas is aws and an acm certificate arn is provided", func() {
+			It("does not call certificateValidator", func() {
+				err := command.CheckFastFails([]string{
+					"--type", "cf",
+					"--acm-certificate-arn", "some-certificate-arn",
+				}, storage.State{
+					IAAS:       "aws",
+					NoDirector: true,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(certificateValidator.ValidateCall.CallCount).To(Equal(0))
+			})
+
+			Context("when a cert and key are also provided", func() {
+				It("returns an error", func() {
+					err := command.CheckFastFails([]string{
+						"--type", "cf",
+						"--acm-certificate-arn", "some-certificate-arn",
+						"--cert", "/path/to/cert",
+						"--key", "/path/to/key",
+					}, storage.State{
+						IAAS: "aws",
+					})
+					Expect(err).To(MatchError("--acm-certificate-arn cannot be used with --cert and --key"))
+				})
+			})
+		})
+
+		Context("when iaas is aws and the lb flavor is invalid", func() {
+			It("returns an error", func() {
+				err := command.CheckFastFails([]string{
+					"--type", "cf",
+					"--lb-flavor", "glb",
+				}, storage.State{
+					IAAS: "aws",
+				})
+				Expect(err).To(MatchError(`--lb-flavor must be "alb", "nlb" or "classic"`))
+			})
+		})
+
+		Context("when iaas is aws and the lb flavor is classic", func() {
+			It("does not return an error", func() {
+				for _, flavor := range []string{"classic", "elb"} {
+					err := command.CheckFastFails([]string{
+						"--type", "cf",
+						"--lb-flavor", flavor,
+					}, storage.State{
+						IAAS: "aws",
+					})
+					Expect(err).NotTo(HaveOccurred())
+				}
+			})
+		})
+
 		Context("when iaas is gcp and lb type is concourse", func() {
 			It("does not call certificateValidator", func() {
 				_ = command.CheckFastFails(
@@ -125,6 +194,21 @@ var _ = Describe("create-lbs", func() {
 			})
 		})
 
+		Context("when iaas is azure", func() {
+			It("does not call certificateValidator", func() {
+				err := command.CheckFastFails(
+					[]string{
+						"--type", "cf",
+					},
+					storage.State{
+						IAAS: "azure",
+					})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(certificateValidator.ValidateCall.CallCount).To(Equal(0))
+			})
+		})
+
 		Context("when lb type is concourse and domain flag is supplied", func() {
 			It("returns an error", func() {
 				err := command.CheckFastFails(
@@ -155,6 +239,19 @@ var _ = Describe("create-lbs", func() {
 			})
 		})
 
+		Context("when --no-confirm is passed", func() {
+			It("passes it on to the iaas create lbs command", func() {
+				err := command.Execute([]string{
+					"--type", "concourse",
+					"--no-confirm",
+				}, storage.State{
+					IAAS: "gcp",
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(createLBsCmd.ExecuteCall.Receives.Config.NoConfirm).To(BeTrue())
+			})
+		})
+
 		Context("if GCP and type is cf", func() {
 			It("creates a GCP cf lb type is the iaas", func() {
 				err := command.Execute([]string{
@@ -175,6 +272,22 @@ var _ = Describe("create-lbs", func() {
 			})
 		})
 
+		Context("if the iaas is Azure", func() {
+			It("creates an Azure lb type", func() {
+				err := command.Execute([]string{
+					"--type", "cf",
+					"--domain", "some-domain",
+				}, storage.State{
+					IAAS: "azure",
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(createLBsCmd.ExecuteCall.Receives.Config).Should(Equal(commands.CreateLBsConfig{Azure: commands.AzureCreateLBsConfig{
+					LBType: "cf",
+					Domain: "some-domain",
+				}}))
+			})
+		})
+
 		Context("if the iaas is AWS", func() {
 			It("creates an AWS lb type", func() {
 				err := command.Execute([]string{
@@ -183,6 +296,8 @@ var _ = Describe("create-lbs", func() {
 					"--key", "my-key",
 					"--chain", "my-chain",
 					"--domain", "some-domain",
+					"--lb-flavor", "nlb",
+					"--acm-certificate-arn", "some-certificate-arn",
 				}, storage.State{
 					IAAS: "aws",
 				})
@@ -191,11 +306,13 @@ var _ = Describe("create-lbs", func() {
 				Expect(createLBsCmd.ExecuteCall.Receives.Config).Should(Equal(
 					commands.CreateLBsConfig{
 						AWS: commands.AWSCreateLBsConfig{
-							LBType:    "concourse",
-							CertPath:  "my-cert",
-							KeyPath:   "my-key",
-							ChainPath: "my-chain",
-							Domain:    "some-domain",
+							LBType:            "concourse",
+							CertPath:          "my-cert",
+							KeyPath:           "my-key",
+							ChainPath:         "my-chain",
+							Domain:            "some-domain",
+							Flavor:            "nlb",
+							ACMCertificateARN: "some-certificate-arn",
 						},
 					},
 				))
@@ -2,6 +2,7 @@ package commands
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/cloudfoundry/bosh-bootloader/flags"
 	"github.com/cloudfoundry/bosh-bootloader/storage"
@@ -11,7 +12,7 @@ type DeleteLBs struct {
 	logger               logger
 	stateValidator       stateValidator
 	boshManager          boshManager
-	cloudConfigManager   cloudConfigManager
+	cloudConfigUpdater   cloudConfigUpdater
 	stateStore           stateStore
 	environmentValidator environmentValidator
 	terraformManager     terraformManager
@@ -19,16 +20,17 @@ type DeleteLBs struct {
 
 type config struct {
 	skipIfMissing bool
+	noConfirm     bool
 }
 
-func NewDeleteLBs(logger logger, stateValidator stateValidator, boshManager boshManager,
+func NewDeleteLBs(logger logger, stdin io.Reader, stateValidator stateValidator, boshManager boshManager,
 	cloudConfigManager cloudConfigManager, stateStore stateStore,
 	environmentValidator environmentValidator, terraformManager terraformManager) DeleteLBs {
 	return DeleteLBs{
 		logger:               logger,
 		stateValidator:       stateValidator,
 		boshManager:          boshManager,
-		cloudConfigManager:   cloudConfigManager,
+		cloudConfigUpdater:   newCloudConfigUpdater(logger, stdin, cloudConfigManager),
 		stateStore:           stateStore,
 		environmentValidator: environmentValidator,
 		terraformManager:     terraformManager,
@@ -74,9 +76,9 @@ func (d DeleteLBs) Execute(subcommandFlags []string, state storage.State) error
 	state.LB = storage.LB{}
 
 	if !state.NoDirector {
-		err = d.cloudConfigManager.Update(state)
+		err = d.cloudConfigUpdater.Update(state, config.noConfirm)
 		if err != nil {
-			return fmt.Errorf("Update cloud config: %s", err)
+			return err
 		}
 	}
 
@@ -96,11 +98,13 @@ func (d DeleteLBs) Execute(subcommandFlags []string, state storage.State) error
 	return nil
 }
 
-func (DeleteLBs) parseFlags(subcommandFlags []string) (config, error) {
-	lbFlags := flags.New("delete-lbs")
+func (DeleteLBs) Flags() []flags.Flag {
+	return newDeleteLBsFlags(&config{}).Registered()
+}
 
+func (DeleteLBs) parseFlags(subcommandFlags []string) (config, error) {
 	c := config{}
-	lbFlags.Bool(&c.skipIfMissing, "skip-if-missing", "", false)
+	lbFlags := newDeleteLBsFlags(&c)
 
 	err := lbFlags.Parse(subcommandFlags)
 	if err != nil {
@@ -109,3 +113,10 @@ func (DeleteLBs) parseFlags(subcommandFlags []string) (config, error) {
 
 	return c, nil
 }
+
+func newDeleteLBsFlags(c *config) flags.Flags {
+	lbFlags := flags.New("delete-lbs")
+	lbFlags.Bool(&c.skipIfMissing, "", "skip-if-missing", false)
+	lbFlags.Bool(&c.noConfirm, "n", "no-confirm", false)
+	return lbFlags
+}
@@ -1,8 +1,10 @@
 package commands_test
 
 import (
+	"bytes"
 	"errors"
 
+	"github.com/cloudfoundry/bosh-bootloader/bosh"
 	"github.com/cloudfoundry/bosh-bootloader/commands"
 	"github.com/cloudfoundry/bosh-bootloader/fakes"
 	"github.com/cloudfoundry/bosh-bootloader/storage"
@@ -18,6 +20,7 @@ var _ = Describe("DeleteLBs", func() {
 
 		stateValidator       *fakes.StateValidator
 		logger               *fakes.Logger
+		stdin                *bytes.Buffer
 		boshManager          *fakes.BOSHManager
 		environmentValidator *fakes.EnvironmentValidator
 		cloudConfigManager   *fakes.CloudConfigManager
@@ -30,6 +33,7 @@ var _ = Describe("DeleteLBs", func() {
 	BeforeEach(func() {
 		stateValidator = &fakes.StateValidator{}
 		logger = &fakes.Logger{}
+		stdin = bytes.NewBuffer([]byte{})
 		boshManager = &fakes.BOSHManager{}
 		boshManager.VersionCall.Returns.Version = "2.0.24"
 		environmentValidator = &fakes.EnvironmentValidator{}
@@ -46,7 +50,7 @@ var _ = Describe("DeleteLBs", func() {
 			TFState: "some-tf-state",
 		}
 
-		command = commands.NewDeleteLBs(logger, stateValidator, boshManager, cloudConfigManager, stateStore, environmentValidator, terraformManager)
+		command = commands.NewDeleteLBs(logger, stdin, stateValidator, boshManager, cloudConfigManager, stateStore, environmentValidator, terraformManager)
 	})
 
 	Describe("CheckFastFails", func() {
@@ -142,6 +146,46 @@ var _ = Describe("DeleteLBs", func() {
 			})
 		})
 
+		Context("when the cloud config would change", func() {
+			BeforeEach(func() {
+				cloudConfigManager.DiffCall.Returns.Diff = bosh.ConfigDiff{
+					Diff: [][]interface{}{{"vm_extensions:", nil}, {"- name: lb", "removed"}},
+				}
+			})
+
+			It("prints the diff and applies the cloud config when the user confirms", func() {
+				stdin.Write([]byte("yes\n"))
+
+				err := command.Execute([]string{}, incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(cloudConfigManager.DiffCall.Receives.State.LB.Type).To(BeEmpty())
+				Expect(logger.PrintlnCall.Messages).To(ContainElement("  vm_extensions:\n- - name: lb"))
+				Expect(logger.PromptCall.Receives.Message).To(Equal("Are you sure you want to apply these changes to the cloud config?"))
+				Expect(cloudConfigManager.UpdateCall.CallCount).To(Equal(1))
+			})
+
+			It("stops without updating the cloud config when the user declines", func() {
+				stdin.Write([]byte("no\n"))
+
+				err := command.Execute([]string{}, incomingState)
+				Expect(err).To(Equal(commands.CloudConfigUpdateDeclined))
+
+				Expect(cloudConfigManager.UpdateCall.CallCount).To(Equal(0))
+				Expect(terraformManager.ApplyCall.CallCount).To(Equal(0))
+				Expect(stateStore.SetCall.CallCount).To(Equal(0))
+			})
+
+			It("applies the cloud config without prompting when --no-confirm is passed", func() {
+				err := command.Execute([]string{"--no-confirm"}, incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(cloudConfigManager.DiffCall.CallCount).To(Equal(0))
+				Expect(logger.PromptCall.CallCount).To(Equal(0))
+				Expect(cloudConfigManager.UpdateCall.CallCount).To(Equal(1))
+			})
+		})
+
 		Context("when there is no lb", func() {
 			It("returns an error", func() {
 				err := command.Execute([]string{}, storage.State{
@@ -3,6 +3,7 @@ package commands
 import (
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 
 	"github.com/cloudfoundry/bosh-bootloader/bosh"
@@ -29,6 +30,7 @@ type destroyConfig struct {
 
 type NetworkDeletionValidator interface {
 	ValidateSafeToDelete(networkName string, envID string) error
+	ValidateSafeToDeleteSubnets(subnets []string, envID string) error
 }
 
 func NewDestroy(logger logger, stdin io.Reader,
@@ -73,11 +75,26 @@ func (d Destroy) CheckFastFails(subcommandFlags []string, state storage.State) e
 		return err
 	}
 
+	if state.IAAS == "vsphere" {
+		return nil
+	}
+
 	terraformOutputs, err := d.terraformManager.GetOutputs(state)
 	if err != nil {
 		return nil
 	}
 
+	// The network belongs to someone else and may run other VMs, so only
+	// the subnets bbl created in it need to be empty.
+	if state.ExistingNetworkID != "" {
+		subnets := bblSubnets(state.IAAS, terraformOutputs)
+		if len(subnets) == 0 {
+			return nil
+		}
+
+		return d.networkDeletionValidator.ValidateSafeToDeleteSubnets(subnets, state.EnvID)
+	}
+
 	var networkName string
 	if state.IAAS == "gcp" {
 		networkName = terraformOutputs.GetString("network_name")
@@ -94,6 +111,11 @@ func (d Destroy) CheckFastFails(subcommandFlags []string, state storage.State) e
 		if networkName == "" {
 			return nil
 		}
+	} else if state.IAAS == "openstack" {
+		networkName = terraformOutputs.GetString("network_name")
+		if networkName == "" {
+			return nil
+		}
 	}
 
 	err = d.networkDeletionValidator.ValidateSafeToDelete(networkName, state.EnvID)
@@ -104,6 +126,40 @@ func (d Destroy) CheckFastFails(subcommandFlags []string, state storage.State) e
 	return nil
 }
 
+func bblSubnets(iaas string, terraformOutputs terraform.Outputs) []string {
+	var subnets []string
+	switch iaas {
+	case "aws":
+		for _, name := range []string{"bosh_subnet_id", "director_subnet_id"} {
+			if subnet := terraformOutputs.GetString(name); subnet != "" {
+				subnets = append(subnets, subnet)
+			}
+		}
+
+		internalSubnets := terraformOutputs.GetStringMap("internal_az_subnet_id_mapping")
+		zones := []string{}
+		for zone := range internalSubnets {
+			zones = append(zones, zone)
+		}
+		sort.Strings(zones)
+		for _, zone := range zones {
+			subnets = append(subnets, internalSubnets[zone])
+		}
+
+		subnets = append(subnets, terraformOutputs.GetStringSlice("lb_subnet_ids")...)
+	case "gcp":
+		if subnet := terraformOutputs.GetString("subnetwork_name"); subnet != "" {
+			subnets = append(subnets, subnet)
+		}
+	case "azure":
+		if subnet := terraformOutputs.GetString("bosh_subnet_name"); subnet != "" {
+			subnets = append(subnets, subnet)
+		}
+	}
+
+	return subnets
+}
+
 func (d Destroy) Execute(subcommandFlags []string, state storage.State) error {
 	config, err := d.parseFlags(subcommandFlags)
 	if err != nil {
@@ -164,12 +220,13 @@ func (d Destroy) Execute(subcommandFlags []string, state storage.State) error {
 	return nil
 }
 
-func (d Destroy) parseFlags(subcommandFlags []string) (destroyConfig, error) {
-	destroyFlags := flags.New("destroy")
+func (Destroy) Flags() []flags.Flag {
+	return newDestroyFlags(&destroyConfig{}).Registered()
+}
 
+func (d Destroy) parseFlags(subcommandFlags []string) (destroyConfig, error) {
 	config := destroyConfig{}
-	destroyFlags.Bool(&config.NoConfirm, "n", "no-confirm", false)
-	destroyFlags.Bool(&config.SkipIfMissing, "", "skip-if-missing", false)
+	destroyFlags := newDestroyFlags(&config)
 
 	err := destroyFlags.Parse(subcommandFlags)
 	if err != nil {
@@ -179,6 +236,13 @@ func (d Destroy) parseFlags(subcommandFlags []string) (destroyConfig, error) {
 	return config, nil
 }
 
+func newDestroyFlags(config *destroyConfig) flags.Flags {
+	destroyFlags := flags.New("destroy")
+	destroyFlags.Bool(&config.NoConfirm, "n", "no-confirm", false)
+	destroyFlags.Bool(&config.SkipIfMissing, "", "skip-if-missing", false)
+	return destroyFlags
+}
+
 func (d Destroy) deleteBOSH(state storage.State, terraformOutputs terraform.Outputs) (storage.State, error) {
 	if state.NoDirector {
 		d.logger.Println("no BOSH director, skipping...")
@@ -230,6 +230,104 @@ var _ = Describe("Destroy", func() {
 				})
 			})
 		})
+		Context("when the environment uses an existing network", func() {
+			It("validates only the subnets bbl created", func() {
+				terraformManager.GetOutputsCall.Returns.Outputs = terraform.Outputs{Map: map[string]interface{}{
+					"vpc_id":         "some-vpc-id",
+					"bosh_subnet_id": "some-bosh-subnet",
+					"internal_az_subnet_id_mapping": map[string]interface{}{
+						"us-east-1b": "some-internal-subnet-b",
+						"us-east-1a": "some-internal-subnet-a",
+					},
+					"lb_subnet_ids": []interface{}{"some-lb-subnet"},
+				}}
+				networkDeletionValidator.ValidateSafeToDeleteSubnetsCall.Returns.Error = errors.New("subnets are not safe to delete")
+
+				err := destroy.CheckFastFails([]string{}, storage.State{
+					IAAS:              "aws",
+					EnvID:             "some-env-id",
+					ExistingNetworkID: "some-vpc-id",
+				})
+				Expect(err).To(MatchError("subnets are not safe to delete"))
+
+				Expect(networkDeletionValidator.ValidateSafeToDeleteCall.CallCount).To(Equal(0))
+				Expect(networkDeletionValidator.ValidateSafeToDeleteSubnetsCall.Receives.Subnets).To(Equal([]string{
+					"some-bosh-subnet",
+					"some-internal-subnet-a",
+					"some-internal-subnet-b",
+					"some-lb-subnet",
+				}))
+				Expect(networkDeletionValidator.ValidateSafeToDeleteSubnetsCall.Receives.EnvID).To(Equal("some-env-id"))
+			})
+
+			It("validates the gcp subnetwork", func() {
+				terraformManager.GetOutputsCall.Returns.Outputs = terraform.Outputs{Map: map[string]interface{}{
+					"network_name":    "some-network-name",
+					"subnetwork_name": "some-subnetwork-name",
+				}}
+
+				err := destroy.CheckFastFails([]string{}, storage.State{
+					IAAS:              "gcp",
+					ExistingNetworkID: "some-network-name",
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(networkDeletionValidator.ValidateSafeToDeleteSubnetsCall.Receives.Subnets).To(Equal([]string{"some-subnetwork-name"}))
+			})
+
+			It("does not validate anything when there are no subnets in the outputs", func() {
+				err := destroy.CheckFastFails([]string{}, storage.State{
+					IAAS:              "aws",
+					ExistingNetworkID: "some-vpc-id",
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(networkDeletionValidator.ValidateSafeToDeleteCall.CallCount).To(Equal(0))
+				Expect(networkDeletionValidator.ValidateSafeToDeleteSubnetsCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("when iaas is vsphere", func() {
+			It("does not validate the network", func() {
+				err := destroy.CheckFastFails([]string{}, storage.State{
+					IAAS: "vsphere",
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(terraformManager.GetOutputsCall.CallCount).To(Equal(0))
+				Expect(networkDeletionValidator.ValidateSafeToDeleteCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("when iaas is openstack", func() {
+			Context("when instances exist in the openstack network", func() {
+				BeforeEach(func() {
+					terraformManager.GetOutputsCall.Returns.Outputs = terraform.Outputs{
+						Map: map[string]interface{}{"network_name": "some-network-name"},
+					}
+					networkDeletionValidator.ValidateSafeToDeleteCall.Returns.Error = errors.New("validation failed")
+				})
+
+				It("returns an error", func() {
+					err := destroy.CheckFastFails([]string{}, storage.State{
+						IAAS:  "openstack",
+						EnvID: "some-env-id",
+					})
+					Expect(networkDeletionValidator.ValidateSafeToDeleteCall.Receives.NetworkName).To(Equal("some-network-name"))
+					Expect(networkDeletionValidator.ValidateSafeToDeleteCall.Receives.EnvID).To(Equal("some-env-id"))
+					Expect(err).To(MatchError("validation failed"))
+				})
+			})
+
+			Context("when the network name output is missing", func() {
+				It("does not validate the network", func() {
+					err := destroy.CheckFastFails([]string{}, storage.State{
+						IAAS: "openstack",
+					})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(networkDeletionValidator.ValidateSafeToDeleteCall.CallCount).To(Equal(0))
+				})
+			})
+		})
 	})
 
 	Describe("Execute", func() {
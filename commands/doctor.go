@@ -0,0 +1,279 @@
+package commands
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+)
+
+const maxClockSkew = 5 * time.Minute
+
+type Doctor struct {
+	logger            logger
+	boshManager       boshManager
+	terraformManager  terraformVersioner
+	clockSkewChecker  clockSkewChecker
+	permissionChecker PermissionChecker
+	credentialsErr    error
+	stateDir          string
+}
+
+type terraformVersioner interface {
+	Version() (string, error)
+	ValidateVersion() error
+}
+
+type clockSkewChecker interface {
+	Skew(url string) (time.Duration, error)
+}
+
+type PermissionChecker interface {
+	CheckPermissions() error
+}
+
+// NewFailedPermissionChecker reports err, e.g. why the IaaS client could not
+// be built, as the result of the permission check.
+func NewFailedPermissionChecker(err error) PermissionChecker {
+	return failedPermissionChecker{err: err}
+}
+
+type failedPermissionChecker struct {
+	err error
+}
+
+func (f failedPermissionChecker) CheckPermissions() error {
+	return f.err
+}
+
+type doctorResult struct {
+	status  string
+	message string
+	hint    string
+}
+
+func doctorPass(format string, a ...interface{}) doctorResult {
+	return doctorResult{status: "PASS", message: fmt.Sprintf(format, a...)}
+}
+
+func doctorSkip(format string, a ...interface{}) doctorResult {
+	return doctorResult{status: "SKIP", message: fmt.Sprintf(format, a...)}
+}
+
+func doctorFail(hint, format string, a ...interface{}) doctorResult {
+	return doctorResult{status: "FAIL", message: fmt.Sprintf(format, a...), hint: hint}
+}
+
+// NewDoctor takes a nil permissionChecker for IaaSes that have no
+// permission probe, and the reason the IaaS credentials could not be used,
+// if any, so that the local checks still run without them.
+func NewDoctor(logger logger, boshManager boshManager, terraformManager terraformVersioner, clockSkewChecker clockSkewChecker,
+	permissionChecker PermissionChecker, credentialsErr error, stateDir string) Doctor {
+	return Doctor{
+		logger:            logger,
+		boshManager:       boshManager,
+		terraformManager:  terraformManager,
+		clockSkewChecker:  clockSkewChecker,
+		permissionChecker: permissionChecker,
+		credentialsErr:    credentialsErr,
+		stateDir:          stateDir,
+	}
+}
+
+func (d Doctor) CheckFastFails(subcommandFlags []string, state storage.State) error {
+	return nil
+}
+
+func (d Doctor) Execute(subcommandFlags []string, state storage.State) error {
+	results := []doctorResult{
+		d.checkBOSH(),
+		d.checkTerraform(),
+		d.checkStateDir(),
+		d.checkClock(state),
+		d.checkPermissions(state),
+	}
+
+	failed := 0
+	for _, result := range results {
+		d.logger.Printf("[%s] %s\n", result.status, result.message)
+		if result.hint != "" {
+			d.logger.Printf("       %s\n", result.hint)
+		}
+
+		if result.status == "FAIL" {
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d checks failed.", failed, len(results))
+	}
+
+	return nil
+}
+
+func (d Doctor) checkBOSH() doctorResult {
+	hint := "Install the bosh CLI v2.0.24 or later: https://bosh.io/docs/cli-v2.html"
+
+	err := fastFailBOSHVersion(d.boshManager)
+	if err != nil {
+		return doctorFail(hint, "bosh CLI: %s", err)
+	}
+
+	version, err := d.boshManager.Version()
+	if err != nil {
+		return doctorPass("bosh CLI (version could not be parsed)")
+	}
+
+	return doctorPass("bosh CLI v%s", version)
+}
+
+func (d Doctor) checkTerraform() doctorResult {
+	hint := "Install terraform v0.10.0 or later: https://www.terraform.io/downloads.html"
+
+	err := d.terraformManager.ValidateVersion()
+	if err != nil {
+		return doctorFail(hint, "terraform: %s", err)
+	}
+
+	version, err := d.terraformManager.Version()
+	if err != nil {
+		return doctorFail(hint, "terraform: %s", err) // not tested
+	}
+
+	return doctorPass("terraform v%s", version)
+}
+
+func (d Doctor) checkStateDir() doctorResult {
+	info, err := os.Stat(d.stateDir)
+	if os.IsNotExist(err) {
+		return doctorFail(fmt.Sprintf("Create it with \"mkdir -p %s\", or pass --state-dir.", d.stateDir), "state dir %s does not exist", d.stateDir)
+	}
+	if err != nil {
+		return doctorFail("Check the permissions of the state dir and its parents.", "state dir %s: %s", d.stateDir, err)
+	}
+	if !info.IsDir() {
+		return doctorFail("Pass a directory with --state-dir.", "state dir %s is not a directory", d.stateDir)
+	}
+
+	probe, err := ioutil.TempFile(d.stateDir, ".bbl-doctor-")
+	if err != nil {
+		return doctorFail("Check the permissions of the state dir.", "state dir %s is not writable: %s", d.stateDir, err)
+	}
+	probe.Close()
+	os.Remove(probe.Name())
+
+	return doctorPass("state dir %s is writable", d.stateDir)
+}
+
+func (d Doctor) checkClock(state storage.State) doctorResult {
+	if state.IAAS == "" {
+		return doctorSkip("clock skew: no IaaS given")
+	}
+
+	url := iaasEndpoint(state)
+	if url == "" {
+		return doctorSkip("clock skew: no endpoint to compare against for %s", state.IAAS)
+	}
+
+	skew, err := d.clockSkewChecker.Skew(url)
+	if err != nil {
+		return doctorSkip("clock skew: could not reach %s: %s", url, err)
+	}
+
+	direction := "ahead of"
+	if skew < 0 {
+		skew = -skew
+		direction = "behind"
+	}
+	skew = skew.Round(time.Second)
+
+	if skew > maxClockSkew {
+		return doctorFail("Sync the local clock with NTP. IaaS APIs reject signed requests when the clock is off by more than a few minutes.",
+			"clock is %s %s %s", skew, direction, url)
+	}
+
+	return doctorPass("clock is within %s of %s", maxClockSkew, url)
+}
+
+// checkPermissions only probes permissions on AWS, where a dry run of
+// creating a VPC reports whether it would be allowed. The other IaaSes
+// offer no such probe to bbl, so their check only shows that the
+// credentials authenticate and can list resources.
+func (d Doctor) checkPermissions(state storage.State) doctorResult {
+	if state.IAAS == "" {
+		return doctorSkip("IaaS credentials: no IaaS given")
+	}
+
+	if d.credentialsErr != nil {
+		return doctorSkip("%s credentials: %s", iaasName(state.IAAS), d.credentialsErr)
+	}
+
+	if d.permissionChecker == nil {
+		return doctorSkip("%s permissions: no permission probe for this IaaS", iaasName(state.IAAS))
+	}
+
+	err := d.permissionChecker.CheckPermissions()
+	switch {
+	case err != nil && state.IAAS == "aws":
+		return doctorFail(permissionHint(state), "%s permissions: %s", iaasName(state.IAAS), err)
+	case err != nil:
+		return doctorFail(permissionHint(state), "%s credentials: %s", iaasName(state.IAAS), err)
+	case state.IAAS == "aws":
+		return doctorPass("%s credentials are allowed to manage resources", iaasName(state.IAAS))
+	}
+
+	return doctorPass("%s credentials authenticate (permissions to manage resources are not checked)", iaasName(state.IAAS))
+}
+
+func iaasEndpoint(state storage.State) string {
+	switch state.IAAS {
+	case "aws":
+		if state.AWS.Region == "" {
+			return ""
+		}
+		return fmt.Sprintf("https://ec2.%s.amazonaws.com", state.AWS.Region)
+	case "gcp":
+		return "https://www.googleapis.com"
+	case "azure":
+		return "https://management.azure.com"
+	case "openstack":
+		return state.OpenStack.AuthURL
+	}
+
+	return ""
+}
+
+func iaasName(iaas string) string {
+	switch iaas {
+	case "aws":
+		return "AWS"
+	case "gcp":
+		return "GCP"
+	case "azure":
+		return "Azure"
+	case "openstack":
+		return "OpenStack"
+	case "vsphere":
+		return "vSphere"
+	}
+
+	return iaas
+}
+
+func permissionHint(state storage.State) string {
+	switch state.IAAS {
+	case "aws":
+		return "Grant the IAM user the policy in https://github.com/cloudfoundry/bosh-bootloader#configure-aws."
+	case "gcp":
+		return fmt.Sprintf("Grant the service account the Editor role on project %s.", state.GCP.ProjectID)
+	case "azure":
+		return fmt.Sprintf("Grant the service principal the Contributor role on subscription %s.", state.Azure.SubscriptionID)
+	case "openstack":
+		return fmt.Sprintf("Check that the OpenStack user can manage networks in project %s.", state.OpenStack.Project)
+	}
+
+	return ""
+}
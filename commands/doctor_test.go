@@ -0,0 +1,312 @@
+package commands_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cloudfoundry/bosh-bootloader/commands"
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Doctor", func() {
+	var (
+		doctor commands.Doctor
+
+		state    storage.State
+		stateDir string
+
+		logger            *fakes.Logger
+		boshManager       *fakes.BOSHManager
+		terraformManager  *fakes.TerraformManager
+		clockSkewChecker  *fakes.ClockSkewChecker
+		permissionChecker *fakes.PermissionChecker
+		credentialsErr    error
+	)
+
+	BeforeEach(func() {
+		var err error
+		stateDir, err = ioutil.TempDir("", "")
+		Expect(err).NotTo(HaveOccurred())
+
+		state = storage.State{
+			IAAS: "aws",
+			AWS: storage.AWS{
+				Region: "some-region",
+			},
+		}
+
+		logger = &fakes.Logger{}
+		boshManager = &fakes.BOSHManager{}
+		boshManager.VersionCall.Returns.Version = "2.0.48"
+		terraformManager = &fakes.TerraformManager{}
+		terraformManager.VersionCall.Returns.Version = "0.11.1"
+		clockSkewChecker = &fakes.ClockSkewChecker{}
+		clockSkewChecker.SkewCall.Returns.Skew = 2 * time.Second
+		permissionChecker = &fakes.PermissionChecker{}
+		credentialsErr = nil
+	})
+
+	JustBeforeEach(func() {
+		doctor = commands.NewDoctor(logger, boshManager, terraformManager, clockSkewChecker, permissionChecker, credentialsErr, stateDir)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(stateDir)
+	})
+
+	Describe("CheckFastFails", func() {
+		It("returns no error", func() {
+			err := doctor.CheckFastFails([]string{}, state)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("Execute", func() {
+		It("prints a passing line for every check", func() {
+			err := doctor.Execute([]string{}, state)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(clockSkewChecker.SkewCall.Receives.URL).To(Equal("https://ec2.some-region.amazonaws.com"))
+			Expect(permissionChecker.CheckPermissionsCall.CallCount).To(Equal(1))
+
+			Expect(logger.PrintfCall.Messages).To(Equal([]string{
+				"[PASS] bosh CLI v2.0.48\n",
+				"[PASS] terraform v0.11.1\n",
+				"[PASS] state dir " + stateDir + " is writable\n",
+				"[PASS] clock is within 5m0s of https://ec2.some-region.amazonaws.com\n",
+				"[PASS] AWS credentials are allowed to manage resources\n",
+			}))
+		})
+
+		It("leaves nothing behind in the state dir", func() {
+			err := doctor.Execute([]string{}, state)
+			Expect(err).NotTo(HaveOccurred())
+
+			files, err := ioutil.ReadDir(stateDir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).To(BeEmpty())
+		})
+
+		Context("when the bosh CLI is too old", func() {
+			BeforeEach(func() {
+				boshManager.VersionCall.Returns.Version = "2.0.0"
+			})
+
+			It("prints a failing line with a hint", func() {
+				err := doctor.Execute([]string{}, state)
+				Expect(err).To(MatchError("1 of 5 checks failed."))
+
+				Expect(logger.PrintfCall.Messages).To(ContainElement("[FAIL] bosh CLI: BOSH version must be at least v2.0.24\n"))
+				Expect(logger.PrintfCall.Messages).To(ContainElement("       Install the bosh CLI v2.0.24 or later: https://bosh.io/docs/cli-v2.html\n"))
+			})
+		})
+
+		Context("when terraform is missing or too old", func() {
+			BeforeEach(func() {
+				terraformManager.ValidateVersionCall.Returns.Error = errors.New("Terraform version must be at least v0.10.0")
+			})
+
+			It("prints a failing line with a hint", func() {
+				err := doctor.Execute([]string{}, state)
+				Expect(err).To(MatchError("1 of 5 checks failed."))
+
+				Expect(logger.PrintfCall.Messages).To(ContainElement("[FAIL] terraform: Terraform version must be at least v0.10.0\n"))
+				Expect(logger.PrintfCall.Messages).To(ContainElement("       Install terraform v0.10.0 or later: https://www.terraform.io/downloads.html\n"))
+			})
+		})
+
+		Context("when the state dir does not exist", func() {
+			BeforeEach(func() {
+				stateDir = filepath.Join(stateDir, "missing")
+			})
+
+			It("prints a failing line with a hint", func() {
+				err := doctor.Execute([]string{}, state)
+				Expect(err).To(MatchError("1 of 5 checks failed."))
+
+				Expect(logger.PrintfCall.Messages).To(ContainElement("[FAIL] state dir " + stateDir + " does not exist\n"))
+				Expect(logger.PrintfCall.Messages).To(ContainElement("       Create it with \"mkdir -p " + stateDir + "\", or pass --state-dir.\n"))
+			})
+		})
+
+		Context("when the state dir is a file", func() {
+			BeforeEach(func() {
+				stateDir = filepath.Join(stateDir, "some-file")
+				err := ioutil.WriteFile(stateDir, []byte{}, os.ModePerm)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("prints a failing line", func() {
+				err := doctor.Execute([]string{}, state)
+				Expect(err).To(MatchError("1 of 5 checks failed."))
+
+				Expect(logger.PrintfCall.Messages).To(ContainElement("[FAIL] state dir " + stateDir + " is not a directory\n"))
+			})
+		})
+
+		Context("when the clock is off", func() {
+			BeforeEach(func() {
+				clockSkewChecker.SkewCall.Returns.Skew = -10 * time.Minute
+			})
+
+			It("prints a failing line with a hint", func() {
+				err := doctor.Execute([]string{}, state)
+				Expect(err).To(MatchError("1 of 5 checks failed."))
+
+				Expect(logger.PrintfCall.Messages).To(ContainElement("[FAIL] clock is 10m0s behind https://ec2.some-region.amazonaws.com\n"))
+				Expect(logger.PrintfCall.Messages).To(ContainElement(ContainSubstring("Sync the local clock with NTP.")))
+			})
+		})
+
+		Context("when the IAAS endpoint cannot be reached", func() {
+			BeforeEach(func() {
+				clockSkewChecker.SkewCall.Returns.Error = errors.New("no route to host")
+			})
+
+			It("skips the clock check", func() {
+				err := doctor.Execute([]string{}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PrintfCall.Messages).To(ContainElement("[SKIP] clock skew: could not reach https://ec2.some-region.amazonaws.com: no route to host\n"))
+			})
+		})
+
+		Context("when the credentials are not allowed to create resources", func() {
+			BeforeEach(func() {
+				permissionChecker.CheckPermissionsCall.Returns.Error = errors.New("UnauthorizedOperation")
+			})
+
+			It("prints a failing line with a hint for the IAAS", func() {
+				err := doctor.Execute([]string{}, state)
+				Expect(err).To(MatchError("1 of 5 checks failed."))
+
+				Expect(logger.PrintfCall.Messages).To(ContainElement("[FAIL] AWS permissions: UnauthorizedOperation\n"))
+				Expect(logger.PrintfCall.Messages).To(ContainElement("       Grant the IAM user the policy in https://github.com/cloudfoundry/bosh-bootloader#configure-aws.\n"))
+			})
+		})
+
+		Context("when the iaas is gcp", func() {
+			BeforeEach(func() {
+				state = storage.State{
+					IAAS: "gcp",
+					GCP: storage.GCP{
+						ProjectID: "some-project",
+					},
+				}
+				permissionChecker.CheckPermissionsCall.Returns.Error = errors.New("forbidden")
+			})
+
+			It("checks the clock against the google apis and hints at the project", func() {
+				err := doctor.Execute([]string{}, state)
+				Expect(err).To(MatchError("1 of 5 checks failed."))
+
+				Expect(clockSkewChecker.SkewCall.Receives.URL).To(Equal("https://www.googleapis.com"))
+				Expect(logger.PrintfCall.Messages).To(ContainElement("[FAIL] GCP credentials: forbidden\n"))
+				Expect(logger.PrintfCall.Messages).To(ContainElement("       Grant the service account the Editor role on project some-project.\n"))
+			})
+		})
+
+		Context("when the iaas client could not be built", func() {
+			BeforeEach(func() {
+				state = storage.State{
+					IAAS: "gcp",
+					GCP: storage.GCP{
+						ProjectID: "some-project",
+					},
+				}
+			})
+
+			JustBeforeEach(func() {
+				checker := commands.NewFailedPermissionChecker(errors.New("invalid service account key"))
+				doctor = commands.NewDoctor(logger, boshManager, terraformManager, clockSkewChecker, checker, nil, stateDir)
+			})
+
+			It("reports the client error as a failed credentials check", func() {
+				err := doctor.Execute([]string{}, state)
+				Expect(err).To(MatchError("1 of 5 checks failed."))
+
+				Expect(logger.PrintfCall.Messages).To(ContainElement("[FAIL] GCP credentials: invalid service account key\n"))
+			})
+		})
+
+		Context("when the credentials authenticate on an iaas without a permission probe", func() {
+			BeforeEach(func() {
+				state = storage.State{
+					IAAS: "gcp",
+					GCP: storage.GCP{
+						ProjectID: "some-project",
+					},
+				}
+			})
+
+			It("says that only authentication was checked", func() {
+				err := doctor.Execute([]string{}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PrintfCall.Messages).To(ContainElement("[PASS] GCP credentials authenticate (permissions to manage resources are not checked)\n"))
+			})
+		})
+
+		Context("when the credentials are missing", func() {
+			BeforeEach(func() {
+				credentialsErr = errors.New("AWS secret access key must be provided")
+			})
+
+			It("runs the local checks and skips the permission check", func() {
+				err := doctor.Execute([]string{}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(permissionChecker.CheckPermissionsCall.CallCount).To(Equal(0))
+				Expect(logger.PrintfCall.Messages).To(Equal([]string{
+					"[PASS] bosh CLI v2.0.48\n",
+					"[PASS] terraform v0.11.1\n",
+					"[PASS] state dir " + stateDir + " is writable\n",
+					"[PASS] clock is within 5m0s of https://ec2.some-region.amazonaws.com\n",
+					"[SKIP] AWS credentials: AWS secret access key must be provided\n",
+				}))
+			})
+		})
+
+		Context("when no iaas is given", func() {
+			BeforeEach(func() {
+				state = storage.State{}
+				credentialsErr = errors.New("--iaas must be provided")
+			})
+
+			It("runs the local checks and skips the iaas checks", func() {
+				err := doctor.Execute([]string{}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(clockSkewChecker.SkewCall.CallCount).To(Equal(0))
+				Expect(logger.PrintfCall.Messages).To(ContainElement("[SKIP] clock skew: no IaaS given\n"))
+				Expect(logger.PrintfCall.Messages).To(ContainElement("[SKIP] IaaS credentials: no IaaS given\n"))
+			})
+		})
+
+		Context("when the iaas is vsphere", func() {
+			BeforeEach(func() {
+				state = storage.State{IAAS: "vsphere"}
+				permissionChecker = nil
+			})
+
+			JustBeforeEach(func() {
+				doctor = commands.NewDoctor(logger, boshManager, terraformManager, clockSkewChecker, nil, nil, stateDir)
+			})
+
+			It("skips the clock and permission checks", func() {
+				err := doctor.Execute([]string{}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(clockSkewChecker.SkewCall.CallCount).To(Equal(0))
+				Expect(logger.PrintfCall.Messages).To(ContainElement("[SKIP] clock skew: no endpoint to compare against for vsphere\n"))
+				Expect(logger.PrintfCall.Messages).To(ContainElement("[SKIP] vSphere permissions: no permission probe for this IaaS\n"))
+			})
+		})
+	})
+})
@@ -1,6 +1,10 @@
 package commands
 
-import yaml "gopkg.in/yaml.v2"
+import (
+	"time"
+
+	yaml "gopkg.in/yaml.v2"
+)
 
 func SetMarshal(f func(interface{}) ([]byte, error)) {
 	marshal = f
@@ -17,3 +21,13 @@ func SetUnmarshal(f func([]byte, interface{}) error) {
 func ResetUnmarshal() {
 	unmarshal = yaml.Unmarshal
 }
+
+func SetTunnelStartTimeout(timeout time.Duration) {
+	tunnelStartTimeout = timeout
+	tunnelStartPollInterval = timeout / 10
+}
+
+func ResetTunnelStartTimeout() {
+	tunnelStartTimeout = 30 * time.Second
+	tunnelStartPollInterval = 100 * time.Millisecond
+}
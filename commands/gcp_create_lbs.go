@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"io"
 	"io/ioutil"
 
 	yaml "gopkg.in/yaml.v2"
@@ -14,7 +15,7 @@ var (
 
 type GCPCreateLBs struct {
 	terraformManager     terraformManager
-	cloudConfigManager   cloudConfigManager
+	cloudConfigUpdater   cloudConfigUpdater
 	stateStore           stateStore
 	environmentValidator EnvironmentValidator
 }
@@ -29,10 +30,11 @@ type GCPCreateLBsConfig struct {
 func NewGCPCreateLBs(terraformManager terraformManager,
 	cloudConfigManager cloudConfigManager,
 	stateStore stateStore, environmentValidator EnvironmentValidator,
+	logger logger, stdin io.Reader,
 ) GCPCreateLBs {
 	return GCPCreateLBs{
 		terraformManager:     terraformManager,
-		cloudConfigManager:   cloudConfigManager,
+		cloudConfigUpdater:   newCloudConfigUpdater(logger, stdin, cloudConfigManager),
 		stateStore:           stateStore,
 		environmentValidator: environmentValidator,
 	}
@@ -75,6 +77,10 @@ func (c GCPCreateLBs) Execute(config CreateLBsConfig, state storage.State) error
 		state.LB.Key = string(key)
 	}
 
+	if config.CloudConfigDiffOnly {
+		return c.cloudConfigUpdater.PrintDiff(state)
+	}
+
 	if err := c.terraformManager.Init(state); err != nil {
 		return err
 	}
@@ -89,9 +95,9 @@ func (c GCPCreateLBs) Execute(config CreateLBsConfig, state storage.State) error
 	}
 
 	if !state.NoDirector {
-		err = c.cloudConfigManager.Update(state)
+		err = c.cloudConfigUpdater.Update(state, config.NoConfirm)
 		if err != nil {
-			return err
+			return cloudConfigUpdateError(err)
 		}
 	}
 
@@ -1,11 +1,13 @@
 package commands_test
 
 import (
+	"bytes"
 	"errors"
 	"io/ioutil"
 	"os"
 
 	"github.com/cloudfoundry/bosh-bootloader/application"
+	"github.com/cloudfoundry/bosh-bootloader/bosh"
 	"github.com/cloudfoundry/bosh-bootloader/commands"
 	"github.com/cloudfoundry/bosh-bootloader/fakes"
 	"github.com/cloudfoundry/bosh-bootloader/storage"
@@ -21,6 +23,8 @@ var _ = Describe("GCPCreateLBs", func() {
 		cloudConfigManager     *fakes.CloudConfigManager
 		stateStore             *fakes.StateStore
 		environmentValidator   *fakes.EnvironmentValidator
+		logger                 *fakes.Logger
+		stdin                  *bytes.Buffer
 		terraformExecutorError *fakes.TerraformExecutorError
 
 		bblState    storage.State
@@ -36,9 +40,11 @@ var _ = Describe("GCPCreateLBs", func() {
 		cloudConfigManager = &fakes.CloudConfigManager{}
 		stateStore = &fakes.StateStore{}
 		environmentValidator = &fakes.EnvironmentValidator{}
+		logger = &fakes.Logger{}
+		stdin = bytes.NewBuffer([]byte{})
 		terraformExecutorError = &fakes.TerraformExecutorError{}
 
-		command = commands.NewGCPCreateLBs(terraformManager, cloudConfigManager, stateStore, environmentValidator)
+		command = commands.NewGCPCreateLBs(terraformManager, cloudConfigManager, stateStore, environmentValidator, logger, stdin)
 
 		tempCertFile, err := ioutil.TempFile("", "cert")
 		Expect(err).NotTo(HaveOccurred())
@@ -144,6 +150,34 @@ var _ = Describe("GCPCreateLBs", func() {
 			})
 		})
 
+		Context("when the cloud config would change", func() {
+			BeforeEach(func() {
+				cloudConfigManager.DiffCall.Returns.Diff = bosh.ConfigDiff{
+					Diff: [][]interface{}{{"vm_extensions:", nil}, {"- name: lb", "added"}},
+				}
+			})
+
+			It("prints the diff and applies the cloud config when the user confirms", func() {
+				stdin.Write([]byte("yes\n"))
+
+				err := command.Execute(commands.CreateLBsConfig{GCP: commands.GCPCreateLBsConfig{LBType: "concourse"}}, bblState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PrintlnCall.Messages).To(ContainElement("  vm_extensions:\n+ - name: lb"))
+				Expect(logger.PromptCall.Receives.Message).To(Equal("Are you sure you want to apply these changes to the cloud config?"))
+				Expect(cloudConfigManager.UpdateCall.CallCount).To(Equal(1))
+			})
+
+			It("stops without updating the cloud config when the user declines", func() {
+				stdin.Write([]byte("no\n"))
+
+				err := command.Execute(commands.CreateLBsConfig{GCP: commands.GCPCreateLBsConfig{LBType: "concourse"}}, bblState)
+				Expect(err).To(MatchError("Cloud config update declined. The IAAS changes were applied, but the cloud config was left as it is. Run the command again to update it."))
+
+				Expect(cloudConfigManager.UpdateCall.CallCount).To(Equal(0))
+			})
+		})
+
 		Context("failure cases", func() {
 			Context("if terraform manager version validator fails", func() {
 				BeforeEach(func() {
@@ -289,7 +323,7 @@ var _ = Describe("GCPCreateLBs", func() {
 					err := command.Execute(commands.CreateLBsConfig{GCP: commands.GCPCreateLBsConfig{
 						LBType: "concourse",
 					}}, storage.State{TFState: "some-tf-state"})
-					Expect(err).To(MatchError("failed to update cloud config"))
+					Expect(err).To(MatchError("Update cloud config: failed to update cloud config"))
 				})
 			})
 		})
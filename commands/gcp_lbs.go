@@ -1,7 +1,6 @@
 package commands
 
 import (
-	"encoding/json"
 	"errors"
 	"strings"
 
@@ -11,12 +10,27 @@ import (
 type GCPLBs struct {
 	terraformManager terraformOutputter
 	logger           logger
+	output           string
 }
 
-func NewGCPLBs(terraformManager terraformOutputter, logger logger) GCPLBs {
+type gcpCFLBsOutput struct {
+	RouterLBIP             string   `json:"cf_router_lb,omitempty"                 yaml:"cf_router_lb,omitempty"`
+	SSHProxyLBIP           string   `json:"cf_ssh_proxy_lb,omitempty"              yaml:"cf_ssh_proxy_lb,omitempty"`
+	TCPRouterLBIP          string   `json:"cf_tcp_router_lb,omitempty"             yaml:"cf_tcp_router_lb,omitempty"`
+	WebSocketLBIP          string   `json:"cf_websocket_lb,omitempty"              yaml:"cf_websocket_lb,omitempty"`
+	CredhubLBIP            string   `json:"cf_credhub_lb,omitempty"                yaml:"cf_credhub_lb,omitempty"`
+	SystemDomainDNSServers []string `json:"cf_system_domain_dns_servers,omitempty" yaml:"cf_system_domain_dns_servers,omitempty"`
+}
+
+type concourseLBOutput struct {
+	ConcourseLBIP string `json:"concourse_lb,omitempty" yaml:"concourse_lb,omitempty"`
+}
+
+func NewGCPLBs(terraformManager terraformOutputter, logger logger, output string) GCPLBs {
 	return GCPLBs{
 		terraformManager: terraformManager,
 		logger:           logger,
+		output:           output,
 	}
 }
 
@@ -26,17 +40,12 @@ func (l GCPLBs) Execute(subcommandFlags []string, state storage.State) error {
 		return err
 	}
 
+	output := lbsOutput(l.output, subcommandFlags)
+
 	switch state.LB.Type {
 	case "cf":
-		if len(subcommandFlags) > 0 && subcommandFlags[0] == "--json" {
-			lbOutput, err := json.Marshal(struct {
-				RouterLBIP             string   `json:"cf_router_lb,omitempty"`
-				SSHProxyLBIP           string   `json:"cf_ssh_proxy_lb,omitempty"`
-				TCPRouterLBIP          string   `json:"cf_tcp_router_lb,omitempty"`
-				WebSocketLBIP          string   `json:"cf_websocket_lb,omitempty"`
-				CredhubLBIP            string   `json:"cf_credhub_lb,omitempty"`
-				SystemDomainDNSServers []string `json:"cf_system_domain_dns_servers,omitempty"`
-			}{
+		if structuredOutput(output) {
+			return printFormatted(l.logger, output, gcpCFLBsOutput{
 				RouterLBIP:             terraformOutputs.GetString("router_lb_ip"),
 				SSHProxyLBIP:           terraformOutputs.GetString("ssh_proxy_lb_ip"),
 				TCPRouterLBIP:          terraformOutputs.GetString("tcp_router_lb_ip"),
@@ -44,24 +53,24 @@ func (l GCPLBs) Execute(subcommandFlags []string, state storage.State) error {
 				CredhubLBIP:            terraformOutputs.GetString("credhub_lb_ip"),
 				SystemDomainDNSServers: terraformOutputs.GetStringSlice("system_domain_dns_servers"),
 			})
-			if err != nil {
-				// not tested
-				return err
-			}
+		}
 
-			l.logger.Println(string(lbOutput))
-		} else {
-			l.logger.Printf("CF Router LB: %s\n", terraformOutputs.GetString("router_lb_ip"))
-			l.logger.Printf("CF SSH Proxy LB: %s\n", terraformOutputs.GetString("ssh_proxy_lb_ip"))
-			l.logger.Printf("CF TCP Router LB: %s\n", terraformOutputs.GetString("tcp_router_lb_ip"))
-			l.logger.Printf("CF WebSocket LB: %s\n", terraformOutputs.GetString("ws_lb_ip"))
-			l.logger.Printf("CF Credhub LB: %s\n", terraformOutputs.GetString("credhub_lb_ip"))
-			dnsServers := terraformOutputs.GetStringSlice("system_domain_dns_servers")
-			if len(dnsServers) > 0 {
-				l.logger.Printf("CF System Domain DNS servers: %s\n", strings.Join(dnsServers, " "))
-			}
+		l.logger.Printf("CF Router LB: %s\n", terraformOutputs.GetString("router_lb_ip"))
+		l.logger.Printf("CF SSH Proxy LB: %s\n", terraformOutputs.GetString("ssh_proxy_lb_ip"))
+		l.logger.Printf("CF TCP Router LB: %s\n", terraformOutputs.GetString("tcp_router_lb_ip"))
+		l.logger.Printf("CF WebSocket LB: %s\n", terraformOutputs.GetString("ws_lb_ip"))
+		l.logger.Printf("CF Credhub LB: %s\n", terraformOutputs.GetString("credhub_lb_ip"))
+		dnsServers := terraformOutputs.GetStringSlice("system_domain_dns_servers")
+		if len(dnsServers) > 0 {
+			l.logger.Printf("CF System Domain DNS servers: %s\n", strings.Join(dnsServers, " "))
 		}
 	case "concourse":
+		if structuredOutput(output) {
+			return printFormatted(l.logger, output, concourseLBOutput{
+				ConcourseLBIP: terraformOutputs.GetString("concourse_lb_ip"),
+			})
+		}
+
 		l.logger.Printf("Concourse LB: %s\n", terraformOutputs.GetString("concourse_lb_ip"))
 	default:
 		return errors.New("no lbs found")
@@ -35,7 +35,7 @@ var _ = Describe("GCPLBs", func() {
 		}}
 		logger = &fakes.Logger{}
 
-		command = commands.NewGCPLBs(terraformManager, logger)
+		command = commands.NewGCPLBs(terraformManager, logger, commands.TextOutput)
 	})
 
 	Describe("Execute", func() {
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"github.com/cloudfoundry/bosh-bootloader/bosh"
 	"github.com/cloudfoundry/bosh-bootloader/storage"
 	"github.com/cloudfoundry/bosh-bootloader/terraform"
 )
@@ -79,4 +80,10 @@ type stateStore interface {
 type cloudConfigManager interface {
 	Update(state storage.State) error
 	Generate(state storage.State) (string, error)
+	Diff(state storage.State) (bosh.ConfigDiff, error)
+}
+
+type runtimeConfigManager interface {
+	Update(state storage.State) error
+	Generate(state storage.State) (string, error)
 }
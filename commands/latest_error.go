@@ -5,12 +5,18 @@ import "github.com/cloudfoundry/bosh-bootloader/storage"
 type LatestError struct {
 	logger         logger
 	stateValidator stateValidator
+	output         string
 }
 
-func NewLatestError(logger logger, stateValidator stateValidator) LatestError {
+type latestErrorOutput struct {
+	LatestError string `json:"latest_error" yaml:"latest_error"`
+}
+
+func NewLatestError(logger logger, stateValidator stateValidator, output string) LatestError {
 	return LatestError{
 		logger:         logger,
 		stateValidator: stateValidator,
+		output:         output,
 	}
 }
 
@@ -24,6 +30,10 @@ func (l LatestError) CheckFastFails(subcommandFlags []string, state storage.Stat
 }
 
 func (l LatestError) Execute(subcommandFlags []string, bblState storage.State) error {
+	if structuredOutput(l.output) {
+		return printFormatted(l.logger, l.output, latestErrorOutput{LatestError: bblState.LatestTFOutput})
+	}
+
 	l.logger.Println(bblState.LatestTFOutput)
 	return nil
 }
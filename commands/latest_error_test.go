@@ -23,7 +23,7 @@ var _ = Describe("latest-error", func() {
 		logger = &fakes.Logger{}
 		stateValidator = &fakes.StateValidator{}
 
-		command = commands.NewLatestError(logger, stateValidator)
+		command = commands.NewLatestError(logger, stateValidator, commands.TextOutput)
 	})
 
 	Describe("CheckFastFails", func() {
@@ -1,6 +1,9 @@
 package commands
 
 import (
+	"errors"
+
+	"github.com/cloudfoundry/bosh-bootloader/flags"
 	"github.com/cloudfoundry/bosh-bootloader/storage"
 )
 
@@ -21,6 +24,14 @@ func NewLBs(lbs LBsCmd, stateValidator stateValidator) LBs {
 }
 
 func (l LBs) CheckFastFails(subcommandFlags []string, state storage.State) error {
+	if state.IAAS == "openstack" {
+		return errors.New("Load balancers are not supported on OpenStack.")
+	}
+
+	if state.IAAS == "vsphere" {
+		return errors.New("Load balancers are not supported on vSphere.")
+	}
+
 	err := l.stateValidator.Validate()
 	if err != nil {
 		return err
@@ -29,6 +40,21 @@ func (l LBs) CheckFastFails(subcommandFlags []string, state storage.State) error
 	return nil
 }
 
+// Flags lists the positional --json that lbsOutput looks for.
+func (LBs) Flags() []flags.Flag {
+	return []flags.Flag{{Name: "json"}}
+}
+
 func (l LBs) Execute(subcommandFlags []string, state storage.State) error {
 	return l.lbs.Execute(subcommandFlags, state)
 }
+
+// lbsOutput returns the format the lbs commands print in. The positional
+// --json predates the global --output flag and is kept as a shorthand.
+func lbsOutput(output string, subcommandFlags []string) string {
+	if len(subcommandFlags) > 0 && subcommandFlags[0] == "--json" {
+		return compactJSONOutput
+	}
+
+	return output
+}
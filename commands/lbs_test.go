@@ -39,6 +39,20 @@ var _ = Describe("LBs", func() {
 				Expect(err).To(MatchError("state validator failed"))
 			})
 		})
+
+		Context("when the iaas is openstack", func() {
+			It("returns an error", func() {
+				err := lbsCommand.CheckFastFails([]string{}, storage.State{IAAS: "openstack"})
+				Expect(err).To(MatchError("Load balancers are not supported on OpenStack."))
+			})
+		})
+
+		Context("when the iaas is vsphere", func() {
+			It("returns an error", func() {
+				err := lbsCommand.CheckFastFails([]string{}, storage.State{IAAS: "vsphere"})
+				Expect(err).To(MatchError("Load balancers are not supported on vSphere."))
+			})
+		})
 	})
 
 	Describe("Execute", func() {
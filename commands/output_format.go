@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"encoding/json"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// The values of the global --output flag.
+const (
+	TextOutput = "text"
+	JSONOutput = "json"
+	YAMLOutput = "yaml"
+)
+
+// compactJSONOutput is what the --json flags of lbs and status select. They
+// printed one-line JSON before --output existed and scripts may depend on it.
+const compactJSONOutput = "compact-json"
+
+// structuredOutput reports whether output asks for JSON or YAML. Anything
+// else, including an unset format, means text.
+func structuredOutput(output string) bool {
+	return output == JSONOutput || output == compactJSONOutput || output == YAMLOutput
+}
+
+// printFormatted prints value as indented JSON, one-line JSON or YAML. Commands print
+// their own text output and only call it when structuredOutput is true. The
+// structs passed here are the documented output schemas in
+// docs/output-formats.md and need both json and yaml tags.
+func printFormatted(logger logger, output string, value interface{}) error {
+	if output == YAMLOutput {
+		contents, err := yaml.Marshal(value)
+		if err != nil {
+			return err // not tested
+		}
+
+		logger.Printf("%s", contents)
+		return nil
+	}
+
+	if output == compactJSONOutput {
+		contents, err := json.Marshal(value)
+		if err != nil {
+			return err // not tested
+		}
+
+		logger.Println(string(contents))
+		return nil
+	}
+
+	contents, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err // not tested
+	}
+
+	logger.Println(string(contents))
+	return nil
+}
@@ -0,0 +1,160 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cloudfoundry/bosh-bootloader/flags"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+)
+
+const redactedOutput = "<sensitive>"
+
+type Outputs struct {
+	logger           logger
+	stateValidator   stateValidator
+	terraformManager terraformOutputter
+	output           string
+}
+
+type outputsConfig struct {
+	name          string
+	json          bool
+	yaml          bool
+	showSensitive bool
+}
+
+func NewOutputs(logger logger, stateValidator stateValidator, terraformManager terraformOutputter, output string) Outputs {
+	return Outputs{
+		logger:           logger,
+		stateValidator:   stateValidator,
+		terraformManager: terraformManager,
+		output:           output,
+	}
+}
+
+func (o Outputs) CheckFastFails(subcommandFlags []string, state storage.State) error {
+	err := o.stateValidator.Validate()
+	if err != nil {
+		return err
+	}
+
+	_, err = parseOutputsFlags(subcommandFlags)
+	return err
+}
+
+func (o Outputs) Execute(subcommandFlags []string, state storage.State) error {
+	config, err := parseOutputsFlags(subcommandFlags)
+	if err != nil {
+		return err
+	}
+
+	terraformOutputs, err := o.terraformManager.GetOutputs(state)
+	if err != nil {
+		return err
+	}
+
+	values := map[string]interface{}{}
+	for name, value := range terraformOutputs.Map {
+		if terraformOutputs.IsSensitive(name) && !config.showSensitive {
+			value = redactedOutput
+		}
+		values[name] = value
+	}
+
+	var outputValue interface{} = values
+	if config.name != "" {
+		value, ok := values[config.name]
+		if !ok {
+			return fmt.Errorf("No output named %q. Run \"bbl outputs\" to list them.", config.name)
+		}
+		outputValue = value
+	}
+
+	output := o.output
+	if config.json {
+		output = JSONOutput
+	} else if config.yaml {
+		output = YAMLOutput
+	}
+
+	switch {
+	case structuredOutput(output):
+		return printFormatted(o.logger, output, outputValue)
+	case config.name != "":
+		o.logger.Println(formatOutputValue(outputValue))
+	default:
+		names := []string{}
+		for name := range values {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			o.logger.Printf("%s: %s\n", name, formatOutputValue(values[name]))
+		}
+	}
+
+	return nil
+}
+
+func (Outputs) Flags() []flags.Flag {
+	return newOutputsFlags(&outputsConfig{}).Registered()
+}
+
+func parseOutputsFlags(subcommandFlags []string) (outputsConfig, error) {
+	var config outputsConfig
+	outputsFlags := newOutputsFlags(&config)
+
+	err := outputsFlags.Parse(subcommandFlags)
+	if err != nil {
+		return outputsConfig{}, err
+	}
+
+	// The output name may come before the flags, where the flag package
+	// stops parsing.
+	if args := outputsFlags.Args(); len(args) > 0 {
+		config.name = args[0]
+
+		err = outputsFlags.Parse(args[1:])
+		if err != nil {
+			return outputsConfig{}, err
+		}
+
+		if len(outputsFlags.Args()) > 0 {
+			return outputsConfig{}, fmt.Errorf("Unexpected arguments: %s.", strings.Join(outputsFlags.Args(), " "))
+		}
+	}
+
+	if config.json && config.yaml {
+		return outputsConfig{}, errors.New("--json and --yaml cannot be used together.")
+	}
+
+	return config, nil
+}
+
+func newOutputsFlags(config *outputsConfig) flags.Flags {
+	outputsFlags := flags.New("outputs")
+	outputsFlags.Bool(&config.json, "", "json", false)
+	outputsFlags.Bool(&config.yaml, "", "yaml", false)
+	outputsFlags.Bool(&config.showSensitive, "", "show-sensitive", false)
+	return outputsFlags
+}
+
+// formatOutputValue prints strings as they are and everything else,
+// such as lists of subnet IDs, as compact JSON.
+func formatOutputValue(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+
+	contents, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value) // not tested
+	}
+
+	return string(contents)
+}
@@ -0,0 +1,157 @@
+package commands_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/cloudfoundry/bosh-bootloader/commands"
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/cloudfoundry/bosh-bootloader/terraform"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Outputs", func() {
+	var (
+		outputs commands.Outputs
+
+		state            storage.State
+		logger           *fakes.Logger
+		stateValidator   *fakes.StateValidator
+		terraformManager *fakes.TerraformManager
+	)
+
+	BeforeEach(func() {
+		state = storage.State{TFState: "some-tf-state"}
+
+		logger = &fakes.Logger{}
+		stateValidator = &fakes.StateValidator{}
+		terraformManager = &fakes.TerraformManager{}
+		terraformManager.GetOutputsCall.Returns.Outputs = terraform.Outputs{
+			Map: map[string]interface{}{
+				"vpc_id":               "some-vpc-id",
+				"internal_az_subnets":  []interface{}{"subnet-1", "subnet-2"},
+				"bosh_vms_private_key": "some-private-key",
+			},
+			Sensitive: map[string]bool{
+				"bosh_vms_private_key": true,
+			},
+		}
+
+		outputs = commands.NewOutputs(logger, stateValidator, terraformManager, commands.TextOutput)
+	})
+
+	Describe("CheckFastFails", func() {
+		It("returns an error when the state is invalid", func() {
+			stateValidator.ValidateCall.Returns.Error = errors.New("no state")
+
+			err := outputs.CheckFastFails([]string{}, state)
+			Expect(err).To(MatchError("no state"))
+		})
+
+		It("returns an error when both --json and --yaml are passed", func() {
+			err := outputs.CheckFastFails([]string{"--json", "--yaml"}, state)
+			Expect(err).To(MatchError("--json and --yaml cannot be used together."))
+		})
+
+		It("returns an error when more than one output name is passed", func() {
+			err := outputs.CheckFastFails([]string{"vpc_id", "other"}, state)
+			Expect(err).To(MatchError("Unexpected arguments: other."))
+		})
+	})
+
+	Describe("Execute", func() {
+		It("prints every output sorted by name with sensitive outputs redacted", func() {
+			err := outputs.Execute([]string{}, state)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(terraformManager.GetOutputsCall.Receives.BBLState).To(Equal(state))
+			Expect(logger.PrintfCall.Messages).To(Equal([]string{
+				"bosh_vms_private_key: <sensitive>\n",
+				"internal_az_subnets: [\"subnet-1\",\"subnet-2\"]\n",
+				"vpc_id: some-vpc-id\n",
+			}))
+		})
+
+		It("prints sensitive outputs with --show-sensitive", func() {
+			err := outputs.Execute([]string{"--show-sensitive"}, state)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(logger.PrintfCall.Messages).To(ContainElement("bosh_vms_private_key: some-private-key\n"))
+		})
+
+		It("prints every output as json with --json", func() {
+			err := outputs.Execute([]string{"--json"}, state)
+			Expect(err).NotTo(HaveOccurred())
+
+			var printed map[string]interface{}
+			err = json.Unmarshal([]byte(logger.PrintlnCall.Messages[0]), &printed)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(printed).To(Equal(map[string]interface{}{
+				"vpc_id":               "some-vpc-id",
+				"internal_az_subnets":  []interface{}{"subnet-1", "subnet-2"},
+				"bosh_vms_private_key": "<sensitive>",
+			}))
+		})
+
+		It("prints every output as yaml with --yaml", func() {
+			err := outputs.Execute([]string{"--yaml"}, state)
+			Expect(err).NotTo(HaveOccurred())
+
+			var printed map[string]interface{}
+			err = yaml.Unmarshal([]byte(logger.PrintfCall.Messages[0]), &printed)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(printed).To(HaveKeyWithValue("vpc_id", "some-vpc-id"))
+			Expect(printed).To(HaveKeyWithValue("internal_az_subnets", []interface{}{"subnet-1", "subnet-2"}))
+			Expect(printed).To(HaveKeyWithValue("bosh_vms_private_key", "<sensitive>"))
+		})
+
+		Context("when an output name is passed", func() {
+			It("prints only that value", func() {
+				err := outputs.Execute([]string{"vpc_id"}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PrintlnCall.Messages).To(Equal([]string{"some-vpc-id"}))
+			})
+
+			It("prints lists as json", func() {
+				err := outputs.Execute([]string{"internal_az_subnets"}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PrintlnCall.Messages).To(Equal([]string{`["subnet-1","subnet-2"]`}))
+			})
+
+			It("accepts flags after the name", func() {
+				err := outputs.Execute([]string{"internal_az_subnets", "--yaml"}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PrintfCall.Messages).To(Equal([]string{"- subnet-1\n- subnet-2\n"}))
+			})
+
+			It("redacts a sensitive value unless --show-sensitive is passed", func() {
+				err := outputs.Execute([]string{"bosh_vms_private_key"}, state)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(logger.PrintlnCall.Messages).To(Equal([]string{"<sensitive>"}))
+
+				err = outputs.Execute([]string{"--show-sensitive", "bosh_vms_private_key"}, state)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(logger.PrintlnCall.Messages).To(ContainElement("some-private-key"))
+			})
+
+			It("returns an error when there is no such output", func() {
+				err := outputs.Execute([]string{"missing"}, state)
+				Expect(err).To(MatchError(`No output named "missing". Run "bbl outputs" to list them.`))
+			})
+		})
+
+		It("returns an error when the outputs cannot be read", func() {
+			terraformManager.GetOutputsCall.Returns.Error = errors.New("tomato")
+
+			err := outputs.Execute([]string{}, state)
+			Expect(err).To(MatchError("tomato"))
+		})
+	})
+})
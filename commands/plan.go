@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/cloudfoundry/bosh-bootloader/flags"
 	"github.com/cloudfoundry/bosh-bootloader/storage"
 	"github.com/cloudfoundry/bosh-bootloader/terraform"
 )
@@ -29,6 +30,10 @@ func NewPlan(up up, boshManager boshManager, cloudConfigManager cloudConfigManag
 	}
 }
 
+func (Plan) Flags() []flags.Flag {
+	return upFlagNames()
+}
+
 func (p Plan) CheckFastFails(args []string, state storage.State) error {
 	return p.up.CheckFastFails(args, state)
 }
@@ -50,6 +55,20 @@ func (p Plan) Execute(args []string, state storage.State) error {
 		state.NoDirector = true
 	}
 
+	if config.ExistingNetworkID != "" {
+		state.ExistingNetworkID = config.ExistingNetworkID
+	}
+
+	if config.PrivateDirector {
+		state.PrivateDirector = true
+	}
+
+	state.AllowedIngressCIDRs = allowedIngressCIDRs(config, state)
+
+	if len(config.AZs) > 0 {
+		state = setAZs(state, config.AZs)
+	}
+
 	err = p.stateStore.Set(state)
 	if err != nil {
 		return fmt.Errorf("Save state: %s", err)
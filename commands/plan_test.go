@@ -74,6 +74,61 @@ var _ = Describe("Plan", func() {
 			// Expect(cloudConfigManager.GenerateCall.Receives.State).To(Equal(state))
 		})
 
+		Context("when --existing-network-id is passed", func() {
+			It("saves the existing network id in the state", func() {
+				up.ParseArgsCall.Returns.Config = commands.UpConfig{ExistingNetworkID: "some-network-id"}
+
+				err := command.Execute([]string{"--existing-network-id", "some-network-id"}, storage.State{})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(stateStore.SetCall.Receives[0].State.ExistingNetworkID).To(Equal("some-network-id"))
+			})
+		})
+
+		Context("when --private-director is passed", func() {
+			It("saves the private director setting in the state", func() {
+				up.ParseArgsCall.Returns.Config = commands.UpConfig{PrivateDirector: true}
+
+				err := command.Execute([]string{"--private-director"}, storage.State{IAAS: "gcp"})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(stateStore.SetCall.Receives[0].State.PrivateDirector).To(BeTrue())
+			})
+		})
+
+		Context("when --azs is passed", func() {
+			It("saves the availability zones in the state", func() {
+				up.ParseArgsCall.Returns.Config = commands.UpConfig{AZs: []string{"z1", "z2"}}
+
+				err := command.Execute([]string{"--azs", "z1,z2"}, storage.State{IAAS: "aws"})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(stateStore.SetCall.Receives[0].State.AWS.AZs).To(Equal([]string{"z1", "z2"}))
+			})
+		})
+
+		Context("when --allowed-ingress-cidr is passed", func() {
+			It("saves the allowed ingress cidrs in the state", func() {
+				up.ParseArgsCall.Returns.Config = commands.UpConfig{AllowedIngressCIDRs: []string{"10.0.0.0/8"}}
+
+				err := command.Execute([]string{"--allowed-ingress-cidr", "10.0.0.0/8"}, storage.State{})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(stateStore.SetCall.Receives[0].State.AllowedIngressCIDRs).To(Equal([]string{"10.0.0.0/8"}))
+			})
+		})
+
+		Context("when --clear-allowed-ingress-cidrs is passed", func() {
+			It("removes the allowed ingress cidrs from the state", func() {
+				up.ParseArgsCall.Returns.Config = commands.UpConfig{ClearAllowedIngressCIDRs: true}
+
+				err := command.Execute([]string{"--clear-allowed-ingress-cidrs"}, storage.State{AllowedIngressCIDRs: []string{"10.0.0.0/8"}})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(stateStore.SetCall.Receives[0].State.AllowedIngressCIDRs).To(BeEmpty())
+			})
+		})
+
 		Context("when --no-director is passed", func() {
 			It("sets no director on the state", func() {
 				up.ParseArgsCall.Returns.Config = commands.UpConfig{NoDirector: true}
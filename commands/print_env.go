@@ -1,14 +1,17 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net"
+	"net/url"
 	"path/filepath"
 	"strings"
 
 	yaml "gopkg.in/yaml.v2"
 
+	"github.com/cloudfoundry/bosh-bootloader/flags"
 	"github.com/cloudfoundry/bosh-bootloader/storage"
 )
 
@@ -16,17 +19,19 @@ type PrintEnv struct {
 	stateValidator   stateValidator
 	logger           logger
 	terraformManager terraformOutputter
+	stateStore       stateStore
 }
 
 type envSetter interface {
 	Set(key, value string) error
 }
 
-func NewPrintEnv(logger logger, stateValidator stateValidator, terraformManager terraformOutputter) PrintEnv {
+func NewPrintEnv(logger logger, stateValidator stateValidator, terraformManager terraformOutputter, stateStore stateStore) PrintEnv {
 	return PrintEnv{
 		stateValidator:   stateValidator,
 		logger:           logger,
 		terraformManager: terraformManager,
+		stateStore:       stateStore,
 	}
 }
 
@@ -39,26 +44,63 @@ func (p PrintEnv) CheckFastFails(subcommandFlags []string, state storage.State)
 	return nil
 }
 
+func (PrintEnv) Flags() []flags.Flag {
+	var (
+		tunnel bool
+		shell  string
+	)
+	return newPrintEnvFlags(&tunnel, &shell).Registered()
+}
+
+func newPrintEnvFlags(tunnel *bool, shell *string) flags.Flags {
+	printEnvFlags := flags.New("print-env")
+	printEnvFlags.Bool(tunnel, "", "tunnel", false)
+	printEnvFlags.String(shell, "shell", "bash")
+	return printEnvFlags
+}
+
 func (p PrintEnv) Execute(args []string, state storage.State) error {
+	var (
+		tunnel bool
+		shell  string
+	)
+	printEnvFlags := newPrintEnvFlags(&tunnel, &shell)
+
+	err := printEnvFlags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	if !validShell(shell) {
+		return fmt.Errorf("Unknown --shell %q, must be one of: %s.", shell, strings.Join(printEnvShells, ", "))
+	}
+
 	if state.NoDirector {
 		directorAddress, err := p.getExternalIP(state)
 		if err != nil {
 			return err
 		}
-		p.logger.Println(fmt.Sprintf("export BOSH_ENVIRONMENT=https://%s:25555", directorAddress))
 
-		return nil
+		return p.printVars(shell, []envVar{
+			{"BOSH_ENVIRONMENT", fmt.Sprintf("https://%s:25555", directorAddress)},
+		})
 	}
 
-	p.logger.Println(fmt.Sprintf("export BOSH_CLIENT=%s", state.BOSH.DirectorUsername))
-	p.logger.Println(fmt.Sprintf("export BOSH_CLIENT_SECRET=%s", state.BOSH.DirectorPassword))
-	p.logger.Println(fmt.Sprintf("export BOSH_ENVIRONMENT=%s", state.BOSH.DirectorAddress))
-	p.logger.Println(fmt.Sprintf("export BOSH_CA_CERT='%s'", state.BOSH.DirectorSSLCA))
+	vars := []envVar{
+		{"BOSH_CLIENT", state.BOSH.DirectorUsername},
+		{"BOSH_CLIENT_SECRET", state.BOSH.DirectorPassword},
+		{"BOSH_ENVIRONMENT", state.BOSH.DirectorAddress},
+		{"BOSH_CA_CERT", state.BOSH.DirectorSSLCA},
+	}
 
-	portNumber, err := p.getPort()
-	if err != nil {
-		// not tested
-		return err
+	if tunnel {
+		tunnelPort, err := p.getTunnelPort()
+		if err != nil {
+			return err
+		}
+
+		vars = append(vars, envVar{"BOSH_ALL_PROXY", fmt.Sprintf("socks5://localhost:%s", tunnelPort)})
+		return p.printVars(shell, vars)
 	}
 
 	dir, err := ioutil.TempDir("", "bosh-jumpbox")
@@ -80,15 +122,81 @@ func (p PrintEnv) Execute(args []string, state storage.State) error {
 		return err
 	}
 
-	jumpboxURL := strings.Split(state.Jumpbox.URL, ":")[0]
+	if !runsSSHCommand(shell) {
+		proxy := fmt.Sprintf("ssh+socks5://jumpbox@%s?private-key=%s", state.Jumpbox.URL, url.QueryEscape(privateKeyPath))
+		vars = append(vars, envVar{"BOSH_ALL_PROXY", proxy}, envVar{"JUMPBOX_PRIVATE_KEY", privateKeyPath})
+
+		return p.printVars(shell, vars)
+	}
+
+	portNumber, err := p.getPort()
+	if err != nil {
+		// not tested
+		return err
+	}
 
-	p.logger.Println(fmt.Sprintf("export BOSH_ALL_PROXY=socks5://localhost:%s", portNumber))
-	p.logger.Println(fmt.Sprintf("export JUMPBOX_PRIVATE_KEY=%s", privateKeyPath))
+	vars = append(vars, envVar{"BOSH_ALL_PROXY", fmt.Sprintf("socks5://localhost:%s", portNumber)}, envVar{"JUMPBOX_PRIVATE_KEY", privateKeyPath})
+	err = p.printVars(shell, vars)
+	if err != nil {
+		return err // not tested
+	}
+
+	jumpboxURL := strings.Split(state.Jumpbox.URL, ":")[0]
 	p.logger.Println(fmt.Sprintf("ssh -f -N -o StrictHostKeyChecking=no -o ServerAliveInterval=300 -D %s jumpbox@%s -i $JUMPBOX_PRIVATE_KEY", portNumber, jumpboxURL))
 
 	return nil
 }
 
+func (p PrintEnv) printVars(shell string, vars []envVar) error {
+	if shell == "cmd" {
+		var err error
+		vars, err = p.multilineValuesToFiles(vars)
+		if err != nil {
+			return err
+		}
+	}
+
+	lines, err := formatEnv(shell, vars)
+	if err != nil {
+		return err
+	}
+
+	for _, line := range lines {
+		p.logger.Println(line)
+	}
+
+	return nil
+}
+
+// multilineValuesToFiles writes every multi-line value to a file and
+// replaces the value with its path. The bosh CLI accepts a path wherever
+// it accepts a certificate.
+func (p PrintEnv) multilineValuesToFiles(vars []envVar) ([]envVar, error) {
+	var dir string
+	for i, v := range vars {
+		if !strings.Contains(v.value, "\n") {
+			continue
+		}
+
+		if dir == "" {
+			var err error
+			dir, err = ioutil.TempDir("", "bosh-env")
+			if err != nil {
+				return nil, err // not tested
+			}
+		}
+
+		path := filepath.Join(dir, strings.ToLower(v.name))
+		err := ioutil.WriteFile(path, []byte(v.value), 0600)
+		if err != nil {
+			return nil, err // not tested
+		}
+		vars[i].value = path
+	}
+
+	return vars, nil
+}
+
 func (p PrintEnv) getExternalIP(state storage.State) (string, error) {
 	terraformOutputs, err := p.terraformManager.GetOutputs(state)
 	if err != nil {
@@ -98,6 +206,20 @@ func (p PrintEnv) getExternalIP(state storage.State) (string, error) {
 	return terraformOutputs.GetString("external_ip"), nil
 }
 
+func (p PrintEnv) getTunnelPort() (string, error) {
+	bblDir, err := p.stateStore.GetBblDir()
+	if err != nil {
+		return "", fmt.Errorf("Get bbl dir: %s", err)
+	}
+
+	port, err := readTunnelFile(filepath.Join(bblDir, tunnelPortFile))
+	if err != nil {
+		return "", errors.New("No tunnel is running. Start one with \"bbl tunnel\" or \"bbl tunnel --daemon\".")
+	}
+
+	return port, nil
+}
+
 func (p PrintEnv) getPort() (string, error) {
 	l, err := net.Listen("tcp4", "127.0.0.1:0")
 	if err != nil {
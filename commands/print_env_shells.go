@@ -0,0 +1,103 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var printEnvShells = []string{"bash", "zsh", "fish", "powershell", "cmd", "json", "dotenv"}
+
+var posixSafeValue = regexp.MustCompile(`^[A-Za-z0-9_@%+=:,./-]+$`)
+
+type envVar struct {
+	name  string
+	value string
+}
+
+func validShell(shell string) bool {
+	for _, s := range printEnvShells {
+		if s == shell {
+			return true
+		}
+	}
+
+	return false
+}
+
+// runsSSHCommand reports whether eval'ing the output can start a
+// backgrounded "ssh -D". The other formats point BOSH_ALL_PROXY at the
+// jumpbox with the bosh CLI's ssh+socks5 scheme instead.
+func runsSSHCommand(shell string) bool {
+	return shell == "bash" || shell == "zsh" || shell == "fish"
+}
+
+// formatEnv renders vars for shell. cmd cannot hold newlines in a
+// variable, so multi-line values must be replaced with file paths before
+// they get here.
+func formatEnv(shell string, vars []envVar) ([]string, error) {
+	if shell == "json" {
+		env := map[string]string{}
+		for _, v := range vars {
+			env[v.name] = v.value
+		}
+
+		contents, err := json.MarshalIndent(env, "", "  ")
+		if err != nil {
+			return nil, err // not tested
+		}
+
+		return []string{string(contents)}, nil
+	}
+
+	lines := []string{}
+	for _, v := range vars {
+		switch shell {
+		case "bash", "zsh":
+			value := posixQuote(v.value)
+			if v.name == "BOSH_CA_CERT" && value == v.value {
+				// print-env has always single-quoted the CA for bash.
+				value = "'" + value + "'"
+			}
+			lines = append(lines, fmt.Sprintf("export %s=%s", v.name, value))
+		case "fish":
+			lines = append(lines, fmt.Sprintf("set -gx %s %s;", v.name, fishQuote(v.value)))
+		case "powershell":
+			lines = append(lines, fmt.Sprintf("$env:%s = '%s'", v.name, strings.Replace(v.value, "'", "''", -1)))
+		case "cmd":
+			lines = append(lines, fmt.Sprintf(`set "%s=%s"`, v.name, v.value))
+		case "dotenv":
+			lines = append(lines, fmt.Sprintf(`%s="%s"`, v.name, dotenvEscape(v.value)))
+		default:
+			return nil, fmt.Errorf("unknown shell %q", shell) // not tested
+		}
+	}
+
+	return lines, nil
+}
+
+func posixQuote(value string) string {
+	if posixSafeValue.MatchString(value) {
+		return value
+	}
+
+	return "'" + strings.Replace(value, "'", `'\''`, -1) + "'"
+}
+
+func fishQuote(value string) string {
+	if posixSafeValue.MatchString(value) {
+		return value
+	}
+
+	value = strings.Replace(value, `\`, `\\`, -1)
+	value = strings.Replace(value, "'", `\'`, -1)
+	return "'" + value + "'"
+}
+
+func dotenvEscape(value string) string {
+	value = strings.Replace(value, `\`, `\\`, -1)
+	value = strings.Replace(value, `"`, `\"`, -1)
+	value = strings.Replace(value, "\n", `\n`, -1)
+	return value
+}
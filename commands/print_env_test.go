@@ -1,8 +1,11 @@
 package commands_test
 
 import (
+	"encoding/json"
 	"errors"
 	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/cloudfoundry/bosh-bootloader/commands"
@@ -18,6 +21,7 @@ var _ = Describe("PrintEnv", func() {
 		logger           *fakes.Logger
 		stateValidator   *fakes.StateValidator
 		terraformManager *fakes.TerraformManager
+		stateStore       *fakes.StateStore
 		printEnv         commands.PrintEnv
 		state            storage.State
 	)
@@ -26,6 +30,7 @@ var _ = Describe("PrintEnv", func() {
 		logger = &fakes.Logger{}
 		stateValidator = &fakes.StateValidator{}
 		terraformManager = &fakes.TerraformManager{}
+		stateStore = &fakes.StateStore{}
 
 		state = storage.State{
 			BOSH: storage.BOSH{
@@ -42,7 +47,7 @@ var _ = Describe("PrintEnv", func() {
 			},
 		}
 
-		printEnv = commands.NewPrintEnv(logger, stateValidator, terraformManager)
+		printEnv = commands.NewPrintEnv(logger, stateValidator, terraformManager, stateStore)
 	})
 
 	Describe("CheckFastFails", func() {
@@ -89,6 +94,168 @@ var _ = Describe("PrintEnv", func() {
 			}
 		})
 
+		Context("when --tunnel is passed", func() {
+			var bblDir string
+
+			BeforeEach(func() {
+				var err error
+				bblDir, err = ioutil.TempDir("", "bbl")
+				Expect(err).NotTo(HaveOccurred())
+
+				stateStore.GetBblDirCall.Returns.Directory = bblDir
+			})
+
+			AfterEach(func() {
+				os.RemoveAll(bblDir)
+			})
+
+			It("points BOSH_ALL_PROXY at the running tunnel", func() {
+				err := ioutil.WriteFile(filepath.Join(bblDir, "tunnel.port"), []byte("1080"), 0600)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = printEnv.Execute([]string{"--tunnel"}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PrintlnCall.Messages).To(ContainElement("export BOSH_CLIENT=some-director-username"))
+				Expect(logger.PrintlnCall.Messages).To(ContainElement("export BOSH_ALL_PROXY=socks5://localhost:1080"))
+				Expect(logger.PrintlnCall.Messages).NotTo(ContainElement(ContainSubstring("JUMPBOX_PRIVATE_KEY")))
+				Expect(logger.PrintlnCall.Messages).NotTo(ContainElement(ContainSubstring("ssh -f -N")))
+			})
+
+			It("returns an error when no tunnel is running", func() {
+				err := printEnv.Execute([]string{"--tunnel"}, state)
+				Expect(err).To(MatchError(`No tunnel is running. Start one with "bbl tunnel" or "bbl tunnel --daemon".`))
+			})
+
+			It("returns an error when the bbl dir cannot be found", func() {
+				stateStore.GetBblDirCall.Returns.Error = errors.New("pear")
+
+				err := printEnv.Execute([]string{"--tunnel"}, state)
+				Expect(err).To(MatchError("Get bbl dir: pear"))
+			})
+		})
+
+		Context("when the director CA spans several lines and the jumpbox URL has a port", func() {
+			BeforeEach(func() {
+				state.BOSH.DirectorSSLCA = "-----BEGIN CERTIFICATE-----\nsome-director-ca-cert\n-----END CERTIFICATE-----\n"
+				state.Jumpbox.URL = "some-magical-jumpbox-url:22"
+			})
+
+			It("keeps the CA in one quoted export and drops the port from the ssh command", func() {
+				err := printEnv.Execute([]string{}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PrintlnCall.Messages).To(ContainElement("export BOSH_CA_CERT='-----BEGIN CERTIFICATE-----\nsome-director-ca-cert\n-----END CERTIFICATE-----\n'"))
+				Expect(logger.PrintlnCall.Messages).To(ContainElement(MatchRegexp(`ssh -f -N -o StrictHostKeyChecking=no -o ServerAliveInterval=300 -D \d+ jumpbox@some-magical-jumpbox-url -i \$JUMPBOX_PRIVATE_KEY`)))
+			})
+		})
+
+		Context("when --shell is passed", func() {
+			BeforeEach(func() {
+				state.BOSH.DirectorPassword = "it's-a-secret"
+				state.BOSH.DirectorSSLCA = "-----BEGIN CERTIFICATE-----\nsome-director-ca-cert\n-----END CERTIFICATE-----\n"
+				state.Jumpbox.URL = "some-magical-jumpbox-url:22"
+			})
+
+			It("quotes values that need it for bash and zsh", func() {
+				err := printEnv.Execute([]string{"--shell", "zsh"}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PrintlnCall.Messages).To(ContainElement("export BOSH_CLIENT=some-director-username"))
+				Expect(logger.PrintlnCall.Messages).To(ContainElement(`export BOSH_CLIENT_SECRET='it'\''s-a-secret'`))
+				Expect(logger.PrintlnCall.Messages).To(ContainElement(ContainSubstring("ssh -f -N")))
+			})
+
+			It("prints fish set commands and the ssh command", func() {
+				err := printEnv.Execute([]string{"--shell", "fish"}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PrintlnCall.Messages).To(ContainElement("set -gx BOSH_CLIENT some-director-username;"))
+				Expect(logger.PrintlnCall.Messages).To(ContainElement(`set -gx BOSH_CLIENT_SECRET 'it\'s-a-secret';`))
+				Expect(logger.PrintlnCall.Messages).To(ContainElement("set -gx BOSH_CA_CERT '-----BEGIN CERTIFICATE-----\nsome-director-ca-cert\n-----END CERTIFICATE-----\n';"))
+				Expect(logger.PrintlnCall.Messages).To(ContainElement(MatchRegexp(`set -gx BOSH_ALL_PROXY socks5://localhost:\d+;`)))
+				Expect(logger.PrintlnCall.Messages).To(ContainElement(MatchRegexp(`ssh -f -N .* -i \$JUMPBOX_PRIVATE_KEY`)))
+			})
+
+			It("prints powershell assignments and proxies through the jumpbox with ssh+socks5", func() {
+				err := printEnv.Execute([]string{"--shell", "powershell"}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PrintlnCall.Messages).To(ContainElement("$env:BOSH_CLIENT = 'some-director-username'"))
+				Expect(logger.PrintlnCall.Messages).To(ContainElement("$env:BOSH_CLIENT_SECRET = 'it''s-a-secret'"))
+				Expect(logger.PrintlnCall.Messages).To(ContainElement("$env:BOSH_CA_CERT = '-----BEGIN CERTIFICATE-----\nsome-director-ca-cert\n-----END CERTIFICATE-----\n'"))
+				Expect(logger.PrintlnCall.Messages).To(ContainElement(MatchRegexp(`\$env:BOSH_ALL_PROXY = 'ssh\+socks5://jumpbox@some-magical-jumpbox-url:22\?private-key=.*bosh_jumpbox_private.key'`)))
+				Expect(logger.PrintlnCall.Messages).NotTo(ContainElement(ContainSubstring("ssh -f -N")))
+			})
+
+			It("writes multi-line values to files for cmd", func() {
+				err := printEnv.Execute([]string{"--shell", "cmd"}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PrintlnCall.Messages).To(ContainElement(`set "BOSH_CLIENT=some-director-username"`))
+				Expect(logger.PrintlnCall.Messages).To(ContainElement(MatchRegexp(`set "BOSH_ALL_PROXY=ssh\+socks5://jumpbox@some-magical-jumpbox-url:22\?private-key=.*"`)))
+
+				var caCertPath string
+				for _, line := range logger.PrintlnCall.Messages {
+					Expect(line).NotTo(ContainSubstring("\n"))
+					if strings.HasPrefix(line, `set "BOSH_CA_CERT=`) {
+						caCertPath = strings.TrimSuffix(strings.TrimPrefix(line, `set "BOSH_CA_CERT=`), `"`)
+					}
+				}
+
+				caCert, err := ioutil.ReadFile(caCertPath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(caCert)).To(Equal(state.BOSH.DirectorSSLCA))
+			})
+
+			It("prints a json object", func() {
+				err := printEnv.Execute([]string{"--shell", "json"}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PrintlnCall.Messages).To(HaveLen(1))
+
+				var env map[string]string
+				err = json.Unmarshal([]byte(logger.PrintlnCall.Messages[0]), &env)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(env).To(HaveKeyWithValue("BOSH_CLIENT", "some-director-username"))
+				Expect(env).To(HaveKeyWithValue("BOSH_CLIENT_SECRET", "it's-a-secret"))
+				Expect(env).To(HaveKeyWithValue("BOSH_ENVIRONMENT", "some-director-address"))
+				Expect(env).To(HaveKeyWithValue("BOSH_CA_CERT", state.BOSH.DirectorSSLCA))
+				Expect(env).To(HaveKeyWithValue("BOSH_ALL_PROXY", MatchRegexp(`ssh\+socks5://jumpbox@some-magical-jumpbox-url:22\?private-key=.*`)))
+				Expect(env).To(HaveKey("JUMPBOX_PRIVATE_KEY"))
+			})
+
+			It("prints dotenv lines with escaped newlines", func() {
+				err := printEnv.Execute([]string{"--shell", "dotenv"}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PrintlnCall.Messages).To(ContainElement(`BOSH_CLIENT="some-director-username"`))
+				Expect(logger.PrintlnCall.Messages).To(ContainElement(`BOSH_CA_CERT="-----BEGIN CERTIFICATE-----\nsome-director-ca-cert\n-----END CERTIFICATE-----\n"`))
+			})
+
+			It("uses the running tunnel with --tunnel", func() {
+				bblDir, err := ioutil.TempDir("", "bbl")
+				Expect(err).NotTo(HaveOccurred())
+				defer os.RemoveAll(bblDir)
+
+				stateStore.GetBblDirCall.Returns.Directory = bblDir
+				err = ioutil.WriteFile(filepath.Join(bblDir, "tunnel.port"), []byte("1080"), 0600)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = printEnv.Execute([]string{"--shell", "powershell", "--tunnel"}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PrintlnCall.Messages).To(ContainElement("$env:BOSH_ALL_PROXY = 'socks5://localhost:1080'"))
+				Expect(logger.PrintlnCall.Messages).NotTo(ContainElement(ContainSubstring("JUMPBOX_PRIVATE_KEY")))
+			})
+
+			It("returns an error for an unknown shell", func() {
+				err := printEnv.Execute([]string{"--shell", "tcsh"}, state)
+				Expect(err).To(MatchError(`Unknown --shell "tcsh", must be one of: bash, zsh, fish, powershell, cmd, json, dotenv.`))
+			})
+		})
+
 		Context("when the jumpbox variables yaml is invalid", func() {
 			It("returns the error", func() {
 				state.Jumpbox.Variables = "%%%"
@@ -3,6 +3,7 @@ package commands
 import (
 	"fmt"
 
+	"github.com/cloudfoundry/bosh-bootloader/flags"
 	"github.com/cloudfoundry/bosh-bootloader/storage"
 )
 
@@ -24,6 +25,10 @@ func NewRotate(stateValidator stateValidator, sshKeyDeleter sshKeyDeleter, up up
 	}
 }
 
+func (Rotate) Flags() []flags.Flag {
+	return upFlagNames()
+}
+
 func (r Rotate) CheckFastFails(subcommandFlags []string, state storage.State) error {
 	err := r.stateValidator.Validate()
 	if err != nil {
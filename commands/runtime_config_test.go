@@ -0,0 +1,76 @@
+package commands_test
+
+import (
+	"errors"
+
+	"github.com/cloudfoundry/bosh-bootloader/commands"
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RuntimeConfig", func() {
+	var (
+		logger               *fakes.Logger
+		stateValidator       *fakes.StateValidator
+		runtimeConfig        commands.RuntimeConfig
+		state                storage.State
+		runtimeConfigManager *fakes.RuntimeConfigManager
+	)
+
+	BeforeEach(func() {
+		logger = &fakes.Logger{}
+		stateValidator = &fakes.StateValidator{}
+		runtimeConfigManager = &fakes.RuntimeConfigManager{}
+
+		runtimeConfigManager.GenerateCall.Returns.RuntimeConfig = "some-runtime-config"
+
+		state = storage.State{
+			BOSH: storage.BOSH{
+				DirectorUsername: "some-director-username",
+				DirectorPassword: "some-director-password",
+				DirectorAddress:  "some-director-address",
+				DirectorSSLCA:    "some-director-ca-cert",
+			},
+		}
+
+		runtimeConfig = commands.NewRuntimeConfig(logger, stateValidator, runtimeConfigManager)
+	})
+
+	Describe("CheckFastFails", func() {
+		Context("when the state validator fails", func() {
+			BeforeEach(func() {
+				stateValidator.ValidateCall.Returns.Error = errors.New("failed to validate state")
+			})
+
+			It("returns an error", func() {
+				err := runtimeConfig.CheckFastFails([]string{}, storage.State{})
+				Expect(err).To(MatchError("failed to validate state"))
+			})
+		})
+	})
+
+	Describe("Execute", func() {
+		It("prints the runtime configuration for the bbl environment", func() {
+			err := runtimeConfig.Execute([]string{}, state)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(runtimeConfigManager.GenerateCall.CallCount).To(Equal(1))
+			Expect(runtimeConfigManager.GenerateCall.Receives.State).To(Equal(state))
+			Expect(logger.PrintlnCall.Messages).To(ContainElement("some-runtime-config"))
+		})
+
+		Context("failure cases", func() {
+			Context("when the runtime config manager fails to generate", func() {
+				BeforeEach(func() {
+					runtimeConfigManager.GenerateCall.Returns.Error = errors.New("failed to generate runtime configuration")
+				})
+
+				It("returns an error", func() {
+					err := runtimeConfig.Execute([]string{}, state)
+					Expect(err).To(MatchError("failed to generate runtime configuration"))
+				})
+			})
+		})
+	})
+})
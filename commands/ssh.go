@@ -0,0 +1,159 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+
+	"github.com/cloudfoundry/bosh-bootloader/flags"
+	"github.com/cloudfoundry/bosh-bootloader/ssh"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+)
+
+type SSH struct {
+	stateValidator stateValidator
+	stateStore     stateStore
+	sshKeyGetter   sshKeyGetter
+	sshClient      sshClient
+}
+
+type sshClient interface {
+	Run(hops []ssh.Hop, command string) ([]string, error)
+}
+
+type sshConfig struct {
+	director bool
+	command  string
+}
+
+func NewSSH(stateValidator stateValidator, stateStore stateStore, sshKeyGetter sshKeyGetter, sshClient sshClient) SSH {
+	return SSH{
+		stateValidator: stateValidator,
+		stateStore:     stateStore,
+		sshKeyGetter:   sshKeyGetter,
+		sshClient:      sshClient,
+	}
+}
+
+func (s SSH) CheckFastFails(subcommandFlags []string, state storage.State) error {
+	err := s.stateValidator.Validate()
+	if err != nil {
+		return err
+	}
+
+	config, err := s.parseFlags(subcommandFlags)
+	if err != nil {
+		return err
+	}
+
+	if config.director && state.NoDirector {
+		return errors.New("--director cannot be used with an environment created with --no-director.")
+	}
+
+	return nil
+}
+
+func (s SSH) Execute(subcommandFlags []string, state storage.State) error {
+	config, err := s.parseFlags(subcommandFlags)
+	if err != nil {
+		return err
+	}
+
+	jumpbox, err := jumpboxHop(s.sshKeyGetter, state)
+	if err != nil {
+		return err
+	}
+
+	hops := []ssh.Hop{jumpbox}
+
+	if config.director {
+		directorKey, err := sshPrivateKey(s.sshKeyGetter, state.BOSH.Variables, "director")
+		if err != nil {
+			return err
+		}
+
+		directorURL, err := url.Parse(state.BOSH.DirectorAddress)
+		if err != nil {
+			return fmt.Errorf("Parse director address: %s", err)
+		}
+
+		hops = append(hops, ssh.Hop{
+			Address:    net.JoinHostPort(directorURL.Hostname(), "22"),
+			User:       "jumpbox",
+			PrivateKey: directorKey,
+			HostKey:    state.BOSH.HostKey,
+		})
+	}
+
+	hostKeys, runErr := s.sshClient.Run(hops, config.command)
+
+	pinned := false
+	if len(hostKeys) > 0 && hostKeys[0] != "" && state.Jumpbox.HostKey == "" {
+		state.Jumpbox.HostKey = hostKeys[0]
+		pinned = true
+	}
+	if len(hostKeys) > 1 && hostKeys[1] != "" && state.BOSH.HostKey == "" {
+		state.BOSH.HostKey = hostKeys[1]
+		pinned = true
+	}
+
+	if pinned {
+		err = s.stateStore.Set(state)
+		if err != nil {
+			return fmt.Errorf("Save host keys: %s", err)
+		}
+	}
+
+	return runErr
+}
+
+func jumpboxHop(sshKeyGetter sshKeyGetter, state storage.State) (ssh.Hop, error) {
+	privateKey, err := sshPrivateKey(sshKeyGetter, state.Jumpbox.Variables, "jumpbox")
+	if err != nil {
+		return ssh.Hop{}, err
+	}
+
+	return ssh.Hop{
+		Address:    state.Jumpbox.URL,
+		User:       "jumpbox",
+		PrivateKey: privateKey,
+		HostKey:    state.Jumpbox.HostKey,
+	}, nil
+}
+
+func sshPrivateKey(sshKeyGetter sshKeyGetter, variables, name string) (string, error) {
+	privateKey, err := sshKeyGetter.Get(variables)
+	if err != nil {
+		return "", err
+	}
+
+	if privateKey == "" {
+		return "", fmt.Errorf("Could not retrieve the %s ssh key, please make sure you are targeting the proper state dir.", name)
+	}
+
+	return privateKey, nil
+}
+
+func (SSH) Flags() []flags.Flag {
+	return newSSHFlags(&sshConfig{}).Registered()
+}
+
+func (SSH) parseFlags(subcommandFlags []string) (sshConfig, error) {
+	c := sshConfig{}
+	sshFlags := newSSHFlags(&c)
+
+	err := sshFlags.Parse(subcommandFlags)
+	if err != nil {
+		return sshConfig{}, err
+	}
+
+	return c, nil
+}
+
+func newSSHFlags(c *sshConfig) flags.Flags {
+	sshFlags := flags.New("ssh")
+	sshFlags.Bool(&c.director, "", "director", false)
+	sshFlags.String(&c.command, "cmd", "")
+	return sshFlags
+}
@@ -11,28 +11,35 @@ type SSHKey struct {
 	logger         logger
 	stateValidator stateValidator
 	sshKeyGetter   sshKeyGetter
+	output         string
 	Director       bool
 }
 
+type sshKeyOutput struct {
+	PrivateKey string `json:"private_key" yaml:"private_key"`
+}
+
 type sshKeyGetter interface {
 	Get(string) (string, error)
 }
 
 var unmarshal = yaml.Unmarshal
 
-func NewSSHKey(logger logger, stateValidator stateValidator, sshKeyGetter sshKeyGetter) SSHKey {
+func NewSSHKey(logger logger, stateValidator stateValidator, sshKeyGetter sshKeyGetter, output string) SSHKey {
 	return SSHKey{
 		logger:         logger,
 		stateValidator: stateValidator,
 		sshKeyGetter:   sshKeyGetter,
+		output:         output,
 	}
 }
 
-func NewDirectorSSHKey(logger logger, stateValidator stateValidator, sshKeyGetter sshKeyGetter) SSHKey {
+func NewDirectorSSHKey(logger logger, stateValidator stateValidator, sshKeyGetter sshKeyGetter, output string) SSHKey {
 	return SSHKey{
 		logger:         logger,
 		stateValidator: stateValidator,
 		sshKeyGetter:   sshKeyGetter,
+		output:         output,
 		Director:       true,
 	}
 }
@@ -62,6 +69,10 @@ func (s SSHKey) Execute(subcommandFlags []string, state storage.State) error {
 		return errors.New("Could not retrieve the ssh key, please make sure you are targeting the proper state dir.")
 	}
 
+	if structuredOutput(s.output) {
+		return printFormatted(s.logger, s.output, sshKeyOutput{PrivateKey: privateKey})
+	}
+
 	s.logger.Println(privateKey)
 
 	return nil
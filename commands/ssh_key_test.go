@@ -37,7 +37,7 @@ var _ = Describe("SSHKey", func() {
 		sshKeyGetter = &fakes.SSHKeyGetter{}
 		sshKeyGetter.GetCall.Returns.PrivateKey = "some-private-ssh-key"
 
-		sshKeyCommand = commands.NewSSHKey(logger, stateValidator, sshKeyGetter)
+		sshKeyCommand = commands.NewSSHKey(logger, stateValidator, sshKeyGetter, commands.TextOutput)
 	})
 
 	Describe("CheckFastFails", func() {
@@ -65,7 +65,7 @@ var _ = Describe("SSHKey", func() {
 
 		Context("director-ssh-key", func() {
 			BeforeEach(func() {
-				sshKeyCommand = commands.NewDirectorSSHKey(logger, stateValidator, sshKeyGetter)
+				sshKeyCommand = commands.NewDirectorSSHKey(logger, stateValidator, sshKeyGetter, commands.TextOutput)
 			})
 
 			It("uses BOSH variables to get the SSH key", func() {
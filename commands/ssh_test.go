@@ -0,0 +1,176 @@
+package commands_test
+
+import (
+	"errors"
+
+	"github.com/cloudfoundry/bosh-bootloader/commands"
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/ssh"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SSH", func() {
+	var (
+		sshCommand commands.SSH
+
+		state storage.State
+
+		stateValidator *fakes.StateValidator
+		stateStore     *fakes.StateStore
+		sshKeyGetter   *fakes.SSHKeyGetter
+		sshClient      *fakes.SSHClient
+	)
+
+	BeforeEach(func() {
+		state = storage.State{
+			Jumpbox: storage.Jumpbox{
+				URL:       "some-jumpbox:22",
+				Variables: "some-jumpbox-variables",
+			},
+			BOSH: storage.BOSH{
+				DirectorAddress: "https://10.0.0.6:25555",
+				Variables:       "some-director-variables",
+			},
+		}
+
+		stateValidator = &fakes.StateValidator{}
+		stateStore = &fakes.StateStore{}
+		sshKeyGetter = &fakes.SSHKeyGetter{}
+		sshKeyGetter.GetCall.Returns.PrivateKey = "some-private-key"
+		sshClient = &fakes.SSHClient{}
+
+		sshCommand = commands.NewSSH(stateValidator, stateStore, sshKeyGetter, sshClient)
+	})
+
+	Describe("CheckFastFails", func() {
+		It("validates the state", func() {
+			err := sshCommand.CheckFastFails([]string{}, state)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(stateValidator.ValidateCall.CallCount).To(Equal(1))
+		})
+
+		It("returns an error when the state is invalid", func() {
+			stateValidator.ValidateCall.Returns.Error = errors.New("no state")
+
+			err := sshCommand.CheckFastFails([]string{}, state)
+			Expect(err).To(MatchError("no state"))
+		})
+
+		It("returns an error when --director is used without a director", func() {
+			state.NoDirector = true
+
+			err := sshCommand.CheckFastFails([]string{"--director"}, state)
+			Expect(err).To(MatchError("--director cannot be used with an environment created with --no-director."))
+		})
+
+		It("returns an error when the flags cannot be parsed", func() {
+			err := sshCommand.CheckFastFails([]string{"--unknown"}, state)
+			Expect(err).To(MatchError("flag provided but not defined: -unknown"))
+		})
+	})
+
+	Describe("Execute", func() {
+		It("opens a shell on the jumpbox", func() {
+			err := sshCommand.Execute([]string{}, state)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(sshKeyGetter.GetCall.Receives.Variables).To(Equal("some-jumpbox-variables"))
+			Expect(sshClient.RunCall.CallCount).To(Equal(1))
+			Expect(sshClient.RunCall.Receives.Hops).To(Equal([]ssh.Hop{
+				{Address: "some-jumpbox:22", User: "jumpbox", PrivateKey: "some-private-key"},
+			}))
+			Expect(sshClient.RunCall.Receives.Command).To(Equal(""))
+		})
+
+		It("runs a single command on the jumpbox", func() {
+			err := sshCommand.Execute([]string{"--cmd", "uptime"}, state)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(sshClient.RunCall.Receives.Command).To(Equal("uptime"))
+		})
+
+		It("connects to the director through the jumpbox", func() {
+			state.Jumpbox.HostKey = "jumpbox-host-key"
+			state.BOSH.HostKey = "director-host-key"
+
+			err := sshCommand.Execute([]string{"--director", "--cmd", "uptime"}, state)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(sshKeyGetter.GetCall.Receives.Variables).To(Equal("some-director-variables"))
+			Expect(sshClient.RunCall.Receives.Hops).To(Equal([]ssh.Hop{
+				{Address: "some-jumpbox:22", User: "jumpbox", PrivateKey: "some-private-key", HostKey: "jumpbox-host-key"},
+				{Address: "10.0.0.6:22", User: "jumpbox", PrivateKey: "some-private-key", HostKey: "director-host-key"},
+			}))
+			Expect(sshClient.RunCall.Receives.Command).To(Equal("uptime"))
+		})
+
+		Context("host keys", func() {
+			It("pins host keys that are not yet in the state", func() {
+				state.Jumpbox.HostKey = "jumpbox-host-key"
+				sshClient.RunCall.Returns.HostKeys = []string{"jumpbox-host-key", "director-host-key"}
+
+				err := sshCommand.Execute([]string{"--director"}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(stateStore.SetCall.CallCount).To(Equal(1))
+				Expect(stateStore.SetCall.Receives[0].State.Jumpbox.HostKey).To(Equal("jumpbox-host-key"))
+				Expect(stateStore.SetCall.Receives[0].State.BOSH.HostKey).To(Equal("director-host-key"))
+			})
+
+			It("does not save the state when every host key is already pinned", func() {
+				state.Jumpbox.HostKey = "jumpbox-host-key"
+				sshClient.RunCall.Returns.HostKeys = []string{"jumpbox-host-key"}
+
+				err := sshCommand.Execute([]string{}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(stateStore.SetCall.CallCount).To(Equal(0))
+			})
+
+			It("pins host keys even when the command fails", func() {
+				sshClient.RunCall.Returns.HostKeys = []string{"jumpbox-host-key"}
+				sshClient.RunCall.Returns.Error = errors.New("Process exited with status 1")
+
+				err := sshCommand.Execute([]string{"--cmd", "false"}, state)
+				Expect(err).To(MatchError("Process exited with status 1"))
+
+				Expect(stateStore.SetCall.CallCount).To(Equal(1))
+				Expect(stateStore.SetCall.Receives[0].State.Jumpbox.HostKey).To(Equal("jumpbox-host-key"))
+			})
+		})
+
+		Context("failure cases", func() {
+			It("returns an error when the ssh key cannot be retrieved", func() {
+				sshKeyGetter.GetCall.Returns.Error = errors.New("fig")
+
+				err := sshCommand.Execute([]string{}, state)
+				Expect(err).To(MatchError("fig"))
+			})
+
+			It("returns an error when the ssh key is missing", func() {
+				sshKeyGetter.GetCall.Returns.PrivateKey = ""
+
+				err := sshCommand.Execute([]string{}, state)
+				Expect(err).To(MatchError("Could not retrieve the jumpbox ssh key, please make sure you are targeting the proper state dir."))
+			})
+
+			It("returns an error when the director address cannot be parsed", func() {
+				state.BOSH.DirectorAddress = "%%%"
+
+				err := sshCommand.Execute([]string{"--director"}, state)
+				Expect(err).To(MatchError(ContainSubstring("Parse director address:")))
+			})
+
+			It("returns an error when the host keys cannot be saved", func() {
+				sshClient.RunCall.Returns.HostKeys = []string{"jumpbox-host-key"}
+				stateStore.SetCall.Returns = []fakes.SetCallReturn{{errors.New("kiwi")}}
+
+				err := sshCommand.Execute([]string{}, state)
+				Expect(err).To(MatchError("Save host keys: kiwi"))
+			})
+		})
+	})
+})
@@ -23,21 +23,34 @@ const (
 	DirectorCACertPropertyName   = "director ca cert"
 )
 
+// stateQueryOutputKeys are the keys of the one-field objects the state
+// queries print with --output json or yaml.
+var stateQueryOutputKeys = map[string]string{
+	EnvIDPropertyName:            "env_id",
+	JumpboxAddressPropertyName:   "jumpbox_address",
+	DirectorUsernamePropertyName: "director_username",
+	DirectorPasswordPropertyName: "director_password",
+	DirectorAddressPropertyName:  "director_address",
+	DirectorCACertPropertyName:   "director_ca_cert",
+}
+
 type StateQuery struct {
 	logger           logger
 	stateValidator   stateValidator
 	terraformManager terraformOutputter
 	propertyName     string
+	output           string
 }
 
 type getPropertyFunc func(storage.State) string
 
-func NewStateQuery(logger logger, stateValidator stateValidator, terraformManager terraformOutputter, propertyName string) StateQuery {
+func NewStateQuery(logger logger, stateValidator stateValidator, terraformManager terraformOutputter, propertyName string, output string) StateQuery {
 	return StateQuery{
 		logger:           logger,
 		stateValidator:   stateValidator,
 		terraformManager: terraformManager,
 		propertyName:     propertyName,
+		output:           output,
 	}
 }
 
@@ -84,6 +97,12 @@ func (s StateQuery) Execute(subcommandFlags []string, state storage.State) error
 		return fmt.Errorf("Could not retrieve %s, please make sure you are targeting the proper state dir.", s.propertyName)
 	}
 
+	if structuredOutput(s.output) {
+		return printFormatted(s.logger, s.output, map[string]string{
+			stateQueryOutputKeys[s.propertyName]: propertyValue,
+		})
+	}
+
 	s.logger.Println(propertyValue)
 	return nil
 }
@@ -35,7 +35,7 @@ var _ = Describe("StateQuery", func() {
 			})
 
 			It("returns an error", func() {
-				command := commands.NewStateQuery(fakeLogger, fakeStateValidator, fakeTerraformManager, "")
+				command := commands.NewStateQuery(fakeLogger, fakeStateValidator, fakeTerraformManager, "", commands.TextOutput)
 
 				err := command.CheckFastFails([]string{}, storage.State{})
 				Expect(err).To(MatchError("state validator failed"))
@@ -54,7 +54,7 @@ var _ = Describe("StateQuery", func() {
 
 			DescribeTable("prints out the director information",
 				func(propertyName string) {
-					command := commands.NewStateQuery(fakeLogger, fakeStateValidator, fakeTerraformManager, propertyName)
+					command := commands.NewStateQuery(fakeLogger, fakeStateValidator, fakeTerraformManager, propertyName, commands.TextOutput)
 
 					err := command.CheckFastFails([]string{}, state)
 					Expect(err).To(MatchError("Error BBL does not manage this director."))
@@ -75,7 +75,7 @@ var _ = Describe("StateQuery", func() {
 			})
 
 			It("prints out the jumpbox information", func() {
-				command := commands.NewStateQuery(fakeLogger, fakeStateValidator, fakeTerraformManager, "jumpbox address")
+				command := commands.NewStateQuery(fakeLogger, fakeStateValidator, fakeTerraformManager, "jumpbox address", commands.TextOutput)
 
 				err := command.Execute([]string{}, storage.State{})
 				Expect(err).NotTo(HaveOccurred())
@@ -100,7 +100,7 @@ var _ = Describe("StateQuery", func() {
 
 			DescribeTable("prints out the director information",
 				func(propertyName, expectedOutput string) {
-					command := commands.NewStateQuery(fakeLogger, fakeStateValidator, fakeTerraformManager, propertyName)
+					command := commands.NewStateQuery(fakeLogger, fakeStateValidator, fakeTerraformManager, propertyName, commands.TextOutput)
 
 					err := command.Execute([]string{}, state)
 					Expect(err).NotTo(HaveOccurred())
@@ -125,7 +125,7 @@ var _ = Describe("StateQuery", func() {
 			})
 
 			It("prints the env id", func() {
-				command := commands.NewStateQuery(fakeLogger, fakeStateValidator, fakeTerraformManager, "environment id")
+				command := commands.NewStateQuery(fakeLogger, fakeStateValidator, fakeTerraformManager, "environment id", commands.TextOutput)
 
 				err := command.Execute([]string{}, state)
 				Expect(err).NotTo(HaveOccurred())
@@ -138,7 +138,7 @@ var _ = Describe("StateQuery", func() {
 					Map: map[string]interface{}{"external_ip": "some-external-ip"},
 				}
 
-				command := commands.NewStateQuery(fakeLogger, fakeStateValidator, fakeTerraformManager, "director address")
+				command := commands.NewStateQuery(fakeLogger, fakeStateValidator, fakeTerraformManager, "director address", commands.TextOutput)
 				err := command.Execute([]string{}, state)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(fakeLogger.PrintlnCall.Receives.Message).To(Equal("https://some-external-ip:25555"))
@@ -152,7 +152,7 @@ var _ = Describe("StateQuery", func() {
 				})
 
 				It("director-address returns an error for no-director environment", func() {
-					command := commands.NewStateQuery(fakeLogger, fakeStateValidator, fakeTerraformManager, "director address")
+					command := commands.NewStateQuery(fakeLogger, fakeStateValidator, fakeTerraformManager, "director address", commands.TextOutput)
 
 					err := command.Execute([]string{}, storage.State{
 						IAAS:       "gcp",
@@ -162,7 +162,7 @@ var _ = Describe("StateQuery", func() {
 				})
 
 				It("jumpbox-address returns an error", func() {
-					command := commands.NewStateQuery(fakeLogger, fakeStateValidator, fakeTerraformManager, "jumpbox address")
+					command := commands.NewStateQuery(fakeLogger, fakeStateValidator, fakeTerraformManager, "jumpbox address", commands.TextOutput)
 
 					err := command.Execute([]string{}, storage.State{})
 					Expect(err).To(MatchError("failed to get terraform output"))
@@ -172,7 +172,7 @@ var _ = Describe("StateQuery", func() {
 			Context("when the state value is empty", func() {
 				It("returns an error", func() {
 					propertyName := fmt.Sprintf("%s-%d", "some-name", rand.Int())
-					command := commands.NewStateQuery(fakeLogger, fakeStateValidator, fakeTerraformManager, propertyName)
+					command := commands.NewStateQuery(fakeLogger, fakeStateValidator, fakeTerraformManager, propertyName, commands.TextOutput)
 					err := command.Execute([]string{}, storage.State{
 						BOSH: storage.BOSH{},
 					})
@@ -0,0 +1,228 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cloudfoundry/bosh-bootloader/bosh"
+	"github.com/cloudfoundry/bosh-bootloader/flags"
+	"github.com/cloudfoundry/bosh-bootloader/ssh"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/cloudfoundry/bosh-bootloader/terraform"
+)
+
+// directorPingTimeout bounds the single request status makes to the
+// director, so that an unreachable director is reported without the retries
+// of the other commands.
+var directorPingTimeout = 5 * time.Second
+
+type Status struct {
+	logger             logger
+	stateValidator     stateValidator
+	sshKeyGetter       sshKeyGetter
+	sshPinger          sshPinger
+	boshClientProvider boshClientProvider
+	output             string
+}
+
+type sshPinger interface {
+	Ping(hops []ssh.Hop) error
+}
+
+type boshClientProvider interface {
+	Client(jumpbox storage.Jumpbox, directorAddress, directorUsername, directorPassword, directorCACert string) (bosh.Client, error)
+}
+
+type environmentStatus struct {
+	IAAS               string       `json:"iaas"                yaml:"iaas"`
+	Region             string       `json:"region,omitempty"    yaml:"region,omitempty"`
+	EnvID              string       `json:"env_id"              yaml:"env_id"`
+	StateVersion       int          `json:"state_version"       yaml:"state_version"`
+	LBType             string       `json:"lb_type,omitempty"   yaml:"lb_type,omitempty"`
+	LBDomain           string       `json:"lb_domain,omitempty" yaml:"lb_domain,omitempty"`
+	TerraformResources int          `json:"terraform_resources" yaml:"terraform_resources"`
+	Jumpbox            healthCheck  `json:"jumpbox"             yaml:"jumpbox"`
+	Director           *healthCheck `json:"director,omitempty"  yaml:"director,omitempty"`
+}
+
+type healthCheck struct {
+	Address   string `json:"address"         yaml:"address"`
+	Reachable bool   `json:"reachable"       yaml:"reachable"`
+	Error     string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+func NewStatus(logger logger, stateValidator stateValidator, sshKeyGetter sshKeyGetter, sshPinger sshPinger,
+	boshClientProvider boshClientProvider, output string) Status {
+	return Status{
+		logger:             logger,
+		stateValidator:     stateValidator,
+		sshKeyGetter:       sshKeyGetter,
+		sshPinger:          sshPinger,
+		boshClientProvider: boshClientProvider,
+		output:             output,
+	}
+}
+
+func (s Status) CheckFastFails(subcommandFlags []string, state storage.State) error {
+	_, err := s.parseFlags(subcommandFlags)
+	if err != nil {
+		return err
+	}
+
+	return s.stateValidator.Validate()
+}
+
+func (s Status) Execute(subcommandFlags []string, state storage.State) error {
+	asJSON, err := s.parseFlags(subcommandFlags)
+	if err != nil {
+		return err
+	}
+
+	resources, err := terraform.ResourceCount(state.TFState)
+	if err != nil {
+		return err
+	}
+
+	status := environmentStatus{
+		IAAS:               state.IAAS,
+		Region:             stateRegion(state),
+		EnvID:              state.EnvID,
+		StateVersion:       state.Version,
+		LBType:             state.LB.Type,
+		LBDomain:           state.LB.Domain,
+		TerraformResources: resources,
+		Jumpbox:            s.checkJumpbox(state),
+	}
+
+	if !state.NoDirector {
+		director := s.checkDirector(state)
+		status.Director = &director
+	}
+
+	output := s.output
+	if asJSON {
+		output = compactJSONOutput
+	}
+
+	if structuredOutput(output) {
+		err = printFormatted(s.logger, output, status)
+		if err != nil {
+			return err // not tested
+		}
+	} else {
+		s.print(status)
+	}
+
+	if !status.Jumpbox.Reachable || (status.Director != nil && !status.Director.Reachable) {
+		return errors.New("The environment is not healthy.")
+	}
+
+	return nil
+}
+
+func (s Status) checkJumpbox(state storage.State) healthCheck {
+	check := healthCheck{Address: state.Jumpbox.URL}
+
+	jumpbox, err := jumpboxHop(s.sshKeyGetter, state)
+	if err == nil {
+		err = s.sshPinger.Ping([]ssh.Hop{jumpbox})
+	}
+
+	if err != nil {
+		check.Error = err.Error()
+		return check
+	}
+
+	check.Reachable = true
+	return check
+}
+
+func (s Status) checkDirector(state storage.State) healthCheck {
+	check := healthCheck{Address: state.BOSH.DirectorAddress}
+
+	boshClient, err := s.boshClientProvider.Client(state.Jumpbox, state.BOSH.DirectorAddress, state.BOSH.DirectorUsername, state.BOSH.DirectorPassword, state.BOSH.DirectorSSLCA)
+	if err == nil {
+		err = boshClient.Ping(directorPingTimeout)
+	}
+
+	if err != nil {
+		check.Error = err.Error()
+		return check
+	}
+
+	check.Reachable = true
+	return check
+}
+
+func (s Status) print(status environmentStatus) {
+	lb := "none"
+	if status.LBType != "" {
+		lb = status.LBType
+		if status.LBDomain != "" {
+			lb = fmt.Sprintf("%s (%s)", status.LBType, status.LBDomain)
+		}
+	}
+
+	s.logger.Printf("IAAS:                %s\n", status.IAAS)
+	s.logger.Printf("Region:              %s\n", status.Region)
+	s.logger.Printf("Environment ID:      %s\n", status.EnvID)
+	s.logger.Printf("State version:       %d\n", status.StateVersion)
+	s.logger.Printf("Load balancer:       %s\n", lb)
+	s.logger.Printf("Terraform resources: %d\n", status.TerraformResources)
+	s.logger.Printf("Jumpbox:             %s\n", describeHealth(status.Jumpbox))
+
+	if status.Director == nil {
+		s.logger.Printf("Director:            none (created with --no-director)\n")
+	} else {
+		s.logger.Printf("Director:            %s\n", describeHealth(*status.Director))
+	}
+}
+
+func describeHealth(check healthCheck) string {
+	if check.Reachable {
+		return fmt.Sprintf("reachable at %s", check.Address)
+	}
+
+	return fmt.Sprintf("unreachable at %s: %s", check.Address, check.Error)
+}
+
+func stateRegion(state storage.State) string {
+	switch state.IAAS {
+	case "aws":
+		return state.AWS.Region
+	case "gcp":
+		return state.GCP.Region
+	case "azure":
+		return state.Azure.Location
+	case "openstack":
+		return state.OpenStack.Region
+	case "vsphere":
+		return state.VSphere.VCenterDC
+	}
+
+	return ""
+}
+
+func (Status) Flags() []flags.Flag {
+	var asJSON bool
+	return newStatusFlags(&asJSON).Registered()
+}
+
+func (Status) parseFlags(subcommandFlags []string) (bool, error) {
+	var asJSON bool
+	statusFlags := newStatusFlags(&asJSON)
+
+	err := statusFlags.Parse(subcommandFlags)
+	if err != nil {
+		return false, err
+	}
+
+	return asJSON, nil
+}
+
+func newStatusFlags(asJSON *bool) flags.Flags {
+	statusFlags := flags.New("status")
+	statusFlags.Bool(asJSON, "", "json", false)
+	return statusFlags
+}
@@ -0,0 +1,191 @@
+package commands_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/cloudfoundry/bosh-bootloader/commands"
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/ssh"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Status", func() {
+	var (
+		status commands.Status
+
+		state storage.State
+
+		logger             *fakes.Logger
+		stateValidator     *fakes.StateValidator
+		sshKeyGetter       *fakes.SSHKeyGetter
+		sshPinger          *fakes.SSHPinger
+		boshClientProvider *fakes.BOSHClientProvider
+		boshClient         *fakes.BOSHClient
+	)
+
+	BeforeEach(func() {
+		state = storage.State{
+			Version: 13,
+			IAAS:    "aws",
+			EnvID:   "some-env-id",
+			AWS: storage.AWS{
+				Region: "some-region",
+			},
+			LB: storage.LB{
+				Type:   "cf",
+				Domain: "cf.example.com",
+			},
+			TFState: `{"modules": [{"resources": {"aws_vpc.vpc": {}, "aws_subnet.bosh_subnet": {}}}]}`,
+			Jumpbox: storage.Jumpbox{
+				URL:       "some-jumpbox:22",
+				Variables: "some-jumpbox-variables",
+				HostKey:   "some-host-key",
+			},
+			BOSH: storage.BOSH{
+				DirectorAddress: "https://10.0.0.6:25555",
+			},
+		}
+
+		logger = &fakes.Logger{}
+		stateValidator = &fakes.StateValidator{}
+		sshKeyGetter = &fakes.SSHKeyGetter{}
+		sshKeyGetter.GetCall.Returns.PrivateKey = "some-private-key"
+		sshPinger = &fakes.SSHPinger{}
+		boshClient = &fakes.BOSHClient{}
+		boshClientProvider = &fakes.BOSHClientProvider{}
+		boshClientProvider.ClientCall.Returns.Client = boshClient
+
+		status = commands.NewStatus(logger, stateValidator, sshKeyGetter, sshPinger, boshClientProvider, commands.TextOutput)
+	})
+
+	Describe("CheckFastFails", func() {
+		It("returns an error when the state is invalid", func() {
+			stateValidator.ValidateCall.Returns.Error = errors.New("no state")
+
+			err := status.CheckFastFails([]string{}, state)
+			Expect(err).To(MatchError("no state"))
+		})
+	})
+
+	Describe("Execute", func() {
+		It("prints an overview of the environment", func() {
+			err := status.Execute([]string{}, state)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(logger.PrintfCall.Messages).To(Equal([]string{
+				"IAAS:                aws\n",
+				"Region:              some-region\n",
+				"Environment ID:      some-env-id\n",
+				"State version:       13\n",
+				"Load balancer:       cf (cf.example.com)\n",
+				"Terraform resources: 2\n",
+				"Jumpbox:             reachable at some-jumpbox:22\n",
+				"Director:            reachable at https://10.0.0.6:25555\n",
+			}))
+		})
+
+		It("pings the jumpbox over ssh and checks the director", func() {
+			err := status.Execute([]string{}, state)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(sshPinger.PingCall.Receives.Hops).To(Equal([]ssh.Hop{
+				{Address: "some-jumpbox:22", User: "jumpbox", PrivateKey: "some-private-key", HostKey: "some-host-key"},
+			}))
+			Expect(boshClientProvider.ClientCall.Receives.Jumpbox).To(Equal(state.Jumpbox))
+			Expect(boshClientProvider.ClientCall.Receives.DirectorAddress).To(Equal("https://10.0.0.6:25555"))
+			Expect(boshClient.PingCall.CallCount).To(Equal(1))
+			Expect(boshClient.PingCall.Receives.Timeout).To(Equal(5 * time.Second))
+			Expect(boshClient.InfoCall.CallCount).To(Equal(0))
+		})
+
+		It("prints the overview as json", func() {
+			err := status.Execute([]string{"--json"}, state)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(logger.PrintlnCall.Messages).To(HaveLen(1))
+			Expect(logger.PrintlnCall.Messages[0]).To(MatchJSON(`{
+				"iaas": "aws",
+				"region": "some-region",
+				"env_id": "some-env-id",
+				"state_version": 13,
+				"lb_type": "cf",
+				"lb_domain": "cf.example.com",
+				"terraform_resources": 2,
+				"jumpbox": {"address": "some-jumpbox:22", "reachable": true},
+				"director": {"address": "https://10.0.0.6:25555", "reachable": true}
+			}`))
+		})
+
+		Context("when the environment has no director", func() {
+			BeforeEach(func() {
+				state.NoDirector = true
+				state.LB = storage.LB{}
+			})
+
+			It("does not check the director", func() {
+				err := status.Execute([]string{}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(boshClientProvider.ClientCall.CallCount).To(Equal(0))
+				Expect(logger.PrintfCall.Messages).To(ContainElement("Load balancer:       none\n"))
+				Expect(logger.PrintfCall.Messages).To(ContainElement("Director:            none (created with --no-director)\n"))
+			})
+		})
+
+		Context("when the jumpbox or director is unreachable", func() {
+			BeforeEach(func() {
+				sshPinger.PingCall.Returns.Error = errors.New("connection refused")
+				boshClient.PingCall.Returns.Error = errors.New("director timed out")
+			})
+
+			It("reports both and returns an error", func() {
+				err := status.Execute([]string{}, state)
+				Expect(err).To(MatchError("The environment is not healthy."))
+
+				Expect(logger.PrintfCall.Messages).To(ContainElement("Jumpbox:             unreachable at some-jumpbox:22: connection refused\n"))
+				Expect(logger.PrintfCall.Messages).To(ContainElement("Director:            unreachable at https://10.0.0.6:25555: director timed out\n"))
+			})
+
+			It("includes the errors in the json", func() {
+				status.Execute([]string{"--json"}, state)
+
+				Expect(logger.PrintlnCall.Messages[0]).To(ContainSubstring(`"jumpbox":{"address":"some-jumpbox:22","reachable":false,"error":"connection refused"}`))
+			})
+		})
+
+		Context("when the proxy to the director cannot be started", func() {
+			It("reports the director as unreachable without pinging it", func() {
+				boshClientProvider.ClientCall.Returns.Error = errors.New("start proxy: no jumpbox")
+
+				err := status.Execute([]string{}, state)
+				Expect(err).To(MatchError("The environment is not healthy."))
+
+				Expect(boshClient.PingCall.CallCount).To(Equal(0))
+				Expect(logger.PrintfCall.Messages).To(ContainElement("Director:            unreachable at https://10.0.0.6:25555: start proxy: no jumpbox\n"))
+			})
+		})
+
+		Context("when the jumpbox ssh key is missing", func() {
+			It("reports the jumpbox as unreachable without dialing it", func() {
+				sshKeyGetter.GetCall.Returns.PrivateKey = ""
+
+				err := status.Execute([]string{}, state)
+				Expect(err).To(MatchError("The environment is not healthy."))
+
+				Expect(sshPinger.PingCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("when the terraform state cannot be parsed", func() {
+			It("returns an error", func() {
+				state.TFState = "%%%"
+
+				err := status.Execute([]string{}, state)
+				Expect(err).To(MatchError(ContainSubstring("parse terraform state:")))
+			})
+		})
+	})
+})
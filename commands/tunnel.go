@@ -0,0 +1,346 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/cloudfoundry/bosh-bootloader/flags"
+	"github.com/cloudfoundry/bosh-bootloader/ssh"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+)
+
+const (
+	tunnelPIDFile  = "tunnel.pid"
+	tunnelPortFile = "tunnel.port"
+	tunnelLogFile  = "tunnel.log"
+)
+
+// tunnelStartTimeout bounds how long bbl waits for a background tunnel to
+// connect to the jumpbox and start listening.
+var (
+	tunnelStartTimeout      = 30 * time.Second
+	tunnelStartPollInterval = 100 * time.Millisecond
+)
+
+type Tunnel struct {
+	logger         logger
+	stateValidator stateValidator
+	stateStore     stateStore
+	sshKeyGetter   sshKeyGetter
+	socks5Tunnel   socks5Tunnel
+	daemon         daemon
+}
+
+type socks5Tunnel interface {
+	Connect(jumpbox ssh.Hop) (string, error)
+	Serve(listener net.Listener) error
+}
+
+type daemon interface {
+	Start(logPath string) (int, error)
+	Running(pid int) bool
+	Stop(pid int) error
+}
+
+type tunnelConfig struct {
+	port   int
+	daemon bool
+	stop   bool
+}
+
+func NewTunnel(logger logger, stateValidator stateValidator, stateStore stateStore, sshKeyGetter sshKeyGetter,
+	socks5Tunnel socks5Tunnel, daemon daemon) Tunnel {
+	return Tunnel{
+		logger:         logger,
+		stateValidator: stateValidator,
+		stateStore:     stateStore,
+		sshKeyGetter:   sshKeyGetter,
+		socks5Tunnel:   socks5Tunnel,
+		daemon:         daemon,
+	}
+}
+
+func (t Tunnel) CheckFastFails(subcommandFlags []string, state storage.State) error {
+	err := t.stateValidator.Validate()
+	if err != nil {
+		return err
+	}
+
+	config, err := t.parseFlags(subcommandFlags)
+	if err != nil {
+		return err
+	}
+
+	if config.port < 0 || config.port > 65535 {
+		return fmt.Errorf("Invalid --port %d.", config.port)
+	}
+
+	return nil
+}
+
+func (t Tunnel) Execute(subcommandFlags []string, state storage.State) error {
+	config, err := t.parseFlags(subcommandFlags)
+	if err != nil {
+		return err
+	}
+
+	bblDir, err := t.stateStore.GetBblDir()
+	if err != nil {
+		return fmt.Errorf("Get bbl dir: %s", err)
+	}
+
+	switch {
+	case config.stop:
+		return t.stopDaemon(bblDir)
+	case config.daemon:
+		return t.startDaemon(bblDir)
+	}
+
+	return t.serve(config.port, bblDir, state)
+}
+
+func (t Tunnel) serve(port int, bblDir string, state storage.State) error {
+	defer removeOwnPIDFile(bblDir)
+
+	jumpbox, err := jumpboxHop(t.sshKeyGetter, state)
+	if err != nil {
+		return err
+	}
+
+	hostKey, err := t.socks5Tunnel.Connect(jumpbox)
+	if err != nil {
+		return fmt.Errorf("Connect to jumpbox: %s", err)
+	}
+
+	if hostKey != "" && state.Jumpbox.HostKey == "" {
+		state.Jumpbox.HostKey = hostKey
+		err = t.stateStore.Set(state)
+		if err != nil {
+			return fmt.Errorf("Save host keys: %s", err)
+		}
+	}
+
+	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+	if err != nil {
+		return fmt.Errorf("Listen on port %d: %s", port, err)
+	}
+	defer listener.Close()
+
+	_, listenPort, err := net.SplitHostPort(listener.Addr().String())
+	if err != nil {
+		return err // not tested
+	}
+
+	portPath := filepath.Join(bblDir, tunnelPortFile)
+	err = ioutil.WriteFile(portPath, []byte(listenPort), 0600)
+	if err != nil {
+		return fmt.Errorf("Write tunnel port: %s", err)
+	}
+	defer os.Remove(portPath)
+
+	t.logger.Printf("SOCKS5 proxy listening on %s. Run eval \"$(bbl print-env --tunnel)\" to use it.\n", listener.Addr())
+
+	stopped := make(chan struct{})
+	done := make(chan struct{})
+	defer close(done)
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+
+	go func() {
+		select {
+		case <-signals:
+			close(stopped)
+			listener.Close()
+		case <-done:
+		}
+	}()
+
+	err = t.socks5Tunnel.Serve(listener)
+	select {
+	case <-stopped:
+		return nil
+	default:
+		return fmt.Errorf("Serve SOCKS5 proxy: %s", err)
+	}
+}
+
+func (t Tunnel) startDaemon(bblDir string) error {
+	pidPath := filepath.Join(bblDir, tunnelPIDFile)
+	if pid, err := t.runningPID(bblDir); err == nil {
+		return fmt.Errorf("A tunnel is already running with pid %d. Stop it with \"bbl tunnel --stop\".", pid)
+	}
+
+	removeTunnelFiles(bblDir)
+
+	logPath := filepath.Join(bblDir, tunnelLogFile)
+	logOffset := fileSize(logPath)
+
+	pid, err := t.daemon.Start(logPath)
+	if err != nil {
+		return fmt.Errorf("Start tunnel: %s", err)
+	}
+
+	err = ioutil.WriteFile(pidPath, []byte(strconv.Itoa(pid)), 0600)
+	if err != nil {
+		return fmt.Errorf("Write tunnel pid: %s", err)
+	}
+
+	port, err := t.waitForPort(bblDir, pid, logPath, logOffset)
+	if err != nil {
+		return err
+	}
+
+	t.logger.Printf("Started tunnel with pid %d on port %s, logging to %s. Run eval \"$(bbl print-env --tunnel)\" to use it.\n", pid, port, logPath)
+	return nil
+}
+
+// waitForPort waits for the background tunnel to write its port, which it
+// does only once it has connected to the jumpbox. A tunnel that exits
+// before then fails with what it logged, and one that neither listens nor
+// exits in time is stopped.
+func (t Tunnel) waitForPort(bblDir string, pid int, logPath string, logOffset int64) (string, error) {
+	portPath := filepath.Join(bblDir, tunnelPortFile)
+	deadline := time.Now().Add(tunnelStartTimeout)
+
+	for {
+		if port, err := readTunnelFile(portPath); err == nil && port != "" {
+			return port, nil
+		}
+
+		if !t.daemon.Running(pid) {
+			removeTunnelFiles(bblDir)
+			return "", fmt.Errorf("Tunnel with pid %d exited before it started listening: %s", pid, tunnelLogTail(logPath, logOffset))
+		}
+
+		if time.Now().After(deadline) {
+			t.daemon.Stop(pid)
+			removeTunnelFiles(bblDir)
+			return "", fmt.Errorf("Tunnel with pid %d did not start listening within %s, see %s.", pid, tunnelStartTimeout, logPath)
+		}
+
+		time.Sleep(tunnelStartPollInterval)
+	}
+}
+
+// tunnelLogTail returns the last line that the tunnel logged after offset,
+// which holds the error of a tunnel that failed to start.
+func tunnelLogTail(logPath string, offset int64) string {
+	contents, err := ioutil.ReadFile(logPath)
+	if err != nil || int64(len(contents)) < offset {
+		return "no output was logged"
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(contents[offset:])), "\n")
+	if lines[len(lines)-1] == "" {
+		return "no output was logged"
+	}
+
+	return lines[len(lines)-1]
+}
+
+func fileSize(path string) int64 {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0
+	}
+
+	return info.Size()
+}
+
+func (t Tunnel) stopDaemon(bblDir string) error {
+	pid, err := t.runningPID(bblDir)
+	if err != nil {
+		return err
+	}
+
+	stopErr := t.daemon.Stop(pid)
+
+	removeTunnelFiles(bblDir)
+
+	if stopErr != nil {
+		return fmt.Errorf("Stop tunnel with pid %d: %s", pid, stopErr)
+	}
+
+	t.logger.Printf("Stopped tunnel with pid %d.\n", pid)
+	return nil
+}
+
+// runningPID returns the pid of the background tunnel. The files of a tunnel
+// that is no longer running, e.g. because it was killed, are removed so
+// that its pid is never signalled after being reused by another process.
+func (t Tunnel) runningPID(bblDir string) (int, error) {
+	contents, err := readTunnelFile(filepath.Join(bblDir, tunnelPIDFile))
+	if err != nil {
+		return 0, errors.New("No tunnel is running in the background.")
+	}
+
+	pid, err := strconv.Atoi(contents)
+	if err != nil {
+		return 0, fmt.Errorf("Invalid tunnel pid %q: %s", contents, err)
+	}
+
+	if !t.daemon.Running(pid) {
+		removeTunnelFiles(bblDir)
+		return 0, errors.New("No tunnel is running in the background.")
+	}
+
+	return pid, nil
+}
+
+func removeTunnelFiles(bblDir string) {
+	os.Remove(filepath.Join(bblDir, tunnelPIDFile))
+	os.Remove(filepath.Join(bblDir, tunnelPortFile))
+}
+
+// removeOwnPIDFile removes the pid file when it was written for this
+// process, so that a background tunnel cleans up after itself on exit.
+func removeOwnPIDFile(bblDir string) {
+	pidPath := filepath.Join(bblDir, tunnelPIDFile)
+	if pid, err := readTunnelFile(pidPath); err == nil && pid == strconv.Itoa(os.Getpid()) {
+		os.Remove(pidPath)
+	}
+}
+
+func readTunnelFile(path string) (string, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(contents)), nil
+}
+
+func (Tunnel) Flags() []flags.Flag {
+	return newTunnelFlags(&tunnelConfig{}).Registered()
+}
+
+func (Tunnel) parseFlags(subcommandFlags []string) (tunnelConfig, error) {
+	c := tunnelConfig{}
+	tunnelFlags := newTunnelFlags(&c)
+
+	err := tunnelFlags.Parse(subcommandFlags)
+	if err != nil {
+		return tunnelConfig{}, err
+	}
+
+	return c, nil
+}
+
+func newTunnelFlags(c *tunnelConfig) flags.Flags {
+	tunnelFlags := flags.New("tunnel")
+	tunnelFlags.Int(&c.port, "port", 0)
+	tunnelFlags.Bool(&c.daemon, "", "daemon", false)
+	tunnelFlags.Bool(&c.stop, "", "stop", false)
+	return tunnelFlags
+}
@@ -0,0 +1,370 @@
+package commands_test
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/cloudfoundry/bosh-bootloader/commands"
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/ssh"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Tunnel", func() {
+	var (
+		tunnel commands.Tunnel
+
+		state  storage.State
+		bblDir string
+
+		logger         *fakes.Logger
+		stateValidator *fakes.StateValidator
+		stateStore     *fakes.StateStore
+		sshKeyGetter   *fakes.SSHKeyGetter
+		socks5Tunnel   *fakes.Socks5Tunnel
+		daemon         *fakes.Daemon
+	)
+
+	BeforeEach(func() {
+		var err error
+		bblDir, err = ioutil.TempDir("", "bbl")
+		Expect(err).NotTo(HaveOccurred())
+
+		state = storage.State{
+			Jumpbox: storage.Jumpbox{
+				URL:       "some-jumpbox:22",
+				Variables: "some-jumpbox-variables",
+			},
+		}
+
+		logger = &fakes.Logger{}
+		stateValidator = &fakes.StateValidator{}
+		stateStore = &fakes.StateStore{}
+		stateStore.GetBblDirCall.Returns.Directory = bblDir
+		sshKeyGetter = &fakes.SSHKeyGetter{}
+		sshKeyGetter.GetCall.Returns.PrivateKey = "some-private-key"
+		socks5Tunnel = &fakes.Socks5Tunnel{}
+		socks5Tunnel.ServeCall.Returns.Error = errors.New("listener closed")
+		daemon = &fakes.Daemon{}
+
+		tunnel = commands.NewTunnel(logger, stateValidator, stateStore, sshKeyGetter, socks5Tunnel, daemon)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(bblDir)
+	})
+
+	Describe("CheckFastFails", func() {
+		It("returns an error when the state is invalid", func() {
+			stateValidator.ValidateCall.Returns.Error = errors.New("no state")
+
+			err := tunnel.CheckFastFails([]string{}, state)
+			Expect(err).To(MatchError("no state"))
+		})
+
+		It("returns an error when the port is out of range", func() {
+			err := tunnel.CheckFastFails([]string{"--port", "70000"}, state)
+			Expect(err).To(MatchError("Invalid --port 70000."))
+		})
+	})
+
+	Describe("Execute", func() {
+		It("connects to the jumpbox and serves the proxy on the given port", func() {
+			var portFile string
+			socks5Tunnel.ServeCall.Stub = func(listener net.Listener) error {
+				contents, err := ioutil.ReadFile(filepath.Join(bblDir, "tunnel.port"))
+				Expect(err).NotTo(HaveOccurred())
+				portFile = string(contents)
+				return errors.New("listener closed")
+			}
+
+			err := tunnel.Execute([]string{"--port", "0"}, state)
+			Expect(err).To(MatchError("Serve SOCKS5 proxy: listener closed"))
+
+			Expect(socks5Tunnel.ConnectCall.Receives.Jumpbox).To(Equal(ssh.Hop{
+				Address:    "some-jumpbox:22",
+				User:       "jumpbox",
+				PrivateKey: "some-private-key",
+			}))
+
+			_, port, err := net.SplitHostPort(socks5Tunnel.ServeCall.Receives.Listener.Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(portFile).To(Equal(port))
+
+			Expect(logger.PrintfCall.Messages).To(ContainElement(ContainSubstring("SOCKS5 proxy listening on 127.0.0.1:" + port)))
+			Expect(filepath.Join(bblDir, "tunnel.port")).NotTo(BeAnExistingFile())
+		})
+
+		It("pins the jumpbox host key", func() {
+			socks5Tunnel.ConnectCall.Returns.HostKey = "jumpbox-host-key"
+
+			tunnel.Execute([]string{}, state)
+
+			Expect(stateStore.SetCall.CallCount).To(Equal(1))
+			Expect(stateStore.SetCall.Receives[0].State.Jumpbox.HostKey).To(Equal("jumpbox-host-key"))
+		})
+
+		It("passes the pinned host key to the tunnel", func() {
+			state.Jumpbox.HostKey = "jumpbox-host-key"
+			socks5Tunnel.ConnectCall.Returns.HostKey = "jumpbox-host-key"
+
+			tunnel.Execute([]string{}, state)
+
+			Expect(socks5Tunnel.ConnectCall.Receives.Jumpbox.HostKey).To(Equal("jumpbox-host-key"))
+			Expect(stateStore.SetCall.CallCount).To(Equal(0))
+		})
+
+		Context("when running in the background", func() {
+			It("removes its pid file on exit", func() {
+				err := ioutil.WriteFile(filepath.Join(bblDir, "tunnel.pid"), []byte(strconv.Itoa(os.Getpid())), 0600)
+				Expect(err).NotTo(HaveOccurred())
+				socks5Tunnel.ConnectCall.Returns.Error = errors.New("connection refused")
+
+				tunnel.Execute([]string{}, state)
+
+				Expect(filepath.Join(bblDir, "tunnel.pid")).NotTo(BeAnExistingFile())
+			})
+
+			It("leaves the pid file of another tunnel alone", func() {
+				err := ioutil.WriteFile(filepath.Join(bblDir, "tunnel.pid"), []byte("1234"), 0600)
+				Expect(err).NotTo(HaveOccurred())
+
+				tunnel.Execute([]string{}, state)
+
+				Expect(filepath.Join(bblDir, "tunnel.pid")).To(BeAnExistingFile())
+			})
+		})
+
+		Context("when --daemon is passed", func() {
+			var running bool
+
+			BeforeEach(func() {
+				commands.SetTunnelStartTimeout(100 * time.Millisecond)
+
+				running = true
+				daemon.StartCall.Returns.PID = 4321
+				daemon.RunningCall.Stub = func(pid int) bool {
+					if pid == 4321 {
+						if running {
+							err := ioutil.WriteFile(filepath.Join(bblDir, "tunnel.port"), []byte("1080"), 0600)
+							Expect(err).NotTo(HaveOccurred())
+						}
+						return running
+					}
+					return daemon.RunningCall.Returns.Running
+				}
+			})
+
+			AfterEach(func() {
+				commands.ResetTunnelStartTimeout()
+			})
+
+			It("starts the tunnel in the background and records its pid", func() {
+				err := tunnel.Execute([]string{"--daemon"}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(daemon.StartCall.Receives.LogPath).To(Equal(filepath.Join(bblDir, "tunnel.log")))
+				Expect(socks5Tunnel.ConnectCall.CallCount).To(Equal(0))
+
+				pid, err := ioutil.ReadFile(filepath.Join(bblDir, "tunnel.pid"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(pid)).To(Equal("4321"))
+
+				Expect(logger.PrintfCall.Messages).To(ContainElement(ContainSubstring("Started tunnel with pid 4321 on port 1080")))
+			})
+
+			It("waits for the tunnel to start listening", func() {
+				daemon.RunningCall.Stub = func(pid int) bool {
+					if daemon.RunningCall.CallCount == 3 {
+						err := ioutil.WriteFile(filepath.Join(bblDir, "tunnel.port"), []byte("1080"), 0600)
+						Expect(err).NotTo(HaveOccurred())
+					}
+					return true
+				}
+
+				err := tunnel.Execute([]string{"--daemon"}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(daemon.RunningCall.CallCount).To(Equal(3))
+				Expect(logger.PrintfCall.Messages).To(ContainElement(ContainSubstring("Started tunnel with pid 4321 on port 1080")))
+			})
+
+			It("returns the error the tunnel logged when it exits before listening", func() {
+				err := ioutil.WriteFile(filepath.Join(bblDir, "tunnel.log"), []byte("an earlier run\n"), 0600)
+				Expect(err).NotTo(HaveOccurred())
+
+				daemon.StartCall.Stub = func(logPath string) {
+					logFile, err := os.OpenFile(logPath, os.O_WRONLY|os.O_APPEND, 0600)
+					Expect(err).NotTo(HaveOccurred())
+					defer logFile.Close()
+
+					_, err = logFile.WriteString("2017/01/01 00:00:00\n\nConnect to jumpbox: dial tcp: i/o timeout\n")
+					Expect(err).NotTo(HaveOccurred())
+				}
+				running = false
+
+				err = tunnel.Execute([]string{"--daemon"}, state)
+				Expect(err).To(MatchError("Tunnel with pid 4321 exited before it started listening: Connect to jumpbox: dial tcp: i/o timeout"))
+
+				Expect(filepath.Join(bblDir, "tunnel.pid")).NotTo(BeAnExistingFile())
+			})
+
+			It("does not report an earlier run's log when the tunnel exits without output", func() {
+				err := ioutil.WriteFile(filepath.Join(bblDir, "tunnel.log"), []byte("an earlier run\n"), 0600)
+				Expect(err).NotTo(HaveOccurred())
+				running = false
+
+				err = tunnel.Execute([]string{"--daemon"}, state)
+				Expect(err).To(MatchError("Tunnel with pid 4321 exited before it started listening: no output was logged"))
+			})
+
+			It("stops a tunnel that does not start listening in time", func() {
+				daemon.RunningCall.Stub = func(int) bool { return true }
+
+				err := tunnel.Execute([]string{"--daemon"}, state)
+				Expect(err).To(MatchError(fmt.Sprintf("Tunnel with pid 4321 did not start listening within 100ms, see %s.", filepath.Join(bblDir, "tunnel.log"))))
+
+				Expect(daemon.StopCall.Receives.PID).To(Equal(4321))
+				Expect(filepath.Join(bblDir, "tunnel.pid")).NotTo(BeAnExistingFile())
+			})
+
+			It("returns an error when a tunnel is already running", func() {
+				err := ioutil.WriteFile(filepath.Join(bblDir, "tunnel.pid"), []byte("1234"), 0600)
+				Expect(err).NotTo(HaveOccurred())
+				daemon.RunningCall.Returns.Running = true
+
+				err = tunnel.Execute([]string{"--daemon"}, state)
+				Expect(err).To(MatchError(`A tunnel is already running with pid 1234. Stop it with "bbl tunnel --stop".`))
+				Expect(daemon.RunningCall.Receives.PID).To(Equal(1234))
+				Expect(daemon.StartCall.CallCount).To(Equal(0))
+			})
+
+			It("replaces the files of a tunnel that is no longer running", func() {
+				err := ioutil.WriteFile(filepath.Join(bblDir, "tunnel.pid"), []byte("1234"), 0600)
+				Expect(err).NotTo(HaveOccurred())
+				err = ioutil.WriteFile(filepath.Join(bblDir, "tunnel.port"), []byte("1081"), 0600)
+				Expect(err).NotTo(HaveOccurred())
+				daemon.StartCall.Stub = func(string) {
+					Expect(filepath.Join(bblDir, "tunnel.port")).NotTo(BeAnExistingFile())
+				}
+
+				err = tunnel.Execute([]string{"--daemon"}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(daemon.StartCall.CallCount).To(Equal(1))
+				pid, err := ioutil.ReadFile(filepath.Join(bblDir, "tunnel.pid"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(pid)).To(Equal("4321"))
+				port, err := ioutil.ReadFile(filepath.Join(bblDir, "tunnel.port"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(port)).To(Equal("1080"))
+			})
+
+			It("returns an error when the daemon fails to start", func() {
+				daemon.StartCall.Returns.Error = errors.New("plum")
+
+				err := tunnel.Execute([]string{"--daemon"}, state)
+				Expect(err).To(MatchError("Start tunnel: plum"))
+			})
+		})
+
+		Context("when --stop is passed", func() {
+			BeforeEach(func() {
+				err := ioutil.WriteFile(filepath.Join(bblDir, "tunnel.pid"), []byte("1234"), 0600)
+				Expect(err).NotTo(HaveOccurred())
+				err = ioutil.WriteFile(filepath.Join(bblDir, "tunnel.port"), []byte("1080"), 0600)
+				Expect(err).NotTo(HaveOccurred())
+				daemon.RunningCall.Returns.Running = true
+			})
+
+			It("stops the background tunnel and removes its files", func() {
+				err := tunnel.Execute([]string{"--stop"}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(daemon.StopCall.Receives.PID).To(Equal(1234))
+				Expect(filepath.Join(bblDir, "tunnel.pid")).NotTo(BeAnExistingFile())
+				Expect(filepath.Join(bblDir, "tunnel.port")).NotTo(BeAnExistingFile())
+			})
+
+			It("removes the files even when the process cannot be stopped", func() {
+				daemon.StopCall.Returns.Error = errors.New("process already finished")
+
+				err := tunnel.Execute([]string{"--stop"}, state)
+				Expect(err).To(MatchError("Stop tunnel with pid 1234: process already finished"))
+
+				Expect(filepath.Join(bblDir, "tunnel.pid")).NotTo(BeAnExistingFile())
+			})
+
+			It("returns an error when no tunnel is running", func() {
+				os.Remove(filepath.Join(bblDir, "tunnel.pid"))
+
+				err := tunnel.Execute([]string{"--stop"}, state)
+				Expect(err).To(MatchError("No tunnel is running in the background."))
+			})
+
+			It("removes the files without signalling a pid that is no longer running", func() {
+				daemon.RunningCall.Returns.Running = false
+
+				err := tunnel.Execute([]string{"--stop"}, state)
+				Expect(err).To(MatchError("No tunnel is running in the background."))
+
+				Expect(daemon.RunningCall.Receives.PID).To(Equal(1234))
+				Expect(daemon.StopCall.CallCount).To(Equal(0))
+				Expect(filepath.Join(bblDir, "tunnel.pid")).NotTo(BeAnExistingFile())
+				Expect(filepath.Join(bblDir, "tunnel.port")).NotTo(BeAnExistingFile())
+			})
+		})
+
+		Context("failure cases", func() {
+			It("returns an error when the bbl dir cannot be found", func() {
+				stateStore.GetBblDirCall.Returns.Error = errors.New("peach")
+
+				err := tunnel.Execute([]string{}, state)
+				Expect(err).To(MatchError("Get bbl dir: peach"))
+			})
+
+			It("returns an error when the jumpbox ssh key is missing", func() {
+				sshKeyGetter.GetCall.Returns.PrivateKey = ""
+
+				err := tunnel.Execute([]string{}, state)
+				Expect(err).To(MatchError("Could not retrieve the jumpbox ssh key, please make sure you are targeting the proper state dir."))
+			})
+
+			It("returns an error when it cannot connect to the jumpbox", func() {
+				socks5Tunnel.ConnectCall.Returns.Error = errors.New("connection refused")
+
+				err := tunnel.Execute([]string{}, state)
+				Expect(err).To(MatchError("Connect to jumpbox: connection refused"))
+				Expect(socks5Tunnel.ServeCall.CallCount).To(Equal(0))
+			})
+
+			It("returns an error when the host key cannot be saved", func() {
+				socks5Tunnel.ConnectCall.Returns.HostKey = "jumpbox-host-key"
+				stateStore.SetCall.Returns = []fakes.SetCallReturn{{errors.New("kiwi")}}
+
+				err := tunnel.Execute([]string{}, state)
+				Expect(err).To(MatchError("Save host keys: kiwi"))
+			})
+
+			It("returns an error when the port is already in use", func() {
+				listener, err := net.Listen("tcp", "127.0.0.1:0")
+				Expect(err).NotTo(HaveOccurred())
+				defer listener.Close()
+
+				_, port, err := net.SplitHostPort(listener.Addr().String())
+				Expect(err).NotTo(HaveOccurred())
+
+				err = tunnel.Execute([]string{"--port", port}, state)
+				Expect(err).To(MatchError(ContainSubstring("Listen on port " + port)))
+			})
+		})
+	})
+})
@@ -3,9 +3,13 @@ package commands
 import (
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"net"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 
 	"github.com/cloudfoundry/bosh-bootloader/bosh"
 	"github.com/cloudfoundry/bosh-bootloader/flags"
@@ -13,27 +17,47 @@ import (
 )
 
 type Up struct {
-	boshManager        boshManager
-	cloudConfigManager cloudConfigManager
-	stateStore         stateStore
-	envIDManager       envIDManager
-	terraformManager   terraformManager
+	boshManager          boshManager
+	runtimeConfigManager runtimeConfigManager
+	stateStore           stateStore
+	envIDManager         envIDManager
+	terraformManager     terraformManager
+
+	logger                    logger
+	cloudConfigUpdater        cloudConfigUpdater
+	certificateStatusReporter certificateStatusReporter
 }
 
 type UpConfig struct {
-	Name       string
-	OpsFile    string
-	NoDirector bool
+	Name                     string
+	OpsFile                  string
+	NoDirector               bool
+	RuntimeConfigOpsFile     string
+	SyslogAddress            string
+	SyslogPort               string
+	SyslogTransport          string
+	CloudConfigDiffOnly      bool
+	NoConfirm                bool
+	ExistingNetworkID        string
+	NetworkCIDR              string
+	AllowedIngressCIDRs      []string
+	ClearAllowedIngressCIDRs bool
+	AZs                      []string
+	PrivateDirector          bool
 }
 
-func NewUp(boshManager boshManager, cloudConfigManager cloudConfigManager,
-	stateStore stateStore, envIDManager envIDManager, terraformManager terraformManager) Up {
+func NewUp(boshManager boshManager, cloudConfigManager cloudConfigManager, runtimeConfigManager runtimeConfigManager,
+	stateStore stateStore, envIDManager envIDManager, terraformManager terraformManager,
+	logger logger, stdin io.Reader, certificateStatusReporter certificateStatusReporter) Up {
 	return Up{
-		boshManager:        boshManager,
-		cloudConfigManager: cloudConfigManager,
-		stateStore:         stateStore,
-		envIDManager:       envIDManager,
-		terraformManager:   terraformManager,
+		boshManager:               boshManager,
+		runtimeConfigManager:      runtimeConfigManager,
+		stateStore:                stateStore,
+		envIDManager:              envIDManager,
+		terraformManager:          terraformManager,
+		logger:                    logger,
+		cloudConfigUpdater:        newCloudConfigUpdater(logger, stdin, cloudConfigManager),
+		certificateStatusReporter: certificateStatusReporter,
 	}
 }
 
@@ -57,6 +81,57 @@ func (u Up) CheckFastFails(args []string, state storage.State) error {
 		return fmt.Errorf("The director name cannot be changed for an existing environment. Current name is %s.", state.EnvID)
 	}
 
+	if config.ExistingNetworkID != "" {
+		switch state.IAAS {
+		case "openstack":
+			return errors.New("Reusing an existing network is not supported on OpenStack.")
+		case "vsphere":
+			return errors.New("Reusing an existing network is not supported on vSphere.")
+		}
+	}
+
+	if state.TFState != "" && config.ExistingNetworkID != state.ExistingNetworkID {
+		return errors.New("The network cannot be changed for an existing environment.")
+	}
+
+	if config.NetworkCIDR != "" {
+		if err := validateNetworkCIDR(config.NetworkCIDR, state.IAAS); err != nil {
+			return err
+		}
+	}
+
+	if state.TFState != "" && config.NetworkCIDR != state.NetworkCIDR {
+		return errors.New("The network CIDR cannot be changed for an existing environment.")
+	}
+
+	if config.PrivateDirector && state.IAAS != "aws" && state.IAAS != "gcp" {
+		return errors.New("--private-director is only supported on AWS and GCP.")
+	}
+
+	if state.TFState != "" && config.PrivateDirector != state.PrivateDirector {
+		return errors.New("A director cannot be made private or public for an existing environment.")
+	}
+
+	if len(config.AllowedIngressCIDRs) > 0 && state.IAAS == "vsphere" {
+		return errors.New("Restricting ingress CIDRs is not supported on vSphere.")
+	}
+
+	if len(config.AllowedIngressCIDRs) > 0 && config.ClearAllowedIngressCIDRs {
+		return errors.New("--clear-allowed-ingress-cidrs cannot be used with --allowed-ingress-cidr.")
+	}
+
+	for _, cidr := range config.AllowedIngressCIDRs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return fmt.Errorf("Invalid --allowed-ingress-cidr %q: %s", cidr, err)
+		}
+	}
+
+	if len(config.AZs) > 0 {
+		if err := u.validateAZs(config.AZs, state); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -66,6 +141,12 @@ func (u Up) Execute(args []string, state storage.State) error {
 		return err
 	}
 
+	warnExpiringCertificates(u.logger, u.certificateStatusReporter, state)
+
+	if config.CloudConfigDiffOnly {
+		return u.cloudConfigUpdater.PrintDiff(state)
+	}
+
 	if config.NoDirector {
 		if !state.BOSH.IsEmpty() {
 			return errors.New(`Director already exists, you must re-create your environment to use "--no-director"`)
@@ -74,6 +155,24 @@ func (u Up) Execute(args []string, state storage.State) error {
 		state.NoDirector = true
 	}
 
+	if config.ExistingNetworkID != "" {
+		state.ExistingNetworkID = config.ExistingNetworkID
+	}
+
+	if config.NetworkCIDR != "" {
+		state.NetworkCIDR = config.NetworkCIDR
+	}
+
+	if config.PrivateDirector {
+		state.PrivateDirector = true
+	}
+
+	state.AllowedIngressCIDRs = allowedIngressCIDRs(config, state)
+
+	if len(config.AZs) > 0 {
+		state = setAZs(state, config.AZs)
+	}
+
 	var opsFileContents []byte
 	if config.OpsFile != "" {
 		opsFileContents, err = ioutil.ReadFile(config.OpsFile)
@@ -82,6 +181,22 @@ func (u Up) Execute(args []string, state storage.State) error {
 		}
 	}
 
+	if config.RuntimeConfigOpsFile != "" {
+		runtimeConfigOpsFileContents, err := ioutil.ReadFile(config.RuntimeConfigOpsFile)
+		if err != nil {
+			return fmt.Errorf("Reading runtime-config-ops-file contents: %v", err)
+		}
+		state.RuntimeConfig.UserOpsFile = string(runtimeConfigOpsFileContents)
+	}
+
+	if config.SyslogAddress != "" {
+		state.RuntimeConfig.Syslog = storage.Syslog{
+			Address:   config.SyslogAddress,
+			Port:      config.SyslogPort,
+			Transport: config.SyslogTransport,
+		}
+	}
+
 	state, err = u.envIDManager.Sync(state, config.Name)
 	if err != nil {
 		return fmt.Errorf("Env id manager sync: %s", err)
@@ -152,9 +267,14 @@ func (u Up) Execute(args []string, state storage.State) error {
 		return fmt.Errorf("Save state after create director: %s", err)
 	}
 
-	err = u.cloudConfigManager.Update(state)
+	err = u.cloudConfigUpdater.Update(state, config.NoConfirm)
 	if err != nil {
-		return fmt.Errorf("Update cloud config: %s", err)
+		return cloudConfigUpdateError(err)
+	}
+
+	err = u.runtimeConfigManager.Update(state)
+	if err != nil {
+		return fmt.Errorf("Update runtime config: %s", err)
 	}
 
 	return nil
@@ -172,16 +292,168 @@ func (u Up) ParseArgs(args []string, state storage.State) (UpConfig, error) {
 		return UpConfig{}, err //not tested
 	}
 
-	var config UpConfig
+	var (
+		config UpConfig
+		azs    string
+	)
+	upFlags := newUpFlags(&config, &azs, prevOpsFilePath, state)
+
+	err = upFlags.Parse(args)
+	if err != nil {
+		return UpConfig{}, err
+	}
+
+	if azs != "" {
+		for _, az := range strings.Split(azs, ",") {
+			if az = strings.TrimSpace(az); az != "" {
+				config.AZs = append(config.AZs, az)
+			}
+		}
+	}
+
+	return config, nil
+}
+
+func (Up) Flags() []flags.Flag {
+	return upFlagNames()
+}
+
+// upFlagNames lists the flags of up, which plan and rotate share.
+func upFlagNames() []flags.Flag {
+	var azs string
+	return newUpFlags(&UpConfig{}, &azs, "", storage.State{}).Registered()
+}
+
+func newUpFlags(config *UpConfig, azs *string, prevOpsFilePath string, state storage.State) flags.Flags {
 	upFlags := flags.New("up")
 	upFlags.String(&config.Name, "name", "")
 	upFlags.String(&config.OpsFile, "ops-file", prevOpsFilePath)
 	upFlags.Bool(&config.NoDirector, "", "no-director", state.NoDirector)
+	upFlags.String(&config.RuntimeConfigOpsFile, "runtime-config-ops-file", "")
+	upFlags.String(&config.SyslogAddress, "syslog-address", "")
+	upFlags.String(&config.SyslogPort, "syslog-port", state.RuntimeConfig.Syslog.Port)
+	upFlags.String(&config.SyslogTransport, "syslog-transport", state.RuntimeConfig.Syslog.Transport)
+	upFlags.Bool(&config.CloudConfigDiffOnly, "", "cloud-config-diff-only", false)
+	upFlags.Bool(&config.NoConfirm, "n", "no-confirm", false)
+	upFlags.String(&config.ExistingNetworkID, "existing-network-id", state.ExistingNetworkID)
+	upFlags.String(&config.NetworkCIDR, "network-cidr", state.NetworkCIDR)
+	upFlags.StringSlice(&config.AllowedIngressCIDRs, "allowed-ingress-cidr", nil)
+	upFlags.Bool(&config.ClearAllowedIngressCIDRs, "", "clear-allowed-ingress-cidrs", false)
+	upFlags.String(azs, "azs", "")
+	upFlags.Bool(&config.PrivateDirector, "", "private-director", state.PrivateDirector)
+	return upFlags
+}
 
-	err = upFlags.Parse(args)
+// allowedIngressCIDRs returns the ranges to save in the state. The saved
+// ranges are kept unless new ones are given or they are cleared, which opens
+// the jumpbox and director to 0.0.0.0/0 again.
+func allowedIngressCIDRs(config UpConfig, state storage.State) []string {
+	switch {
+	case config.ClearAllowedIngressCIDRs:
+		return nil
+	case len(config.AllowedIngressCIDRs) > 0:
+		return config.AllowedIngressCIDRs
+	default:
+		return state.AllowedIngressCIDRs
+	}
+}
+
+// validateNetworkCIDR accepts IPv4 ranges of at least a /19, so that the
+// smallest subnets bbl carves out of it can still hold an AWS load balancer.
+func validateNetworkCIDR(cidr, iaas string) error {
+	switch iaas {
+	case "openstack", "vsphere":
+		return errors.New("--network-cidr is only supported on AWS, GCP and Azure.")
+	}
+
+	ip, ipNet, err := net.ParseCIDR(cidr)
 	if err != nil {
-		return UpConfig{}, err
+		return fmt.Errorf("Invalid --network-cidr %q: %s", cidr, err)
 	}
 
-	return config, nil
+	if ip.To4() == nil || !ip.Equal(ipNet.IP) {
+		return fmt.Errorf("Invalid --network-cidr %q: must be an IPv4 network address such as 172.16.0.0/16", cidr)
+	}
+
+	if ones, _ := ipNet.Mask.Size(); ones > 19 {
+		return fmt.Errorf("Invalid --network-cidr %q: must be a /19 or larger", cidr)
+	}
+
+	return nil
+}
+
+func (u Up) validateAZs(azs []string, state storage.State) error {
+	if state.IAAS != "aws" && state.IAAS != "gcp" {
+		return errors.New("--azs is only supported on AWS and GCP.")
+	}
+
+	if state.TFState == "" {
+		return nil
+	}
+
+	if state.IAAS == "gcp" && state.GCP.Zone != "" && !containsString(azs, state.GCP.Zone) {
+		return fmt.Errorf("--azs must include the director zone %s.", state.GCP.Zone)
+	}
+
+	current, err := u.currentAZs(state)
+	if err != nil {
+		return err
+	}
+
+	for i, az := range current {
+		if i >= len(azs) || azs[i] != az {
+			return fmt.Errorf("Availability zones with existing subnets cannot be removed or reordered; new zones can only be appended. Current zones are %s.", strings.Join(current, ","))
+		}
+	}
+
+	return nil
+}
+
+// currentAZs returns the zones the environment has subnets in. AWS
+// environments that have never pinned their zones use every zone in the
+// region, so those are read back from the terraform outputs.
+func (u Up) currentAZs(state storage.State) ([]string, error) {
+	if state.IAAS == "gcp" {
+		return state.GCP.Zones, nil
+	}
+
+	if len(state.AWS.AZs) > 0 {
+		return state.AWS.AZs, nil
+	}
+
+	terraformOutputs, err := u.terraformManager.GetOutputs(state)
+	if err != nil {
+		return []string{}, fmt.Errorf("Parse terraform outputs: %s", err)
+	}
+
+	var azs []string
+	for az := range terraformOutputs.GetStringMap("internal_az_subnet_id_mapping") {
+		azs = append(azs, az)
+	}
+	sort.Strings(azs)
+
+	return azs, nil
+}
+
+func setAZs(state storage.State, azs []string) storage.State {
+	switch state.IAAS {
+	case "aws":
+		state.AWS.AZs = azs
+	case "gcp":
+		state.GCP.Zones = azs
+		if !containsString(azs, state.GCP.Zone) {
+			state.GCP.Zone = azs[0]
+		}
+	}
+
+	return state
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
 }
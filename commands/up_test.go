@@ -1,12 +1,15 @@
 package commands_test
 
 import (
+	"bytes"
 	"errors"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/cloudfoundry/bosh-bootloader/bosh"
+	"github.com/cloudfoundry/bosh-bootloader/certs"
 	"github.com/cloudfoundry/bosh-bootloader/commands"
 	"github.com/cloudfoundry/bosh-bootloader/fakes"
 	"github.com/cloudfoundry/bosh-bootloader/storage"
@@ -20,11 +23,15 @@ var _ = Describe("Up", func() {
 	var (
 		command commands.Up
 
-		boshManager        *fakes.BOSHManager
-		terraformManager   *fakes.TerraformManager
-		cloudConfigManager *fakes.CloudConfigManager
-		stateStore         *fakes.StateStore
-		envIDManager       *fakes.EnvIDManager
+		boshManager          *fakes.BOSHManager
+		terraformManager     *fakes.TerraformManager
+		cloudConfigManager   *fakes.CloudConfigManager
+		runtimeConfigManager *fakes.RuntimeConfigManager
+		stateStore           *fakes.StateStore
+		envIDManager         *fakes.EnvIDManager
+		logger               *fakes.Logger
+		certStatusReporter   *fakes.CertificateStatusReporter
+		stdin                *bytes.Buffer
 
 		tempDir string
 	)
@@ -35,8 +42,12 @@ var _ = Describe("Up", func() {
 
 		terraformManager = &fakes.TerraformManager{}
 		cloudConfigManager = &fakes.CloudConfigManager{}
+		runtimeConfigManager = &fakes.RuntimeConfigManager{}
 		stateStore = &fakes.StateStore{}
 		envIDManager = &fakes.EnvIDManager{}
+		logger = &fakes.Logger{}
+		certStatusReporter = &fakes.CertificateStatusReporter{}
+		stdin = bytes.NewBuffer([]byte{})
 
 		var err error
 		tempDir, err = ioutil.TempDir("", "")
@@ -44,7 +55,7 @@ var _ = Describe("Up", func() {
 
 		stateStore.GetBblDirCall.Returns.Directory = tempDir
 
-		command = commands.NewUp(boshManager, cloudConfigManager, stateStore, envIDManager, terraformManager)
+		command = commands.NewUp(boshManager, cloudConfigManager, runtimeConfigManager, stateStore, envIDManager, terraformManager, logger, stdin, certStatusReporter)
 	})
 
 	Describe("CheckFastFails", func() {
@@ -123,6 +134,199 @@ var _ = Describe("Up", func() {
 				})
 			})
 		})
+
+		Context("when an existing network id is provided", func() {
+			It("returns an error on openstack", func() {
+				err := command.CheckFastFails([]string{
+					"--existing-network-id", "some-network-id",
+				}, storage.State{IAAS: "openstack"})
+				Expect(err).To(MatchError("Reusing an existing network is not supported on OpenStack."))
+			})
+
+			It("returns an error on vsphere", func() {
+				err := command.CheckFastFails([]string{
+					"--existing-network-id", "some-network-id",
+				}, storage.State{IAAS: "vsphere"})
+				Expect(err).To(MatchError("Reusing an existing network is not supported on vSphere."))
+			})
+
+			Context("when the environment has already been created", func() {
+				It("returns no error when the network id matches the state", func() {
+					err := command.CheckFastFails([]string{
+						"--existing-network-id", "some-network-id",
+					}, storage.State{IAAS: "aws", TFState: "some-tf-state", ExistingNetworkID: "some-network-id"})
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("returns an error when the network id differs from the state", func() {
+					err := command.CheckFastFails([]string{
+						"--existing-network-id", "some-other-network-id",
+					}, storage.State{IAAS: "aws", TFState: "some-tf-state"})
+					Expect(err).To(MatchError("The network cannot be changed for an existing environment."))
+				})
+			})
+		})
+
+		Context("when a network cidr is provided", func() {
+			It("returns no error for a /19 or larger ipv4 range", func() {
+				err := command.CheckFastFails([]string{
+					"--network-cidr", "172.16.0.0/19",
+				}, storage.State{IAAS: "aws"})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns an error on openstack", func() {
+				err := command.CheckFastFails([]string{
+					"--network-cidr", "172.16.0.0/16",
+				}, storage.State{IAAS: "openstack"})
+				Expect(err).To(MatchError("--network-cidr is only supported on AWS, GCP and Azure."))
+			})
+
+			It("returns an error when the range is smaller than a /19", func() {
+				err := command.CheckFastFails([]string{
+					"--network-cidr", "172.16.0.0/20",
+				}, storage.State{IAAS: "gcp"})
+				Expect(err).To(MatchError(`Invalid --network-cidr "172.16.0.0/20": must be a /19 or larger`))
+			})
+
+			It("returns an error when the range is not a network address", func() {
+				err := command.CheckFastFails([]string{
+					"--network-cidr", "172.16.0.1/16",
+				}, storage.State{IAAS: "azure"})
+				Expect(err).To(MatchError(`Invalid --network-cidr "172.16.0.1/16": must be an IPv4 network address such as 172.16.0.0/16`))
+			})
+
+			It("returns an error when the range cannot be parsed", func() {
+				err := command.CheckFastFails([]string{
+					"--network-cidr", "not-a-cidr",
+				}, storage.State{IAAS: "aws"})
+				Expect(err).To(MatchError(ContainSubstring(`Invalid --network-cidr "not-a-cidr"`)))
+			})
+
+			It("returns an error when it differs from the state of an existing environment", func() {
+				err := command.CheckFastFails([]string{
+					"--network-cidr", "172.16.0.0/16",
+				}, storage.State{IAAS: "aws", TFState: "some-tf-state"})
+				Expect(err).To(MatchError("The network CIDR cannot be changed for an existing environment."))
+			})
+		})
+
+		Context("when allowed ingress cidrs are provided", func() {
+			It("returns an error on vsphere", func() {
+				err := command.CheckFastFails([]string{
+					"--allowed-ingress-cidr", "10.0.0.0/8",
+				}, storage.State{IAAS: "vsphere"})
+				Expect(err).To(MatchError("Restricting ingress CIDRs is not supported on vSphere."))
+			})
+
+			It("returns an error when a cidr is invalid", func() {
+				err := command.CheckFastFails([]string{
+					"--allowed-ingress-cidr", "10.0.0.0/8",
+					"--allowed-ingress-cidr", "not-a-cidr",
+				}, storage.State{IAAS: "aws"})
+				Expect(err).To(MatchError(`Invalid --allowed-ingress-cidr "not-a-cidr": invalid CIDR address: not-a-cidr`))
+			})
+
+			It("returns an error when they are also cleared", func() {
+				err := command.CheckFastFails([]string{
+					"--allowed-ingress-cidr", "10.0.0.0/8",
+					"--clear-allowed-ingress-cidrs",
+				}, storage.State{IAAS: "aws"})
+				Expect(err).To(MatchError("--clear-allowed-ingress-cidrs cannot be used with --allowed-ingress-cidr."))
+			})
+		})
+
+		Context("when a private director is requested", func() {
+			It("returns an error on iaases other than aws and gcp", func() {
+				err := command.CheckFastFails([]string{"--private-director"}, storage.State{IAAS: "azure"})
+				Expect(err).To(MatchError("--private-director is only supported on AWS and GCP."))
+			})
+
+			It("returns an error when the environment already has a public director", func() {
+				err := command.CheckFastFails([]string{"--private-director"}, storage.State{IAAS: "aws", TFState: "some-tf-state"})
+				Expect(err).To(MatchError("A director cannot be made private or public for an existing environment."))
+			})
+
+			It("keeps an existing private director private", func() {
+				err := command.CheckFastFails([]string{}, storage.State{IAAS: "gcp", TFState: "some-tf-state", PrivateDirector: true})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when availability zones are provided", func() {
+			It("returns an error on iaases other than aws and gcp", func() {
+				err := command.CheckFastFails([]string{"--azs", "z1"}, storage.State{IAAS: "azure"})
+				Expect(err).To(MatchError("--azs is only supported on AWS and GCP."))
+			})
+
+			It("allows any zones for a new environment", func() {
+				err := command.CheckFastFails([]string{"--azs", "z2,z1"}, storage.State{IAAS: "aws"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(terraformManager.GetOutputsCall.CallCount).To(Equal(0))
+			})
+
+			Context("when the aws environment has already been created", func() {
+				BeforeEach(func() {
+					terraformManager.GetOutputsCall.Returns.Outputs = terraform.Outputs{Map: map[string]interface{}{
+						"internal_az_subnet_id_mapping": map[string]interface{}{
+							"z2": "some-subnet-2",
+							"z1": "some-subnet-1",
+						},
+					}}
+				})
+
+				It("allows appending zones to the ones with subnets", func() {
+					err := command.CheckFastFails([]string{"--azs", "z1,z2,z3"}, storage.State{IAAS: "aws", TFState: "some-tf-state"})
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("returns an error when a zone with subnets is removed", func() {
+					err := command.CheckFastFails([]string{"--azs", "z1"}, storage.State{IAAS: "aws", TFState: "some-tf-state"})
+					Expect(err).To(MatchError("Availability zones with existing subnets cannot be removed or reordered; new zones can only be appended. Current zones are z1,z2."))
+				})
+
+				It("compares against the pinned zones when there are some", func() {
+					err := command.CheckFastFails([]string{"--azs", "z1,z2"}, storage.State{
+						IAAS:    "aws",
+						TFState: "some-tf-state",
+						AWS:     storage.AWS{AZs: []string{"z2", "z1"}},
+					})
+					Expect(err).To(MatchError("Availability zones with existing subnets cannot be removed or reordered; new zones can only be appended. Current zones are z2,z1."))
+					Expect(terraformManager.GetOutputsCall.CallCount).To(Equal(0))
+				})
+
+				It("returns an error when the terraform outputs cannot be read", func() {
+					terraformManager.GetOutputsCall.Returns.Error = errors.New("lime")
+
+					err := command.CheckFastFails([]string{"--azs", "z1"}, storage.State{IAAS: "aws", TFState: "some-tf-state"})
+					Expect(err).To(MatchError("Parse terraform outputs: lime"))
+				})
+			})
+
+			Context("when the gcp environment has already been created", func() {
+				var state storage.State
+
+				BeforeEach(func() {
+					state = storage.State{
+						IAAS:    "gcp",
+						TFState: "some-tf-state",
+						GCP:     storage.GCP{Zone: "z1", Zones: []string{"z1", "z2"}},
+					}
+				})
+
+				It("returns an error when a zone is removed", func() {
+					err := command.CheckFastFails([]string{"--azs", "z1,z3"}, state)
+					Expect(err).To(MatchError("Availability zones with existing subnets cannot be removed or reordered; new zones can only be appended. Current zones are z1,z2."))
+				})
+
+				It("returns an error when the director zone is missing", func() {
+					state.GCP.Zones = []string{"z2"}
+
+					err := command.CheckFastFails([]string{"--azs", "z2,z3"}, state)
+					Expect(err).To(MatchError("--azs must include the director zone z1."))
+				})
+			})
+		})
 	})
 
 	Describe("Execute", func() {
@@ -192,9 +396,91 @@ var _ = Describe("Up", func() {
 			Expect(cloudConfigManager.UpdateCall.CallCount).To(Equal(1))
 			Expect(cloudConfigManager.UpdateCall.Receives.State).To(Equal(createDirectorState))
 
+			Expect(runtimeConfigManager.UpdateCall.CallCount).To(Equal(1))
+			Expect(runtimeConfigManager.UpdateCall.Receives.State).To(Equal(createDirectorState))
+
 			Expect(stateStore.SetCall.CallCount).To(Equal(4))
 		})
 
+		Context("when runtime config flags are provided", func() {
+			var runtimeConfigOpsFilePath string
+
+			BeforeEach(func() {
+				opsFile, err := ioutil.TempFile("", "runtime-config-ops-file")
+				Expect(err).NotTo(HaveOccurred())
+
+				runtimeConfigOpsFilePath = opsFile.Name()
+				err = ioutil.WriteFile(runtimeConfigOpsFilePath, []byte("some-runtime-config-ops"), os.ModePerm)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("saves the runtime config settings in the state", func() {
+				err := command.Execute([]string{
+					"--runtime-config-ops-file", runtimeConfigOpsFilePath,
+					"--syslog-address", "logs.example.com",
+					"--syslog-port", "6514",
+					"--syslog-transport", "tcp",
+				}, incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(envIDManager.SyncCall.Receives.State.RuntimeConfig).To(Equal(storage.RuntimeConfig{
+					Syslog: storage.Syslog{
+						Address:   "logs.example.com",
+						Port:      "6514",
+						Transport: "tcp",
+					},
+					UserOpsFile: "some-runtime-config-ops",
+				}))
+			})
+		})
+
+		Context("when the config has no runtime config flags", func() {
+			It("keeps the runtime config settings from the state", func() {
+				incomingState.RuntimeConfig = storage.RuntimeConfig{
+					Syslog:      storage.Syslog{Address: "some-address", Port: "514"},
+					UserOpsFile: "some-ops",
+				}
+
+				err := command.Execute([]string{}, incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(envIDManager.SyncCall.Receives.State.RuntimeConfig).To(Equal(incomingState.RuntimeConfig))
+			})
+		})
+
+		Context("when the state has certificates about to expire", func() {
+			BeforeEach(func() {
+				certStatusReporter.ReportCall.Returns.Statuses = []certs.Status{
+					{Name: "director_ssl_ca", DaysRemaining: 12, NotAfter: time.Date(2017, time.July, 13, 0, 0, 0, 0, time.UTC)},
+					{Name: "lb_cert", DaysRemaining: 300},
+				}
+			})
+
+			It("prints a warning for each expiring certificate", func() {
+				err := command.Execute([]string{}, incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(certStatusReporter.ReportCall.Receives.State).To(Equal(incomingState))
+				Expect(logger.PrintlnCall.Messages).To(Equal([]string{
+					"warning: certificate director_ssl_ca expires in 12 days (2017-07-13)",
+				}))
+			})
+		})
+
+		Context("when the certificate status cannot be determined", func() {
+			BeforeEach(func() {
+				certStatusReporter.ReportCall.Returns.Error = errors.New("lb_cert is not PEM encoded")
+			})
+
+			It("prints a warning and continues", func() {
+				err := command.Execute([]string{}, incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PrintlnCall.Messages).To(ContainElement("warning: could not check certificate expiry: lb_cert is not PEM encoded"))
+				Expect(cloudConfigManager.UpdateCall.CallCount).To(Equal(1))
+			})
+		})
+
 		Context("when the config has ops files", func() {
 			var opsFilePath string
 
@@ -216,6 +502,85 @@ var _ = Describe("Up", func() {
 			})
 		})
 
+		Context("when --existing-network-id is passed", func() {
+			It("saves the existing network id in the state", func() {
+				err := command.Execute([]string{"--existing-network-id", "some-network-id"}, storage.State{})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(envIDManager.SyncCall.Receives.State.ExistingNetworkID).To(Equal("some-network-id"))
+			})
+		})
+
+		Context("when --network-cidr is passed", func() {
+			It("saves the network cidr in the state", func() {
+				err := command.Execute([]string{"--network-cidr", "172.16.0.0/16"}, storage.State{})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(envIDManager.SyncCall.Receives.State.NetworkCIDR).To(Equal("172.16.0.0/16"))
+			})
+		})
+
+		Context("when --private-director is passed", func() {
+			It("saves the private director setting in the state", func() {
+				err := command.Execute([]string{"--private-director"}, storage.State{IAAS: "aws"})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(envIDManager.SyncCall.Receives.State.PrivateDirector).To(BeTrue())
+			})
+		})
+
+		Context("when --azs is passed", func() {
+			It("pins the aws availability zones in the state", func() {
+				err := command.Execute([]string{"--azs", "z1,z2"}, storage.State{IAAS: "aws"})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(envIDManager.SyncCall.Receives.State.AWS.AZs).To(Equal([]string{"z1", "z2"}))
+			})
+
+			It("replaces the gcp zones and moves the director into them", func() {
+				err := command.Execute([]string{"--azs", "z2,z3"}, storage.State{
+					IAAS: "gcp",
+					GCP:  storage.GCP{Zone: "z1", Zones: []string{"z1", "z2", "z3"}},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(envIDManager.SyncCall.Receives.State.GCP.Zones).To(Equal([]string{"z2", "z3"}))
+				Expect(envIDManager.SyncCall.Receives.State.GCP.Zone).To(Equal("z2"))
+			})
+		})
+
+		Context("when --allowed-ingress-cidr is passed", func() {
+			It("saves the allowed ingress cidrs in the state", func() {
+				err := command.Execute([]string{
+					"--allowed-ingress-cidr", "10.0.0.0/8",
+					"--allowed-ingress-cidr", "192.168.0.0/16",
+				}, storage.State{AllowedIngressCIDRs: []string{"172.16.0.0/12"}})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(envIDManager.SyncCall.Receives.State.AllowedIngressCIDRs).To(Equal([]string{"10.0.0.0/8", "192.168.0.0/16"}))
+			})
+		})
+
+		Context("when --allowed-ingress-cidr is omitted", func() {
+			It("keeps the allowed ingress cidrs from the state", func() {
+				err := command.Execute([]string{}, storage.State{AllowedIngressCIDRs: []string{"172.16.0.0/12"}})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(envIDManager.SyncCall.Receives.State.AllowedIngressCIDRs).To(Equal([]string{"172.16.0.0/12"}))
+			})
+		})
+
+		Context("when --clear-allowed-ingress-cidrs is passed", func() {
+			It("removes the allowed ingress cidrs from the state", func() {
+				err := command.Execute([]string{
+					"--clear-allowed-ingress-cidrs",
+				}, storage.State{AllowedIngressCIDRs: []string{"172.16.0.0/12"}})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(envIDManager.SyncCall.Receives.State.AllowedIngressCIDRs).To(BeEmpty())
+			})
+		})
+
 		Context("when --no-director flag is passed", func() {
 			It("sets NoDirector to true on the state", func() {
 				err := command.Execute([]string{"--no-director"}, storage.State{})
@@ -225,6 +590,111 @@ var _ = Describe("Up", func() {
 			})
 		})
 
+		Context("when the cloud config would change", func() {
+			BeforeEach(func() {
+				cloudConfigManager.DiffCall.Returns.Diff = bosh.ConfigDiff{
+					Diff: [][]interface{}{{"vm_types:", nil}, {"- name: default", "removed"}},
+				}
+			})
+
+			It("prints the diff and applies the cloud config when the user confirms", func() {
+				stdin.Write([]byte("yes\n"))
+
+				err := command.Execute([]string{}, incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(cloudConfigManager.DiffCall.Receives.State).To(Equal(createDirectorState))
+				Expect(logger.PrintlnCall.Messages).To(ContainElement("  vm_types:\n- - name: default"))
+				Expect(logger.PromptCall.Receives.Message).To(Equal("Are you sure you want to apply these changes to the cloud config?"))
+				Expect(cloudConfigManager.UpdateCall.CallCount).To(Equal(1))
+				Expect(runtimeConfigManager.UpdateCall.CallCount).To(Equal(1))
+			})
+
+			It("stops without updating the cloud config when the user declines", func() {
+				stdin.Write([]byte("no\n"))
+
+				err := command.Execute([]string{}, incomingState)
+				Expect(err).To(MatchError("Cloud config update declined. The IAAS changes were applied, but the cloud config was left as it is. Run the command again to update it."))
+
+				Expect(cloudConfigManager.UpdateCall.CallCount).To(Equal(0))
+				Expect(runtimeConfigManager.UpdateCall.CallCount).To(Equal(0))
+			})
+
+			It("returns an error asking for --no-confirm when no answer can be read", func() {
+				err := command.Execute([]string{}, incomingState)
+				Expect(err).To(MatchError("Cannot confirm cloud config changes: no answer could be read from stdin. Pass --no-confirm to apply them without confirmation."))
+
+				Expect(cloudConfigManager.UpdateCall.CallCount).To(Equal(0))
+			})
+
+			It("applies the cloud config without prompting when --no-confirm is passed", func() {
+				err := command.Execute([]string{"--no-confirm"}, incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(cloudConfigManager.DiffCall.CallCount).To(Equal(0))
+				Expect(logger.PromptCall.CallCount).To(Equal(0))
+				Expect(cloudConfigManager.UpdateCall.CallCount).To(Equal(1))
+			})
+		})
+
+		Context("when the cloud config would not change", func() {
+			It("applies the cloud config without prompting", func() {
+				err := command.Execute([]string{}, incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(cloudConfigManager.DiffCall.CallCount).To(Equal(1))
+				Expect(logger.PromptCall.CallCount).To(Equal(0))
+				Expect(cloudConfigManager.UpdateCall.CallCount).To(Equal(1))
+			})
+		})
+
+		Context("when --cloud-config-diff-only is passed", func() {
+			var stateWithDirector storage.State
+
+			BeforeEach(func() {
+				stateWithDirector = storage.State{
+					BOSH: storage.BOSH{DirectorAddress: "some-director-address"},
+				}
+			})
+
+			It("prints the cloud config diff without changing anything", func() {
+				cloudConfigManager.DiffCall.Returns.Diff = bosh.ConfigDiff{
+					Diff: [][]interface{}{{"- name: default", "added"}},
+				}
+
+				err := command.Execute([]string{"--cloud-config-diff-only"}, stateWithDirector)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(cloudConfigManager.DiffCall.Receives.State).To(Equal(stateWithDirector))
+				Expect(logger.PrintlnCall.Messages).To(Equal([]string{"+ - name: default"}))
+
+				Expect(envIDManager.SyncCall.CallCount).To(Equal(0))
+				Expect(terraformManager.ApplyCall.CallCount).To(Equal(0))
+				Expect(boshManager.CreateDirectorCall.CallCount).To(Equal(0))
+				Expect(cloudConfigManager.UpdateCall.CallCount).To(Equal(0))
+				Expect(runtimeConfigManager.UpdateCall.CallCount).To(Equal(0))
+			})
+
+			It("reports when there are no changes", func() {
+				err := command.Execute([]string{"--cloud-config-diff-only"}, stateWithDirector)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PrintlnCall.Messages).To(Equal([]string{"no changes to cloud config"}))
+			})
+
+			It("returns an error when there is no director", func() {
+				err := command.Execute([]string{"--cloud-config-diff-only"}, storage.State{})
+				Expect(err).To(MatchError("Cannot diff cloud config: a bosh director has not been created yet"))
+			})
+
+			It("returns an error when the diff fails", func() {
+				cloudConfigManager.DiffCall.Returns.Error = errors.New("guava")
+
+				err := command.Execute([]string{"--cloud-config-diff-only"}, stateWithDirector)
+				Expect(err).To(MatchError("Diff cloud config: guava"))
+			})
+		})
+
 		Context("when the config or state has the no-director flag set", func() {
 			BeforeEach(func() {
 				terraformManager.ApplyCall.Returns.BBLState.NoDirector = true
@@ -401,6 +871,17 @@ var _ = Describe("Up", func() {
 				})
 			})
 
+			Context("when the cloud config cannot be diffed", func() {
+				BeforeEach(func() {
+					cloudConfigManager.DiffCall.Returns.Error = errors.New("papaya")
+				})
+
+				It("returns an error", func() {
+					err := command.Execute([]string{}, storage.State{})
+					Expect(err).To(MatchError("Diff cloud config: papaya"))
+				})
+			})
+
 			Context("when the cloud config cannot be uploaded", func() {
 				BeforeEach(func() {
 					cloudConfigManager.UpdateCall.Returns.Error = errors.New("coconut")
@@ -412,6 +893,24 @@ var _ = Describe("Up", func() {
 				})
 			})
 
+			Context("when the runtime config cannot be uploaded", func() {
+				BeforeEach(func() {
+					runtimeConfigManager.UpdateCall.Returns.Error = errors.New("mango")
+				})
+
+				It("returns an error", func() {
+					err := command.Execute([]string{}, storage.State{})
+					Expect(err).To(MatchError("Update runtime config: mango"))
+				})
+			})
+
+			Context("when the runtime config ops file cannot be read", func() {
+				It("returns an error", func() {
+					err := command.Execute([]string{"--runtime-config-ops-file", "some/fake/path"}, storage.State{})
+					Expect(err).To(MatchError("Reading runtime-config-ops-file contents: open some/fake/path: no such file or directory"))
+				})
+			})
+
 			Context("when the terraform manager fails with terraformManagerError", func() {
 				var (
 					managerError *fakes.TerraformManagerError
@@ -550,6 +1049,16 @@ var _ = Describe("Up", func() {
 			})
 		})
 
+		Context("when the user provides the azs flag", func() {
+			It("splits the comma separated zones", func() {
+				config, err := command.ParseArgs([]string{
+					"--azs", "z1, z2,,z3",
+				}, storage.State{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(config.AZs).To(Equal([]string{"z1", "z2", "z3"}))
+			})
+		})
+
 		Context("when the user provides the name flag", func() {
 			It("passes the name flag in the up config", func() {
 				config, err := command.ParseArgs([]string{
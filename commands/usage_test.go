@@ -41,13 +41,18 @@ Usage:
 Global Options:
   --help      [-h]       Prints usage. Use "bbl [command] --help" for more information about a command
   --state-dir            Directory containing the bbl state
+  --config               Path to a bbl.yml describing the environment (defaults to bbl.yml in the state dir)
   --debug                Prints debugging output
+  --output               Format of query commands: text (default), json or yaml
   --version   [-v]       Prints version
 
 Basic Commands: A good place to start
   up                      Deploys BOSH director on an IAAS. Updates existing director
   print-env               All environment variables needed for targeting BOSH. Use with: eval "$(bbl print-env)"
+  ssh                     Opens an SSH session on the jumpbox or director
+  tunnel                  Runs a SOCKS5 proxy to the director through the jumpbox
   create-lbs              Creates recommended load balancer(s) for CF, Concourse
+  completion              Prints a bash, zsh or fish completion script. Use with: source <(bbl completion bash)
 
 Maintenance Lifecycle Commands:
   destroy                 Tears down BOSH director infrastructure. Cleans up state directory
@@ -55,25 +60,31 @@ Maintenance Lifecycle Commands:
   delete-lbs              Deletes attached load balancer(s)
   rotate                  Rotates SSH key for the jumpbox user
   plan                    Populates a state directory with the latest config without applying it
+  cleanup-leftovers       Deletes resources left behind by a failed or partial destroy, found by env ID
 
 Environmental Detail Commands: Useful for automation and gaining access
   bosh-deployment-vars    Prints required variables for BOSH deployment
   jumpbox-deployment-vars Prints required variables for jumpbox deployment
   cloud-config            Prints suggested cloud configuration for BOSH environment
+  runtime-config          Prints runtime configuration applied to BOSH environment
   jumpbox-address         Prints BOSH jumpbox address
   director-address        Prints BOSH director address
   director-username       Prints BOSH director username
   director-password       Prints BOSH director password
   director-ca-cert        Prints BOSH director CA certificate
   env-id                  Prints environment ID
+  status                  Prints an overview of the environment and checks it is reachable
   ssh-key                 Prints jumpbox SSH private key
   director-ssh-key        Prints director SSH private key
   lbs                     Prints load balancer(s) and DNS records
+  outputs                 Prints all terraform outputs, e.g. subnet IDs and security groups
+  cert-status             Prints subject, issuer and expiry of certificates managed by bbl
 
 Troubleshooting Commands:
   help                    Prints usage
   version                 Prints version
   latest-error            Prints the output from the latest call to terraform
+  doctor                  Checks the local toolchain, state directory and IAAS credentials
 `, "\n")))
 		})
 	})
@@ -88,7 +99,9 @@ Troubleshooting Commands:
 Global Options:
   --help      [-h]       Prints usage. Use "bbl [command] --help" for more information about a command
   --state-dir            Directory containing the bbl state
+  --config               Path to a bbl.yml describing the environment (defaults to bbl.yml in the state dir)
   --debug                Prints debugging output
+  --output               Format of query commands: text (default), json or yaml
   --version   [-v]       Prints version
 
 [my-command command options]
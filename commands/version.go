@@ -1,7 +1,6 @@
 package commands
 
 import (
-	"fmt"
 	"runtime"
 
 	"github.com/cloudfoundry/bosh-bootloader/storage"
@@ -12,20 +11,36 @@ const BBLDevVersion = "dev"
 type Version struct {
 	logger  logger
 	version string
+	output  string
 }
 
-func NewVersion(version string, logger logger) Version {
+type versionOutput struct {
+	Version string `json:"version" yaml:"version"`
+	OS      string `json:"os"      yaml:"os"`
+	Arch    string `json:"arch"    yaml:"arch"`
+}
+
+func NewVersion(version string, logger logger, output string) Version {
 	if version == "" {
 		version = BBLDevVersion
 	}
 	return Version{
 		logger:  logger,
-		version: fmt.Sprintf("%s (%s/%s)", version, runtime.GOOS, runtime.GOARCH),
+		version: version,
+		output:  output,
 	}
 }
 
 func (v Version) Execute(subcommandFlags []string, state storage.State) error {
-	v.logger.Printf("bbl %s\n", v.version)
+	if structuredOutput(v.output) {
+		return printFormatted(v.logger, v.output, versionOutput{
+			Version: v.version,
+			OS:      runtime.GOOS,
+			Arch:    runtime.GOARCH,
+		})
+	}
+
+	v.logger.Printf("bbl %s (%s/%s)\n", v.version, runtime.GOOS, runtime.GOARCH)
 	return nil
 }
 
@@ -24,7 +24,7 @@ var _ = Describe("Version", func() {
 
 	Describe("CheckFastFails", func() {
 		BeforeEach(func() {
-			version = commands.NewVersion("", logger)
+			version = commands.NewVersion("", logger, commands.TextOutput)
 		})
 
 		It("returns no error", func() {
@@ -36,7 +36,7 @@ var _ = Describe("Version", func() {
 	Describe("Execute", func() {
 		Context("when no version number was passed in", func() {
 			BeforeEach(func() {
-				version = commands.NewVersion("", logger)
+				version = commands.NewVersion("", logger, commands.TextOutput)
 			})
 
 			Describe("Execute", func() {
@@ -53,7 +53,7 @@ var _ = Describe("Version", func() {
 
 		Context("when a version number was passed in", func() {
 			BeforeEach(func() {
-				version = commands.NewVersion("1.2.3", logger)
+				version = commands.NewVersion("1.2.3", logger, commands.TextOutput)
 			})
 
 			Describe("Execute", func() {
@@ -67,5 +67,23 @@ var _ = Describe("Version", func() {
 				})
 			})
 		})
+
+		Context("when --output json is passed", func() {
+			BeforeEach(func() {
+				version = commands.NewVersion("1.2.3", logger, commands.JSONOutput)
+			})
+
+			It("prints the version, os and arch as json", func() {
+				err := version.Execute([]string{}, storage.State{})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PrintlnCall.Messages).To(HaveLen(1))
+				Expect(logger.PrintlnCall.Messages[0]).To(MatchJSON(fmt.Sprintf(`{
+					"version": "1.2.3",
+					"os": %q,
+					"arch": %q
+				}`, runtime.GOOS, runtime.GOARCH)))
+			})
+		})
 	})
 })
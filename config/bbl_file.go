@@ -0,0 +1,183 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+const bblFileName = "bbl.yml"
+
+// bblFile declares an environment so that it can be recreated with a plain
+// "bbl up". It holds no credentials; those still come from flags or BBL_*
+// environment variables.
+type bblFile struct {
+	IAAS    string `yaml:"iaas"`
+	Name    string `yaml:"name"`
+	OpsFile string `yaml:"ops_file"`
+
+	AWS struct {
+		Region string `yaml:"region"`
+	} `yaml:"aws"`
+
+	GCP struct {
+		Region string `yaml:"region"`
+	} `yaml:"gcp"`
+
+	Azure struct {
+		Location string `yaml:"location"`
+	} `yaml:"azure"`
+
+	LB struct {
+		Type   string `yaml:"type"`
+		Cert   string `yaml:"cert"`
+		Key    string `yaml:"key"`
+		Chain  string `yaml:"chain"`
+		Domain string `yaml:"domain"`
+	} `yaml:"lb"`
+
+	Features struct {
+		NoDirector          bool     `yaml:"no_director"`
+		PrivateDirector     bool     `yaml:"private_director"`
+		AZs                 []string `yaml:"azs"`
+		AllowedIngressCIDRs []string `yaml:"allowed_ingress_cidrs"`
+	} `yaml:"features"`
+}
+
+// fileFlag is a subcommand flag set in bbl.yml. A flag without values is a
+// bool flag.
+type fileFlag struct {
+	name   string
+	values []string
+}
+
+// loadBBLFile reads the file at path, or bbl.yml in the state dir when path
+// is empty. Only an explicitly passed file has to exist. Relative paths in
+// the file are relative to the file.
+func loadBBLFile(path, stateDir string) (bblFile, error) {
+	required := path != ""
+	if !required {
+		path = filepath.Join(stateDir, bblFileName)
+	}
+
+	contents, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) && !required {
+		return bblFile{}, nil
+	}
+	if err != nil {
+		return bblFile{}, fmt.Errorf("Reading bbl config file: %s", err)
+	}
+
+	var file bblFile
+	err = yaml.UnmarshalStrict(contents, &file)
+	if err != nil {
+		return bblFile{}, fmt.Errorf("Parsing bbl config file %s: %s", path, err)
+	}
+
+	dir := filepath.Dir(path)
+	for _, p := range []*string{&file.OpsFile, &file.LB.Cert, &file.LB.Key, &file.LB.Chain} {
+		if *p != "" && !filepath.IsAbs(*p) {
+			*p = filepath.Join(dir, *p)
+		}
+	}
+
+	return file, nil
+}
+
+// applyGlobals sets the global flags that were neither passed nor set in
+// the environment, so that flags and BBL_* variables win over the file.
+func (f bblFile) applyGlobals(globals *globalFlags) {
+	setDefault(&globals.IAAS, f.IAAS)
+	setDefault(&globals.AWSRegion, f.AWS.Region)
+	setDefault(&globals.GCPRegion, f.GCP.Region)
+	setDefault(&globals.AzureLocation, f.Azure.Location)
+}
+
+func (f bblFile) upFlags() []fileFlag {
+	var upFlags []fileFlag
+	if f.Name != "" {
+		upFlags = append(upFlags, fileFlag{name: "name", values: []string{f.Name}})
+	}
+	if f.OpsFile != "" {
+		upFlags = append(upFlags, fileFlag{name: "ops-file", values: []string{f.OpsFile}})
+	}
+	if f.Features.NoDirector {
+		upFlags = append(upFlags, fileFlag{name: "no-director"})
+	}
+	if f.Features.PrivateDirector {
+		upFlags = append(upFlags, fileFlag{name: "private-director"})
+	}
+	if len(f.Features.AZs) > 0 {
+		upFlags = append(upFlags, fileFlag{name: "azs", values: []string{strings.Join(f.Features.AZs, ",")}})
+	}
+	if len(f.Features.AllowedIngressCIDRs) > 0 {
+		upFlags = append(upFlags, fileFlag{name: "allowed-ingress-cidr", values: f.Features.AllowedIngressCIDRs})
+	}
+	return upFlags
+}
+
+func (f bblFile) lbFlags() []fileFlag {
+	if f.LB.Type == "" {
+		return nil
+	}
+
+	lbFlags := []fileFlag{{name: "type", values: []string{f.LB.Type}}}
+	for _, flag := range []fileFlag{
+		{name: "cert", values: []string{f.LB.Cert}},
+		{name: "key", values: []string{f.LB.Key}},
+		{name: "chain", values: []string{f.LB.Chain}},
+		{name: "domain", values: []string{f.LB.Domain}},
+	} {
+		if flag.values[0] != "" {
+			lbFlags = append(lbFlags, flag)
+		}
+	}
+	return lbFlags
+}
+
+// withFileFlags prepends the file flags that args does not already pass.
+func withFileFlags(fileFlags []fileFlag, args []string) []string {
+	var merged []string
+	for _, flag := range fileFlags {
+		if passesFlag(args, flag.name) {
+			continue
+		}
+
+		if len(flag.values) == 0 {
+			merged = append(merged, "--"+flag.name)
+		}
+		for _, value := range flag.values {
+			merged = append(merged, "--"+flag.name, value)
+		}
+	}
+
+	if len(merged) == 0 {
+		return args
+	}
+
+	return append(merged, args...)
+}
+
+func passesFlag(args []string, name string) bool {
+	for _, arg := range args {
+		if !strings.HasPrefix(arg, "-") {
+			continue
+		}
+
+		arg = strings.TrimLeft(arg, "-")
+		if arg == name || strings.HasPrefix(arg, name+"=") {
+			return true
+		}
+	}
+	return false
+}
+
+func setDefault(value *string, fileValue string) {
+	if *value == "" {
+		*value = fileValue
+	}
+}
@@ -0,0 +1,178 @@
+package config_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/cloudfoundry/bosh-bootloader/application"
+	"github.com/cloudfoundry/bosh-bootloader/config"
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("bbl.yml", func() {
+	var (
+		c                  config.Config
+		fakeStateBootstrap *fakes.StateBootstrap
+		stateDir           string
+	)
+
+	BeforeEach(func() {
+		fakeStateBootstrap = &fakes.StateBootstrap{}
+		c = config.NewConfig(fakeStateBootstrap, &fakes.Logger{})
+		os.Clearenv()
+
+		var err error
+		stateDir, err = ioutil.TempDir("", "bbl-file")
+		Expect(err).NotTo(HaveOccurred())
+
+		err = ioutil.WriteFile(filepath.Join(stateDir, "bbl.yml"), []byte(`
+iaas: aws
+name: some-env
+ops_file: ops/bosh.yml
+aws:
+  region: us-west-1
+lb:
+  type: cf
+  cert: certs/lb.crt
+  key: /abs/lb.key
+  domain: cf.example.com
+features:
+  private_director: true
+  azs: [us-west-1a, us-west-1b]
+  allowed_ingress_cidrs: [10.0.0.0/8, 192.168.0.0/16]
+`), os.ModePerm)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(stateDir)
+	})
+
+	It("uses the iaas and region from the file", func() {
+		appConfig, err := c.Bootstrap([]string{"bbl", "--state-dir", stateDir, "destroy"})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(appConfig.State.IAAS).To(Equal("aws"))
+		Expect(appConfig.State.AWS.Region).To(Equal("us-west-1"))
+	})
+
+	It("prefers flags and environment variables over the file", func() {
+		os.Setenv("BBL_AWS_REGION", "us-east-1")
+
+		appConfig, err := c.Bootstrap([]string{"bbl", "--state-dir", stateDir, "destroy"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(appConfig.State.AWS.Region).To(Equal("us-east-1"))
+
+		appConfig, err = c.Bootstrap([]string{"bbl", "--state-dir", stateDir, "--aws-region", "eu-west-1", "destroy"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(appConfig.State.AWS.Region).To(Equal("eu-west-1"))
+	})
+
+	It("checks the file against the state like flags", func() {
+		fakeStateBootstrap.GetStateCall.Returns.State = storage.State{
+			IAAS: "aws",
+			AWS:  storage.AWS{Region: "some-old-region"},
+		}
+
+		_, err := c.Bootstrap([]string{"bbl", "--state-dir", stateDir, "destroy"})
+		Expect(err).To(MatchError("The region cannot be changed for an existing environment. The current region is some-old-region."))
+	})
+
+	It("passes the up flags from the file, resolving paths relative to it", func() {
+		appConfig, err := c.Bootstrap([]string{"bbl", "--state-dir", stateDir, "up"})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(appConfig.SubcommandFlags).To(Equal(application.StringSlice{
+			"--name", "some-env",
+			"--ops-file", filepath.Join(stateDir, "ops", "bosh.yml"),
+			"--private-director",
+			"--azs", "us-west-1a,us-west-1b",
+			"--allowed-ingress-cidr", "10.0.0.0/8",
+			"--allowed-ingress-cidr", "192.168.0.0/16",
+		}))
+	})
+
+	It("lets subcommand flags replace the flags from the file", func() {
+		appConfig, err := c.Bootstrap([]string{"bbl", "--state-dir", stateDir, "plan", "--ops-file=other.yml", "--allowed-ingress-cidr", "1.2.3.4/32"})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(appConfig.SubcommandFlags).To(Equal(application.StringSlice{
+			"--name", "some-env",
+			"--private-director",
+			"--azs", "us-west-1a,us-west-1b",
+			"--ops-file=other.yml",
+			"--allowed-ingress-cidr", "1.2.3.4/32",
+		}))
+	})
+
+	It("returns the load balancer flags for up to converge to", func() {
+		appConfig, err := c.Bootstrap([]string{"bbl", "--state-dir", stateDir, "up"})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(appConfig.LBFlags).To(Equal(application.StringSlice{
+			"--type", "cf",
+			"--cert", filepath.Join(stateDir, "certs", "lb.crt"),
+			"--key", "/abs/lb.key",
+			"--domain", "cf.example.com",
+		}))
+	})
+
+	It("passes the load balancer flags to create-lbs", func() {
+		appConfig, err := c.Bootstrap([]string{"bbl", "--state-dir", stateDir, "create-lbs", "--domain", "other.example.com"})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(appConfig.SubcommandFlags).To(Equal(application.StringSlice{
+			"--type", "cf",
+			"--cert", filepath.Join(stateDir, "certs", "lb.crt"),
+			"--key", "/abs/lb.key",
+			"--domain", "other.example.com",
+		}))
+		Expect(appConfig.LBFlags).To(BeEmpty())
+	})
+
+	Context("when --config is passed", func() {
+		It("reads that file instead", func() {
+			configPath := filepath.Join(stateDir, "other.yml")
+			err := ioutil.WriteFile(configPath, []byte("iaas: gcp\ngcp:\n  region: us-east1\n"), os.ModePerm)
+			Expect(err).NotTo(HaveOccurred())
+
+			appConfig, err := c.Bootstrap([]string{"bbl", "--state-dir", stateDir, "--config", configPath, "destroy"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(appConfig.State.IAAS).To(Equal("gcp"))
+			Expect(appConfig.State.GCP.Region).To(Equal("us-east1"))
+		})
+
+		It("returns an error when the file does not exist", func() {
+			_, err := c.Bootstrap([]string{"bbl", "--state-dir", stateDir, "--config", "/no/such/bbl.yml", "up"})
+			Expect(err).To(MatchError(ContainSubstring("Reading bbl config file: open /no/such/bbl.yml")))
+		})
+	})
+
+	Context("when the state dir has no bbl.yml", func() {
+		It("does not change the subcommand flags", func() {
+			os.Remove(filepath.Join(stateDir, "bbl.yml"))
+
+			appConfig, err := c.Bootstrap([]string{"bbl", "--state-dir", stateDir, "up", "--name", "some-name"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(appConfig.SubcommandFlags).To(Equal(application.StringSlice{"--name", "some-name"}))
+			Expect(appConfig.LBFlags).To(BeEmpty())
+		})
+	})
+
+	Context("when the file has an unknown key", func() {
+		It("returns an error", func() {
+			err := ioutil.WriteFile(filepath.Join(stateDir, "bbl.yml"), []byte("iaas: aws\nregion: us-west-1\n"), os.ModePerm)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = c.Bootstrap([]string{"bbl", "--state-dir", stateDir, "up"})
+			Expect(err).To(MatchError(ContainSubstring("Parsing bbl config file")))
+			Expect(err).To(MatchError(ContainSubstring("field region not found")))
+		})
+	})
+})
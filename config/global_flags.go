@@ -0,0 +1,28 @@
+package config
+
+import (
+	"reflect"
+
+	"github.com/cloudfoundry/bosh-bootloader/flags"
+)
+
+// GlobalFlags returns the global flags bbl accepts, as listed in the struct
+// tags of globalFlags, so that bbl completion stays in sync with them.
+func GlobalFlags() []flags.Flag {
+	var globals []flags.Flag
+
+	t := reflect.TypeOf(globalFlags{})
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		// Options with an optional value only take one as --name=value.
+		takesValue := field.Type.Kind() != reflect.Bool && field.Tag.Get("optional") == ""
+
+		for _, tag := range []string{"short", "long"} {
+			if name := field.Tag.Get(tag); name != "" {
+				globals = append(globals, flags.Flag{Name: name, TakesValue: takesValue})
+			}
+		}
+	}
+
+	return globals
+}
@@ -0,0 +1,22 @@
+package config_test
+
+import (
+	"github.com/cloudfoundry/bosh-bootloader/config"
+	"github.com/cloudfoundry/bosh-bootloader/flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GlobalFlags", func() {
+	It("returns the short and long names of every global flag", func() {
+		globals := config.GlobalFlags()
+
+		Expect(globals).To(ContainElement(flags.Flag{Name: "h", TakesValue: false}))
+		Expect(globals).To(ContainElement(flags.Flag{Name: "help", TakesValue: false}))
+		Expect(globals).To(ContainElement(flags.Flag{Name: "s", TakesValue: true}))
+		Expect(globals).To(ContainElement(flags.Flag{Name: "state-dir", TakesValue: true}))
+		Expect(globals).To(ContainElement(flags.Flag{Name: "output", TakesValue: true}))
+		Expect(globals).To(ContainElement(flags.Flag{Name: "aws-nat-gateway", TakesValue: false}))
+		Expect(globals).To(ContainElement(flags.Flag{Name: "vsphere-gateway", TakesValue: true}))
+	})
+})
@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strconv"
 
 	"github.com/cloudfoundry/bosh-bootloader/application"
 	"github.com/cloudfoundry/bosh-bootloader/storage"
@@ -18,11 +19,14 @@ type globalFlags struct {
 	Debug    bool   `short:"d" long:"debug"         env:"BBL_DEBUG"`
 	Version  bool   `short:"v" long:"version"`
 	StateDir string `short:"s" long:"state-dir"`
+	Config   string `long:"config"                  env:"BBL_CONFIG"`
+	Output   string `long:"output"                  env:"BBL_OUTPUT" default:"text"`
 	IAAS     string `long:"iaas"                    env:"BBL_IAAS"`
 
 	AWSAccessKeyID     string `long:"aws-access-key-id"       env:"BBL_AWS_ACCESS_KEY_ID"`
 	AWSSecretAccessKey string `long:"aws-secret-access-key"   env:"BBL_AWS_SECRET_ACCESS_KEY"`
 	AWSRegion          string `long:"aws-region"              env:"BBL_AWS_REGION"`
+	AWSNATGateway      string `long:"aws-nat-gateway"         env:"BBL_AWS_NAT_GATEWAY" optional:"yes" optional-value:"true"`
 
 	AzureClientID       string `long:"azure-client-id"        env:"BBL_AZURE_CLIENT_ID"`
 	AzureClientSecret   string `long:"azure-client-secret"    env:"BBL_AZURE_CLIENT_SECRET"`
@@ -34,6 +38,25 @@ type globalFlags struct {
 	GCPProjectID         string `long:"gcp-project-id"          env:"BBL_GCP_PROJECT_ID"`
 	GCPZone              string `long:"gcp-zone"                env:"BBL_GCP_ZONE"`
 	GCPRegion            string `long:"gcp-region"              env:"BBL_GCP_REGION"`
+
+	OpenStackAuthURL     string `long:"openstack-auth-url"      env:"BBL_OPENSTACK_AUTH_URL"`
+	OpenStackAZ          string `long:"openstack-az"            env:"BBL_OPENSTACK_AZ"`
+	OpenStackNetworkName string `long:"openstack-network-name"  env:"BBL_OPENSTACK_NETWORK_NAME"`
+	OpenStackProject     string `long:"openstack-project"       env:"BBL_OPENSTACK_PROJECT"`
+	OpenStackDomain      string `long:"openstack-domain"        env:"BBL_OPENSTACK_DOMAIN"`
+	OpenStackRegion      string `long:"openstack-region"        env:"BBL_OPENSTACK_REGION"`
+	OpenStackUsername    string `long:"openstack-username"      env:"BBL_OPENSTACK_USERNAME"`
+	OpenStackPassword    string `long:"openstack-password"      env:"BBL_OPENSTACK_PASSWORD"`
+
+	VSphereVCenterIP       string `long:"vsphere-vcenter-ip"       env:"BBL_VSPHERE_VCENTER_IP"`
+	VSphereVCenterUser     string `long:"vsphere-vcenter-user"     env:"BBL_VSPHERE_VCENTER_USER"`
+	VSphereVCenterPassword string `long:"vsphere-vcenter-password" env:"BBL_VSPHERE_VCENTER_PASSWORD"`
+	VSphereVCenterDC       string `long:"vsphere-vcenter-dc"       env:"BBL_VSPHERE_VCENTER_DC"`
+	VSphereVCenterCluster  string `long:"vsphere-vcenter-cluster"  env:"BBL_VSPHERE_VCENTER_CLUSTER"`
+	VSphereVCenterDS       string `long:"vsphere-vcenter-ds"       env:"BBL_VSPHERE_VCENTER_DS"`
+	VSphereNetwork         string `long:"vsphere-network"          env:"BBL_VSPHERE_NETWORK"`
+	VSphereSubnet          string `long:"vsphere-subnet"           env:"BBL_VSPHERE_SUBNET"`
+	VSphereGateway         string `long:"vsphere-gateway"          env:"BBL_VSPHERE_GATEWAY"`
 }
 
 type logger interface {
@@ -71,8 +94,15 @@ func (c Config) Bootstrap(args []string) (application.Configuration, error) {
 		return application.Configuration{}, err
 	}
 
+	if !validOutput(globalFlags.Output) {
+		return application.Configuration{}, fmt.Errorf("--output must be one of text, json or yaml, got %q.", globalFlags.Output)
+	}
+
 	if globalFlags.Version || (len(remainingArgs) > 0 && remainingArgs[0] == "version") {
 		return application.Configuration{
+			Global: application.GlobalConfiguration{
+				Output: globalFlags.Output,
+			},
 			ShowCommandHelp: globalFlags.Help,
 			Command:         "version",
 		}, nil
@@ -105,6 +135,12 @@ func (c Config) Bootstrap(args []string) (application.Configuration, error) {
 		c.logger.Println("Deprecation warning: the --gcp-zone flag (BBL_GCP_ZONE) is now ignored.")
 	}
 
+	file, err := loadBBLFile(globalFlags.Config, globalFlags.StateDir)
+	if err != nil {
+		return application.Configuration{}, err
+	}
+	file.applyGlobals(&globalFlags)
+
 	state, err := c.stateBootstrap.GetState(globalFlags.StateDir)
 	if err != nil {
 		return application.Configuration{}, err
@@ -115,18 +151,36 @@ func (c Config) Bootstrap(args []string) (application.Configuration, error) {
 		return application.Configuration{}, err
 	}
 
+	command, subcommandFlags := remainingArgs[0], remainingArgs[1:]
+	var lbFlags []string
+	switch command {
+	case "up", "plan", "rotate":
+		subcommandFlags = withFileFlags(file.upFlags(), subcommandFlags)
+		if command == "up" {
+			lbFlags = withFileFlags(file.lbFlags(), nil)
+		}
+	case "create-lbs", "update-lbs":
+		subcommandFlags = withFileFlags(file.lbFlags(), subcommandFlags)
+	}
+
 	return application.Configuration{
 		Global: application.GlobalConfiguration{
 			Debug:    globalFlags.Debug,
 			StateDir: globalFlags.StateDir,
+			Output:   globalFlags.Output,
 		},
 		State:           state,
-		Command:         remainingArgs[0],
-		SubcommandFlags: remainingArgs[1:],
+		Command:         command,
+		SubcommandFlags: subcommandFlags,
+		LBFlags:         lbFlags,
 		ShowCommandHelp: globalFlags.Help,
 	}, nil
 }
 
+func validOutput(output string) bool {
+	return output == "text" || output == "json" || output == "yaml"
+}
+
 func updateIAASState(globalFlags globalFlags, state storage.State) (storage.State, error) {
 	if globalFlags.IAAS != "" {
 		if state.IAAS != "" && globalFlags.IAAS != state.IAAS {
@@ -146,6 +200,12 @@ func updateIAASState(globalFlags globalFlags, state storage.State) (storage.Stat
 	case "azure":
 		state, err := updateAzureState(globalFlags, state)
 		return state, err
+	case "openstack":
+		state, err := updateOpenStackState(globalFlags, state)
+		return state, err
+	case "vsphere":
+		state, err := updateVSphereState(globalFlags, state)
+		return state, err
 	}
 
 	return state, nil
@@ -165,6 +225,13 @@ func updateAWSState(globalFlags globalFlags, state storage.State) (storage.State
 		}
 		state.AWS.Region = globalFlags.AWSRegion
 	}
+	if globalFlags.AWSNATGateway != "" {
+		natGateway, err := strconv.ParseBool(globalFlags.AWSNATGateway)
+		if err != nil {
+			return storage.State{}, fmt.Errorf("--aws-nat-gateway must be true or false, got %q", globalFlags.AWSNATGateway)
+		}
+		state.AWS.NATGateway = natGateway
+	}
 
 	return state, nil
 }
@@ -213,9 +280,82 @@ func updateAzureState(globalFlags globalFlags, state storage.State) (storage.Sta
 	return state, nil
 }
 
+func updateOpenStackState(globalFlags globalFlags, state storage.State) (storage.State, error) {
+	if globalFlags.OpenStackAuthURL != "" {
+		state.OpenStack.AuthURL = globalFlags.OpenStackAuthURL
+	}
+	if globalFlags.OpenStackAZ != "" {
+		state.OpenStack.AZ = globalFlags.OpenStackAZ
+	}
+	if globalFlags.OpenStackNetworkName != "" {
+		state.OpenStack.ExternalNetworkName = globalFlags.OpenStackNetworkName
+	}
+	if globalFlags.OpenStackProject != "" {
+		state.OpenStack.Project = globalFlags.OpenStackProject
+	}
+	if globalFlags.OpenStackDomain != "" {
+		state.OpenStack.Domain = globalFlags.OpenStackDomain
+	}
+	if globalFlags.OpenStackRegion != "" {
+		if state.OpenStack.Region != "" && globalFlags.OpenStackRegion != state.OpenStack.Region {
+			regionMismatch := fmt.Sprintf("The region cannot be changed for an existing environment. The current region is %s.", state.OpenStack.Region)
+			return storage.State{}, errors.New(regionMismatch)
+		}
+		state.OpenStack.Region = globalFlags.OpenStackRegion
+	}
+	if globalFlags.OpenStackUsername != "" {
+		state.OpenStack.Username = globalFlags.OpenStackUsername
+	}
+	if globalFlags.OpenStackPassword != "" {
+		state.OpenStack.Password = globalFlags.OpenStackPassword
+	}
+
+	return state, nil
+}
+
+func updateVSphereState(globalFlags globalFlags, state storage.State) (storage.State, error) {
+	if globalFlags.VSphereVCenterIP != "" {
+		state.VSphere.VCenterIP = globalFlags.VSphereVCenterIP
+	}
+	if globalFlags.VSphereVCenterUser != "" {
+		state.VSphere.VCenterUser = globalFlags.VSphereVCenterUser
+	}
+	if globalFlags.VSphereVCenterPassword != "" {
+		state.VSphere.VCenterPassword = globalFlags.VSphereVCenterPassword
+	}
+	if globalFlags.VSphereVCenterDC != "" {
+		state.VSphere.VCenterDC = globalFlags.VSphereVCenterDC
+	}
+	if globalFlags.VSphereVCenterCluster != "" {
+		state.VSphere.VCenterCluster = globalFlags.VSphereVCenterCluster
+	}
+	if globalFlags.VSphereVCenterDS != "" {
+		state.VSphere.VCenterDS = globalFlags.VSphereVCenterDS
+	}
+	if globalFlags.VSphereNetwork != "" {
+		if state.VSphere.Network != "" && globalFlags.VSphereNetwork != state.VSphere.Network {
+			networkMismatch := fmt.Sprintf("The network cannot be changed for an existing environment. The current network is %s.", state.VSphere.Network)
+			return storage.State{}, errors.New(networkMismatch)
+		}
+		state.VSphere.Network = globalFlags.VSphereNetwork
+	}
+	if globalFlags.VSphereSubnet != "" {
+		if state.VSphere.Subnet != "" && globalFlags.VSphereSubnet != state.VSphere.Subnet {
+			subnetMismatch := fmt.Sprintf("The subnet cannot be changed for an existing environment. The current subnet is %s.", state.VSphere.Subnet)
+			return storage.State{}, errors.New(subnetMismatch)
+		}
+		state.VSphere.Subnet = globalFlags.VSphereSubnet
+	}
+	if globalFlags.VSphereGateway != "" {
+		state.VSphere.Gateway = globalFlags.VSphereGateway
+	}
+
+	return state, nil
+}
+
 func ValidateIAAS(state storage.State) error {
-	if state.IAAS == "" || (state.IAAS != "gcp" && state.IAAS != "aws" && state.IAAS != "azure") {
-		return errors.New("--iaas [gcp, aws, azure] must be provided or BBL_IAAS must be set")
+	if state.IAAS == "" || (state.IAAS != "gcp" && state.IAAS != "aws" && state.IAAS != "azure" && state.IAAS != "openstack" && state.IAAS != "vsphere") {
+		return errors.New("--iaas [gcp, aws, azure, openstack, vsphere] must be provided or BBL_IAAS must be set")
 	}
 	if state.IAAS == "aws" {
 		err := validateAWS(state.AWS)
@@ -235,18 +375,31 @@ func ValidateIAAS(state storage.State) error {
 			return err
 		}
 	}
+	if state.IAAS == "openstack" {
+		err := validateOpenStack(state.OpenStack)
+		if err != nil {
+			return err
+		}
+	}
+	if state.IAAS == "vsphere" {
+		err := validateVSphere(state.VSphere)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 func NeedsIAASCreds(command string) bool {
 	_, ok := map[string]struct{}{
-		"up":         struct{}{},
-		"down":       struct{}{},
-		"destroy":    struct{}{},
-		"create-lbs": struct{}{},
-		"delete-lbs": struct{}{},
-		"update-lbs": struct{}{},
-		"rotate":     struct{}{},
+		"up":                struct{}{},
+		"down":              struct{}{},
+		"destroy":           struct{}{},
+		"create-lbs":        struct{}{},
+		"delete-lbs":        struct{}{},
+		"update-lbs":        struct{}{},
+		"rotate":            struct{}{},
+		"cleanup-leftovers": struct{}{},
 	}[command]
 	return ok
 }
@@ -293,6 +446,65 @@ func validateAzure(azure storage.Azure) error {
 	return nil
 }
 
+func validateOpenStack(openstack storage.OpenStack) error {
+	if openstack.AuthURL == "" {
+		return errors.New("OpenStack auth url must be provided (--openstack-auth-url or BBL_OPENSTACK_AUTH_URL)")
+	}
+	if openstack.AZ == "" {
+		return errors.New("OpenStack availability zone must be provided (--openstack-az or BBL_OPENSTACK_AZ)")
+	}
+	if openstack.ExternalNetworkName == "" {
+		return errors.New("OpenStack external network name must be provided (--openstack-network-name or BBL_OPENSTACK_NETWORK_NAME)")
+	}
+	if openstack.Project == "" {
+		return errors.New("OpenStack project must be provided (--openstack-project or BBL_OPENSTACK_PROJECT)")
+	}
+	if openstack.Domain == "" {
+		return errors.New("OpenStack domain must be provided (--openstack-domain or BBL_OPENSTACK_DOMAIN)")
+	}
+	if openstack.Region == "" {
+		return errors.New("OpenStack region must be provided (--openstack-region or BBL_OPENSTACK_REGION)")
+	}
+	if openstack.Username == "" {
+		return errors.New("OpenStack username must be provided (--openstack-username or BBL_OPENSTACK_USERNAME)")
+	}
+	if openstack.Password == "" {
+		return errors.New("OpenStack password must be provided (--openstack-password or BBL_OPENSTACK_PASSWORD)")
+	}
+	return nil
+}
+
+func validateVSphere(vsphere storage.VSphere) error {
+	if vsphere.VCenterIP == "" {
+		return errors.New("vSphere vCenter IP must be provided (--vsphere-vcenter-ip or BBL_VSPHERE_VCENTER_IP)")
+	}
+	if vsphere.VCenterUser == "" {
+		return errors.New("vSphere vCenter user must be provided (--vsphere-vcenter-user or BBL_VSPHERE_VCENTER_USER)")
+	}
+	if vsphere.VCenterPassword == "" {
+		return errors.New("vSphere vCenter password must be provided (--vsphere-vcenter-password or BBL_VSPHERE_VCENTER_PASSWORD)")
+	}
+	if vsphere.VCenterDC == "" {
+		return errors.New("vSphere vCenter datacenter must be provided (--vsphere-vcenter-dc or BBL_VSPHERE_VCENTER_DC)")
+	}
+	if vsphere.VCenterCluster == "" {
+		return errors.New("vSphere vCenter cluster must be provided (--vsphere-vcenter-cluster or BBL_VSPHERE_VCENTER_CLUSTER)")
+	}
+	if vsphere.VCenterDS == "" {
+		return errors.New("vSphere vCenter datastore must be provided (--vsphere-vcenter-ds or BBL_VSPHERE_VCENTER_DS)")
+	}
+	if vsphere.Network == "" {
+		return errors.New("vSphere network must be provided (--vsphere-network or BBL_VSPHERE_NETWORK)")
+	}
+	if vsphere.Subnet == "" {
+		return errors.New("vSphere subnet must be provided (--vsphere-subnet or BBL_VSPHERE_SUBNET)")
+	}
+	if vsphere.Gateway == "" {
+		return errors.New("vSphere gateway must be provided (--vsphere-gateway or BBL_VSPHERE_GATEWAY)")
+	}
+	return nil
+}
+
 func parseServiceAccountKey(serviceAccountKey string) (string, string, error) {
 	var key string
 
@@ -98,6 +98,35 @@ var _ = Describe("LoadState", func() {
 				Expect(appConfig.Global.StateDir).To(Equal(fullStateDirPath))
 			})
 
+			It("defaults the output format to text", func() {
+				appConfig, err := c.Bootstrap([]string{"bbl", "lbs"})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(appConfig.Global.Output).To(Equal("text"))
+			})
+
+			It("returns the output format", func() {
+				appConfig, err := c.Bootstrap([]string{"bbl", "lbs", "--output", "yaml"})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(appConfig.Command).To(Equal("lbs"))
+				Expect(appConfig.SubcommandFlags).To(BeEmpty())
+				Expect(appConfig.Global.Output).To(Equal("yaml"))
+			})
+
+			It("returns the output format for the version command", func() {
+				appConfig, err := c.Bootstrap([]string{"bbl", "--output", "json", "version"})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(appConfig.Command).To(Equal("version"))
+				Expect(appConfig.Global.Output).To(Equal("json"))
+			})
+
+			It("returns an error for an unknown output format", func() {
+				_, err := c.Bootstrap([]string{"bbl", "--output", "xml", "lbs"})
+				Expect(err).To(MatchError(`--output must be one of text, json or yaml, got "xml".`))
+			})
+
 			Context("when --help is passed in after a command", func() {
 				It("returns command help", func() {
 					args := []string{
@@ -373,6 +402,84 @@ var _ = Describe("LoadState", func() {
 					})
 				})
 
+				Context("when --aws-nat-gateway is passed in", func() {
+					It("switches the environment to a nat gateway", func() {
+						appConfig, err := c.Bootstrap([]string{
+							"bbl",
+							"--aws-nat-gateway",
+							"up",
+						})
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(appConfig.State.AWS.NATGateway).To(BeTrue())
+					})
+				})
+
+				Context("when --aws-nat-gateway=false is passed in", func() {
+					BeforeEach(func() {
+						fakeStateBootstrap.GetStateCall.Returns.State.AWS.NATGateway = true
+					})
+
+					It("switches the environment back to a nat instance", func() {
+						appConfig, err := c.Bootstrap([]string{
+							"bbl",
+							"--aws-nat-gateway=false",
+							"up",
+						})
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(appConfig.State.AWS.NATGateway).To(BeFalse())
+					})
+				})
+
+				Context("when BBL_AWS_NAT_GATEWAY is false", func() {
+					BeforeEach(func() {
+						fakeStateBootstrap.GetStateCall.Returns.State.AWS.NATGateway = true
+						os.Setenv("BBL_AWS_NAT_GATEWAY", "false")
+					})
+
+					AfterEach(func() {
+						os.Unsetenv("BBL_AWS_NAT_GATEWAY")
+					})
+
+					It("switches the environment back to a nat instance", func() {
+						appConfig, err := c.Bootstrap([]string{
+							"bbl",
+							"up",
+						})
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(appConfig.State.AWS.NATGateway).To(BeFalse())
+					})
+				})
+
+				Context("when --aws-nat-gateway is not a boolean", func() {
+					It("returns an error", func() {
+						_, err := c.Bootstrap([]string{
+							"bbl",
+							"--aws-nat-gateway=sometimes",
+							"up",
+						})
+						Expect(err).To(MatchError(`--aws-nat-gateway must be true or false, got "sometimes"`))
+					})
+				})
+
+				Context("when --aws-nat-gateway is not passed in", func() {
+					BeforeEach(func() {
+						fakeStateBootstrap.GetStateCall.Returns.State.AWS.NATGateway = true
+					})
+
+					It("keeps the nat gateway setting from the state", func() {
+						appConfig, err := c.Bootstrap([]string{
+							"bbl",
+							"up",
+						})
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(appConfig.State.AWS.NATGateway).To(BeTrue())
+					})
+				})
+
 				DescribeTable("when non-matching configuration is passed in",
 					func(args []string, expected string) {
 						_, err := c.Bootstrap(args)
@@ -781,6 +888,233 @@ var _ = Describe("LoadState", func() {
 				)
 			})
 		})
+
+		Context("using OpenStack", func() {
+			Context("when a previous state does not exist", func() {
+				Context("when configuration is passed in by flag", func() {
+					It("returns a state object containing configuration flags", func() {
+						appConfig, err := c.Bootstrap([]string{
+							"bbl", "up",
+							"--iaas", "openstack",
+							"--openstack-auth-url", "auth-url",
+							"--openstack-az", "az",
+							"--openstack-network-name", "network-name",
+							"--openstack-project", "project",
+							"--openstack-domain", "domain",
+							"--openstack-region", "region",
+							"--openstack-username", "username",
+							"--openstack-password", "password",
+						})
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(appConfig.State.IAAS).To(Equal("openstack"))
+						Expect(appConfig.State.OpenStack).To(Equal(storage.OpenStack{
+							AuthURL:             "auth-url",
+							AZ:                  "az",
+							ExternalNetworkName: "network-name",
+							Project:             "project",
+							Domain:              "domain",
+							Region:              "region",
+							Username:            "username",
+							Password:            "password",
+						}))
+					})
+				})
+
+				Context("when configuration is passed in by env vars", func() {
+					BeforeEach(func() {
+						os.Setenv("BBL_IAAS", "openstack")
+						os.Setenv("BBL_OPENSTACK_AUTH_URL", "openstack-auth-url")
+						os.Setenv("BBL_OPENSTACK_AZ", "openstack-az")
+						os.Setenv("BBL_OPENSTACK_NETWORK_NAME", "openstack-network-name")
+						os.Setenv("BBL_OPENSTACK_PROJECT", "openstack-project")
+						os.Setenv("BBL_OPENSTACK_DOMAIN", "openstack-domain")
+						os.Setenv("BBL_OPENSTACK_REGION", "openstack-region")
+						os.Setenv("BBL_OPENSTACK_USERNAME", "openstack-username")
+						os.Setenv("BBL_OPENSTACK_PASSWORD", "openstack-password")
+					})
+
+					AfterEach(func() {
+						os.Unsetenv("BBL_IAAS")
+						os.Unsetenv("BBL_OPENSTACK_AUTH_URL")
+						os.Unsetenv("BBL_OPENSTACK_AZ")
+						os.Unsetenv("BBL_OPENSTACK_NETWORK_NAME")
+						os.Unsetenv("BBL_OPENSTACK_PROJECT")
+						os.Unsetenv("BBL_OPENSTACK_DOMAIN")
+						os.Unsetenv("BBL_OPENSTACK_REGION")
+						os.Unsetenv("BBL_OPENSTACK_USERNAME")
+						os.Unsetenv("BBL_OPENSTACK_PASSWORD")
+					})
+
+					It("returns a state containing configuration", func() {
+						appConfig, err := c.Bootstrap([]string{"bbl", "up"})
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(appConfig.State.IAAS).To(Equal("openstack"))
+						Expect(appConfig.State.OpenStack).To(Equal(storage.OpenStack{
+							AuthURL:             "openstack-auth-url",
+							AZ:                  "openstack-az",
+							ExternalNetworkName: "openstack-network-name",
+							Project:             "openstack-project",
+							Domain:              "openstack-domain",
+							Region:              "openstack-region",
+							Username:            "openstack-username",
+							Password:            "openstack-password",
+						}))
+					})
+				})
+			})
+
+			Context("when a previous state exists", func() {
+				BeforeEach(func() {
+					fakeStateBootstrap.GetStateCall.Returns.State = storage.State{
+						IAAS: "openstack",
+						OpenStack: storage.OpenStack{
+							AuthURL: "auth-url",
+							Region:  "region",
+						},
+						EnvID: "some-env-id",
+					}
+				})
+
+				It("keeps the existing configuration and adds the credentials", func() {
+					appConfig, err := c.Bootstrap([]string{
+						"bbl", "create-lbs",
+						"--openstack-username", "username",
+						"--openstack-password", "password",
+					})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(appConfig.State.OpenStack.AuthURL).To(Equal("auth-url"))
+					Expect(appConfig.State.OpenStack.Username).To(Equal("username"))
+					Expect(appConfig.State.OpenStack.Password).To(Equal("password"))
+				})
+
+				It("returns an error for a non-matching region", func() {
+					_, err := c.Bootstrap([]string{"bbl", "up", "--openstack-region", "other-region"})
+					Expect(err).To(MatchError("The region cannot be changed for an existing environment. The current region is region."))
+				})
+			})
+		})
+
+		Context("using vSphere", func() {
+			Context("when a previous state does not exist", func() {
+				Context("when configuration is passed in by flag", func() {
+					It("returns a state object containing configuration flags", func() {
+						appConfig, err := c.Bootstrap([]string{
+							"bbl", "up",
+							"--iaas", "vsphere",
+							"--vsphere-vcenter-ip", "vcenter-ip",
+							"--vsphere-vcenter-user", "vcenter-user",
+							"--vsphere-vcenter-password", "vcenter-password",
+							"--vsphere-vcenter-dc", "vcenter-dc",
+							"--vsphere-vcenter-cluster", "vcenter-cluster",
+							"--vsphere-vcenter-ds", "vcenter-ds",
+							"--vsphere-network", "network",
+							"--vsphere-subnet", "10.0.0.0/24",
+							"--vsphere-gateway", "10.0.0.1",
+						})
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(appConfig.State.IAAS).To(Equal("vsphere"))
+						Expect(appConfig.State.VSphere).To(Equal(storage.VSphere{
+							VCenterIP:       "vcenter-ip",
+							VCenterUser:     "vcenter-user",
+							VCenterPassword: "vcenter-password",
+							VCenterDC:       "vcenter-dc",
+							VCenterCluster:  "vcenter-cluster",
+							VCenterDS:       "vcenter-ds",
+							Network:         "network",
+							Subnet:          "10.0.0.0/24",
+							Gateway:         "10.0.0.1",
+						}))
+					})
+				})
+
+				Context("when configuration is passed in by env vars", func() {
+					BeforeEach(func() {
+						os.Setenv("BBL_IAAS", "vsphere")
+						os.Setenv("BBL_VSPHERE_VCENTER_IP", "vsphere-vcenter-ip")
+						os.Setenv("BBL_VSPHERE_VCENTER_USER", "vsphere-vcenter-user")
+						os.Setenv("BBL_VSPHERE_VCENTER_PASSWORD", "vsphere-vcenter-password")
+						os.Setenv("BBL_VSPHERE_VCENTER_DC", "vsphere-vcenter-dc")
+						os.Setenv("BBL_VSPHERE_VCENTER_CLUSTER", "vsphere-vcenter-cluster")
+						os.Setenv("BBL_VSPHERE_VCENTER_DS", "vsphere-vcenter-ds")
+						os.Setenv("BBL_VSPHERE_NETWORK", "vsphere-network")
+						os.Setenv("BBL_VSPHERE_SUBNET", "vsphere-subnet")
+						os.Setenv("BBL_VSPHERE_GATEWAY", "vsphere-gateway")
+					})
+
+					AfterEach(func() {
+						os.Unsetenv("BBL_IAAS")
+						os.Unsetenv("BBL_VSPHERE_VCENTER_IP")
+						os.Unsetenv("BBL_VSPHERE_VCENTER_USER")
+						os.Unsetenv("BBL_VSPHERE_VCENTER_PASSWORD")
+						os.Unsetenv("BBL_VSPHERE_VCENTER_DC")
+						os.Unsetenv("BBL_VSPHERE_VCENTER_CLUSTER")
+						os.Unsetenv("BBL_VSPHERE_VCENTER_DS")
+						os.Unsetenv("BBL_VSPHERE_NETWORK")
+						os.Unsetenv("BBL_VSPHERE_SUBNET")
+						os.Unsetenv("BBL_VSPHERE_GATEWAY")
+					})
+
+					It("returns a state containing configuration", func() {
+						appConfig, err := c.Bootstrap([]string{"bbl", "up"})
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(appConfig.State.IAAS).To(Equal("vsphere"))
+						Expect(appConfig.State.VSphere).To(Equal(storage.VSphere{
+							VCenterIP:       "vsphere-vcenter-ip",
+							VCenterUser:     "vsphere-vcenter-user",
+							VCenterPassword: "vsphere-vcenter-password",
+							VCenterDC:       "vsphere-vcenter-dc",
+							VCenterCluster:  "vsphere-vcenter-cluster",
+							VCenterDS:       "vsphere-vcenter-ds",
+							Network:         "vsphere-network",
+							Subnet:          "vsphere-subnet",
+							Gateway:         "vsphere-gateway",
+						}))
+					})
+				})
+			})
+
+			Context("when a previous state exists", func() {
+				BeforeEach(func() {
+					fakeStateBootstrap.GetStateCall.Returns.State = storage.State{
+						IAAS: "vsphere",
+						VSphere: storage.VSphere{
+							VCenterIP: "vcenter-ip",
+							Network:   "network",
+							Subnet:    "10.0.0.0/24",
+						},
+						EnvID: "some-env-id",
+					}
+				})
+
+				It("keeps the existing configuration and adds the credentials", func() {
+					appConfig, err := c.Bootstrap([]string{
+						"bbl", "up",
+						"--vsphere-vcenter-user", "vcenter-user",
+						"--vsphere-vcenter-password", "vcenter-password",
+					})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(appConfig.State.VSphere.VCenterIP).To(Equal("vcenter-ip"))
+					Expect(appConfig.State.VSphere.VCenterUser).To(Equal("vcenter-user"))
+					Expect(appConfig.State.VSphere.VCenterPassword).To(Equal("vcenter-password"))
+				})
+
+				It("returns an error for a non-matching network", func() {
+					_, err := c.Bootstrap([]string{"bbl", "up", "--vsphere-network", "other-network"})
+					Expect(err).To(MatchError("The network cannot be changed for an existing environment. The current network is network."))
+				})
+
+				It("returns an error for a non-matching subnet", func() {
+					_, err := c.Bootstrap([]string{"bbl", "up", "--vsphere-subnet", "10.1.0.0/24"})
+					Expect(err).To(MatchError("The subnet cannot be changed for an existing environment. The current subnet is 10.0.0.0/24."))
+				})
+			})
+		})
 	})
 
 	Describe("ValidateIAAS", func() {
@@ -791,12 +1125,12 @@ var _ = Describe("LoadState", func() {
 			},
 			Entry("when IAAS is missing",
 				storage.State{},
-				"--iaas [gcp, aws, azure] must be provided or BBL_IAAS must be set"),
+				"--iaas [gcp, aws, azure, openstack, vsphere] must be provided or BBL_IAAS must be set"),
 			Entry("when IAAS is unsupported",
 				storage.State{
 					IAAS: "not-a-real-iaas",
 				},
-				"--iaas [gcp, aws, azure] must be provided or BBL_IAAS must be set"),
+				"--iaas [gcp, aws, azure, openstack, vsphere] must be provided or BBL_IAAS must be set"),
 			Entry("when AWS access key is missing",
 				storage.State{
 					IAAS: "aws",
@@ -895,6 +1229,216 @@ var _ = Describe("LoadState", func() {
 					},
 				},
 				"Azure tenant id must be provided (--azure-tenant-id or BBL_AZURE_TENANT_ID)"),
+			Entry("when OpenStack auth url is missing",
+				storage.State{
+					IAAS: "openstack",
+					OpenStack: storage.OpenStack{
+						AZ:                  "some-az",
+						ExternalNetworkName: "some-network-name",
+						Project:             "some-project",
+						Domain:              "some-domain",
+						Region:              "some-region",
+						Username:            "some-username",
+						Password:            "some-password",
+					},
+				},
+				"OpenStack auth url must be provided (--openstack-auth-url or BBL_OPENSTACK_AUTH_URL)"),
+			Entry("when OpenStack availability zone is missing",
+				storage.State{
+					IAAS: "openstack",
+					OpenStack: storage.OpenStack{
+						AuthURL:             "some-auth-url",
+						ExternalNetworkName: "some-network-name",
+						Project:             "some-project",
+						Domain:              "some-domain",
+						Region:              "some-region",
+						Username:            "some-username",
+						Password:            "some-password",
+					},
+				},
+				"OpenStack availability zone must be provided (--openstack-az or BBL_OPENSTACK_AZ)"),
+			Entry("when OpenStack external network name is missing",
+				storage.State{
+					IAAS: "openstack",
+					OpenStack: storage.OpenStack{
+						AuthURL:  "some-auth-url",
+						AZ:       "some-az",
+						Project:  "some-project",
+						Domain:   "some-domain",
+						Region:   "some-region",
+						Username: "some-username",
+						Password: "some-password",
+					},
+				},
+				"OpenStack external network name must be provided (--openstack-network-name or BBL_OPENSTACK_NETWORK_NAME)"),
+			Entry("when OpenStack project is missing",
+				storage.State{
+					IAAS: "openstack",
+					OpenStack: storage.OpenStack{
+						AuthURL:             "some-auth-url",
+						AZ:                  "some-az",
+						ExternalNetworkName: "some-network-name",
+						Domain:              "some-domain",
+						Region:              "some-region",
+						Username:            "some-username",
+						Password:            "some-password",
+					},
+				},
+				"OpenStack project must be provided (--openstack-project or BBL_OPENSTACK_PROJECT)"),
+			Entry("when OpenStack domain is missing",
+				storage.State{
+					IAAS: "openstack",
+					OpenStack: storage.OpenStack{
+						AuthURL:             "some-auth-url",
+						AZ:                  "some-az",
+						ExternalNetworkName: "some-network-name",
+						Project:             "some-project",
+						Region:              "some-region",
+						Username:            "some-username",
+						Password:            "some-password",
+					},
+				},
+				"OpenStack domain must be provided (--openstack-domain or BBL_OPENSTACK_DOMAIN)"),
+			Entry("when OpenStack region is missing",
+				storage.State{
+					IAAS: "openstack",
+					OpenStack: storage.OpenStack{
+						AuthURL:             "some-auth-url",
+						AZ:                  "some-az",
+						ExternalNetworkName: "some-network-name",
+						Project:             "some-project",
+						Domain:              "some-domain",
+						Username:            "some-username",
+						Password:            "some-password",
+					},
+				},
+				"OpenStack region must be provided (--openstack-region or BBL_OPENSTACK_REGION)"),
+			Entry("when OpenStack username is missing",
+				storage.State{
+					IAAS: "openstack",
+					OpenStack: storage.OpenStack{
+						AuthURL:             "some-auth-url",
+						AZ:                  "some-az",
+						ExternalNetworkName: "some-network-name",
+						Project:             "some-project",
+						Domain:              "some-domain",
+						Region:              "some-region",
+						Password:            "some-password",
+					},
+				},
+				"OpenStack username must be provided (--openstack-username or BBL_OPENSTACK_USERNAME)"),
+			Entry("when OpenStack password is missing",
+				storage.State{
+					IAAS: "openstack",
+					OpenStack: storage.OpenStack{
+						AuthURL:             "some-auth-url",
+						AZ:                  "some-az",
+						ExternalNetworkName: "some-network-name",
+						Project:             "some-project",
+						Domain:              "some-domain",
+						Region:              "some-region",
+						Username:            "some-username",
+					},
+				},
+				"OpenStack password must be provided (--openstack-password or BBL_OPENSTACK_PASSWORD)"),
+			Entry("when vSphere vCenter IP is missing",
+				storage.State{
+					IAAS:    "vsphere",
+					VSphere: storage.VSphere{},
+				},
+				"vSphere vCenter IP must be provided (--vsphere-vcenter-ip or BBL_VSPHERE_VCENTER_IP)"),
+			Entry("when vSphere vCenter user is missing",
+				storage.State{
+					IAAS: "vsphere",
+					VSphere: storage.VSphere{
+						VCenterIP: "some-vcenter-ip",
+					},
+				},
+				"vSphere vCenter user must be provided (--vsphere-vcenter-user or BBL_VSPHERE_VCENTER_USER)"),
+			Entry("when vSphere vCenter password is missing",
+				storage.State{
+					IAAS: "vsphere",
+					VSphere: storage.VSphere{
+						VCenterIP:   "some-vcenter-ip",
+						VCenterUser: "some-vcenter-user",
+					},
+				},
+				"vSphere vCenter password must be provided (--vsphere-vcenter-password or BBL_VSPHERE_VCENTER_PASSWORD)"),
+			Entry("when vSphere vCenter datacenter is missing",
+				storage.State{
+					IAAS: "vsphere",
+					VSphere: storage.VSphere{
+						VCenterIP:       "some-vcenter-ip",
+						VCenterUser:     "some-vcenter-user",
+						VCenterPassword: "some-vcenter-password",
+					},
+				},
+				"vSphere vCenter datacenter must be provided (--vsphere-vcenter-dc or BBL_VSPHERE_VCENTER_DC)"),
+			Entry("when vSphere vCenter cluster is missing",
+				storage.State{
+					IAAS: "vsphere",
+					VSphere: storage.VSphere{
+						VCenterIP:       "some-vcenter-ip",
+						VCenterUser:     "some-vcenter-user",
+						VCenterPassword: "some-vcenter-password",
+						VCenterDC:       "some-vcenter-dc",
+					},
+				},
+				"vSphere vCenter cluster must be provided (--vsphere-vcenter-cluster or BBL_VSPHERE_VCENTER_CLUSTER)"),
+			Entry("when vSphere vCenter datastore is missing",
+				storage.State{
+					IAAS: "vsphere",
+					VSphere: storage.VSphere{
+						VCenterIP:       "some-vcenter-ip",
+						VCenterUser:     "some-vcenter-user",
+						VCenterPassword: "some-vcenter-password",
+						VCenterDC:       "some-vcenter-dc",
+						VCenterCluster:  "some-vcenter-cluster",
+					},
+				},
+				"vSphere vCenter datastore must be provided (--vsphere-vcenter-ds or BBL_VSPHERE_VCENTER_DS)"),
+			Entry("when vSphere network is missing",
+				storage.State{
+					IAAS: "vsphere",
+					VSphere: storage.VSphere{
+						VCenterIP:       "some-vcenter-ip",
+						VCenterUser:     "some-vcenter-user",
+						VCenterPassword: "some-vcenter-password",
+						VCenterDC:       "some-vcenter-dc",
+						VCenterCluster:  "some-vcenter-cluster",
+						VCenterDS:       "some-vcenter-ds",
+					},
+				},
+				"vSphere network must be provided (--vsphere-network or BBL_VSPHERE_NETWORK)"),
+			Entry("when vSphere subnet is missing",
+				storage.State{
+					IAAS: "vsphere",
+					VSphere: storage.VSphere{
+						VCenterIP:       "some-vcenter-ip",
+						VCenterUser:     "some-vcenter-user",
+						VCenterPassword: "some-vcenter-password",
+						VCenterDC:       "some-vcenter-dc",
+						VCenterCluster:  "some-vcenter-cluster",
+						VCenterDS:       "some-vcenter-ds",
+						Network:         "some-network",
+					},
+				},
+				"vSphere subnet must be provided (--vsphere-subnet or BBL_VSPHERE_SUBNET)"),
+			Entry("when vSphere gateway is missing",
+				storage.State{
+					IAAS: "vsphere",
+					VSphere: storage.VSphere{
+						VCenterIP:       "some-vcenter-ip",
+						VCenterUser:     "some-vcenter-user",
+						VCenterPassword: "some-vcenter-password",
+						VCenterDC:       "some-vcenter-dc",
+						VCenterCluster:  "some-vcenter-cluster",
+						VCenterDS:       "some-vcenter-ds",
+						Network:         "some-network",
+						Subnet:          "some-subnet",
+					},
+				},
+				"vSphere gateway must be provided (--vsphere-gateway or BBL_VSPHERE_GATEWAY)"),
 		)
 	})
 })
@@ -34,6 +34,213 @@ type AWSEC2Client struct {
 			Error  error
 		}
 	}
+
+	CreateVpcCall struct {
+		Receives struct {
+			Input *awsec2.CreateVpcInput
+		}
+		Returns struct {
+			Output *awsec2.CreateVpcOutput
+			Error  error
+		}
+	}
+
+	DescribeSecurityGroupsCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsec2.DescribeSecurityGroupsInput
+		}
+		Returns struct {
+			Output *awsec2.DescribeSecurityGroupsOutput
+			Error  error
+		}
+	}
+
+	DescribeSubnetsCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsec2.DescribeSubnetsInput
+		}
+		Returns struct {
+			Output *awsec2.DescribeSubnetsOutput
+			Error  error
+		}
+	}
+
+	DescribeRouteTablesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsec2.DescribeRouteTablesInput
+		}
+		Returns struct {
+			Output *awsec2.DescribeRouteTablesOutput
+			Error  error
+		}
+	}
+
+	DescribeInternetGatewaysCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsec2.DescribeInternetGatewaysInput
+		}
+		Returns struct {
+			Output *awsec2.DescribeInternetGatewaysOutput
+			Error  error
+		}
+	}
+
+	TerminateInstancesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsec2.TerminateInstancesInput
+		}
+		Returns struct {
+			Output *awsec2.TerminateInstancesOutput
+			Error  error
+		}
+	}
+
+	RevokeSecurityGroupIngressCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsec2.RevokeSecurityGroupIngressInput
+		}
+		Returns struct {
+			Output *awsec2.RevokeSecurityGroupIngressOutput
+			Error  error
+		}
+	}
+
+	DeleteSecurityGroupCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsec2.DeleteSecurityGroupInput
+		}
+		Returns struct {
+			Output *awsec2.DeleteSecurityGroupOutput
+			Error  error
+		}
+	}
+
+	DeleteSubnetCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsec2.DeleteSubnetInput
+		}
+		Returns struct {
+			Output *awsec2.DeleteSubnetOutput
+			Error  error
+		}
+	}
+
+	DeleteRouteTableCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsec2.DeleteRouteTableInput
+		}
+		Returns struct {
+			Output *awsec2.DeleteRouteTableOutput
+			Error  error
+		}
+	}
+
+	DetachInternetGatewayCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsec2.DetachInternetGatewayInput
+		}
+		Returns struct {
+			Output *awsec2.DetachInternetGatewayOutput
+			Error  error
+		}
+	}
+
+	DeleteInternetGatewayCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsec2.DeleteInternetGatewayInput
+		}
+		Returns struct {
+			Output *awsec2.DeleteInternetGatewayOutput
+			Error  error
+		}
+	}
+
+	DeleteVpcCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsec2.DeleteVpcInput
+		}
+		Returns struct {
+			Output *awsec2.DeleteVpcOutput
+			Error  error
+		}
+	}
+
+	WaitUntilInstanceTerminatedCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsec2.DescribeInstancesInput
+		}
+		Returns struct {
+			Error error
+		}
+	}
+
+	DescribeNatGatewaysCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsec2.DescribeNatGatewaysInput
+		}
+		Returns struct {
+			Output *awsec2.DescribeNatGatewaysOutput
+			Error  error
+		}
+	}
+
+	DeleteNatGatewayCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsec2.DeleteNatGatewayInput
+		}
+		Returns struct {
+			Output *awsec2.DeleteNatGatewayOutput
+			Error  error
+		}
+	}
+
+	DescribeAddressesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsec2.DescribeAddressesInput
+		}
+		Returns struct {
+			Output *awsec2.DescribeAddressesOutput
+			Error  error
+		}
+	}
+
+	ReleaseAddressCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsec2.ReleaseAddressInput
+		}
+		Returns struct {
+			Output *awsec2.ReleaseAddressOutput
+			Error  error
+		}
+	}
+
+	DescribeNetworkInterfacesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsec2.DescribeNetworkInterfacesInput
+		}
+		Returns struct {
+			Output *awsec2.DescribeNetworkInterfacesOutput
+			Error  error
+		}
+	}
 }
 
 func (c *AWSEC2Client) DescribeAvailabilityZones(input *awsec2.DescribeAvailabilityZonesInput) (*awsec2.DescribeAvailabilityZonesOutput, error) {
@@ -53,3 +260,135 @@ func (c *AWSEC2Client) DescribeVpcs(input *awsec2.DescribeVpcsInput) (*awsec2.De
 
 	return c.DescribeVpcsCall.Returns.Output, c.DescribeVpcsCall.Returns.Error
 }
+
+func (c *AWSEC2Client) CreateVpc(input *awsec2.CreateVpcInput) (*awsec2.CreateVpcOutput, error) {
+	c.CreateVpcCall.Receives.Input = input
+
+	return c.CreateVpcCall.Returns.Output, c.CreateVpcCall.Returns.Error
+}
+
+func (c *AWSEC2Client) DescribeSecurityGroups(input *awsec2.DescribeSecurityGroupsInput) (*awsec2.DescribeSecurityGroupsOutput, error) {
+	c.DescribeSecurityGroupsCall.CallCount++
+	c.DescribeSecurityGroupsCall.Receives.Input = input
+
+	return c.DescribeSecurityGroupsCall.Returns.Output, c.DescribeSecurityGroupsCall.Returns.Error
+}
+
+func (c *AWSEC2Client) DescribeSubnets(input *awsec2.DescribeSubnetsInput) (*awsec2.DescribeSubnetsOutput, error) {
+	c.DescribeSubnetsCall.CallCount++
+	c.DescribeSubnetsCall.Receives.Input = input
+
+	return c.DescribeSubnetsCall.Returns.Output, c.DescribeSubnetsCall.Returns.Error
+}
+
+func (c *AWSEC2Client) DescribeRouteTables(input *awsec2.DescribeRouteTablesInput) (*awsec2.DescribeRouteTablesOutput, error) {
+	c.DescribeRouteTablesCall.CallCount++
+	c.DescribeRouteTablesCall.Receives.Input = input
+
+	return c.DescribeRouteTablesCall.Returns.Output, c.DescribeRouteTablesCall.Returns.Error
+}
+
+func (c *AWSEC2Client) DescribeInternetGateways(input *awsec2.DescribeInternetGatewaysInput) (*awsec2.DescribeInternetGatewaysOutput, error) {
+	c.DescribeInternetGatewaysCall.CallCount++
+	c.DescribeInternetGatewaysCall.Receives.Input = input
+
+	return c.DescribeInternetGatewaysCall.Returns.Output, c.DescribeInternetGatewaysCall.Returns.Error
+}
+
+func (c *AWSEC2Client) TerminateInstances(input *awsec2.TerminateInstancesInput) (*awsec2.TerminateInstancesOutput, error) {
+	c.TerminateInstancesCall.CallCount++
+	c.TerminateInstancesCall.Receives.Input = input
+
+	return c.TerminateInstancesCall.Returns.Output, c.TerminateInstancesCall.Returns.Error
+}
+
+func (c *AWSEC2Client) RevokeSecurityGroupIngress(input *awsec2.RevokeSecurityGroupIngressInput) (*awsec2.RevokeSecurityGroupIngressOutput, error) {
+	c.RevokeSecurityGroupIngressCall.CallCount++
+	c.RevokeSecurityGroupIngressCall.Receives.Input = input
+
+	return c.RevokeSecurityGroupIngressCall.Returns.Output, c.RevokeSecurityGroupIngressCall.Returns.Error
+}
+
+func (c *AWSEC2Client) DeleteSecurityGroup(input *awsec2.DeleteSecurityGroupInput) (*awsec2.DeleteSecurityGroupOutput, error) {
+	c.DeleteSecurityGroupCall.CallCount++
+	c.DeleteSecurityGroupCall.Receives.Input = input
+
+	return c.DeleteSecurityGroupCall.Returns.Output, c.DeleteSecurityGroupCall.Returns.Error
+}
+
+func (c *AWSEC2Client) DeleteSubnet(input *awsec2.DeleteSubnetInput) (*awsec2.DeleteSubnetOutput, error) {
+	c.DeleteSubnetCall.CallCount++
+	c.DeleteSubnetCall.Receives.Input = input
+
+	return c.DeleteSubnetCall.Returns.Output, c.DeleteSubnetCall.Returns.Error
+}
+
+func (c *AWSEC2Client) DeleteRouteTable(input *awsec2.DeleteRouteTableInput) (*awsec2.DeleteRouteTableOutput, error) {
+	c.DeleteRouteTableCall.CallCount++
+	c.DeleteRouteTableCall.Receives.Input = input
+
+	return c.DeleteRouteTableCall.Returns.Output, c.DeleteRouteTableCall.Returns.Error
+}
+
+func (c *AWSEC2Client) DetachInternetGateway(input *awsec2.DetachInternetGatewayInput) (*awsec2.DetachInternetGatewayOutput, error) {
+	c.DetachInternetGatewayCall.CallCount++
+	c.DetachInternetGatewayCall.Receives.Input = input
+
+	return c.DetachInternetGatewayCall.Returns.Output, c.DetachInternetGatewayCall.Returns.Error
+}
+
+func (c *AWSEC2Client) DeleteInternetGateway(input *awsec2.DeleteInternetGatewayInput) (*awsec2.DeleteInternetGatewayOutput, error) {
+	c.DeleteInternetGatewayCall.CallCount++
+	c.DeleteInternetGatewayCall.Receives.Input = input
+
+	return c.DeleteInternetGatewayCall.Returns.Output, c.DeleteInternetGatewayCall.Returns.Error
+}
+
+func (c *AWSEC2Client) DeleteVpc(input *awsec2.DeleteVpcInput) (*awsec2.DeleteVpcOutput, error) {
+	c.DeleteVpcCall.CallCount++
+	c.DeleteVpcCall.Receives.Input = input
+
+	return c.DeleteVpcCall.Returns.Output, c.DeleteVpcCall.Returns.Error
+}
+
+func (c *AWSEC2Client) WaitUntilInstanceTerminated(input *awsec2.DescribeInstancesInput) error {
+	c.WaitUntilInstanceTerminatedCall.CallCount++
+	c.WaitUntilInstanceTerminatedCall.Receives.Input = input
+
+	return c.WaitUntilInstanceTerminatedCall.Returns.Error
+}
+
+func (c *AWSEC2Client) DescribeNatGateways(input *awsec2.DescribeNatGatewaysInput) (*awsec2.DescribeNatGatewaysOutput, error) {
+	c.DescribeNatGatewaysCall.CallCount++
+	c.DescribeNatGatewaysCall.Receives.Input = input
+
+	return c.DescribeNatGatewaysCall.Returns.Output, c.DescribeNatGatewaysCall.Returns.Error
+}
+
+func (c *AWSEC2Client) DeleteNatGateway(input *awsec2.DeleteNatGatewayInput) (*awsec2.DeleteNatGatewayOutput, error) {
+	c.DeleteNatGatewayCall.CallCount++
+	c.DeleteNatGatewayCall.Receives.Input = input
+
+	return c.DeleteNatGatewayCall.Returns.Output, c.DeleteNatGatewayCall.Returns.Error
+}
+
+func (c *AWSEC2Client) DescribeAddresses(input *awsec2.DescribeAddressesInput) (*awsec2.DescribeAddressesOutput, error) {
+	c.DescribeAddressesCall.CallCount++
+	c.DescribeAddressesCall.Receives.Input = input
+
+	return c.DescribeAddressesCall.Returns.Output, c.DescribeAddressesCall.Returns.Error
+}
+
+func (c *AWSEC2Client) ReleaseAddress(input *awsec2.ReleaseAddressInput) (*awsec2.ReleaseAddressOutput, error) {
+	c.ReleaseAddressCall.CallCount++
+	c.ReleaseAddressCall.Receives.Input = input
+
+	return c.ReleaseAddressCall.Returns.Output, c.ReleaseAddressCall.Returns.Error
+}
+
+func (c *AWSEC2Client) DescribeNetworkInterfaces(input *awsec2.DescribeNetworkInterfacesInput) (*awsec2.DescribeNetworkInterfacesOutput, error) {
+	c.DescribeNetworkInterfacesCall.CallCount++
+	c.DescribeNetworkInterfacesCall.Receives.Input = input
+
+	return c.DescribeNetworkInterfacesCall.Returns.Output, c.DescribeNetworkInterfacesCall.Returns.Error
+}
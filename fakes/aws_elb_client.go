@@ -0,0 +1,43 @@
+package fakes
+
+import (
+	awselb "github.com/aws/aws-sdk-go/service/elb"
+)
+
+type AWSELBClient struct {
+	DescribeLoadBalancersCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awselb.DescribeLoadBalancersInput
+		}
+		Returns struct {
+			Output *awselb.DescribeLoadBalancersOutput
+			Error  error
+		}
+	}
+
+	DeleteLoadBalancerCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awselb.DeleteLoadBalancerInput
+		}
+		Returns struct {
+			Output *awselb.DeleteLoadBalancerOutput
+			Error  error
+		}
+	}
+}
+
+func (c *AWSELBClient) DescribeLoadBalancers(input *awselb.DescribeLoadBalancersInput) (*awselb.DescribeLoadBalancersOutput, error) {
+	c.DescribeLoadBalancersCall.CallCount++
+	c.DescribeLoadBalancersCall.Receives.Input = input
+
+	return c.DescribeLoadBalancersCall.Returns.Output, c.DescribeLoadBalancersCall.Returns.Error
+}
+
+func (c *AWSELBClient) DeleteLoadBalancer(input *awselb.DeleteLoadBalancerInput) (*awselb.DeleteLoadBalancerOutput, error) {
+	c.DeleteLoadBalancerCall.CallCount++
+	c.DeleteLoadBalancerCall.Receives.Input = input
+
+	return c.DeleteLoadBalancerCall.Returns.Output, c.DeleteLoadBalancerCall.Returns.Error
+}
@@ -0,0 +1,43 @@
+package fakes
+
+import (
+	"github.com/cloudfoundry/bosh-bootloader/aws/elbv2"
+)
+
+type AWSELBV2Client struct {
+	DescribeLoadBalancersCall struct {
+		CallCount int
+		Receives  struct {
+			Input *elbv2.DescribeLoadBalancersInput
+		}
+		Returns struct {
+			Output *elbv2.DescribeLoadBalancersOutput
+			Error  error
+		}
+	}
+
+	DeleteLoadBalancerCall struct {
+		CallCount int
+		Receives  struct {
+			Input *elbv2.DeleteLoadBalancerInput
+		}
+		Returns struct {
+			Output *elbv2.DeleteLoadBalancerOutput
+			Error  error
+		}
+	}
+}
+
+func (c *AWSELBV2Client) DescribeLoadBalancers(input *elbv2.DescribeLoadBalancersInput) (*elbv2.DescribeLoadBalancersOutput, error) {
+	c.DescribeLoadBalancersCall.CallCount++
+	c.DescribeLoadBalancersCall.Receives.Input = input
+
+	return c.DescribeLoadBalancersCall.Returns.Output, c.DescribeLoadBalancersCall.Returns.Error
+}
+
+func (c *AWSELBV2Client) DeleteLoadBalancer(input *elbv2.DeleteLoadBalancerInput) (*elbv2.DeleteLoadBalancerOutput, error) {
+	c.DeleteLoadBalancerCall.CallCount++
+	c.DeleteLoadBalancerCall.Receives.Input = input
+
+	return c.DeleteLoadBalancerCall.Returns.Output, c.DeleteLoadBalancerCall.Returns.Error
+}
@@ -1,6 +1,7 @@
 package fakes
 
 import (
+	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
 	"github.com/Azure/go-autorest/autorest"
 )
 
@@ -15,6 +16,36 @@ type AzureGroupsClient struct {
 			Error    error
 		}
 	}
+	ListCall struct {
+		CallCount int
+		Receives  struct {
+			Filter string
+			Top    *int32
+		}
+		Returns struct {
+			Result resources.GroupListResult
+			Error  error
+		}
+	}
+	ListNextResultsCall struct {
+		CallCount int
+		Receives  struct {
+			LastResults resources.GroupListResult
+		}
+		Returns struct {
+			Result resources.GroupListResult
+			Error  error
+		}
+	}
+	DeleteCall struct {
+		CallCount int
+		Receives  struct {
+			ResourceGroup string
+		}
+		Returns struct {
+			Error error
+		}
+	}
 }
 
 func (a *AzureGroupsClient) CheckExistence(resourceGroup string) (autorest.Response, error) {
@@ -22,3 +53,29 @@ func (a *AzureGroupsClient) CheckExistence(resourceGroup string) (autorest.Respo
 	a.CheckExistenceCall.Receives.ResourceGroup = resourceGroup
 	return a.CheckExistenceCall.Returns.Response, a.CheckExistenceCall.Returns.Error
 }
+
+func (a *AzureGroupsClient) List(filter string, top *int32) (resources.GroupListResult, error) {
+	a.ListCall.CallCount++
+	a.ListCall.Receives.Filter = filter
+	a.ListCall.Receives.Top = top
+	return a.ListCall.Returns.Result, a.ListCall.Returns.Error
+}
+
+func (a *AzureGroupsClient) ListNextResults(lastResults resources.GroupListResult) (resources.GroupListResult, error) {
+	a.ListNextResultsCall.CallCount++
+	a.ListNextResultsCall.Receives.LastResults = lastResults
+	return a.ListNextResultsCall.Returns.Result, a.ListNextResultsCall.Returns.Error
+}
+
+func (a *AzureGroupsClient) Delete(resourceGroup string, cancel <-chan struct{}) (<-chan autorest.Response, <-chan error) {
+	a.DeleteCall.CallCount++
+	a.DeleteCall.Receives.ResourceGroup = resourceGroup
+
+	responses := make(chan autorest.Response, 1)
+	errs := make(chan error, 1)
+	responses <- autorest.Response{}
+	errs <- a.DeleteCall.Returns.Error
+	close(responses)
+	close(errs)
+	return responses, errs
+}
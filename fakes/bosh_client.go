@@ -1,6 +1,8 @@
 package fakes
 
 import (
+	"time"
+
 	"github.com/cloudfoundry/bosh-bootloader/bosh"
 	"golang.org/x/net/proxy"
 )
@@ -16,6 +18,77 @@ type BOSHClient struct {
 		}
 	}
 
+	UpdateRuntimeConfigCall struct {
+		CallCount int
+		Receives  struct {
+			Name string
+			Yaml []byte
+		}
+		Returns struct {
+			Error error
+		}
+	}
+
+	ListConfigsCall struct {
+		CallCount int
+		Receives  struct {
+			Type string
+		}
+		Returns struct {
+			Configs []bosh.Config
+			Error   error
+		}
+	}
+
+	GetConfigCall struct {
+		CallCount int
+		Stub      func(configType, name string) (bosh.Config, error)
+		Receives  struct {
+			Type string
+			Name string
+		}
+		Returns struct {
+			Config bosh.Config
+			Error  error
+		}
+	}
+
+	CreateConfigCall struct {
+		CallCount int
+		Receives  struct {
+			Type    string
+			Name    string
+			Content []byte
+		}
+		Returns struct {
+			Error error
+		}
+	}
+
+	DeleteConfigCall struct {
+		CallCount int
+		Receives  struct {
+			Type string
+			Name string
+		}
+		Returns struct {
+			Error error
+		}
+	}
+
+	DiffConfigCall struct {
+		CallCount int
+		Receives  struct {
+			Type    string
+			Name    string
+			Content []byte
+		}
+		Returns struct {
+			Diff  bosh.ConfigDiff
+			Error error
+		}
+	}
+
 	ConfigureHTTPClientCall struct {
 		CallCount int
 		Receives  struct {
@@ -30,6 +103,16 @@ type BOSHClient struct {
 			Error error
 		}
 	}
+
+	PingCall struct {
+		CallCount int
+		Receives  struct {
+			Timeout time.Duration
+		}
+		Returns struct {
+			Error error
+		}
+	}
 }
 
 func (c *BOSHClient) UpdateCloudConfig(yaml []byte) error {
@@ -38,6 +121,54 @@ func (c *BOSHClient) UpdateCloudConfig(yaml []byte) error {
 	return c.UpdateCloudConfigCall.Returns.Error
 }
 
+func (c *BOSHClient) UpdateRuntimeConfig(name string, yaml []byte) error {
+	c.UpdateRuntimeConfigCall.CallCount++
+	c.UpdateRuntimeConfigCall.Receives.Name = name
+	c.UpdateRuntimeConfigCall.Receives.Yaml = yaml
+	return c.UpdateRuntimeConfigCall.Returns.Error
+}
+
+func (c *BOSHClient) ListConfigs(configType string) ([]bosh.Config, error) {
+	c.ListConfigsCall.CallCount++
+	c.ListConfigsCall.Receives.Type = configType
+	return c.ListConfigsCall.Returns.Configs, c.ListConfigsCall.Returns.Error
+}
+
+func (c *BOSHClient) GetConfig(configType, name string) (bosh.Config, error) {
+	c.GetConfigCall.CallCount++
+	c.GetConfigCall.Receives.Type = configType
+	c.GetConfigCall.Receives.Name = name
+
+	if c.GetConfigCall.Stub != nil {
+		return c.GetConfigCall.Stub(configType, name)
+	}
+
+	return c.GetConfigCall.Returns.Config, c.GetConfigCall.Returns.Error
+}
+
+func (c *BOSHClient) CreateConfig(configType, name string, content []byte) error {
+	c.CreateConfigCall.CallCount++
+	c.CreateConfigCall.Receives.Type = configType
+	c.CreateConfigCall.Receives.Name = name
+	c.CreateConfigCall.Receives.Content = content
+	return c.CreateConfigCall.Returns.Error
+}
+
+func (c *BOSHClient) DeleteConfig(configType, name string) error {
+	c.DeleteConfigCall.CallCount++
+	c.DeleteConfigCall.Receives.Type = configType
+	c.DeleteConfigCall.Receives.Name = name
+	return c.DeleteConfigCall.Returns.Error
+}
+
+func (c *BOSHClient) DiffConfig(configType, name string, content []byte) (bosh.ConfigDiff, error) {
+	c.DiffConfigCall.CallCount++
+	c.DiffConfigCall.Receives.Type = configType
+	c.DiffConfigCall.Receives.Name = name
+	c.DiffConfigCall.Receives.Content = content
+	return c.DiffConfigCall.Returns.Diff, c.DiffConfigCall.Returns.Error
+}
+
 func (c *BOSHClient) ConfigureHTTPClient(socks5Client proxy.Dialer) {
 	c.ConfigureHTTPClientCall.CallCount++
 	c.ConfigureHTTPClientCall.Receives.Socks5Client = socks5Client
@@ -47,3 +178,9 @@ func (c *BOSHClient) Info() (bosh.Info, error) {
 	c.InfoCall.CallCount++
 	return c.InfoCall.Returns.Info, c.InfoCall.Returns.Error
 }
+
+func (c *BOSHClient) Ping(timeout time.Duration) error {
+	c.PingCall.CallCount++
+	c.PingCall.Receives.Timeout = timeout
+	return c.PingCall.Returns.Error
+}
@@ -0,0 +1,25 @@
+package fakes
+
+import (
+	"github.com/cloudfoundry/bosh-bootloader/certs"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+)
+
+type CertificateStatusReporter struct {
+	ReportCall struct {
+		CallCount int
+		Receives  struct {
+			State storage.State
+		}
+		Returns struct {
+			Statuses []certs.Status
+			Error    error
+		}
+	}
+}
+
+func (c *CertificateStatusReporter) Report(state storage.State) ([]certs.Status, error) {
+	c.ReportCall.CallCount++
+	c.ReportCall.Receives.State = state
+	return c.ReportCall.Returns.Statuses, c.ReportCall.Returns.Error
+}
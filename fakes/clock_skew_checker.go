@@ -0,0 +1,23 @@
+package fakes
+
+import "time"
+
+type ClockSkewChecker struct {
+	SkewCall struct {
+		CallCount int
+		Receives  struct {
+			URL string
+		}
+		Returns struct {
+			Skew  time.Duration
+			Error error
+		}
+	}
+}
+
+func (c *ClockSkewChecker) Skew(url string) (time.Duration, error) {
+	c.SkewCall.CallCount++
+	c.SkewCall.Receives.URL = url
+
+	return c.SkewCall.Returns.Skew, c.SkewCall.Returns.Error
+}
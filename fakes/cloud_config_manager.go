@@ -1,6 +1,7 @@
 package fakes
 
 import (
+	"github.com/cloudfoundry/bosh-bootloader/bosh"
 	"github.com/cloudfoundry/bosh-bootloader/storage"
 )
 
@@ -24,6 +25,16 @@ type CloudConfigManager struct {
 			Error       error
 		}
 	}
+	DiffCall struct {
+		CallCount int
+		Receives  struct {
+			State storage.State
+		}
+		Returns struct {
+			Diff  bosh.ConfigDiff
+			Error error
+		}
+	}
 }
 
 func (c *CloudConfigManager) Update(state storage.State) error {
@@ -37,3 +48,9 @@ func (c *CloudConfigManager) Generate(state storage.State) (string, error) {
 	c.GenerateCall.Receives.State = state
 	return c.GenerateCall.Returns.CloudConfig, c.GenerateCall.Returns.Error
 }
+
+func (c *CloudConfigManager) Diff(state storage.State) (bosh.ConfigDiff, error) {
+	c.DiffCall.CallCount++
+	c.DiffCall.Receives.State = state
+	return c.DiffCall.Returns.Diff, c.DiffCall.Returns.Error
+}
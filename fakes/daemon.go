@@ -0,0 +1,65 @@
+package fakes
+
+type Daemon struct {
+	StartCall struct {
+		CallCount int
+		Stub      func(logPath string)
+		Receives  struct {
+			LogPath string
+		}
+		Returns struct {
+			PID   int
+			Error error
+		}
+	}
+
+	RunningCall struct {
+		CallCount int
+		Stub      func(int) bool
+		Receives  struct {
+			PID int
+		}
+		Returns struct {
+			Running bool
+		}
+	}
+
+	StopCall struct {
+		CallCount int
+		Receives  struct {
+			PID int
+		}
+		Returns struct {
+			Error error
+		}
+	}
+}
+
+func (d *Daemon) Start(logPath string) (int, error) {
+	d.StartCall.CallCount++
+	d.StartCall.Receives.LogPath = logPath
+
+	if d.StartCall.Stub != nil {
+		d.StartCall.Stub(logPath)
+	}
+
+	return d.StartCall.Returns.PID, d.StartCall.Returns.Error
+}
+
+func (d *Daemon) Running(pid int) bool {
+	d.RunningCall.CallCount++
+	d.RunningCall.Receives.PID = pid
+
+	if d.RunningCall.Stub != nil {
+		return d.RunningCall.Stub(pid)
+	}
+
+	return d.RunningCall.Returns.Running
+}
+
+func (d *Daemon) Stop(pid int) error {
+	d.StopCall.CallCount++
+	d.StopCall.Receives.PID = pid
+
+	return d.StopCall.Returns.Error
+}
@@ -7,7 +7,6 @@ type GCPComputeClient struct {
 		CallCount int
 		Receives  struct {
 			ProjectID string
-			Zone      string
 		}
 		Returns struct {
 			InstanceList *compute.InstanceList
@@ -58,12 +57,379 @@ type GCPComputeClient struct {
 			Error       error
 		}
 	}
+	ListFirewallsCall struct {
+		CallCount int
+		Receives  struct {
+			Filter    string
+			ProjectID string
+		}
+		Returns struct {
+			FirewallList *compute.FirewallList
+			Error        error
+		}
+	}
+	ListSubnetworksCall struct {
+		CallCount int
+		Receives  struct {
+			Filter    string
+			ProjectID string
+			Region    string
+		}
+		Returns struct {
+			SubnetworkList *compute.SubnetworkList
+			Error          error
+		}
+	}
+	DeleteInstanceCall struct {
+		CallCount int
+		Receives  struct {
+			ProjectID string
+			Zone      string
+			Name      string
+		}
+		Returns struct {
+			Error error
+		}
+	}
+	DeleteFirewallCall struct {
+		CallCount int
+		Receives  struct {
+			ProjectID string
+			Name      string
+		}
+		Returns struct {
+			Error error
+		}
+	}
+	DeleteSubnetworkCall struct {
+		CallCount int
+		Receives  struct {
+			ProjectID string
+			Region    string
+			Name      string
+		}
+		Returns struct {
+			Error error
+		}
+	}
+	DeleteNetworkCall struct {
+		CallCount int
+		Receives  struct {
+			ProjectID string
+			Name      string
+		}
+		Returns struct {
+			Error error
+		}
+	}
+	ListGlobalForwardingRulesCall struct {
+		CallCount int
+		Receives  struct {
+			Filter    string
+			ProjectID string
+		}
+		Returns struct {
+			ForwardingRuleList *compute.ForwardingRuleList
+			Error              error
+		}
+	}
+	DeleteGlobalForwardingRuleCall struct {
+		CallCount int
+		Receives  struct {
+			ProjectID string
+			Name      string
+		}
+		Returns struct {
+			Error error
+		}
+	}
+	ListTargetHttpsProxiesCall struct {
+		CallCount int
+		Receives  struct {
+			Filter    string
+			ProjectID string
+		}
+		Returns struct {
+			TargetHttpsProxyList *compute.TargetHttpsProxyList
+			Error                error
+		}
+	}
+	DeleteTargetHttpsProxyCall struct {
+		CallCount int
+		Receives  struct {
+			ProjectID string
+			Name      string
+		}
+		Returns struct {
+			Error error
+		}
+	}
+	ListTargetHttpProxiesCall struct {
+		CallCount int
+		Receives  struct {
+			Filter    string
+			ProjectID string
+		}
+		Returns struct {
+			TargetHttpProxyList *compute.TargetHttpProxyList
+			Error               error
+		}
+	}
+	DeleteTargetHttpProxyCall struct {
+		CallCount int
+		Receives  struct {
+			ProjectID string
+			Name      string
+		}
+		Returns struct {
+			Error error
+		}
+	}
+	ListUrlMapsCall struct {
+		CallCount int
+		Receives  struct {
+			Filter    string
+			ProjectID string
+		}
+		Returns struct {
+			UrlMapList *compute.UrlMapList
+			Error      error
+		}
+	}
+	DeleteUrlMapCall struct {
+		CallCount int
+		Receives  struct {
+			ProjectID string
+			Name      string
+		}
+		Returns struct {
+			Error error
+		}
+	}
+	ListBackendServicesCall struct {
+		CallCount int
+		Receives  struct {
+			Filter    string
+			ProjectID string
+		}
+		Returns struct {
+			BackendServiceList *compute.BackendServiceList
+			Error              error
+		}
+	}
+	DeleteBackendServiceCall struct {
+		CallCount int
+		Receives  struct {
+			ProjectID string
+			Name      string
+		}
+		Returns struct {
+			Error error
+		}
+	}
+	ListForwardingRulesCall struct {
+		CallCount int
+		Receives  struct {
+			Filter    string
+			ProjectID string
+			Region    string
+		}
+		Returns struct {
+			ForwardingRuleList *compute.ForwardingRuleList
+			Error              error
+		}
+	}
+	DeleteForwardingRuleCall struct {
+		CallCount int
+		Receives  struct {
+			ProjectID string
+			Region    string
+			Name      string
+		}
+		Returns struct {
+			Error error
+		}
+	}
+	ListTargetPoolsCall struct {
+		CallCount int
+		Receives  struct {
+			Filter    string
+			ProjectID string
+			Region    string
+		}
+		Returns struct {
+			TargetPoolList *compute.TargetPoolList
+			Error          error
+		}
+	}
+	DeleteTargetPoolCall struct {
+		CallCount int
+		Receives  struct {
+			ProjectID string
+			Region    string
+			Name      string
+		}
+		Returns struct {
+			Error error
+		}
+	}
+	ListSslCertificatesCall struct {
+		CallCount int
+		Receives  struct {
+			Filter    string
+			ProjectID string
+		}
+		Returns struct {
+			SslCertificateList *compute.SslCertificateList
+			Error              error
+		}
+	}
+	DeleteSslCertificateCall struct {
+		CallCount int
+		Receives  struct {
+			ProjectID string
+			Name      string
+		}
+		Returns struct {
+			Error error
+		}
+	}
+	ListHealthChecksCall struct {
+		CallCount int
+		Receives  struct {
+			Filter    string
+			ProjectID string
+		}
+		Returns struct {
+			HealthCheckList *compute.HealthCheckList
+			Error           error
+		}
+	}
+	DeleteHealthCheckCall struct {
+		CallCount int
+		Receives  struct {
+			ProjectID string
+			Name      string
+		}
+		Returns struct {
+			Error error
+		}
+	}
+	ListHttpHealthChecksCall struct {
+		CallCount int
+		Receives  struct {
+			Filter    string
+			ProjectID string
+		}
+		Returns struct {
+			HttpHealthCheckList *compute.HttpHealthCheckList
+			Error               error
+		}
+	}
+	DeleteHttpHealthCheckCall struct {
+		CallCount int
+		Receives  struct {
+			ProjectID string
+			Name      string
+		}
+		Returns struct {
+			Error error
+		}
+	}
+	ListInstanceGroupsCall struct {
+		CallCount int
+		Receives  struct {
+			Filter    string
+			ProjectID string
+		}
+		Returns struct {
+			InstanceGroupList *compute.InstanceGroupList
+			Error             error
+		}
+	}
+	DeleteInstanceGroupCall struct {
+		CallCount int
+		Receives  struct {
+			ProjectID string
+			Zone      string
+			Name      string
+		}
+		Returns struct {
+			Error error
+		}
+	}
+	ListAddressesCall struct {
+		CallCount int
+		Receives  struct {
+			Filter    string
+			ProjectID string
+			Region    string
+		}
+		Returns struct {
+			AddressList *compute.AddressList
+			Error       error
+		}
+	}
+	DeleteAddressCall struct {
+		CallCount int
+		Receives  struct {
+			ProjectID string
+			Region    string
+			Name      string
+		}
+		Returns struct {
+			Error error
+		}
+	}
+	ListGlobalAddressesCall struct {
+		CallCount int
+		Receives  struct {
+			Filter    string
+			ProjectID string
+		}
+		Returns struct {
+			AddressList *compute.AddressList
+			Error       error
+		}
+	}
+	DeleteGlobalAddressCall struct {
+		CallCount int
+		Receives  struct {
+			ProjectID string
+			Name      string
+		}
+		Returns struct {
+			Error error
+		}
+	}
+	ListRoutersCall struct {
+		CallCount int
+		Receives  struct {
+			Filter    string
+			ProjectID string
+			Region    string
+		}
+		Returns struct {
+			RouterList *compute.RouterList
+			Error      error
+		}
+	}
+	DeleteRouterCall struct {
+		CallCount int
+		Receives  struct {
+			ProjectID string
+			Region    string
+			Name      string
+		}
+		Returns struct {
+			Error error
+		}
+	}
 }
 
-func (g *GCPComputeClient) ListInstances(projectID, zone string) (*compute.InstanceList, error) {
+func (g *GCPComputeClient) ListInstances(projectID string) (*compute.InstanceList, error) {
 	g.ListInstancesCall.CallCount++
 	g.ListInstancesCall.Receives.ProjectID = projectID
-	g.ListInstancesCall.Receives.Zone = zone
 	return g.ListInstancesCall.Returns.InstanceList, g.ListInstancesCall.Returns.Error
 }
 
@@ -94,3 +460,253 @@ func (g *GCPComputeClient) GetNetworks(name, projectID string) (*compute.Network
 	g.GetNetworksCall.Receives.ProjectID = projectID
 	return g.GetNetworksCall.Returns.NetworkList, g.GetNetworksCall.Returns.Error
 }
+
+func (g *GCPComputeClient) ListFirewalls(filter, projectID string) (*compute.FirewallList, error) {
+	g.ListFirewallsCall.CallCount++
+	g.ListFirewallsCall.Receives.Filter = filter
+	g.ListFirewallsCall.Receives.ProjectID = projectID
+	return g.ListFirewallsCall.Returns.FirewallList, g.ListFirewallsCall.Returns.Error
+}
+
+func (g *GCPComputeClient) ListSubnetworks(filter, projectID, region string) (*compute.SubnetworkList, error) {
+	g.ListSubnetworksCall.CallCount++
+	g.ListSubnetworksCall.Receives.Filter = filter
+	g.ListSubnetworksCall.Receives.ProjectID = projectID
+	g.ListSubnetworksCall.Receives.Region = region
+	return g.ListSubnetworksCall.Returns.SubnetworkList, g.ListSubnetworksCall.Returns.Error
+}
+
+func (g *GCPComputeClient) DeleteInstance(projectID, zone, name string) error {
+	g.DeleteInstanceCall.CallCount++
+	g.DeleteInstanceCall.Receives.ProjectID = projectID
+	g.DeleteInstanceCall.Receives.Zone = zone
+	g.DeleteInstanceCall.Receives.Name = name
+	return g.DeleteInstanceCall.Returns.Error
+}
+
+func (g *GCPComputeClient) DeleteFirewall(projectID, name string) error {
+	g.DeleteFirewallCall.CallCount++
+	g.DeleteFirewallCall.Receives.ProjectID = projectID
+	g.DeleteFirewallCall.Receives.Name = name
+	return g.DeleteFirewallCall.Returns.Error
+}
+
+func (g *GCPComputeClient) DeleteSubnetwork(projectID, region, name string) error {
+	g.DeleteSubnetworkCall.CallCount++
+	g.DeleteSubnetworkCall.Receives.ProjectID = projectID
+	g.DeleteSubnetworkCall.Receives.Region = region
+	g.DeleteSubnetworkCall.Receives.Name = name
+	return g.DeleteSubnetworkCall.Returns.Error
+}
+
+func (g *GCPComputeClient) DeleteNetwork(projectID, name string) error {
+	g.DeleteNetworkCall.CallCount++
+	g.DeleteNetworkCall.Receives.ProjectID = projectID
+	g.DeleteNetworkCall.Receives.Name = name
+	return g.DeleteNetworkCall.Returns.Error
+}
+
+func (g *GCPComputeClient) ListGlobalForwardingRules(filter, projectID string) (*compute.ForwardingRuleList, error) {
+	g.ListGlobalForwardingRulesCall.CallCount++
+	g.ListGlobalForwardingRulesCall.Receives.Filter = filter
+	g.ListGlobalForwardingRulesCall.Receives.ProjectID = projectID
+	return g.ListGlobalForwardingRulesCall.Returns.ForwardingRuleList, g.ListGlobalForwardingRulesCall.Returns.Error
+}
+
+func (g *GCPComputeClient) DeleteGlobalForwardingRule(projectID, name string) error {
+	g.DeleteGlobalForwardingRuleCall.CallCount++
+	g.DeleteGlobalForwardingRuleCall.Receives.ProjectID = projectID
+	g.DeleteGlobalForwardingRuleCall.Receives.Name = name
+	return g.DeleteGlobalForwardingRuleCall.Returns.Error
+}
+
+func (g *GCPComputeClient) ListTargetHttpsProxies(filter, projectID string) (*compute.TargetHttpsProxyList, error) {
+	g.ListTargetHttpsProxiesCall.CallCount++
+	g.ListTargetHttpsProxiesCall.Receives.Filter = filter
+	g.ListTargetHttpsProxiesCall.Receives.ProjectID = projectID
+	return g.ListTargetHttpsProxiesCall.Returns.TargetHttpsProxyList, g.ListTargetHttpsProxiesCall.Returns.Error
+}
+
+func (g *GCPComputeClient) DeleteTargetHttpsProxy(projectID, name string) error {
+	g.DeleteTargetHttpsProxyCall.CallCount++
+	g.DeleteTargetHttpsProxyCall.Receives.ProjectID = projectID
+	g.DeleteTargetHttpsProxyCall.Receives.Name = name
+	return g.DeleteTargetHttpsProxyCall.Returns.Error
+}
+
+func (g *GCPComputeClient) ListTargetHttpProxies(filter, projectID string) (*compute.TargetHttpProxyList, error) {
+	g.ListTargetHttpProxiesCall.CallCount++
+	g.ListTargetHttpProxiesCall.Receives.Filter = filter
+	g.ListTargetHttpProxiesCall.Receives.ProjectID = projectID
+	return g.ListTargetHttpProxiesCall.Returns.TargetHttpProxyList, g.ListTargetHttpProxiesCall.Returns.Error
+}
+
+func (g *GCPComputeClient) DeleteTargetHttpProxy(projectID, name string) error {
+	g.DeleteTargetHttpProxyCall.CallCount++
+	g.DeleteTargetHttpProxyCall.Receives.ProjectID = projectID
+	g.DeleteTargetHttpProxyCall.Receives.Name = name
+	return g.DeleteTargetHttpProxyCall.Returns.Error
+}
+
+func (g *GCPComputeClient) ListUrlMaps(filter, projectID string) (*compute.UrlMapList, error) {
+	g.ListUrlMapsCall.CallCount++
+	g.ListUrlMapsCall.Receives.Filter = filter
+	g.ListUrlMapsCall.Receives.ProjectID = projectID
+	return g.ListUrlMapsCall.Returns.UrlMapList, g.ListUrlMapsCall.Returns.Error
+}
+
+func (g *GCPComputeClient) DeleteUrlMap(projectID, name string) error {
+	g.DeleteUrlMapCall.CallCount++
+	g.DeleteUrlMapCall.Receives.ProjectID = projectID
+	g.DeleteUrlMapCall.Receives.Name = name
+	return g.DeleteUrlMapCall.Returns.Error
+}
+
+func (g *GCPComputeClient) ListBackendServices(filter, projectID string) (*compute.BackendServiceList, error) {
+	g.ListBackendServicesCall.CallCount++
+	g.ListBackendServicesCall.Receives.Filter = filter
+	g.ListBackendServicesCall.Receives.ProjectID = projectID
+	return g.ListBackendServicesCall.Returns.BackendServiceList, g.ListBackendServicesCall.Returns.Error
+}
+
+func (g *GCPComputeClient) DeleteBackendService(projectID, name string) error {
+	g.DeleteBackendServiceCall.CallCount++
+	g.DeleteBackendServiceCall.Receives.ProjectID = projectID
+	g.DeleteBackendServiceCall.Receives.Name = name
+	return g.DeleteBackendServiceCall.Returns.Error
+}
+
+func (g *GCPComputeClient) ListForwardingRules(filter, projectID, region string) (*compute.ForwardingRuleList, error) {
+	g.ListForwardingRulesCall.CallCount++
+	g.ListForwardingRulesCall.Receives.Filter = filter
+	g.ListForwardingRulesCall.Receives.ProjectID = projectID
+	g.ListForwardingRulesCall.Receives.Region = region
+	return g.ListForwardingRulesCall.Returns.ForwardingRuleList, g.ListForwardingRulesCall.Returns.Error
+}
+
+func (g *GCPComputeClient) DeleteForwardingRule(projectID, region, name string) error {
+	g.DeleteForwardingRuleCall.CallCount++
+	g.DeleteForwardingRuleCall.Receives.ProjectID = projectID
+	g.DeleteForwardingRuleCall.Receives.Region = region
+	g.DeleteForwardingRuleCall.Receives.Name = name
+	return g.DeleteForwardingRuleCall.Returns.Error
+}
+
+func (g *GCPComputeClient) ListTargetPools(filter, projectID, region string) (*compute.TargetPoolList, error) {
+	g.ListTargetPoolsCall.CallCount++
+	g.ListTargetPoolsCall.Receives.Filter = filter
+	g.ListTargetPoolsCall.Receives.ProjectID = projectID
+	g.ListTargetPoolsCall.Receives.Region = region
+	return g.ListTargetPoolsCall.Returns.TargetPoolList, g.ListTargetPoolsCall.Returns.Error
+}
+
+func (g *GCPComputeClient) DeleteTargetPool(projectID, region, name string) error {
+	g.DeleteTargetPoolCall.CallCount++
+	g.DeleteTargetPoolCall.Receives.ProjectID = projectID
+	g.DeleteTargetPoolCall.Receives.Region = region
+	g.DeleteTargetPoolCall.Receives.Name = name
+	return g.DeleteTargetPoolCall.Returns.Error
+}
+
+func (g *GCPComputeClient) ListSslCertificates(filter, projectID string) (*compute.SslCertificateList, error) {
+	g.ListSslCertificatesCall.CallCount++
+	g.ListSslCertificatesCall.Receives.Filter = filter
+	g.ListSslCertificatesCall.Receives.ProjectID = projectID
+	return g.ListSslCertificatesCall.Returns.SslCertificateList, g.ListSslCertificatesCall.Returns.Error
+}
+
+func (g *GCPComputeClient) DeleteSslCertificate(projectID, name string) error {
+	g.DeleteSslCertificateCall.CallCount++
+	g.DeleteSslCertificateCall.Receives.ProjectID = projectID
+	g.DeleteSslCertificateCall.Receives.Name = name
+	return g.DeleteSslCertificateCall.Returns.Error
+}
+
+func (g *GCPComputeClient) ListHealthChecks(filter, projectID string) (*compute.HealthCheckList, error) {
+	g.ListHealthChecksCall.CallCount++
+	g.ListHealthChecksCall.Receives.Filter = filter
+	g.ListHealthChecksCall.Receives.ProjectID = projectID
+	return g.ListHealthChecksCall.Returns.HealthCheckList, g.ListHealthChecksCall.Returns.Error
+}
+
+func (g *GCPComputeClient) DeleteHealthCheck(projectID, name string) error {
+	g.DeleteHealthCheckCall.CallCount++
+	g.DeleteHealthCheckCall.Receives.ProjectID = projectID
+	g.DeleteHealthCheckCall.Receives.Name = name
+	return g.DeleteHealthCheckCall.Returns.Error
+}
+
+func (g *GCPComputeClient) ListHttpHealthChecks(filter, projectID string) (*compute.HttpHealthCheckList, error) {
+	g.ListHttpHealthChecksCall.CallCount++
+	g.ListHttpHealthChecksCall.Receives.Filter = filter
+	g.ListHttpHealthChecksCall.Receives.ProjectID = projectID
+	return g.ListHttpHealthChecksCall.Returns.HttpHealthCheckList, g.ListHttpHealthChecksCall.Returns.Error
+}
+
+func (g *GCPComputeClient) DeleteHttpHealthCheck(projectID, name string) error {
+	g.DeleteHttpHealthCheckCall.CallCount++
+	g.DeleteHttpHealthCheckCall.Receives.ProjectID = projectID
+	g.DeleteHttpHealthCheckCall.Receives.Name = name
+	return g.DeleteHttpHealthCheckCall.Returns.Error
+}
+
+func (g *GCPComputeClient) ListInstanceGroups(filter, projectID string) (*compute.InstanceGroupList, error) {
+	g.ListInstanceGroupsCall.CallCount++
+	g.ListInstanceGroupsCall.Receives.Filter = filter
+	g.ListInstanceGroupsCall.Receives.ProjectID = projectID
+	return g.ListInstanceGroupsCall.Returns.InstanceGroupList, g.ListInstanceGroupsCall.Returns.Error
+}
+
+func (g *GCPComputeClient) DeleteInstanceGroup(projectID, zone, name string) error {
+	g.DeleteInstanceGroupCall.CallCount++
+	g.DeleteInstanceGroupCall.Receives.ProjectID = projectID
+	g.DeleteInstanceGroupCall.Receives.Zone = zone
+	g.DeleteInstanceGroupCall.Receives.Name = name
+	return g.DeleteInstanceGroupCall.Returns.Error
+}
+
+func (g *GCPComputeClient) ListAddresses(filter, projectID, region string) (*compute.AddressList, error) {
+	g.ListAddressesCall.CallCount++
+	g.ListAddressesCall.Receives.Filter = filter
+	g.ListAddressesCall.Receives.ProjectID = projectID
+	g.ListAddressesCall.Receives.Region = region
+	return g.ListAddressesCall.Returns.AddressList, g.ListAddressesCall.Returns.Error
+}
+
+func (g *GCPComputeClient) DeleteAddress(projectID, region, name string) error {
+	g.DeleteAddressCall.CallCount++
+	g.DeleteAddressCall.Receives.ProjectID = projectID
+	g.DeleteAddressCall.Receives.Region = region
+	g.DeleteAddressCall.Receives.Name = name
+	return g.DeleteAddressCall.Returns.Error
+}
+
+func (g *GCPComputeClient) ListGlobalAddresses(filter, projectID string) (*compute.AddressList, error) {
+	g.ListGlobalAddressesCall.CallCount++
+	g.ListGlobalAddressesCall.Receives.Filter = filter
+	g.ListGlobalAddressesCall.Receives.ProjectID = projectID
+	return g.ListGlobalAddressesCall.Returns.AddressList, g.ListGlobalAddressesCall.Returns.Error
+}
+
+func (g *GCPComputeClient) DeleteGlobalAddress(projectID, name string) error {
+	g.DeleteGlobalAddressCall.CallCount++
+	g.DeleteGlobalAddressCall.Receives.ProjectID = projectID
+	g.DeleteGlobalAddressCall.Receives.Name = name
+	return g.DeleteGlobalAddressCall.Returns.Error
+}
+
+func (g *GCPComputeClient) ListRouters(filter, projectID, region string) (*compute.RouterList, error) {
+	g.ListRoutersCall.CallCount++
+	g.ListRoutersCall.Receives.Filter = filter
+	g.ListRoutersCall.Receives.ProjectID = projectID
+	g.ListRoutersCall.Receives.Region = region
+	return g.ListRoutersCall.Returns.RouterList, g.ListRoutersCall.Returns.Error
+}
+
+func (g *GCPComputeClient) DeleteRouter(projectID, region, name string) error {
+	g.DeleteRouterCall.CallCount++
+	g.DeleteRouterCall.Receives.ProjectID = projectID
+	g.DeleteRouterCall.Receives.Region = region
+	g.DeleteRouterCall.Receives.Name = name
+	return g.DeleteRouterCall.Returns.Error
+}
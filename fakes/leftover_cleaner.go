@@ -0,0 +1,43 @@
+package fakes
+
+import "github.com/cloudfoundry/bosh-bootloader/helpers"
+
+type LeftoverCleaner struct {
+	ListLeftoversCall struct {
+		CallCount int
+		Receives  struct {
+			Filter string
+		}
+		Returns struct {
+			Leftovers []helpers.Leftover
+			Error     error
+		}
+	}
+	DeleteLeftoverCall struct {
+		Stub      func(helpers.Leftover) error
+		CallCount int
+		Receives  struct {
+			Leftovers []helpers.Leftover
+		}
+		Returns struct {
+			Error error
+		}
+	}
+}
+
+func (l *LeftoverCleaner) ListLeftovers(filter string) ([]helpers.Leftover, error) {
+	l.ListLeftoversCall.CallCount++
+	l.ListLeftoversCall.Receives.Filter = filter
+	return l.ListLeftoversCall.Returns.Leftovers, l.ListLeftoversCall.Returns.Error
+}
+
+func (l *LeftoverCleaner) DeleteLeftover(leftover helpers.Leftover) error {
+	l.DeleteLeftoverCall.CallCount++
+	l.DeleteLeftoverCall.Receives.Leftovers = append(l.DeleteLeftoverCall.Receives.Leftovers, leftover)
+
+	if l.DeleteLeftoverCall.Stub != nil {
+		return l.DeleteLeftoverCall.Stub(leftover)
+	}
+
+	return l.DeleteLeftoverCall.Returns.Error
+}
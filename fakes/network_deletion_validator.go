@@ -11,6 +11,16 @@ type NetworkDeletionValidator struct {
 			EnvID       string
 		}
 	}
+	ValidateSafeToDeleteSubnetsCall struct {
+		CallCount int
+		Returns   struct {
+			Error error
+		}
+		Receives struct {
+			Subnets []string
+			EnvID   string
+		}
+	}
 }
 
 func (n *NetworkDeletionValidator) ValidateSafeToDelete(networkName string, envID string) error {
@@ -20,3 +30,11 @@ func (n *NetworkDeletionValidator) ValidateSafeToDelete(networkName string, envI
 
 	return n.ValidateSafeToDeleteCall.Returns.Error
 }
+
+func (n *NetworkDeletionValidator) ValidateSafeToDeleteSubnets(subnets []string, envID string) error {
+	n.ValidateSafeToDeleteSubnetsCall.CallCount++
+	n.ValidateSafeToDeleteSubnetsCall.Receives.Subnets = subnets
+	n.ValidateSafeToDeleteSubnetsCall.Receives.EnvID = envID
+
+	return n.ValidateSafeToDeleteSubnetsCall.Returns.Error
+}
@@ -0,0 +1,22 @@
+package fakes
+
+import "github.com/cloudfoundry/bosh-bootloader/openstack"
+
+type OpenStackNetworksClient struct {
+	ListCall struct {
+		CallCount int
+		Receives  struct {
+			Name string
+		}
+		Returns struct {
+			Networks []openstack.Network
+			Error    error
+		}
+	}
+}
+
+func (o *OpenStackNetworksClient) List(name string) ([]openstack.Network, error) {
+	o.ListCall.CallCount++
+	o.ListCall.Receives.Name = name
+	return o.ListCall.Returns.Networks, o.ListCall.Returns.Error
+}
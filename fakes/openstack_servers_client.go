@@ -0,0 +1,18 @@
+package fakes
+
+import "github.com/cloudfoundry/bosh-bootloader/openstack"
+
+type OpenStackServersClient struct {
+	ListCall struct {
+		CallCount int
+		Returns   struct {
+			Servers []openstack.Server
+			Error   error
+		}
+	}
+}
+
+func (o *OpenStackServersClient) List() ([]openstack.Server, error) {
+	o.ListCall.CallCount++
+	return o.ListCall.Returns.Servers, o.ListCall.Returns.Error
+}
@@ -0,0 +1,16 @@
+package fakes
+
+type PermissionChecker struct {
+	CheckPermissionsCall struct {
+		CallCount int
+		Returns   struct {
+			Error error
+		}
+	}
+}
+
+func (p *PermissionChecker) CheckPermissions() error {
+	p.CheckPermissionsCall.CallCount++
+
+	return p.CheckPermissionsCall.Returns.Error
+}
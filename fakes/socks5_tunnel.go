@@ -0,0 +1,49 @@
+package fakes
+
+import (
+	"net"
+
+	"github.com/cloudfoundry/bosh-bootloader/ssh"
+)
+
+type Socks5Tunnel struct {
+	ConnectCall struct {
+		CallCount int
+		Receives  struct {
+			Jumpbox ssh.Hop
+		}
+		Returns struct {
+			HostKey string
+			Error   error
+		}
+	}
+
+	ServeCall struct {
+		CallCount int
+		Stub      func(net.Listener) error
+		Receives  struct {
+			Listener net.Listener
+		}
+		Returns struct {
+			Error error
+		}
+	}
+}
+
+func (s *Socks5Tunnel) Connect(jumpbox ssh.Hop) (string, error) {
+	s.ConnectCall.CallCount++
+	s.ConnectCall.Receives.Jumpbox = jumpbox
+
+	return s.ConnectCall.Returns.HostKey, s.ConnectCall.Returns.Error
+}
+
+func (s *Socks5Tunnel) Serve(listener net.Listener) error {
+	s.ServeCall.CallCount++
+	s.ServeCall.Receives.Listener = listener
+
+	if s.ServeCall.Stub != nil {
+		return s.ServeCall.Stub(listener)
+	}
+
+	return s.ServeCall.Returns.Error
+}
@@ -0,0 +1,25 @@
+package fakes
+
+import "github.com/cloudfoundry/bosh-bootloader/ssh"
+
+type SSHClient struct {
+	RunCall struct {
+		CallCount int
+		Receives  struct {
+			Hops    []ssh.Hop
+			Command string
+		}
+		Returns struct {
+			HostKeys []string
+			Error    error
+		}
+	}
+}
+
+func (s *SSHClient) Run(hops []ssh.Hop, command string) ([]string, error) {
+	s.RunCall.CallCount++
+	s.RunCall.Receives.Hops = hops
+	s.RunCall.Receives.Command = command
+
+	return s.RunCall.Returns.HostKeys, s.RunCall.Returns.Error
+}
@@ -0,0 +1,22 @@
+package fakes
+
+import "github.com/cloudfoundry/bosh-bootloader/ssh"
+
+type SSHPinger struct {
+	PingCall struct {
+		CallCount int
+		Receives  struct {
+			Hops []ssh.Hop
+		}
+		Returns struct {
+			Error error
+		}
+	}
+}
+
+func (s *SSHPinger) Ping(hops []ssh.Hop) error {
+	s.PingCall.CallCount++
+	s.PingCall.Receives.Hops = hops
+
+	return s.PingCall.Returns.Error
+}
@@ -28,6 +28,21 @@ type StateStore struct {
 		}
 	}
 
+	GetCloudConfigOpsDirCall struct {
+		CallCount int
+		Returns   struct {
+			Directory string
+		}
+	}
+
+	GetRuntimeConfigDirCall struct {
+		CallCount int
+		Returns   struct {
+			Directory string
+			Error     error
+		}
+	}
+
 	GetStateDirCall struct {
 		CallCount int
 		Returns   struct {
@@ -102,6 +117,18 @@ func (s *StateStore) GetCloudConfigDir() (string, error) {
 	return s.GetCloudConfigDirCall.Returns.Directory, s.GetCloudConfigDirCall.Returns.Error
 }
 
+func (s *StateStore) GetCloudConfigOpsDir() string {
+	s.GetCloudConfigOpsDirCall.CallCount++
+
+	return s.GetCloudConfigOpsDirCall.Returns.Directory
+}
+
+func (s *StateStore) GetRuntimeConfigDir() (string, error) {
+	s.GetRuntimeConfigDirCall.CallCount++
+
+	return s.GetRuntimeConfigDirCall.Returns.Directory, s.GetRuntimeConfigDirCall.Returns.Error
+}
+
 func (s *StateStore) GetStateDir() string {
 	s.GetStateDirCall.CallCount++
 
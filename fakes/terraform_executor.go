@@ -1,6 +1,9 @@
 package fakes
 
-import "github.com/cloudfoundry/bosh-bootloader/storage"
+import (
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/cloudfoundry/bosh-bootloader/terraform"
+)
 
 type Import struct {
 	Addr string
@@ -70,13 +73,13 @@ type TerraformExecutor struct {
 		}
 	}
 	OutputsCall struct {
-		Stub      func() (map[string]interface{}, error)
+		Stub      func() (terraform.Outputs, error)
 		CallCount int
 		Receives  struct {
 			TFState string
 		}
 		Returns struct {
-			Outputs map[string]interface{}
+			Outputs terraform.Outputs
 			Error   error
 		}
 	}
@@ -130,7 +133,7 @@ func (t *TerraformExecutor) Output(tfState, outputName string) (string, error) {
 	return t.OutputCall.Returns.Output, t.OutputCall.Returns.Error
 }
 
-func (t *TerraformExecutor) Outputs(tfState string) (map[string]interface{}, error) {
+func (t *TerraformExecutor) Outputs(tfState string) (terraform.Outputs, error) {
 	t.OutputsCall.CallCount++
 	t.OutputsCall.Receives.TFState = tfState
 
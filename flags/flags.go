@@ -3,12 +3,20 @@ package flags
 import (
 	"flag"
 	"io/ioutil"
+	"strings"
 )
 
 type Flags struct {
 	set *flag.FlagSet
 }
 
+// Flag describes a registered flag. Short and long names of the same flag
+// are separate Flags.
+type Flag struct {
+	Name       string
+	TakesValue bool
+}
+
 func New(name string) Flags {
 	set := flag.NewFlagSet(name, flag.ContinueOnError)
 	set.Usage = func() {}
@@ -30,6 +38,15 @@ func (f Flags) String(v *string, name string, value string) {
 	f.set.StringVar(v, name, value, "")
 }
 
+func (f Flags) Int(v *int, name string, value int) {
+	f.set.IntVar(v, name, value, "")
+}
+
+func (f Flags) StringSlice(v *[]string, name string, value []string) {
+	*v = value
+	f.set.Var(&stringSlice{values: v}, name, "")
+}
+
 func (f Flags) Parse(args []string) error {
 	return f.set.Parse(args)
 }
@@ -37,3 +54,41 @@ func (f Flags) Parse(args []string) error {
 func (f Flags) Args() []string {
 	return f.set.Args()
 }
+
+// Registered returns the registered flags sorted by name, e.g. for shell
+// completion.
+func (f Flags) Registered() []Flag {
+	var registered []Flag
+	f.set.VisitAll(func(fl *flag.Flag) {
+		boolFlag, ok := fl.Value.(interface {
+			IsBoolFlag() bool
+		})
+
+		registered = append(registered, Flag{
+			Name:       fl.Name,
+			TakesValue: !ok || !boolFlag.IsBoolFlag(),
+		})
+	})
+	return registered
+}
+
+type stringSlice struct {
+	values *[]string
+	parsed bool
+}
+
+func (s *stringSlice) String() string {
+	if s.values == nil {
+		return ""
+	}
+	return strings.Join(*s.values, ",")
+}
+
+func (s *stringSlice) Set(value string) error {
+	if !s.parsed {
+		*s.values = []string{}
+		s.parsed = true
+	}
+	*s.values = append(*s.values, value)
+	return nil
+}
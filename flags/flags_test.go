@@ -12,12 +12,16 @@ var _ = Describe("Flags", func() {
 		f         flags.Flags
 		boolVal   bool
 		stringVal string
+		intVal    int
+		sliceVal  []string
 	)
 
 	BeforeEach(func() {
 		f = flags.New("test")
 		f.Bool(&boolVal, "b", "bool", false)
 		f.String(&stringVal, "string", "")
+		f.Int(&intVal, "int", 0)
+		f.StringSlice(&sliceVal, "slice", []string{"default-value"})
 	})
 
 	Describe("Parse", func() {
@@ -49,6 +53,33 @@ var _ = Describe("Flags", func() {
 				Expect(stringVal).To(Equal("string_value"))
 			})
 		})
+
+		Context("Int flags", func() {
+			It("can parse int fields from flags", func() {
+				err := f.Parse([]string{"--int", "42"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(intVal).To(Equal(42))
+			})
+
+			It("returns an error when the value is not an int", func() {
+				err := f.Parse([]string{"--int", "forty-two"})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Context("StringSlice flags", func() {
+			It("uses the default when the flag is not provided", func() {
+				err := f.Parse([]string{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(sliceVal).To(Equal([]string{"default-value"}))
+			})
+
+			It("replaces the default with every provided value", func() {
+				err := f.Parse([]string{"--slice", "first", "--slice", "second"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(sliceVal).To(Equal([]string{"first", "second"}))
+			})
+		})
 	})
 
 	Describe("Args", func() {
@@ -58,4 +89,16 @@ var _ = Describe("Flags", func() {
 			Expect(f.Args()).To(Equal([]string{"some-command", "--some-flag"}))
 		})
 	})
+
+	Describe("Registered", func() {
+		It("returns every flag name sorted, and whether it takes a value", func() {
+			Expect(f.Registered()).To(Equal([]flags.Flag{
+				{Name: "b", TakesValue: false},
+				{Name: "bool", TakesValue: false},
+				{Name: "int", TakesValue: true},
+				{Name: "slice", TakesValue: true},
+				{Name: "string", TakesValue: true},
+			}))
+		})
+	})
 })
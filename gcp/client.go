@@ -14,11 +14,45 @@ type Client struct {
 }
 
 type ComputeClient interface {
-	ListInstances(projectID, zone string) (*compute.InstanceList, error)
+	ListInstances(projectID string) (*compute.InstanceList, error)
 	GetZones(region, projectID string) ([]string, error)
 	GetZone(zone, projectID string) (*compute.Zone, error)
 	GetRegion(region, projectID string) (*compute.Region, error)
 	GetNetworks(name, projectID string) (*compute.NetworkList, error)
+	ListFirewalls(filter, projectID string) (*compute.FirewallList, error)
+	ListSubnetworks(filter, projectID, region string) (*compute.SubnetworkList, error)
+	DeleteInstance(projectID, zone, name string) error
+	DeleteFirewall(projectID, name string) error
+	DeleteSubnetwork(projectID, region, name string) error
+	DeleteNetwork(projectID, name string) error
+	ListGlobalForwardingRules(filter, projectID string) (*compute.ForwardingRuleList, error)
+	DeleteGlobalForwardingRule(projectID, name string) error
+	ListTargetHttpsProxies(filter, projectID string) (*compute.TargetHttpsProxyList, error)
+	DeleteTargetHttpsProxy(projectID, name string) error
+	ListTargetHttpProxies(filter, projectID string) (*compute.TargetHttpProxyList, error)
+	DeleteTargetHttpProxy(projectID, name string) error
+	ListUrlMaps(filter, projectID string) (*compute.UrlMapList, error)
+	DeleteUrlMap(projectID, name string) error
+	ListBackendServices(filter, projectID string) (*compute.BackendServiceList, error)
+	DeleteBackendService(projectID, name string) error
+	ListForwardingRules(filter, projectID, region string) (*compute.ForwardingRuleList, error)
+	DeleteForwardingRule(projectID, region, name string) error
+	ListTargetPools(filter, projectID, region string) (*compute.TargetPoolList, error)
+	DeleteTargetPool(projectID, region, name string) error
+	ListSslCertificates(filter, projectID string) (*compute.SslCertificateList, error)
+	DeleteSslCertificate(projectID, name string) error
+	ListHealthChecks(filter, projectID string) (*compute.HealthCheckList, error)
+	DeleteHealthCheck(projectID, name string) error
+	ListHttpHealthChecks(filter, projectID string) (*compute.HttpHealthCheckList, error)
+	DeleteHttpHealthCheck(projectID, name string) error
+	ListInstanceGroups(filter, projectID string) (*compute.InstanceGroupList, error)
+	DeleteInstanceGroup(projectID, zone, name string) error
+	ListAddresses(filter, projectID, region string) (*compute.AddressList, error)
+	DeleteAddress(projectID, region, name string) error
+	ListGlobalAddresses(filter, projectID string) (*compute.AddressList, error)
+	DeleteGlobalAddress(projectID, name string) error
+	ListRouters(filter, projectID, region string) (*compute.RouterList, error)
+	DeleteRouter(projectID, region, name string) error
 }
 
 func (c Client) ProjectID() string {
@@ -26,7 +60,7 @@ func (c Client) ProjectID() string {
 }
 
 func (c Client) listInstances() (*compute.InstanceList, error) {
-	return c.computeClient.ListInstances(c.projectID, c.zone)
+	return c.computeClient.ListInstances(c.projectID)
 }
 
 func (c Client) GetZones(region string) ([]string, error) {
@@ -58,7 +92,38 @@ func (c Client) CheckExists(networkName string) (bool, error) {
 	return false, nil
 }
 
+// CheckPermissions lists the instances in the project. It only shows
+// that the service account authenticates and can read compute resources;
+// whether it may create them is not checked.
+func (c Client) CheckPermissions() error {
+	_, err := c.listInstances()
+	if err != nil {
+		return fmt.Errorf("List instances: %s", err)
+	}
+
+	return nil
+}
+
 func (c Client) ValidateSafeToDelete(networkName string, envID string) error {
+	return c.validateNoInstances("network", func(networkInterface *compute.NetworkInterface) bool {
+		return strings.Contains(networkInterface.Network, networkName)
+	})
+}
+
+// ValidateSafeToDeleteSubnets is ValidateSafeToDelete for a network bbl did
+// not create, where only the subnetworks bbl created in it have to be empty.
+func (c Client) ValidateSafeToDeleteSubnets(subnetworks []string, envID string) error {
+	return c.validateNoInstances("subnetwork", func(networkInterface *compute.NetworkInterface) bool {
+		for _, subnetwork := range subnetworks {
+			if strings.HasSuffix(networkInterface.Subnetwork, "/"+subnetwork) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func (c Client) validateNoInstances(network string, attached func(*compute.NetworkInterface) bool) error {
 	instanceList, err := c.listInstances()
 	if err != nil {
 		return err
@@ -66,7 +131,7 @@ func (c Client) ValidateSafeToDelete(networkName string, envID string) error {
 
 	var runningInstances []*compute.Instance
 	for _, instance := range instanceList.Items {
-		isInNetwork := c.isInNetwork(networkName, instance.NetworkInterfaces)
+		isInNetwork := c.isAttached(instance.NetworkInterfaces, attached)
 		isBoshDirector := c.isBoshDirector(instance.Metadata)
 
 		if isInNetwork && !isBoshDirector {
@@ -94,13 +159,13 @@ func (c Client) ValidateSafeToDelete(networkName string, envID string) error {
 		}
 	}
 
-	return fmt.Errorf("bbl environment is not safe to delete; vms still exist in network:\n%s",
-		strings.Join(errorMessages, "\n"))
+	return fmt.Errorf("bbl environment is not safe to delete; vms still exist in %s:\n%s",
+		network, strings.Join(errorMessages, "\n"))
 }
 
-func (c Client) isInNetwork(networkName string, networkInterfaces []*compute.NetworkInterface) bool {
+func (c Client) isAttached(networkInterfaces []*compute.NetworkInterface, attached func(*compute.NetworkInterface) bool) bool {
 	for _, networkInterface := range networkInterfaces {
-		if strings.Contains(networkInterface.Network, networkName) {
+		if attached(networkInterface) {
 			return true
 		}
 	}
@@ -18,6 +18,27 @@ var _ = Describe("Client", func() {
 		client        gcp.Client
 	)
 
+	Describe("CheckPermissions", func() {
+		BeforeEach(func() {
+			computeClient = &fakes.GCPComputeClient{}
+			client = gcp.NewClientWithInjectedComputeClient(computeClient, "some-project-id", "some-zone")
+		})
+
+		It("lists the instances in the project", func() {
+			err := client.CheckPermissions()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(computeClient.ListInstancesCall.Receives.ProjectID).To(Equal("some-project-id"))
+		})
+
+		It("returns an error when the instances cannot be listed", func() {
+			computeClient.ListInstancesCall.Returns.Error = errors.New("forbidden")
+
+			err := client.CheckPermissions()
+			Expect(err).To(MatchError("List instances: forbidden"))
+		})
+	})
+
 	Describe("ValidateSafeToDelete", func() {
 		BeforeEach(func() {
 			computeClient = &fakes.GCPComputeClient{}
@@ -198,4 +219,45 @@ var _ = Describe("Client", func() {
 			})
 		})
 	})
+
+	Describe("ValidateSafeToDeleteSubnets", func() {
+		BeforeEach(func() {
+			computeClient = &fakes.GCPComputeClient{}
+			client = gcp.NewClientWithInjectedComputeClient(computeClient, "some-project-id", "some-zone")
+		})
+
+		It("returns an error when vms other than the director are in the subnetworks", func() {
+			deployment := "cf"
+			computeClient.ListInstancesCall.Returns.InstanceList = &compute.InstanceList{
+				Items: []*compute.Instance{
+					{
+						Name:              "some-vm",
+						NetworkInterfaces: []*compute.NetworkInterface{{Subnetwork: "https://some-host/subnetworks/some-subnetwork"}},
+						Metadata:          &compute.Metadata{Items: []*compute.MetadataItems{{Key: "deployment", Value: &deployment}}},
+					},
+					{
+						Name:              "other-vm",
+						NetworkInterfaces: []*compute.NetworkInterface{{Subnetwork: "https://some-host/subnetworks/other-subnetwork"}},
+						Metadata:          &compute.Metadata{},
+					},
+				},
+			}
+
+			err := client.ValidateSafeToDeleteSubnets([]string{"some-subnetwork"}, "some-env-id")
+			Expect(err).To(MatchError("bbl environment is not safe to delete; vms still exist in subnetwork:\nsome-vm (deployment: cf)"))
+		})
+
+		It("ignores vms in other subnetworks of the network", func() {
+			computeClient.ListInstancesCall.Returns.InstanceList = &compute.InstanceList{
+				Items: []*compute.Instance{{
+					Name:              "other-vm",
+					NetworkInterfaces: []*compute.NetworkInterface{{Subnetwork: "https://some-host/subnetworks/some-subnetwork-2"}},
+					Metadata:          &compute.Metadata{},
+				}},
+			}
+
+			err := client.ValidateSafeToDeleteSubnets([]string{"some-subnetwork"}, "some-env-id")
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
 })
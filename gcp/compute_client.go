@@ -1,9 +1,12 @@
 package gcp
 
 import (
+	"errors"
 	"fmt"
 	"sort"
+	"time"
 
+	"golang.org/x/net/context"
 	compute "google.golang.org/api/compute/v1"
 )
 
@@ -11,8 +14,20 @@ type gcpComputeClient struct {
 	service *compute.Service
 }
 
-func (g gcpComputeClient) ListInstances(projectID, zone string) (*compute.InstanceList, error) {
-	return g.service.Instances.List(projectID, zone).Do()
+// ListInstances lists the instances in every zone of the project.
+func (g gcpComputeClient) ListInstances(projectID string) (*compute.InstanceList, error) {
+	instances := &compute.InstanceList{}
+	err := g.service.Instances.AggregatedList(projectID).Pages(context.Background(), func(list *compute.InstanceAggregatedList) error {
+		for _, scopedList := range list.Items {
+			instances.Items = append(instances.Items, scopedList.Instances...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return instances, nil
 }
 
 func (g gcpComputeClient) GetZones(region, projectID string) ([]string, error) {
@@ -51,3 +66,298 @@ func (g gcpComputeClient) GetNetworks(name, projectID string) (*compute.NetworkL
 	networksListCall := g.service.Networks.List(projectID)
 	return networksListCall.Filter(fmt.Sprintf("name eq %s", name)).Do()
 }
+
+func (g gcpComputeClient) ListFirewalls(filter, projectID string) (*compute.FirewallList, error) {
+	return g.service.Firewalls.List(projectID).Filter(fmt.Sprintf("name eq %s", filter)).Do()
+}
+
+func (g gcpComputeClient) ListSubnetworks(filter, projectID, region string) (*compute.SubnetworkList, error) {
+	return g.service.Subnetworks.List(projectID, region).Filter(fmt.Sprintf("name eq %s", filter)).Do()
+}
+
+func (g gcpComputeClient) DeleteInstance(projectID, zone, name string) error {
+	operation, err := g.service.Instances.Delete(projectID, zone, name).Do()
+	if err != nil {
+		return err
+	}
+
+	return g.waitForOperation(operation, func() (*compute.Operation, error) {
+		return g.service.ZoneOperations.Get(projectID, zone, operation.Name).Do()
+	})
+}
+
+func (g gcpComputeClient) DeleteFirewall(projectID, name string) error {
+	operation, err := g.service.Firewalls.Delete(projectID, name).Do()
+	if err != nil {
+		return err
+	}
+
+	return g.waitForOperation(operation, func() (*compute.Operation, error) {
+		return g.service.GlobalOperations.Get(projectID, operation.Name).Do()
+	})
+}
+
+func (g gcpComputeClient) DeleteSubnetwork(projectID, region, name string) error {
+	operation, err := g.service.Subnetworks.Delete(projectID, region, name).Do()
+	if err != nil {
+		return err
+	}
+
+	return g.waitForOperation(operation, func() (*compute.Operation, error) {
+		return g.service.RegionOperations.Get(projectID, region, operation.Name).Do()
+	})
+}
+
+func (g gcpComputeClient) DeleteNetwork(projectID, name string) error {
+	operation, err := g.service.Networks.Delete(projectID, name).Do()
+	if err != nil {
+		return err
+	}
+
+	return g.waitForOperation(operation, func() (*compute.Operation, error) {
+		return g.service.GlobalOperations.Get(projectID, operation.Name).Do()
+	})
+}
+
+func (g gcpComputeClient) ListGlobalForwardingRules(filter, projectID string) (*compute.ForwardingRuleList, error) {
+	return g.service.GlobalForwardingRules.List(projectID).Filter(fmt.Sprintf("name eq %s", filter)).Do()
+}
+
+func (g gcpComputeClient) DeleteGlobalForwardingRule(projectID, name string) error {
+	operation, err := g.service.GlobalForwardingRules.Delete(projectID, name).Do()
+	if err != nil {
+		return err
+	}
+
+	return g.waitForOperation(operation, func() (*compute.Operation, error) {
+		return g.service.GlobalOperations.Get(projectID, operation.Name).Do()
+	})
+}
+
+func (g gcpComputeClient) ListTargetHttpsProxies(filter, projectID string) (*compute.TargetHttpsProxyList, error) {
+	return g.service.TargetHttpsProxies.List(projectID).Filter(fmt.Sprintf("name eq %s", filter)).Do()
+}
+
+func (g gcpComputeClient) DeleteTargetHttpsProxy(projectID, name string) error {
+	operation, err := g.service.TargetHttpsProxies.Delete(projectID, name).Do()
+	if err != nil {
+		return err
+	}
+
+	return g.waitForOperation(operation, func() (*compute.Operation, error) {
+		return g.service.GlobalOperations.Get(projectID, operation.Name).Do()
+	})
+}
+
+func (g gcpComputeClient) ListTargetHttpProxies(filter, projectID string) (*compute.TargetHttpProxyList, error) {
+	return g.service.TargetHttpProxies.List(projectID).Filter(fmt.Sprintf("name eq %s", filter)).Do()
+}
+
+func (g gcpComputeClient) DeleteTargetHttpProxy(projectID, name string) error {
+	operation, err := g.service.TargetHttpProxies.Delete(projectID, name).Do()
+	if err != nil {
+		return err
+	}
+
+	return g.waitForOperation(operation, func() (*compute.Operation, error) {
+		return g.service.GlobalOperations.Get(projectID, operation.Name).Do()
+	})
+}
+
+func (g gcpComputeClient) ListUrlMaps(filter, projectID string) (*compute.UrlMapList, error) {
+	return g.service.UrlMaps.List(projectID).Filter(fmt.Sprintf("name eq %s", filter)).Do()
+}
+
+func (g gcpComputeClient) DeleteUrlMap(projectID, name string) error {
+	operation, err := g.service.UrlMaps.Delete(projectID, name).Do()
+	if err != nil {
+		return err
+	}
+
+	return g.waitForOperation(operation, func() (*compute.Operation, error) {
+		return g.service.GlobalOperations.Get(projectID, operation.Name).Do()
+	})
+}
+
+func (g gcpComputeClient) ListBackendServices(filter, projectID string) (*compute.BackendServiceList, error) {
+	return g.service.BackendServices.List(projectID).Filter(fmt.Sprintf("name eq %s", filter)).Do()
+}
+
+func (g gcpComputeClient) DeleteBackendService(projectID, name string) error {
+	operation, err := g.service.BackendServices.Delete(projectID, name).Do()
+	if err != nil {
+		return err
+	}
+
+	return g.waitForOperation(operation, func() (*compute.Operation, error) {
+		return g.service.GlobalOperations.Get(projectID, operation.Name).Do()
+	})
+}
+
+func (g gcpComputeClient) ListForwardingRules(filter, projectID, region string) (*compute.ForwardingRuleList, error) {
+	return g.service.ForwardingRules.List(projectID, region).Filter(fmt.Sprintf("name eq %s", filter)).Do()
+}
+
+func (g gcpComputeClient) DeleteForwardingRule(projectID, region, name string) error {
+	operation, err := g.service.ForwardingRules.Delete(projectID, region, name).Do()
+	if err != nil {
+		return err
+	}
+
+	return g.waitForOperation(operation, func() (*compute.Operation, error) {
+		return g.service.RegionOperations.Get(projectID, region, operation.Name).Do()
+	})
+}
+
+func (g gcpComputeClient) ListTargetPools(filter, projectID, region string) (*compute.TargetPoolList, error) {
+	return g.service.TargetPools.List(projectID, region).Filter(fmt.Sprintf("name eq %s", filter)).Do()
+}
+
+func (g gcpComputeClient) DeleteTargetPool(projectID, region, name string) error {
+	operation, err := g.service.TargetPools.Delete(projectID, region, name).Do()
+	if err != nil {
+		return err
+	}
+
+	return g.waitForOperation(operation, func() (*compute.Operation, error) {
+		return g.service.RegionOperations.Get(projectID, region, operation.Name).Do()
+	})
+}
+
+func (g gcpComputeClient) ListSslCertificates(filter, projectID string) (*compute.SslCertificateList, error) {
+	return g.service.SslCertificates.List(projectID).Filter(fmt.Sprintf("name eq %s", filter)).Do()
+}
+
+func (g gcpComputeClient) DeleteSslCertificate(projectID, name string) error {
+	operation, err := g.service.SslCertificates.Delete(projectID, name).Do()
+	if err != nil {
+		return err
+	}
+
+	return g.waitForOperation(operation, func() (*compute.Operation, error) {
+		return g.service.GlobalOperations.Get(projectID, operation.Name).Do()
+	})
+}
+
+func (g gcpComputeClient) ListHealthChecks(filter, projectID string) (*compute.HealthCheckList, error) {
+	return g.service.HealthChecks.List(projectID).Filter(fmt.Sprintf("name eq %s", filter)).Do()
+}
+
+func (g gcpComputeClient) DeleteHealthCheck(projectID, name string) error {
+	operation, err := g.service.HealthChecks.Delete(projectID, name).Do()
+	if err != nil {
+		return err
+	}
+
+	return g.waitForOperation(operation, func() (*compute.Operation, error) {
+		return g.service.GlobalOperations.Get(projectID, operation.Name).Do()
+	})
+}
+
+func (g gcpComputeClient) ListHttpHealthChecks(filter, projectID string) (*compute.HttpHealthCheckList, error) {
+	return g.service.HttpHealthChecks.List(projectID).Filter(fmt.Sprintf("name eq %s", filter)).Do()
+}
+
+func (g gcpComputeClient) DeleteHttpHealthCheck(projectID, name string) error {
+	operation, err := g.service.HttpHealthChecks.Delete(projectID, name).Do()
+	if err != nil {
+		return err
+	}
+
+	return g.waitForOperation(operation, func() (*compute.Operation, error) {
+		return g.service.GlobalOperations.Get(projectID, operation.Name).Do()
+	})
+}
+
+// ListInstanceGroups lists the instance groups in every zone of the project.
+func (g gcpComputeClient) ListInstanceGroups(filter, projectID string) (*compute.InstanceGroupList, error) {
+	instanceGroups := &compute.InstanceGroupList{}
+	call := g.service.InstanceGroups.AggregatedList(projectID).Filter(fmt.Sprintf("name eq %s", filter))
+	err := call.Pages(context.Background(), func(list *compute.InstanceGroupAggregatedList) error {
+		for _, scopedList := range list.Items {
+			instanceGroups.Items = append(instanceGroups.Items, scopedList.InstanceGroups...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return instanceGroups, nil
+}
+
+func (g gcpComputeClient) DeleteInstanceGroup(projectID, zone, name string) error {
+	operation, err := g.service.InstanceGroups.Delete(projectID, zone, name).Do()
+	if err != nil {
+		return err
+	}
+
+	return g.waitForOperation(operation, func() (*compute.Operation, error) {
+		return g.service.ZoneOperations.Get(projectID, zone, operation.Name).Do()
+	})
+}
+
+func (g gcpComputeClient) ListAddresses(filter, projectID, region string) (*compute.AddressList, error) {
+	return g.service.Addresses.List(projectID, region).Filter(fmt.Sprintf("name eq %s", filter)).Do()
+}
+
+func (g gcpComputeClient) DeleteAddress(projectID, region, name string) error {
+	operation, err := g.service.Addresses.Delete(projectID, region, name).Do()
+	if err != nil {
+		return err
+	}
+
+	return g.waitForOperation(operation, func() (*compute.Operation, error) {
+		return g.service.RegionOperations.Get(projectID, region, operation.Name).Do()
+	})
+}
+
+func (g gcpComputeClient) ListGlobalAddresses(filter, projectID string) (*compute.AddressList, error) {
+	return g.service.GlobalAddresses.List(projectID).Filter(fmt.Sprintf("name eq %s", filter)).Do()
+}
+
+func (g gcpComputeClient) DeleteGlobalAddress(projectID, name string) error {
+	operation, err := g.service.GlobalAddresses.Delete(projectID, name).Do()
+	if err != nil {
+		return err
+	}
+
+	return g.waitForOperation(operation, func() (*compute.Operation, error) {
+		return g.service.GlobalOperations.Get(projectID, operation.Name).Do()
+	})
+}
+
+func (g gcpComputeClient) ListRouters(filter, projectID, region string) (*compute.RouterList, error) {
+	return g.service.Routers.List(projectID, region).Filter(fmt.Sprintf("name eq %s", filter)).Do()
+}
+
+func (g gcpComputeClient) DeleteRouter(projectID, region, name string) error {
+	operation, err := g.service.Routers.Delete(projectID, region, name).Do()
+	if err != nil {
+		return err
+	}
+
+	return g.waitForOperation(operation, func() (*compute.Operation, error) {
+		return g.service.RegionOperations.Get(projectID, region, operation.Name).Do()
+	})
+}
+
+// waitForOperation polls a delete operation until it is done, so that a
+// network is only deleted once nothing in it is left.
+func (g gcpComputeClient) waitForOperation(operation *compute.Operation, get func() (*compute.Operation, error)) error {
+	var err error
+	for operation.Status != "DONE" {
+		time.Sleep(2 * time.Second)
+
+		operation, err = get()
+		if err != nil {
+			return err
+		}
+	}
+
+	if operation.Error != nil && len(operation.Error.Errors) > 0 {
+		return errors.New(operation.Error.Errors[0].Message)
+	}
+
+	return nil
+}
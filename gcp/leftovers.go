@@ -0,0 +1,357 @@
+package gcp
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/cloudfoundry/bosh-bootloader/helpers"
+	compute "google.golang.org/api/compute/v1"
+)
+
+const (
+	leftoverInstance             = "instance"
+	leftoverGlobalForwardingRule = "global-forwarding-rule"
+	leftoverTargetHTTPSProxy     = "target-https-proxy"
+	leftoverTargetHTTPProxy      = "target-http-proxy"
+	leftoverURLMap               = "url-map"
+	leftoverBackendService       = "backend-service"
+	leftoverForwardingRule       = "forwarding-rule"
+	leftoverTargetPool           = "target-pool"
+	leftoverSSLCertificate       = "ssl-certificate"
+	leftoverHealthCheck          = "health-check"
+	leftoverHTTPHealthCheck      = "http-health-check"
+	leftoverInstanceGroup        = "instance-group"
+	leftoverAddress              = "address"
+	leftoverGlobalAddress        = "global-address"
+	leftoverRouter               = "router"
+	leftoverFirewall             = "firewall"
+	leftoverSubnetwork           = "subnetwork"
+	leftoverNetwork              = "network"
+)
+
+// leftoverLister lists the names of one type of resource whose name matches
+// the filter regex.
+type leftoverLister struct {
+	leftoverType string
+	description  string
+	list         func(filter string) ([]string, error)
+}
+
+// ListLeftovers finds the load balancer resources, routers, firewalls,
+// subnetworks and networks whose name starts with filter, and the instances
+// in any zone that are named after it or attached to one of those networks.
+// Instances and instance groups are identified by "zone/name" so they are
+// deleted in their own zone. They are returned in the order they can be
+// deleted in: forwarding rules before the proxies and target pools they
+// point at, those before the health checks and addresses they use, and
+// everything before the network.
+func (c Client) ListLeftovers(filter string) ([]helpers.Leftover, error) {
+	nameFilter := regexp.QuoteMeta(filter) + ".*"
+
+	networks, err := c.GetNetworks(nameFilter)
+	if err != nil {
+		return nil, fmt.Errorf("List networks: %s", err)
+	}
+
+	instances, err := c.listInstances()
+	if err != nil {
+		return nil, fmt.Errorf("List instances: %s", err)
+	}
+
+	var leftovers []helpers.Leftover
+	for _, instance := range instances.Items {
+		if strings.HasPrefix(instance.Name, filter) || c.isInAnyNetwork(networks, instance.NetworkInterfaces) {
+			leftovers = append(leftovers, helpers.Leftover{
+				Type: leftoverInstance,
+				ID:   path.Join(path.Base(instance.Zone), instance.Name),
+				Name: instance.Name,
+			})
+		}
+	}
+
+	leftovers, err = appendListed(leftovers, c.loadBalancerListers(), nameFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	instanceGroups, err := c.computeClient.ListInstanceGroups(nameFilter, c.projectID)
+	if err != nil {
+		return nil, fmt.Errorf("List instance groups: %s", err)
+	}
+	for _, instanceGroup := range instanceGroups.Items {
+		leftovers = append(leftovers, helpers.Leftover{
+			Type: leftoverInstanceGroup,
+			ID:   path.Join(path.Base(instanceGroup.Zone), instanceGroup.Name),
+			Name: instanceGroup.Name,
+		})
+	}
+
+	leftovers, err = appendListed(leftovers, c.addressAndRouterListers(), nameFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	firewalls, err := c.computeClient.ListFirewalls(nameFilter, c.projectID)
+	if err != nil {
+		return nil, fmt.Errorf("List firewalls: %s", err)
+	}
+	for _, firewall := range firewalls.Items {
+		leftovers = append(leftovers, helpers.Leftover{Type: leftoverFirewall, ID: firewall.Name, Name: firewall.Name})
+	}
+
+	subnetworks, err := c.computeClient.ListSubnetworks(nameFilter, c.projectID, c.region())
+	if err != nil {
+		return nil, fmt.Errorf("List subnetworks: %s", err)
+	}
+	for _, subnetwork := range subnetworks.Items {
+		leftovers = append(leftovers, helpers.Leftover{Type: leftoverSubnetwork, ID: subnetwork.Name, Name: subnetwork.Name})
+	}
+
+	for _, network := range networks.Items {
+		leftovers = append(leftovers, helpers.Leftover{Type: leftoverNetwork, ID: network.Name, Name: network.Name})
+	}
+
+	return leftovers, nil
+}
+
+func appendListed(leftovers []helpers.Leftover, listers []leftoverLister, filter string) ([]helpers.Leftover, error) {
+	for _, lister := range listers {
+		names, err := lister.list(filter)
+		if err != nil {
+			return nil, fmt.Errorf("List %s: %s", lister.description, err)
+		}
+		for _, name := range names {
+			leftovers = append(leftovers, helpers.Leftover{Type: lister.leftoverType, ID: name, Name: name})
+		}
+	}
+
+	return leftovers, nil
+}
+
+// loadBalancerListers list the resources of the cf and concourse load
+// balancers, in the order they can be deleted in.
+func (c Client) loadBalancerListers() []leftoverLister {
+	return []leftoverLister{
+		{leftoverGlobalForwardingRule, "global forwarding rules", func(filter string) ([]string, error) {
+			list, err := c.computeClient.ListGlobalForwardingRules(filter, c.projectID)
+			if err != nil {
+				return nil, err
+			}
+			var names []string
+			for _, item := range list.Items {
+				names = append(names, item.Name)
+			}
+			return names, nil
+		}},
+		{leftoverTargetHTTPSProxy, "target https proxies", func(filter string) ([]string, error) {
+			list, err := c.computeClient.ListTargetHttpsProxies(filter, c.projectID)
+			if err != nil {
+				return nil, err
+			}
+			var names []string
+			for _, item := range list.Items {
+				names = append(names, item.Name)
+			}
+			return names, nil
+		}},
+		{leftoverTargetHTTPProxy, "target http proxies", func(filter string) ([]string, error) {
+			list, err := c.computeClient.ListTargetHttpProxies(filter, c.projectID)
+			if err != nil {
+				return nil, err
+			}
+			var names []string
+			for _, item := range list.Items {
+				names = append(names, item.Name)
+			}
+			return names, nil
+		}},
+		{leftoverURLMap, "url maps", func(filter string) ([]string, error) {
+			list, err := c.computeClient.ListUrlMaps(filter, c.projectID)
+			if err != nil {
+				return nil, err
+			}
+			var names []string
+			for _, item := range list.Items {
+				names = append(names, item.Name)
+			}
+			return names, nil
+		}},
+		{leftoverBackendService, "backend services", func(filter string) ([]string, error) {
+			list, err := c.computeClient.ListBackendServices(filter, c.projectID)
+			if err != nil {
+				return nil, err
+			}
+			var names []string
+			for _, item := range list.Items {
+				names = append(names, item.Name)
+			}
+			return names, nil
+		}},
+		{leftoverForwardingRule, "forwarding rules", func(filter string) ([]string, error) {
+			list, err := c.computeClient.ListForwardingRules(filter, c.projectID, c.region())
+			if err != nil {
+				return nil, err
+			}
+			var names []string
+			for _, item := range list.Items {
+				names = append(names, item.Name)
+			}
+			return names, nil
+		}},
+		{leftoverTargetPool, "target pools", func(filter string) ([]string, error) {
+			list, err := c.computeClient.ListTargetPools(filter, c.projectID, c.region())
+			if err != nil {
+				return nil, err
+			}
+			var names []string
+			for _, item := range list.Items {
+				names = append(names, item.Name)
+			}
+			return names, nil
+		}},
+		{leftoverSSLCertificate, "ssl certificates", func(filter string) ([]string, error) {
+			list, err := c.computeClient.ListSslCertificates(filter, c.projectID)
+			if err != nil {
+				return nil, err
+			}
+			var names []string
+			for _, item := range list.Items {
+				names = append(names, item.Name)
+			}
+			return names, nil
+		}},
+		{leftoverHealthCheck, "health checks", func(filter string) ([]string, error) {
+			list, err := c.computeClient.ListHealthChecks(filter, c.projectID)
+			if err != nil {
+				return nil, err
+			}
+			var names []string
+			for _, item := range list.Items {
+				names = append(names, item.Name)
+			}
+			return names, nil
+		}},
+		{leftoverHTTPHealthCheck, "http health checks", func(filter string) ([]string, error) {
+			list, err := c.computeClient.ListHttpHealthChecks(filter, c.projectID)
+			if err != nil {
+				return nil, err
+			}
+			var names []string
+			for _, item := range list.Items {
+				names = append(names, item.Name)
+			}
+			return names, nil
+		}},
+	}
+}
+
+// addressAndRouterListers list the addresses, which can only be deleted once
+// no forwarding rule uses them, and the NAT router of private directors.
+func (c Client) addressAndRouterListers() []leftoverLister {
+	return []leftoverLister{
+		{leftoverAddress, "addresses", func(filter string) ([]string, error) {
+			list, err := c.computeClient.ListAddresses(filter, c.projectID, c.region())
+			if err != nil {
+				return nil, err
+			}
+			var names []string
+			for _, item := range list.Items {
+				names = append(names, item.Name)
+			}
+			return names, nil
+		}},
+		{leftoverGlobalAddress, "global addresses", func(filter string) ([]string, error) {
+			list, err := c.computeClient.ListGlobalAddresses(filter, c.projectID)
+			if err != nil {
+				return nil, err
+			}
+			var names []string
+			for _, item := range list.Items {
+				names = append(names, item.Name)
+			}
+			return names, nil
+		}},
+		{leftoverRouter, "routers", func(filter string) ([]string, error) {
+			list, err := c.computeClient.ListRouters(filter, c.projectID, c.region())
+			if err != nil {
+				return nil, err
+			}
+			var names []string
+			for _, item := range list.Items {
+				names = append(names, item.Name)
+			}
+			return names, nil
+		}},
+	}
+}
+
+func (c Client) DeleteLeftover(leftover helpers.Leftover) error {
+	switch leftover.Type {
+	case leftoverInstance:
+		zone, name := path.Split(leftover.ID)
+		if zone == "" {
+			return c.computeClient.DeleteInstance(c.projectID, c.zone, name)
+		}
+		return c.computeClient.DeleteInstance(c.projectID, path.Clean(zone), name)
+	case leftoverGlobalForwardingRule:
+		return c.computeClient.DeleteGlobalForwardingRule(c.projectID, leftover.ID)
+	case leftoverTargetHTTPSProxy:
+		return c.computeClient.DeleteTargetHttpsProxy(c.projectID, leftover.ID)
+	case leftoverTargetHTTPProxy:
+		return c.computeClient.DeleteTargetHttpProxy(c.projectID, leftover.ID)
+	case leftoverURLMap:
+		return c.computeClient.DeleteUrlMap(c.projectID, leftover.ID)
+	case leftoverBackendService:
+		return c.computeClient.DeleteBackendService(c.projectID, leftover.ID)
+	case leftoverForwardingRule:
+		return c.computeClient.DeleteForwardingRule(c.projectID, c.region(), leftover.ID)
+	case leftoverTargetPool:
+		return c.computeClient.DeleteTargetPool(c.projectID, c.region(), leftover.ID)
+	case leftoverSSLCertificate:
+		return c.computeClient.DeleteSslCertificate(c.projectID, leftover.ID)
+	case leftoverHealthCheck:
+		return c.computeClient.DeleteHealthCheck(c.projectID, leftover.ID)
+	case leftoverHTTPHealthCheck:
+		return c.computeClient.DeleteHttpHealthCheck(c.projectID, leftover.ID)
+	case leftoverAddress:
+		return c.computeClient.DeleteAddress(c.projectID, c.region(), leftover.ID)
+	case leftoverGlobalAddress:
+		return c.computeClient.DeleteGlobalAddress(c.projectID, leftover.ID)
+	case leftoverRouter:
+		return c.computeClient.DeleteRouter(c.projectID, c.region(), leftover.ID)
+	case leftoverInstanceGroup:
+		zone, name := path.Split(leftover.ID)
+		return c.computeClient.DeleteInstanceGroup(c.projectID, path.Clean(zone), name)
+	case leftoverFirewall:
+		return c.computeClient.DeleteFirewall(c.projectID, leftover.ID)
+	case leftoverSubnetwork:
+		return c.computeClient.DeleteSubnetwork(c.projectID, c.region(), leftover.ID)
+	case leftoverNetwork:
+		return c.computeClient.DeleteNetwork(c.projectID, leftover.ID)
+	default:
+		return fmt.Errorf("Unknown leftover type %q", leftover.Type)
+	}
+}
+
+// region derives the region from the configured zone, e.g. us-east1 from
+// us-east1-b.
+func (c Client) region() string {
+	if i := strings.LastIndex(c.zone, "-"); i > 0 {
+		return c.zone[:i]
+	}
+	return c.zone
+}
+
+func (c Client) isInAnyNetwork(networks *compute.NetworkList, networkInterfaces []*compute.NetworkInterface) bool {
+	for _, network := range networks.Items {
+		for _, networkInterface := range networkInterfaces {
+			if strings.HasSuffix(networkInterface.Network, "/"+network.Name) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,237 @@
+package gcp_test
+
+import (
+	"errors"
+
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/gcp"
+	"github.com/cloudfoundry/bosh-bootloader/helpers"
+	compute "google.golang.org/api/compute/v1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Leftovers", func() {
+	var (
+		computeClient *fakes.GCPComputeClient
+		client        gcp.Client
+	)
+
+	BeforeEach(func() {
+		computeClient = &fakes.GCPComputeClient{}
+		client = gcp.NewClientWithInjectedComputeClient(computeClient, "some-project-id", "us-east1-b")
+	})
+
+	Describe("ListLeftovers", func() {
+		BeforeEach(func() {
+			computeClient.GetNetworksCall.Returns.NetworkList = &compute.NetworkList{
+				Items: []*compute.Network{{Name: "bbl-env-network"}},
+			}
+			computeClient.ListInstancesCall.Returns.InstanceList = &compute.InstanceList{
+				Items: []*compute.Instance{
+					{Name: "bbl-env-jumpbox", Zone: "https://some-host/zones/us-east1-b"},
+					{
+						Name:              "vm-director",
+						Zone:              "https://some-host/zones/us-east1-c",
+						NetworkInterfaces: []*compute.NetworkInterface{{Network: "https://some-host/global/networks/bbl-env-network"}},
+					},
+					{
+						Name:              "vm-other",
+						Zone:              "https://some-host/zones/us-east1-d",
+						NetworkInterfaces: []*compute.NetworkInterface{{Network: "https://some-host/global/networks/other-network"}},
+					},
+				},
+			}
+			computeClient.ListFirewallsCall.Returns.FirewallList = &compute.FirewallList{
+				Items: []*compute.Firewall{{Name: "bbl-env-bosh-open"}},
+			}
+			computeClient.ListSubnetworksCall.Returns.SubnetworkList = &compute.SubnetworkList{
+				Items: []*compute.Subnetwork{{Name: "bbl-env-subnet"}},
+			}
+			computeClient.ListGlobalForwardingRulesCall.Returns.ForwardingRuleList = &compute.ForwardingRuleList{
+				Items: []*compute.ForwardingRule{{Name: "bbl-env-cf-https"}},
+			}
+			computeClient.ListTargetHttpsProxiesCall.Returns.TargetHttpsProxyList = &compute.TargetHttpsProxyList{
+				Items: []*compute.TargetHttpsProxy{{Name: "bbl-env-https-proxy"}},
+			}
+			computeClient.ListTargetHttpProxiesCall.Returns.TargetHttpProxyList = &compute.TargetHttpProxyList{}
+			computeClient.ListUrlMapsCall.Returns.UrlMapList = &compute.UrlMapList{
+				Items: []*compute.UrlMap{{Name: "bbl-env-cf-http"}},
+			}
+			computeClient.ListBackendServicesCall.Returns.BackendServiceList = &compute.BackendServiceList{
+				Items: []*compute.BackendService{{Name: "bbl-env-router-lb"}},
+			}
+			computeClient.ListForwardingRulesCall.Returns.ForwardingRuleList = &compute.ForwardingRuleList{
+				Items: []*compute.ForwardingRule{{Name: "bbl-env-cf-ssh-proxy"}},
+			}
+			computeClient.ListTargetPoolsCall.Returns.TargetPoolList = &compute.TargetPoolList{
+				Items: []*compute.TargetPool{{Name: "bbl-env-cf-ssh-proxy"}},
+			}
+			computeClient.ListSslCertificatesCall.Returns.SslCertificateList = &compute.SslCertificateList{
+				Items: []*compute.SslCertificate{{Name: "bbl-env20171114"}},
+			}
+			computeClient.ListHealthChecksCall.Returns.HealthCheckList = &compute.HealthCheckList{
+				Items: []*compute.HealthCheck{{Name: "bbl-env-cf-public"}},
+			}
+			computeClient.ListHttpHealthChecksCall.Returns.HttpHealthCheckList = &compute.HttpHealthCheckList{
+				Items: []*compute.HttpHealthCheck{{Name: "bbl-env-cf-tcp-router"}},
+			}
+			computeClient.ListInstanceGroupsCall.Returns.InstanceGroupList = &compute.InstanceGroupList{
+				Items: []*compute.InstanceGroup{{Name: "bbl-env-router-lb-0-us-east1-b", Zone: "https://some-host/zones/us-east1-b"}},
+			}
+			computeClient.ListAddressesCall.Returns.AddressList = &compute.AddressList{
+				Items: []*compute.Address{{Name: "bbl-env-cf-ssh-proxy"}},
+			}
+			computeClient.ListGlobalAddressesCall.Returns.AddressList = &compute.AddressList{
+				Items: []*compute.Address{{Name: "bbl-env-cf"}},
+			}
+			computeClient.ListRoutersCall.Returns.RouterList = &compute.RouterList{}
+		})
+
+		It("returns the resources named after the filter and the instances in their networks", func() {
+			leftovers, err := client.ListLeftovers("bbl-env")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(leftovers).To(Equal([]helpers.Leftover{
+				{Type: "instance", ID: "us-east1-b/bbl-env-jumpbox", Name: "bbl-env-jumpbox"},
+				{Type: "instance", ID: "us-east1-c/vm-director", Name: "vm-director"},
+				{Type: "global-forwarding-rule", ID: "bbl-env-cf-https", Name: "bbl-env-cf-https"},
+				{Type: "target-https-proxy", ID: "bbl-env-https-proxy", Name: "bbl-env-https-proxy"},
+				{Type: "url-map", ID: "bbl-env-cf-http", Name: "bbl-env-cf-http"},
+				{Type: "backend-service", ID: "bbl-env-router-lb", Name: "bbl-env-router-lb"},
+				{Type: "forwarding-rule", ID: "bbl-env-cf-ssh-proxy", Name: "bbl-env-cf-ssh-proxy"},
+				{Type: "target-pool", ID: "bbl-env-cf-ssh-proxy", Name: "bbl-env-cf-ssh-proxy"},
+				{Type: "ssl-certificate", ID: "bbl-env20171114", Name: "bbl-env20171114"},
+				{Type: "health-check", ID: "bbl-env-cf-public", Name: "bbl-env-cf-public"},
+				{Type: "http-health-check", ID: "bbl-env-cf-tcp-router", Name: "bbl-env-cf-tcp-router"},
+				{Type: "instance-group", ID: "us-east1-b/bbl-env-router-lb-0-us-east1-b", Name: "bbl-env-router-lb-0-us-east1-b"},
+				{Type: "address", ID: "bbl-env-cf-ssh-proxy", Name: "bbl-env-cf-ssh-proxy"},
+				{Type: "global-address", ID: "bbl-env-cf", Name: "bbl-env-cf"},
+				{Type: "firewall", ID: "bbl-env-bosh-open", Name: "bbl-env-bosh-open"},
+				{Type: "subnetwork", ID: "bbl-env-subnet", Name: "bbl-env-subnet"},
+				{Type: "network", ID: "bbl-env-network", Name: "bbl-env-network"},
+			}))
+
+			Expect(computeClient.ListInstancesCall.Receives.ProjectID).To(Equal("some-project-id"))
+			Expect(computeClient.GetNetworksCall.Receives.Name).To(Equal(`bbl-env.*`))
+			Expect(computeClient.ListFirewallsCall.Receives.Filter).To(Equal(`bbl-env.*`))
+			Expect(computeClient.ListSubnetworksCall.Receives.Region).To(Equal("us-east1"))
+			Expect(computeClient.ListForwardingRulesCall.Receives.Region).To(Equal("us-east1"))
+			Expect(computeClient.ListTargetPoolsCall.Receives.Region).To(Equal("us-east1"))
+			Expect(computeClient.ListAddressesCall.Receives.Region).To(Equal("us-east1"))
+			Expect(computeClient.ListInstanceGroupsCall.Receives.Filter).To(Equal(`bbl-env.*`))
+		})
+
+		Context("when the network has a nat router", func() {
+			BeforeEach(func() {
+				computeClient.ListRoutersCall.Returns.RouterList = &compute.RouterList{
+					Items: []*compute.Router{{Name: "bbl-env-nat-router"}},
+				}
+			})
+
+			It("lists the router so that the network can be deleted after it", func() {
+				leftovers, err := client.ListLeftovers("bbl-env")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(computeClient.ListRoutersCall.Receives.Region).To(Equal("us-east1"))
+				Expect(leftovers[len(leftovers)-4:]).To(Equal([]helpers.Leftover{
+					{Type: "router", ID: "bbl-env-nat-router", Name: "bbl-env-nat-router"},
+					{Type: "firewall", ID: "bbl-env-bosh-open", Name: "bbl-env-bosh-open"},
+					{Type: "subnetwork", ID: "bbl-env-subnet", Name: "bbl-env-subnet"},
+					{Type: "network", ID: "bbl-env-network", Name: "bbl-env-network"},
+				}))
+			})
+		})
+
+		It("quotes the filter in the name regex", func() {
+			_, err := client.ListLeftovers("bbl.env")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(computeClient.ListSubnetworksCall.Receives.Filter).To(Equal(`bbl\.env.*`))
+		})
+
+		It("returns an error when listing fails", func() {
+			computeClient.ListFirewallsCall.Returns.Error = errors.New("forbidden")
+
+			_, err := client.ListLeftovers("bbl-env")
+			Expect(err).To(MatchError("List firewalls: forbidden"))
+		})
+
+		It("returns an error when listing load balancer resources fails", func() {
+			computeClient.ListTargetPoolsCall.Returns.Error = errors.New("forbidden")
+
+			_, err := client.ListLeftovers("bbl-env")
+			Expect(err).To(MatchError("List target pools: forbidden"))
+		})
+	})
+
+	Describe("DeleteLeftover", func() {
+		It("deletes each type of resource", func() {
+			Expect(client.DeleteLeftover(helpers.Leftover{Type: "instance", ID: "some-instance"})).To(Succeed())
+			Expect(client.DeleteLeftover(helpers.Leftover{Type: "firewall", ID: "some-firewall"})).To(Succeed())
+			Expect(client.DeleteLeftover(helpers.Leftover{Type: "subnetwork", ID: "some-subnetwork"})).To(Succeed())
+			Expect(client.DeleteLeftover(helpers.Leftover{Type: "network", ID: "some-network"})).To(Succeed())
+
+			Expect(computeClient.DeleteInstanceCall.Receives.Zone).To(Equal("us-east1-b"))
+			Expect(computeClient.DeleteInstanceCall.Receives.Name).To(Equal("some-instance"))
+			Expect(computeClient.DeleteFirewallCall.Receives.Name).To(Equal("some-firewall"))
+			Expect(computeClient.DeleteSubnetworkCall.Receives.Region).To(Equal("us-east1"))
+			Expect(computeClient.DeleteSubnetworkCall.Receives.Name).To(Equal("some-subnetwork"))
+			Expect(computeClient.DeleteNetworkCall.Receives.ProjectID).To(Equal("some-project-id"))
+			Expect(computeClient.DeleteNetworkCall.Receives.Name).To(Equal("some-network"))
+		})
+
+		It("deletes load balancer resources and routers", func() {
+			for _, leftoverType := range []string{
+				"global-forwarding-rule", "target-https-proxy", "target-http-proxy", "url-map", "backend-service",
+				"forwarding-rule", "target-pool", "ssl-certificate", "health-check", "http-health-check",
+				"address", "global-address", "router",
+			} {
+				Expect(client.DeleteLeftover(helpers.Leftover{Type: leftoverType, ID: "some-" + leftoverType})).To(Succeed())
+			}
+			Expect(client.DeleteLeftover(helpers.Leftover{Type: "instance-group", ID: "us-east1-c/some-instance-group"})).To(Succeed())
+
+			Expect(computeClient.DeleteGlobalForwardingRuleCall.Receives.Name).To(Equal("some-global-forwarding-rule"))
+			Expect(computeClient.DeleteTargetHttpsProxyCall.Receives.Name).To(Equal("some-target-https-proxy"))
+			Expect(computeClient.DeleteTargetHttpProxyCall.Receives.Name).To(Equal("some-target-http-proxy"))
+			Expect(computeClient.DeleteUrlMapCall.Receives.Name).To(Equal("some-url-map"))
+			Expect(computeClient.DeleteBackendServiceCall.Receives.Name).To(Equal("some-backend-service"))
+			Expect(computeClient.DeleteForwardingRuleCall.Receives.Region).To(Equal("us-east1"))
+			Expect(computeClient.DeleteForwardingRuleCall.Receives.Name).To(Equal("some-forwarding-rule"))
+			Expect(computeClient.DeleteTargetPoolCall.Receives.Region).To(Equal("us-east1"))
+			Expect(computeClient.DeleteTargetPoolCall.Receives.Name).To(Equal("some-target-pool"))
+			Expect(computeClient.DeleteSslCertificateCall.Receives.Name).To(Equal("some-ssl-certificate"))
+			Expect(computeClient.DeleteHealthCheckCall.Receives.Name).To(Equal("some-health-check"))
+			Expect(computeClient.DeleteHttpHealthCheckCall.Receives.Name).To(Equal("some-http-health-check"))
+			Expect(computeClient.DeleteAddressCall.Receives.Region).To(Equal("us-east1"))
+			Expect(computeClient.DeleteAddressCall.Receives.Name).To(Equal("some-address"))
+			Expect(computeClient.DeleteGlobalAddressCall.Receives.Name).To(Equal("some-global-address"))
+			Expect(computeClient.DeleteRouterCall.Receives.ProjectID).To(Equal("some-project-id"))
+			Expect(computeClient.DeleteRouterCall.Receives.Region).To(Equal("us-east1"))
+			Expect(computeClient.DeleteRouterCall.Receives.Name).To(Equal("some-router"))
+			Expect(computeClient.DeleteInstanceGroupCall.Receives.Zone).To(Equal("us-east1-c"))
+			Expect(computeClient.DeleteInstanceGroupCall.Receives.Name).To(Equal("some-instance-group"))
+		})
+
+		It("deletes instances in their own zone", func() {
+			err := client.DeleteLeftover(helpers.Leftover{Type: "instance", ID: "us-east1-c/some-instance"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(computeClient.DeleteInstanceCall.Receives.Zone).To(Equal("us-east1-c"))
+			Expect(computeClient.DeleteInstanceCall.Receives.Name).To(Equal("some-instance"))
+		})
+
+		It("returns the error from gcp", func() {
+			computeClient.DeleteNetworkCall.Returns.Error = errors.New("resource in use")
+
+			err := client.DeleteLeftover(helpers.Leftover{Type: "network", ID: "some-network"})
+			Expect(err).To(MatchError("resource in use"))
+		})
+
+		It("returns an error for unknown types", func() {
+			err := client.DeleteLeftover(helpers.Leftover{Type: "some-type", ID: "some-resource"})
+			Expect(err).To(MatchError(`Unknown leftover type "some-type"`))
+		})
+	})
+})
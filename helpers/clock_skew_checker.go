@@ -0,0 +1,44 @@
+package helpers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ClockSkewChecker compares the local clock with the Date header of an
+// HTTP response. Request signing on most IaaSes fails once the two drift
+// apart by more than a few minutes.
+type ClockSkewChecker struct {
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+func NewClockSkewChecker() ClockSkewChecker {
+	return ClockSkewChecker{
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		now:        time.Now,
+	}
+}
+
+// Skew returns how far the local clock is ahead of the server at url.
+// A negative skew means the local clock is behind.
+func (c ClockSkewChecker) Skew(url string) (time.Duration, error) {
+	response, err := c.httpClient.Head(url)
+	if err != nil {
+		return 0, err
+	}
+	response.Body.Close()
+
+	date := response.Header.Get("Date")
+	if date == "" {
+		return 0, fmt.Errorf("%s did not return a Date header", url)
+	}
+
+	serverTime, err := http.ParseTime(date)
+	if err != nil {
+		return 0, fmt.Errorf("parse Date header %q: %s", date, err)
+	}
+
+	return c.now().Sub(serverTime), nil
+}
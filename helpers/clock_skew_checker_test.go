@@ -0,0 +1,61 @@
+package helpers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/cloudfoundry/bosh-bootloader/helpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ClockSkewChecker", func() {
+	var (
+		server *httptest.Server
+		date   string
+	)
+
+	BeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header()["Date"] = []string{date}
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("returns how far the local clock is ahead of the server", func() {
+		date = time.Now().UTC().Add(-10 * time.Minute).Format(http.TimeFormat)
+
+		skew, err := helpers.NewClockSkewChecker().Skew(server.URL)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(skew).To(BeNumerically("~", 10*time.Minute, 5*time.Second))
+	})
+
+	It("returns a negative skew when the local clock is behind", func() {
+		date = time.Now().UTC().Add(10 * time.Minute).Format(http.TimeFormat)
+
+		skew, err := helpers.NewClockSkewChecker().Skew(server.URL)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(skew).To(BeNumerically("~", -10*time.Minute, 5*time.Second))
+	})
+
+	Context("failure cases", func() {
+		It("returns an error when the Date header cannot be parsed", func() {
+			date = "not-a-date"
+
+			_, err := helpers.NewClockSkewChecker().Skew(server.URL)
+			Expect(err).To(MatchError(ContainSubstring(`parse Date header "not-a-date"`)))
+		})
+
+		It("returns an error when the server cannot be reached", func() {
+			server.Close()
+
+			_, err := helpers.NewClockSkewChecker().Skew(server.URL)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
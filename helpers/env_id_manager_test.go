@@ -82,6 +82,32 @@ var _ = Describe("EnvIDManager", func() {
 					Expect(err).To(MatchError("It looks like a bbl environment already exists with the name 'existing-env'. Please provide a different name."))
 				})
 			})
+
+			Context("for openstack", func() {
+				It("fails if an environment with that name was already created", func() {
+					networkClient.CheckExistsCall.Returns.Exists = true
+					_, err := envIDManager.Sync(storage.State{
+						IAAS: "openstack",
+					}, "existing-env")
+
+					Expect(networkClient.CheckExistsCall.CallCount).To(Equal(1))
+					Expect(networkClient.CheckExistsCall.Receives.Name).To(Equal("existing-env-network"))
+
+					Expect(err).To(MatchError("It looks like a bbl environment already exists with the name 'existing-env'. Please provide a different name."))
+				})
+			})
+
+			Context("for vsphere", func() {
+				It("does not check for an existing network", func() {
+					state, err := envIDManager.Sync(storage.State{
+						IAAS: "vsphere",
+					}, "some-env")
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(networkClient.CheckExistsCall.CallCount).To(Equal(0))
+					Expect(state.EnvID).To(Equal("some-env"))
+				})
+			})
 		})
 
 		Context("when an env id exists in the state", func() {
@@ -0,0 +1,10 @@
+package helpers
+
+// Leftover is a resource that a failed or abandoned environment left
+// behind. ID is what the IAAS deletes it by and Name is its env ID based
+// name, which may be the same.
+type Leftover struct {
+	Type string
+	ID   string
+	Name string
+}
@@ -0,0 +1,90 @@
+package openstack
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Client struct {
+	networksClient NetworksClient
+	serversClient  ServersClient
+}
+
+type Network struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type Server struct {
+	ID        string                 `json:"id"`
+	Name      string                 `json:"name"`
+	Metadata  map[string]string      `json:"metadata"`
+	Addresses map[string]interface{} `json:"addresses"`
+}
+
+type NetworksClient interface {
+	List(name string) ([]Network, error)
+}
+
+type ServersClient interface {
+	List() ([]Server, error)
+}
+
+func (c Client) CheckExists(networkName string) (bool, error) {
+	networks, err := c.networksClient.List(networkName)
+	if err != nil {
+		return false, fmt.Errorf("List networks: %s", err)
+	}
+
+	return len(networks) > 0, nil
+}
+
+// CheckPermissions lists networks. It only shows that the credentials
+// authenticate and can read resources in the project; whether they may
+// create them is not checked.
+func (c Client) CheckPermissions() error {
+	_, err := c.networksClient.List("bbl-permission-check")
+	if err != nil {
+		return fmt.Errorf("List networks: %s", err)
+	}
+
+	return nil
+}
+
+// ValidateSafeToDeleteSubnets is never called on OpenStack, where bbl does
+// not reuse existing networks.
+func (c Client) ValidateSafeToDeleteSubnets(subnets []string, envID string) error {
+	return nil
+}
+
+func (c Client) ValidateSafeToDelete(networkName string, envID string) error {
+	servers, err := c.serversClient.List()
+	if err != nil {
+		return fmt.Errorf("List servers: %s", err)
+	}
+
+	var errorMessages []string
+	for _, server := range servers {
+		if _, ok := server.Addresses[networkName]; !ok {
+			continue
+		}
+
+		job := server.Metadata["job"]
+		if job == "bosh" || job == "jumpbox" {
+			continue
+		}
+
+		if deployment, ok := server.Metadata["deployment"]; ok {
+			errorMessages = append(errorMessages, fmt.Sprintf("%s (deployment: %s)", server.Name, deployment))
+		} else {
+			errorMessages = append(errorMessages, fmt.Sprintf("%s (not managed by bosh)", server.Name))
+		}
+	}
+
+	if len(errorMessages) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("bbl environment is not safe to delete; vms still exist in network:\n%s",
+		strings.Join(errorMessages, "\n"))
+}
@@ -0,0 +1,183 @@
+package openstack
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+)
+
+type authRequest struct {
+	Auth struct {
+		Identity struct {
+			Methods  []string `json:"methods"`
+			Password struct {
+				User struct {
+					Name     string `json:"name"`
+					Domain   domain `json:"domain"`
+					Password string `json:"password"`
+				} `json:"user"`
+			} `json:"password"`
+		} `json:"identity"`
+		Scope struct {
+			Project struct {
+				Name   string `json:"name"`
+				Domain domain `json:"domain"`
+			} `json:"project"`
+		} `json:"scope"`
+	} `json:"auth"`
+}
+
+type domain struct {
+	Name string `json:"name"`
+}
+
+type authResponse struct {
+	Token struct {
+		Catalog []struct {
+			Type      string `json:"type"`
+			Endpoints []struct {
+				Interface string `json:"interface"`
+				Region    string `json:"region"`
+				RegionID  string `json:"region_id"`
+				URL       string `json:"url"`
+			} `json:"endpoints"`
+		} `json:"catalog"`
+	} `json:"token"`
+}
+
+func NewClient(openstackConfig storage.OpenStack) (Client, error) {
+	token, catalog, err := authenticate(openstackConfig)
+	if err != nil {
+		return Client{}, err
+	}
+
+	networkURL, err := catalog.endpoint("network", openstackConfig.Region)
+	if err != nil {
+		return Client{}, err
+	}
+
+	computeURL, err := catalog.endpoint("compute", openstackConfig.Region)
+	if err != nil {
+		return Client{}, err
+	}
+
+	return Client{
+		networksClient: networksClient{url: networkURL, token: token},
+		serversClient:  serversClient{url: computeURL, token: token},
+	}, nil
+}
+
+func authenticate(openstackConfig storage.OpenStack) (string, authResponse, error) {
+	var request authRequest
+	request.Auth.Identity.Methods = []string{"password"}
+	request.Auth.Identity.Password.User.Name = openstackConfig.Username
+	request.Auth.Identity.Password.User.Domain = domain{Name: openstackConfig.Domain}
+	request.Auth.Identity.Password.User.Password = openstackConfig.Password
+	request.Auth.Scope.Project.Name = openstackConfig.Project
+	request.Auth.Scope.Project.Domain = domain{Name: openstackConfig.Domain}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return "", authResponse{}, err //not tested
+	}
+
+	tokensURL := fmt.Sprintf("%s/auth/tokens", strings.TrimSuffix(openstackConfig.AuthURL, "/"))
+	response, err := http.Post(tokensURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return "", authResponse{}, fmt.Errorf("Authenticate: %s", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusCreated {
+		return "", authResponse{}, fmt.Errorf("Authenticate: unexpected status code %d", response.StatusCode)
+	}
+
+	var auth authResponse
+	err = json.NewDecoder(response.Body).Decode(&auth)
+	if err != nil {
+		return "", authResponse{}, fmt.Errorf("Authenticate: %s", err)
+	}
+
+	return response.Header.Get("X-Subject-Token"), auth, nil
+}
+
+func (a authResponse) endpoint(serviceType, region string) (string, error) {
+	for _, service := range a.Token.Catalog {
+		if service.Type != serviceType {
+			continue
+		}
+
+		for _, endpoint := range service.Endpoints {
+			if endpoint.Interface != "public" {
+				continue
+			}
+			if endpoint.Region == region || endpoint.RegionID == region {
+				return strings.TrimSuffix(endpoint.URL, "/"), nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("no public %s endpoint found for region %s", serviceType, region)
+}
+
+type networksClient struct {
+	url   string
+	token string
+}
+
+func (n networksClient) List(name string) ([]Network, error) {
+	var body struct {
+		Networks []Network `json:"networks"`
+	}
+
+	err := get(fmt.Sprintf("%s/v2.0/networks?name=%s", n.url, url.QueryEscape(name)), n.token, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	return body.Networks, nil
+}
+
+type serversClient struct {
+	url   string
+	token string
+}
+
+func (s serversClient) List() ([]Server, error) {
+	var body struct {
+		Servers []Server `json:"servers"`
+	}
+
+	err := get(fmt.Sprintf("%s/servers/detail", s.url), s.token, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	return body.Servers, nil
+}
+
+func get(resourceURL, token string, body interface{}) error {
+	request, err := http.NewRequest("GET", resourceURL, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("X-Auth-Token", token)
+	request.Header.Set("Accept", "application/json")
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d from %s", response.StatusCode, resourceURL)
+	}
+
+	return json.NewDecoder(response.Body).Decode(body)
+}
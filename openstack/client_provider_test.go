@@ -0,0 +1,130 @@
+package openstack_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/cloudfoundry/bosh-bootloader/openstack"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewClient", func() {
+	var (
+		server          *httptest.Server
+		authStatus      int
+		authRequestBody map[string]interface{}
+		openstackConfig storage.OpenStack
+	)
+
+	BeforeEach(func() {
+		authStatus = http.StatusCreated
+		authRequestBody = nil
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == "POST" && r.URL.Path == "/v3/auth/tokens":
+				body, err := ioutil.ReadAll(r.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(json.Unmarshal(body, &authRequestBody)).To(Succeed())
+
+				w.Header().Set("X-Subject-Token", "some-token")
+				w.WriteHeader(authStatus)
+				fmt.Fprintf(w, `{
+					"token": {
+						"catalog": [
+							{"type": "network", "endpoints": [
+								{"interface": "internal", "region": "some-region", "url": "http://internal.example.com"},
+								{"interface": "public", "region": "other-region", "url": "http://other.example.com"},
+								{"interface": "public", "region": "some-region", "url": "%[1]s/network/"}
+							]},
+							{"type": "compute", "endpoints": [
+								{"interface": "public", "region_id": "some-region", "url": "%[1]s/compute"}
+							]}
+						]
+					}
+				}`, server.URL)
+			case r.URL.Path == "/network/v2.0/networks":
+				Expect(r.Header.Get("X-Auth-Token")).To(Equal("some-token"))
+				Expect(r.URL.Query().Get("name")).To(Equal("some-env-network"))
+				w.Write([]byte(`{"networks": [{"id": "some-network-id", "name": "some-env-network"}]}`))
+			case r.URL.Path == "/compute/servers/detail":
+				Expect(r.Header.Get("X-Auth-Token")).To(Equal("some-token"))
+				w.Write([]byte(`{"servers": [{"name": "router/0", "metadata": {"deployment": "cf"}, "addresses": {"some-env-network": []}}]}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+
+		openstackConfig = storage.OpenStack{
+			AuthURL:  server.URL + "/v3",
+			Project:  "some-project",
+			Domain:   "some-domain",
+			Region:   "some-region",
+			Username: "some-username",
+			Password: "some-password",
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("authenticates with keystone using the project scope", func() {
+		_, err := openstack.NewClient(openstackConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(authRequestBody).To(Equal(map[string]interface{}{
+			"auth": map[string]interface{}{
+				"identity": map[string]interface{}{
+					"methods": []interface{}{"password"},
+					"password": map[string]interface{}{
+						"user": map[string]interface{}{
+							"name":     "some-username",
+							"domain":   map[string]interface{}{"name": "some-domain"},
+							"password": "some-password",
+						},
+					},
+				},
+				"scope": map[string]interface{}{
+					"project": map[string]interface{}{
+						"name":   "some-project",
+						"domain": map[string]interface{}{"name": "some-domain"},
+					},
+				},
+			},
+		}))
+	})
+
+	It("uses the public endpoints for the region from the service catalog", func() {
+		client, err := openstack.NewClient(openstackConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		exists, err := client.CheckExists("some-env-network")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exists).To(BeTrue())
+
+		err = client.ValidateSafeToDelete("some-env-network", "some-env-id")
+		Expect(err).To(MatchError("bbl environment is not safe to delete; vms still exist in network:\nrouter/0 (deployment: cf)"))
+	})
+
+	Context("failure cases", func() {
+		It("returns an error when authentication fails", func() {
+			authStatus = http.StatusUnauthorized
+
+			_, err := openstack.NewClient(openstackConfig)
+			Expect(err).To(MatchError("Authenticate: unexpected status code 401"))
+		})
+
+		It("returns an error when the region has no endpoints", func() {
+			openstackConfig.Region = "missing-region"
+
+			_, err := openstack.NewClient(openstackConfig)
+			Expect(err).To(MatchError("no public network endpoint found for region missing-region"))
+		})
+	})
+})
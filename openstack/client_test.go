@@ -0,0 +1,156 @@
+package openstack_test
+
+import (
+	"errors"
+
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/openstack"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Client", func() {
+	Describe("CheckExists", func() {
+		var (
+			networksClient *fakes.OpenStackNetworksClient
+			client         openstack.Client
+		)
+
+		BeforeEach(func() {
+			networksClient = &fakes.OpenStackNetworksClient{}
+			client = openstack.NewClientWithInjectedNetworksClient(networksClient)
+		})
+
+		Context("when the network does not exist", func() {
+			It("returns false", func() {
+				exists, err := client.CheckExists("some-env-network")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(networksClient.ListCall.Receives.Name).To(Equal("some-env-network"))
+				Expect(exists).To(BeFalse())
+			})
+		})
+
+		Context("when the network already exists", func() {
+			BeforeEach(func() {
+				networksClient.ListCall.Returns.Networks = []openstack.Network{
+					{ID: "some-network-id", Name: "some-env-network"},
+				}
+			})
+
+			It("returns true", func() {
+				exists, err := client.CheckExists("some-env-network")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(exists).To(BeTrue())
+			})
+		})
+
+		Context("when the networks client returns an error", func() {
+			BeforeEach(func() {
+				networksClient.ListCall.Returns.Error = errors.New("grape")
+			})
+
+			It("returns the error", func() {
+				_, err := client.CheckExists("some-env-network")
+				Expect(err).To(MatchError("List networks: grape"))
+			})
+		})
+	})
+
+	Describe("CheckPermissions", func() {
+		var (
+			networksClient *fakes.OpenStackNetworksClient
+			client         openstack.Client
+		)
+
+		BeforeEach(func() {
+			networksClient = &fakes.OpenStackNetworksClient{}
+			client = openstack.NewClientWithInjectedNetworksClient(networksClient)
+		})
+
+		It("lists networks", func() {
+			err := client.CheckPermissions()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(networksClient.ListCall.Receives.Name).To(Equal("bbl-permission-check"))
+		})
+
+		It("returns an error when the networks cannot be listed", func() {
+			networksClient.ListCall.Returns.Error = errors.New("forbidden")
+
+			err := client.CheckPermissions()
+			Expect(err).To(MatchError("List networks: forbidden"))
+		})
+	})
+
+	Describe("ValidateSafeToDelete", func() {
+		var (
+			serversClient *fakes.OpenStackServersClient
+			client        openstack.Client
+		)
+
+		BeforeEach(func() {
+			serversClient = &fakes.OpenStackServersClient{}
+			client = openstack.NewClientWithInjectedServersClient(serversClient)
+
+			serversClient.ListCall.Returns.Servers = []openstack.Server{
+				{
+					Name:      "jumpbox/0",
+					Metadata:  map[string]string{"job": "jumpbox"},
+					Addresses: map[string]interface{}{"some-env-network": []interface{}{}},
+				},
+				{
+					Name:      "bosh/0",
+					Metadata:  map[string]string{"job": "bosh"},
+					Addresses: map[string]interface{}{"some-env-network": []interface{}{}},
+				},
+				{
+					Name:      "other-env-vm",
+					Addresses: map[string]interface{}{"other-env-network": []interface{}{}},
+				},
+			}
+		})
+
+		Context("when the bosh director and jumpbox are the only vms in the network", func() {
+			It("does not return an error", func() {
+				err := client.ValidateSafeToDelete("some-env-network", "some-env-id")
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when other vms exist in the network", func() {
+			BeforeEach(func() {
+				serversClient.ListCall.Returns.Servers = append(serversClient.ListCall.Returns.Servers,
+					openstack.Server{
+						Name:      "router/0",
+						Metadata:  map[string]string{"job": "router", "deployment": "cf"},
+						Addresses: map[string]interface{}{"some-env-network": []interface{}{}},
+					},
+					openstack.Server{
+						Name:      "some-manual-vm",
+						Addresses: map[string]interface{}{"some-env-network": []interface{}{}},
+					},
+				)
+			})
+
+			It("returns a helpful error message", func() {
+				err := client.ValidateSafeToDelete("some-env-network", "some-env-id")
+				Expect(err).To(MatchError(`bbl environment is not safe to delete; vms still exist in network:
+router/0 (deployment: cf)
+some-manual-vm (not managed by bosh)`))
+			})
+		})
+
+		Context("when the servers client returns an error", func() {
+			BeforeEach(func() {
+				serversClient.ListCall.Returns.Error = errors.New("grape")
+			})
+
+			It("returns the error", func() {
+				err := client.ValidateSafeToDelete("some-env-network", "some-env-id")
+				Expect(err).To(MatchError("List servers: grape"))
+			})
+		})
+	})
+})
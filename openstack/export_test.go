@@ -0,0 +1,13 @@
+package openstack
+
+func NewClientWithInjectedNetworksClient(networksClient NetworksClient) Client {
+	return Client{
+		networksClient: networksClient,
+	}
+}
+
+func NewClientWithInjectedServersClient(serversClient ServersClient) Client {
+	return Client{
+		serversClient: serversClient,
+	}
+}
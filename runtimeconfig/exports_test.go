@@ -0,0 +1,14 @@
+package runtimeconfig
+
+import (
+	"io/ioutil"
+	"os"
+)
+
+func SetWriteFile(f func(string, []byte, os.FileMode) error) {
+	writeFile = f
+}
+
+func ResetWriteFile() {
+	writeFile = ioutil.WriteFile
+}
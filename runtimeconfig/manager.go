@@ -0,0 +1,179 @@
+package runtimeconfig
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/cloudfoundry/bosh-bootloader/bosh"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+)
+
+const (
+	Name = "bbl"
+
+	dnsRuntimeConfigPath    = "vendor/github.com/cloudfoundry/bosh-deployment/runtime-configs/dns.yml"
+	syslogRuntimeConfigPath = "vendor/github.com/cloudfoundry/bosh-deployment/runtime-configs/syslog.yml"
+
+	defaultSyslogPort      = "514"
+	defaultSyslogTransport = "udp"
+)
+
+var writeFile func(string, []byte, os.FileMode) error = ioutil.WriteFile
+
+type Manager struct {
+	logger             logger
+	command            command
+	stateStore         stateStore
+	boshClientProvider boshClientProvider
+}
+
+type logger interface {
+	Step(string, ...interface{})
+}
+
+type command interface {
+	Run(stdout io.Writer, runtimeConfigDirectory string, args []string) error
+}
+
+type boshClientProvider interface {
+	Client(jumpbox storage.Jumpbox, directorAddress, directorUsername, directorPassword, caCert string) (bosh.Client, error)
+}
+
+type stateStore interface {
+	GetRuntimeConfigDir() (string, error)
+}
+
+type op struct {
+	Type  string      `yaml:"type"`
+	Path  string      `yaml:"path"`
+	Value interface{} `yaml:"value"`
+}
+
+func NewManager(logger logger, cmd command, stateStore stateStore, boshClientProvider boshClientProvider) Manager {
+	return Manager{
+		logger:             logger,
+		command:            cmd,
+		stateStore:         stateStore,
+		boshClientProvider: boshClientProvider,
+	}
+}
+
+func (m Manager) Generate(state storage.State) (string, error) {
+	runtimeConfigDir, err := m.stateStore.GetRuntimeConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	dnsRuntimeConfig, err := bosh.Asset(dnsRuntimeConfigPath)
+	if err != nil {
+		return "", err //not tested
+	}
+
+	runtimeConfigPath := filepath.Join(runtimeConfigDir, "runtime-config.yml")
+	err = writeFile(runtimeConfigPath, dnsRuntimeConfig, os.ModePerm)
+	if err != nil {
+		return "", err
+	}
+
+	args := []string{"interpolate", runtimeConfigPath}
+
+	if !state.RuntimeConfig.Syslog.IsEmpty() {
+		syslogOps, err := syslogOps()
+		if err != nil {
+			return "", err //not tested
+		}
+
+		syslogOpsPath := filepath.Join(runtimeConfigDir, "syslog-ops.yml")
+		err = writeFile(syslogOpsPath, syslogOps, os.ModePerm)
+		if err != nil {
+			return "", err
+		}
+
+		args = append(args,
+			"-o", syslogOpsPath,
+			"-v", fmt.Sprintf("syslog_address=%s", state.RuntimeConfig.Syslog.Address),
+			"-v", fmt.Sprintf("syslog_port=%s", valueOrDefault(state.RuntimeConfig.Syslog.Port, defaultSyslogPort)),
+			"-v", fmt.Sprintf("syslog_transport=%s", valueOrDefault(state.RuntimeConfig.Syslog.Transport, defaultSyslogTransport)),
+		)
+	}
+
+	if state.RuntimeConfig.UserOpsFile != "" {
+		userOpsPath := filepath.Join(runtimeConfigDir, "user-ops.yml")
+		err = writeFile(userOpsPath, []byte(state.RuntimeConfig.UserOpsFile), os.ModePerm)
+		if err != nil {
+			return "", err
+		}
+
+		args = append(args, "-o", userOpsPath)
+	}
+
+	buf := bytes.NewBuffer([]byte{})
+	err = m.command.Run(buf, runtimeConfigDir, args)
+	if err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+func (m Manager) Update(state storage.State) error {
+	boshClient, err := m.boshClientProvider.Client(state.Jumpbox, state.BOSH.DirectorAddress, state.BOSH.DirectorUsername, state.BOSH.DirectorPassword, state.BOSH.DirectorSSLCA)
+	if err != nil {
+		return err // not tested
+	}
+
+	m.logger.Step("generating runtime config")
+	runtimeConfig, err := m.Generate(state)
+	if err != nil {
+		return err
+	}
+
+	m.logger.Step("applying runtime config")
+	err = boshClient.UpdateRuntimeConfig(Name, []byte(runtimeConfig))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// syslogOps converts the vendored syslog runtime config into an ops file so
+// that its release and addon can be layered on top of the DNS runtime config.
+func syslogOps() ([]byte, error) {
+	contents, err := bosh.Asset(syslogRuntimeConfigPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var syslogRuntimeConfig struct {
+		Releases []interface{} `yaml:"releases"`
+		Addons   []interface{} `yaml:"addons"`
+	}
+	err = yaml.Unmarshal(contents, &syslogRuntimeConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	ops := []op{}
+	for _, release := range syslogRuntimeConfig.Releases {
+		ops = append(ops, op{Type: "replace", Path: "/releases/-", Value: release})
+	}
+	for _, addon := range syslogRuntimeConfig.Addons {
+		ops = append(ops, op{Type: "replace", Path: "/addons/-", Value: addon})
+	}
+
+	return yaml.Marshal(ops)
+}
+
+func valueOrDefault(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
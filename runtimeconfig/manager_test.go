@@ -0,0 +1,233 @@
+package runtimeconfig_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/runtimeconfig"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Manager", func() {
+	var (
+		logger             *fakes.Logger
+		cmd                *fakes.BOSHCommand
+		stateStore         *fakes.StateStore
+		boshClientProvider *fakes.BOSHClientProvider
+		boshClient         *fakes.BOSHClient
+		manager            runtimeconfig.Manager
+
+		tempDir       string
+		incomingState storage.State
+	)
+
+	BeforeEach(func() {
+		logger = &fakes.Logger{}
+		cmd = &fakes.BOSHCommand{}
+		stateStore = &fakes.StateStore{}
+		boshClient = &fakes.BOSHClient{}
+		boshClientProvider = &fakes.BOSHClientProvider{}
+
+		boshClientProvider.ClientCall.Returns.Client = boshClient
+
+		var err error
+		tempDir, err = ioutil.TempDir("", "")
+		Expect(err).NotTo(HaveOccurred())
+
+		stateStore.GetRuntimeConfigDirCall.Returns.Directory = tempDir
+
+		cmd.RunStub = func(stdout io.Writer, workingDirectory string, args []string) error {
+			stdout.Write([]byte("some-runtime-config"))
+			return nil
+		}
+
+		incomingState = storage.State{
+			IAAS: "gcp",
+			BOSH: storage.BOSH{
+				DirectorAddress:  "some-director-address",
+				DirectorUsername: "some-director-username",
+				DirectorPassword: "some-director-password",
+			},
+		}
+
+		manager = runtimeconfig.NewManager(logger, cmd, stateStore, boshClientProvider)
+	})
+
+	Describe("Generate", func() {
+		It("returns the dns runtime config", func() {
+			runtimeConfigYAML, err := manager.Generate(incomingState)
+			Expect(err).NotTo(HaveOccurred())
+
+			runtimeConfig, err := ioutil.ReadFile(fmt.Sprintf("%s/runtime-config.yml", tempDir))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(runtimeConfig)).To(ContainSubstring("name: bosh-dns"))
+
+			Expect(cmd.RunCallCount()).To(Equal(1))
+			_, workingDirectory, args := cmd.RunArgsForCall(0)
+			Expect(workingDirectory).To(Equal(tempDir))
+			Expect(args).To(Equal([]string{
+				"interpolate", fmt.Sprintf("%s/runtime-config.yml", tempDir),
+			}))
+
+			Expect(runtimeConfigYAML).To(Equal("some-runtime-config"))
+		})
+
+		Context("when syslog forwarding is configured", func() {
+			BeforeEach(func() {
+				incomingState.RuntimeConfig.Syslog = storage.Syslog{
+					Address: "some-syslog-address",
+				}
+			})
+
+			It("adds the syslog release and addon with default port and transport", func() {
+				_, err := manager.Generate(incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				syslogOps, err := ioutil.ReadFile(fmt.Sprintf("%s/syslog-ops.yml", tempDir))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(syslogOps)).To(ContainSubstring("path: /releases/-"))
+				Expect(string(syslogOps)).To(ContainSubstring("path: /addons/-"))
+				Expect(string(syslogOps)).To(ContainSubstring("name: syslog_forwarder"))
+
+				_, _, args := cmd.RunArgsForCall(0)
+				Expect(args).To(Equal([]string{
+					"interpolate", fmt.Sprintf("%s/runtime-config.yml", tempDir),
+					"-o", fmt.Sprintf("%s/syslog-ops.yml", tempDir),
+					"-v", "syslog_address=some-syslog-address",
+					"-v", "syslog_port=514",
+					"-v", "syslog_transport=udp",
+				}))
+			})
+
+			It("uses the configured port and transport", func() {
+				incomingState.RuntimeConfig.Syslog.Port = "6514"
+				incomingState.RuntimeConfig.Syslog.Transport = "tcp"
+
+				_, err := manager.Generate(incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, _, args := cmd.RunArgsForCall(0)
+				Expect(args).To(ContainElement("syslog_port=6514"))
+				Expect(args).To(ContainElement("syslog_transport=tcp"))
+			})
+		})
+
+		Context("when the user provided an ops file", func() {
+			BeforeEach(func() {
+				incomingState.RuntimeConfig.UserOpsFile = "some-user-ops"
+			})
+
+			It("applies the user ops file last", func() {
+				_, err := manager.Generate(incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				userOps, err := ioutil.ReadFile(fmt.Sprintf("%s/user-ops.yml", tempDir))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(userOps)).To(Equal("some-user-ops"))
+
+				_, _, args := cmd.RunArgsForCall(0)
+				Expect(args).To(Equal([]string{
+					"interpolate", fmt.Sprintf("%s/runtime-config.yml", tempDir),
+					"-o", fmt.Sprintf("%s/user-ops.yml", tempDir),
+				}))
+			})
+		})
+
+		Context("failure cases", func() {
+			Context("when getting runtime config dir fails", func() {
+				BeforeEach(func() {
+					stateStore.GetRuntimeConfigDirCall.Returns.Error = errors.New("failed to create dir")
+				})
+
+				It("returns an error", func() {
+					_, err := manager.Generate(storage.State{})
+					Expect(err).To(MatchError("failed to create dir"))
+				})
+			})
+
+			DescribeTable("when write file fails", func(filename string, state storage.State) {
+				runtimeconfig.SetWriteFile(func(path string, body []byte, mode os.FileMode) error {
+					if strings.HasSuffix(path, filename) {
+						return errors.New("failed to write file")
+					}
+					return nil
+				})
+				defer runtimeconfig.ResetWriteFile()
+
+				_, err := manager.Generate(state)
+				Expect(err).To(MatchError("failed to write file"))
+			},
+				Entry("runtime-config.yml", "runtime-config.yml", storage.State{}),
+				Entry("syslog-ops.yml", "syslog-ops.yml", storage.State{RuntimeConfig: storage.RuntimeConfig{Syslog: storage.Syslog{Address: "some-address"}}}),
+				Entry("user-ops.yml", "user-ops.yml", storage.State{RuntimeConfig: storage.RuntimeConfig{UserOpsFile: "some-ops"}}),
+			)
+
+			Context("when command fails to run", func() {
+				BeforeEach(func() {
+					cmd.RunReturns(errors.New("failed to run"))
+				})
+
+				It("returns an error", func() {
+					_, err := manager.Generate(storage.State{})
+					Expect(err).To(MatchError("failed to run"))
+				})
+			})
+		})
+	})
+
+	Describe("Update", func() {
+		It("logs steps taken", func() {
+			err := manager.Update(incomingState)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(logger.StepCall.Messages).To(Equal([]string{
+				"generating runtime config",
+				"applying runtime config",
+			}))
+		})
+
+		It("updates the bosh director with a named runtime config", func() {
+			err := manager.Update(incomingState)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(boshClientProvider.ClientCall.Receives.DirectorAddress).To(Equal("some-director-address"))
+			Expect(boshClientProvider.ClientCall.Receives.DirectorUsername).To(Equal("some-director-username"))
+			Expect(boshClientProvider.ClientCall.Receives.DirectorPassword).To(Equal("some-director-password"))
+
+			Expect(boshClient.UpdateRuntimeConfigCall.Receives.Name).To(Equal("bbl"))
+			Expect(boshClient.UpdateRuntimeConfigCall.Receives.Yaml).To(Equal([]byte("some-runtime-config")))
+		})
+
+		Context("failure cases", func() {
+			Context("when generate fails", func() {
+				BeforeEach(func() {
+					cmd.RunReturns(errors.New("failed to run"))
+				})
+
+				It("returns an error", func() {
+					err := manager.Update(storage.State{})
+					Expect(err).To(MatchError("failed to run"))
+				})
+			})
+
+			Context("when bosh client fails to update runtime config", func() {
+				BeforeEach(func() {
+					boshClient.UpdateRuntimeConfigCall.Returns.Error = errors.New("failed to update")
+				})
+
+				It("returns an error", func() {
+					err := manager.Update(storage.State{})
+					Expect(err).To(MatchError("failed to update"))
+				})
+			})
+		})
+	})
+})
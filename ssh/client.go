@@ -0,0 +1,270 @@
+package ssh
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	gossh "golang.org/x/crypto/ssh"
+)
+
+// Hop is one host on the way to the target. The first hop is dialed
+// directly and every later hop is dialed through the one before it.
+// HostKey is the key pinned in state, in authorized_keys format. When it
+// is empty the key the host presents is accepted and returned by Run.
+type Hop struct {
+	Address    string
+	User       string
+	PrivateKey string
+	HostKey    string
+}
+
+// dialTimeout bounds connecting to a hop, so an unreachable jumpbox fails
+// fast instead of waiting for the OS TCP timeout.
+var dialTimeout = 10 * time.Second
+
+type terminal interface {
+	IsTerminal() bool
+	MakeRaw() (func() error, error)
+	Size() (int, int, error)
+}
+
+type Client struct {
+	stdin    io.Reader
+	stdout   io.Writer
+	stderr   io.Writer
+	terminal terminal
+}
+
+func NewClient(stdin *os.File, stdout, stderr io.Writer) Client {
+	return Client{
+		stdin:    stdin,
+		stdout:   stdout,
+		stderr:   stderr,
+		terminal: newTerminal(int(stdin.Fd())),
+	}
+}
+
+// Run connects to the last hop and runs command on it. An empty command
+// opens an interactive shell. The host keys presented by each hop are
+// returned even when the command itself fails.
+func (c Client) Run(hops []Hop, command string) ([]string, error) {
+	client, hostKeys, closeAll, err := dialHops(hops)
+	if err != nil {
+		return hostKeys, err
+	}
+	defer closeAll()
+
+	session, err := client.NewSession()
+	if err != nil {
+		return hostKeys, fmt.Errorf("new session: %s", err)
+	}
+	defer session.Close()
+
+	session.Stdin = c.stdin
+	session.Stdout = c.stdout
+	session.Stderr = c.stderr
+
+	if command != "" {
+		return hostKeys, session.Run(command)
+	}
+
+	return hostKeys, c.shell(session)
+}
+
+// Ping connects to the last hop and disconnects again, checking that
+// every host is reachable and presents its pinned host key.
+func (c Client) Ping(hops []Hop) error {
+	_, _, closeAll, err := dialHops(hops)
+	if err != nil {
+		return err
+	}
+	closeAll()
+
+	return nil
+}
+
+func dialHops(hops []Hop) (*gossh.Client, []string, func(), error) {
+	if len(hops) == 0 {
+		return nil, nil, nil, errors.New("no hosts to connect to")
+	}
+
+	hostKeys := make([]string, len(hops))
+	clients := []*gossh.Client{}
+	closeAll := func() {
+		for i := len(clients) - 1; i >= 0; i-- {
+			clients[i].Close()
+		}
+	}
+
+	var client *gossh.Client
+	for i, hop := range hops {
+		config, err := clientConfig(hop, &hostKeys[i])
+		if err != nil {
+			closeAll()
+			return nil, hostKeys, nil, err
+		}
+
+		var conn net.Conn
+		if client == nil {
+			conn, err = net.DialTimeout("tcp", hop.Address, config.Timeout)
+			if err != nil {
+				return nil, hostKeys, nil, fmt.Errorf("ssh dial %s: %s", hop.Address, err)
+			}
+		} else {
+			conn, err = dialThrough(client, hop.Address)
+			if err != nil {
+				closeAll()
+				return nil, hostKeys, nil, fmt.Errorf("dial %s through %s: %s", hop.Address, hops[i-1].Address, err)
+			}
+		}
+
+		client, err = handshake(conn, hop.Address, config)
+		if err != nil {
+			closeAll()
+			return nil, hostKeys, nil, fmt.Errorf("ssh dial %s: %s", hop.Address, err)
+		}
+		clients = append(clients, client)
+	}
+
+	return client, hostKeys, closeAll, nil
+}
+
+// dialThrough opens a connection to address from the host client is
+// connected to. The remote host may take minutes to give up on an
+// unreachable address, so it is abandoned after dialTimeout.
+func dialThrough(client *gossh.Client, address string) (net.Conn, error) {
+	type result struct {
+		conn net.Conn
+		err  error
+	}
+
+	results := make(chan result, 1)
+	go func() {
+		conn, err := client.Dial("tcp", address)
+		results <- result{conn, err}
+	}()
+
+	select {
+	case r := <-results:
+		return r.conn, r.err
+	case <-time.After(dialTimeout):
+		go func() {
+			if r := <-results; r.conn != nil {
+				r.conn.Close()
+			}
+		}()
+		return nil, fmt.Errorf("timed out after %s", dialTimeout)
+	}
+}
+
+// handshake runs the ssh handshake on conn and gives up after the dial
+// timeout. Connections through a jumpbox do not support deadlines, so the
+// timeout closes conn instead.
+func handshake(conn net.Conn, address string, config *gossh.ClientConfig) (*gossh.Client, error) {
+	timer := time.AfterFunc(config.Timeout, func() { conn.Close() })
+
+	sshConn, chans, reqs, err := gossh.NewClientConn(conn, address, config)
+	if !timer.Stop() {
+		if err == nil {
+			sshConn.Close()
+		}
+		return nil, fmt.Errorf("handshake timed out after %s", config.Timeout)
+	}
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return gossh.NewClient(sshConn, chans, reqs), nil
+}
+
+func (c Client) shell(session *gossh.Session) error {
+	if c.terminal.IsTerminal() {
+		width, height, err := c.terminal.Size()
+		if err != nil {
+			width, height = 80, 24
+		}
+
+		term := os.Getenv("TERM")
+		if term == "" {
+			term = "xterm"
+		}
+
+		modes := gossh.TerminalModes{
+			gossh.ECHO:          1,
+			gossh.TTY_OP_ISPEED: 14400,
+			gossh.TTY_OP_OSPEED: 14400,
+		}
+
+		err = session.RequestPty(term, height, width, modes)
+		if err != nil {
+			return fmt.Errorf("request pty: %s", err)
+		}
+
+		restore, err := c.terminal.MakeRaw()
+		if err != nil {
+			return fmt.Errorf("make terminal raw: %s", err)
+		}
+		defer restore()
+	}
+
+	err := session.Shell()
+	if err != nil {
+		return fmt.Errorf("start shell: %s", err)
+	}
+
+	return session.Wait()
+}
+
+func clientConfig(hop Hop, hostKey *string) (*gossh.ClientConfig, error) {
+	signer, err := gossh.ParsePrivateKey([]byte(hop.PrivateKey))
+	if err != nil {
+		return nil, fmt.Errorf("parse private key for %s: %s", hop.Address, err)
+	}
+
+	callback, err := hostKeyCallback(hop.HostKey, hostKey)
+	if err != nil {
+		return nil, fmt.Errorf("parse host key for %s: %s", hop.Address, err)
+	}
+
+	return &gossh.ClientConfig{
+		User: hop.User,
+		Auth: []gossh.AuthMethod{
+			gossh.PublicKeys(signer),
+		},
+		HostKeyCallback: callback,
+		Timeout:         dialTimeout,
+	}, nil
+}
+
+func hostKeyCallback(pinned string, seen *string) (gossh.HostKeyCallback, error) {
+	if pinned == "" {
+		return func(hostname string, remote net.Addr, key gossh.PublicKey) error {
+			*seen = marshalHostKey(key)
+			return nil
+		}, nil
+	}
+
+	pinnedKey, _, _, _, err := gossh.ParseAuthorizedKey([]byte(pinned))
+	if err != nil {
+		return nil, err
+	}
+
+	fixed := gossh.FixedHostKey(pinnedKey)
+	return func(hostname string, remote net.Addr, key gossh.PublicKey) error {
+		if err := fixed(hostname, remote, key); err != nil {
+			return fmt.Errorf("host key for %s does not match the key pinned in the bbl state", hostname)
+		}
+		*seen = marshalHostKey(key)
+		return nil
+	}, nil
+}
+
+func marshalHostKey(key gossh.PublicKey) string {
+	return strings.TrimSpace(string(gossh.MarshalAuthorizedKey(key)))
+}
@@ -0,0 +1,362 @@
+package ssh_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudfoundry/bosh-bootloader/ssh"
+	gossh "golang.org/x/crypto/ssh"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Client", func() {
+	var (
+		client     ssh.Client
+		stdin      *os.File
+		stdout     *bytes.Buffer
+		stderr     *bytes.Buffer
+		privateKey string
+		jumpbox    *testServer
+		director   *testServer
+	)
+
+	BeforeEach(func() {
+		var err error
+		stdin, err = ioutil.TempFile("", "stdin")
+		Expect(err).NotTo(HaveOccurred())
+
+		stdout = bytes.NewBuffer([]byte{})
+		stderr = bytes.NewBuffer([]byte{})
+		client = ssh.NewClient(stdin, stdout, stderr)
+
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+		privateKey = string(pem.EncodeToMemory(&pem.Block{
+			Type:  "RSA PRIVATE KEY",
+			Bytes: x509.MarshalPKCS1PrivateKey(key),
+		}))
+
+		jumpbox = newTestServer("jumpbox")
+		director = newTestServer("director")
+	})
+
+	AfterEach(func() {
+		jumpbox.Close()
+		director.Close()
+		os.Remove(stdin.Name())
+	})
+
+	Describe("Run", func() {
+		It("runs the command on the host and returns its host key", func() {
+			hostKeys, err := client.Run([]ssh.Hop{
+				{Address: jumpbox.Addr(), User: "jumpbox", PrivateKey: privateKey},
+			}, "whoami")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(stdout.String()).To(Equal("jumpbox ran whoami as jumpbox\n"))
+			Expect(hostKeys).To(Equal([]string{jumpbox.HostKey()}))
+		})
+
+		It("runs the command on the last hop through the ones before it", func() {
+			hostKeys, err := client.Run([]ssh.Hop{
+				{Address: jumpbox.Addr(), User: "jumpbox", PrivateKey: privateKey},
+				{Address: director.Addr(), User: "jumpbox", PrivateKey: privateKey, HostKey: director.HostKey()},
+			}, "whoami")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(stdout.String()).To(Equal("director ran whoami as jumpbox\n"))
+			Expect(hostKeys).To(Equal([]string{jumpbox.HostKey(), director.HostKey()}))
+		})
+
+		It("returns the host keys when the command fails", func() {
+			hostKeys, err := client.Run([]ssh.Hop{
+				{Address: jumpbox.Addr(), User: "jumpbox", PrivateKey: privateKey},
+			}, "exit")
+			Expect(err).To(BeAssignableToTypeOf(&gossh.ExitError{}))
+			Expect(hostKeys).To(Equal([]string{jumpbox.HostKey()}))
+		})
+
+		Context("failure cases", func() {
+			It("returns an error when no hops are given", func() {
+				_, err := client.Run([]ssh.Hop{}, "whoami")
+				Expect(err).To(MatchError("no hosts to connect to"))
+			})
+
+			It("returns an error when the host key does not match the pinned key", func() {
+				_, err := client.Run([]ssh.Hop{
+					{Address: jumpbox.Addr(), User: "jumpbox", PrivateKey: privateKey, HostKey: director.HostKey()},
+				}, "whoami")
+				Expect(err).To(MatchError(ContainSubstring(fmt.Sprintf("host key for %s does not match the key pinned in the bbl state", jumpbox.Addr()))))
+				Expect(stdout.String()).To(BeEmpty())
+			})
+
+			It("returns an error when the private key cannot be parsed", func() {
+				_, err := client.Run([]ssh.Hop{
+					{Address: jumpbox.Addr(), User: "jumpbox", PrivateKey: "%%%"},
+				}, "whoami")
+				Expect(err).To(MatchError(ContainSubstring(fmt.Sprintf("parse private key for %s", jumpbox.Addr()))))
+			})
+
+			It("returns an error when the pinned host key cannot be parsed", func() {
+				_, err := client.Run([]ssh.Hop{
+					{Address: jumpbox.Addr(), User: "jumpbox", PrivateKey: privateKey, HostKey: "%%%"},
+				}, "whoami")
+				Expect(err).To(MatchError(ContainSubstring(fmt.Sprintf("parse host key for %s", jumpbox.Addr()))))
+			})
+		})
+	})
+})
+
+var _ = Describe("Ping", func() {
+	It("connects to every hop", func() {
+		jumpbox := newTestServer("jumpbox")
+		defer jumpbox.Close()
+
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+		privateKey := string(pem.EncodeToMemory(&pem.Block{
+			Type:  "RSA PRIVATE KEY",
+			Bytes: x509.MarshalPKCS1PrivateKey(key),
+		}))
+
+		client := ssh.NewClient(os.Stdin, ioutil.Discard, ioutil.Discard)
+
+		err = client.Ping([]ssh.Hop{{Address: jumpbox.Addr(), User: "jumpbox", PrivateKey: privateKey, HostKey: jumpbox.HostKey()}})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = client.Ping([]ssh.Hop{{Address: jumpbox.Addr(), User: "jumpbox", PrivateKey: privateKey, HostKey: "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIEbnC+Bn3Fw0Y9fJpr9J8Zj8pZ0rF3E1gLYu1Q9eQGxk"}})
+		Expect(err).To(MatchError(ContainSubstring("does not match the key pinned in the bbl state")))
+	})
+
+	Context("when a hop accepts connections but never answers", func() {
+		var (
+			jumpbox    *testServer
+			silent     net.Listener
+			privateKey string
+		)
+
+		BeforeEach(func() {
+			ssh.SetDialTimeout(500 * time.Millisecond)
+
+			jumpbox = newTestServer("jumpbox")
+
+			var err error
+			silent, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			go func() {
+				var conns []net.Conn
+				for {
+					conn, err := silent.Accept()
+					if err != nil {
+						for _, conn := range conns {
+							conn.Close()
+						}
+						return
+					}
+					conns = append(conns, conn)
+				}
+			}()
+
+			key, err := rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).NotTo(HaveOccurred())
+			privateKey = string(pem.EncodeToMemory(&pem.Block{
+				Type:  "RSA PRIVATE KEY",
+				Bytes: x509.MarshalPKCS1PrivateKey(key),
+			}))
+		})
+
+		AfterEach(func() {
+			ssh.SetDialTimeout(10 * time.Second)
+			jumpbox.Close()
+			silent.Close()
+		})
+
+		It("times out on the first hop", func() {
+			client := ssh.NewClient(os.Stdin, ioutil.Discard, ioutil.Discard)
+
+			err := client.Ping([]ssh.Hop{{Address: silent.Addr().String(), User: "jumpbox", PrivateKey: privateKey}})
+			Expect(err).To(MatchError(ContainSubstring("handshake timed out after 500ms")))
+		})
+
+		It("times out on a hop behind the jumpbox", func() {
+			client := ssh.NewClient(os.Stdin, ioutil.Discard, ioutil.Discard)
+
+			err := client.Ping([]ssh.Hop{
+				{Address: jumpbox.Addr(), User: "jumpbox", PrivateKey: privateKey},
+				{Address: silent.Addr().String(), User: "jumpbox", PrivateKey: privateKey},
+			})
+			Expect(err).To(MatchError(ContainSubstring("handshake timed out after 500ms")))
+		})
+	})
+})
+
+type testServer struct {
+	name     string
+	listener net.Listener
+	config   *gossh.ServerConfig
+	hostKey  gossh.PublicKey
+
+	mutex sync.Mutex
+	conns []net.Conn
+}
+
+func newTestServer(name string) *testServer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	Expect(err).NotTo(HaveOccurred())
+
+	signer, err := gossh.NewSignerFromKey(key)
+	Expect(err).NotTo(HaveOccurred())
+
+	config := &gossh.ServerConfig{
+		PublicKeyCallback: func(conn gossh.ConnMetadata, key gossh.PublicKey) (*gossh.Permissions, error) {
+			return nil, nil
+		},
+	}
+	config.AddHostKey(signer)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).NotTo(HaveOccurred())
+
+	s := &testServer{
+		name:     name,
+		listener: listener,
+		config:   config,
+		hostKey:  signer.PublicKey(),
+	}
+	go s.serve()
+
+	return s
+}
+
+func (s *testServer) Addr() string {
+	return s.listener.Addr().String()
+}
+
+func (s *testServer) HostKey() string {
+	return strings.TrimSpace(string(gossh.MarshalAuthorizedKey(s.hostKey)))
+}
+
+func (s *testServer) Close() {
+	s.listener.Close()
+	s.DropConnections()
+}
+
+func (s *testServer) DropConnections() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, conn := range s.conns {
+		conn.Close()
+	}
+	s.conns = nil
+}
+
+func (s *testServer) serve() {
+	defer GinkgoRecover()
+
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		s.mutex.Lock()
+		s.conns = append(s.conns, conn)
+		s.mutex.Unlock()
+
+		go s.handle(conn)
+	}
+}
+
+func (s *testServer) handle(conn net.Conn) {
+	sshConn, chans, reqs, err := gossh.NewServerConn(conn, s.config)
+	if err != nil {
+		return
+	}
+	defer sshConn.Close()
+	go gossh.DiscardRequests(reqs)
+
+	for newChannel := range chans {
+		switch newChannel.ChannelType() {
+		case "session":
+			go s.session(sshConn.User(), newChannel)
+		case "direct-tcpip":
+			go s.forward(newChannel)
+		default:
+			newChannel.Reject(gossh.UnknownChannelType, "unknown channel type")
+		}
+	}
+}
+
+func (s *testServer) session(user string, newChannel gossh.NewChannel) {
+	channel, requests, err := newChannel.Accept()
+	if err != nil {
+		return
+	}
+	defer channel.Close()
+
+	for req := range requests {
+		if req.Type != "exec" {
+			req.Reply(false, nil)
+			continue
+		}
+
+		var payload struct{ Command string }
+		gossh.Unmarshal(req.Payload, &payload)
+		req.Reply(true, nil)
+
+		status := uint32(0)
+		if payload.Command == "exit" {
+			status = 1
+		} else {
+			fmt.Fprintf(channel, "%s ran %s as %s\n", s.name, payload.Command, user)
+		}
+
+		channel.SendRequest("exit-status", false, gossh.Marshal(struct{ Status uint32 }{status}))
+		return
+	}
+}
+
+func (s *testServer) forward(newChannel gossh.NewChannel) {
+	var payload struct {
+		Host       string
+		Port       uint32
+		OriginHost string
+		OriginPort uint32
+	}
+	gossh.Unmarshal(newChannel.ExtraData(), &payload)
+
+	target, err := net.Dial("tcp", net.JoinHostPort(payload.Host, strconv.Itoa(int(payload.Port))))
+	if err != nil {
+		newChannel.Reject(gossh.ConnectionFailed, err.Error())
+		return
+	}
+
+	channel, requests, err := newChannel.Accept()
+	if err != nil {
+		target.Close()
+		return
+	}
+	go gossh.DiscardRequests(requests)
+
+	go func() {
+		io.Copy(target, channel)
+		target.Close()
+	}()
+	io.Copy(channel, target)
+	channel.Close()
+}
@@ -0,0 +1,11 @@
+package ssh
+
+import "time"
+
+func SetTunnelRetryInterval(t *Tunnel, interval time.Duration) {
+	t.retryInterval = interval
+}
+
+func SetDialTimeout(timeout time.Duration) {
+	dialTimeout = timeout
+}
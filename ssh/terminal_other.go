@@ -0,0 +1,24 @@
+//go:build !linux && !darwin
+// +build !linux,!darwin
+
+package ssh
+
+import "errors"
+
+// Interactive shells still work on other platforms, they just run
+// without a pty.
+type noTerminal struct{}
+
+func newTerminal(fd int) terminal {
+	return noTerminal{}
+}
+
+func (noTerminal) IsTerminal() bool { return false }
+
+func (noTerminal) MakeRaw() (func() error, error) {
+	return nil, errors.New("raw terminal mode is not supported on this platform")
+}
+
+func (noTerminal) Size() (int, int, error) {
+	return 0, 0, errors.New("terminal size is not supported on this platform")
+}
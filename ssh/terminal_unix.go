@@ -0,0 +1,54 @@
+//go:build linux || darwin
+// +build linux darwin
+
+package ssh
+
+import "golang.org/x/sys/unix"
+
+type unixTerminal struct {
+	fd int
+}
+
+func newTerminal(fd int) terminal {
+	return unixTerminal{fd: fd}
+}
+
+func (t unixTerminal) IsTerminal() bool {
+	_, err := unix.IoctlGetTermios(t.fd, ioctlReadTermios)
+	return err == nil
+}
+
+func (t unixTerminal) MakeRaw() (func() error, error) {
+	termios, err := unix.IoctlGetTermios(t.fd, ioctlReadTermios)
+	if err != nil {
+		return nil, err
+	}
+
+	original := *termios
+
+	termios.Iflag &^= unix.IGNBRK | unix.BRKINT | unix.PARMRK | unix.ISTRIP | unix.INLCR | unix.IGNCR | unix.ICRNL | unix.IXON
+	termios.Oflag &^= unix.OPOST
+	termios.Lflag &^= unix.ECHO | unix.ECHONL | unix.ICANON | unix.ISIG | unix.IEXTEN
+	termios.Cflag &^= unix.CSIZE | unix.PARENB
+	termios.Cflag |= unix.CS8
+	termios.Cc[unix.VMIN] = 1
+	termios.Cc[unix.VTIME] = 0
+
+	err = unix.IoctlSetTermios(t.fd, ioctlWriteTermios, termios)
+	if err != nil {
+		return nil, err
+	}
+
+	return func() error {
+		return unix.IoctlSetTermios(t.fd, ioctlWriteTermios, &original)
+	}, nil
+}
+
+func (t unixTerminal) Size() (int, int, error) {
+	winsize, err := unix.IoctlGetWinsize(t.fd, unix.TIOCGWINSZ)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return int(winsize.Col), int(winsize.Row), nil
+}
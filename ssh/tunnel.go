@@ -0,0 +1,150 @@
+package ssh
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"sync"
+	"time"
+
+	socks5 "github.com/armon/go-socks5"
+	gossh "golang.org/x/crypto/ssh"
+	"golang.org/x/net/context"
+)
+
+// Tunnel is a SOCKS5 proxy that sends every connection through an ssh
+// connection to the jumpbox, and reconnects when that connection drops.
+type Tunnel struct {
+	stderr            io.Writer
+	keepAliveInterval time.Duration
+	retryInterval     time.Duration
+
+	mutex  sync.Mutex
+	hop    Hop
+	client *gossh.Client
+}
+
+func NewTunnel(stderr io.Writer) *Tunnel {
+	return &Tunnel{
+		stderr:            stderr,
+		keepAliveInterval: 30 * time.Second,
+		retryInterval:     5 * time.Second,
+	}
+}
+
+// Connect opens the ssh connection to the jumpbox and returns the host key
+// it presented. Reconnects only accept that same key.
+func (t *Tunnel) Connect(jumpbox Hop) (string, error) {
+	t.mutex.Lock()
+	t.hop = jumpbox
+	t.mutex.Unlock()
+
+	err := t.connect()
+	if err != nil {
+		return "", err
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.hop.HostKey, nil
+}
+
+func (t *Tunnel) Serve(listener net.Listener) error {
+	server, err := socks5.New(&socks5.Config{
+		Dial:   t.dial,
+		Logger: log.New(t.stderr, "", log.LstdFlags),
+	})
+	if err != nil {
+		return fmt.Errorf("new socks5 server: %s", err) // not tested
+	}
+
+	return server.Serve(listener)
+}
+
+func (t *Tunnel) connect() error {
+	t.mutex.Lock()
+	hop := t.hop
+	t.mutex.Unlock()
+
+	var hostKey string
+	config, err := clientConfig(hop, &hostKey)
+	if err != nil {
+		return err
+	}
+
+	client, err := gossh.Dial("tcp", hop.Address, config)
+	if err != nil {
+		return fmt.Errorf("ssh dial %s: %s", hop.Address, err)
+	}
+
+	t.mutex.Lock()
+	t.hop.HostKey = hostKey
+	t.client = client
+	t.mutex.Unlock()
+
+	go t.keepAlive(client)
+	go t.reconnectOnDrop(client)
+
+	return nil
+}
+
+func (t *Tunnel) dial(ctx context.Context, network, addr string) (net.Conn, error) {
+	t.mutex.Lock()
+	client := t.client
+	t.mutex.Unlock()
+
+	if client == nil {
+		return nil, errors.New("not connected to the jumpbox")
+	}
+
+	return client.Dial(network, addr)
+}
+
+func (t *Tunnel) keepAlive(client *gossh.Client) {
+	ticker := time.NewTicker(t.keepAliveInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		replied := make(chan error, 1)
+		go func() {
+			_, _, err := client.SendRequest("keepalive@openssh.com", true, nil)
+			replied <- err
+		}()
+
+		select {
+		case err := <-replied:
+			if err != nil {
+				client.Close()
+				return
+			}
+		case <-time.After(t.keepAliveInterval):
+			client.Close()
+			return
+		}
+	}
+}
+
+func (t *Tunnel) reconnectOnDrop(client *gossh.Client) {
+	client.Wait()
+
+	t.mutex.Lock()
+	if t.client == client {
+		t.client = nil
+	}
+	address := t.hop.Address
+	t.mutex.Unlock()
+
+	fmt.Fprintf(t.stderr, "lost connection to %s, reconnecting\n", address)
+	for {
+		err := t.connect()
+		if err == nil {
+			fmt.Fprintf(t.stderr, "reconnected to %s\n", address)
+			return
+		}
+
+		fmt.Fprintf(t.stderr, "reconnect: %s\n", err)
+		time.Sleep(t.retryInterval)
+	}
+}
@@ -0,0 +1,125 @@
+package ssh_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/cloudfoundry/bosh-bootloader/ssh"
+	"github.com/onsi/gomega/gbytes"
+	"golang.org/x/net/proxy"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Tunnel", func() {
+	var (
+		tunnel     *ssh.Tunnel
+		stderr     *gbytes.Buffer
+		privateKey string
+		jumpbox    *testServer
+		echo       net.Listener
+	)
+
+	BeforeEach(func() {
+		stderr = gbytes.NewBuffer()
+		tunnel = ssh.NewTunnel(stderr)
+		ssh.SetTunnelRetryInterval(tunnel, 10*time.Millisecond)
+
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+		privateKey = string(pem.EncodeToMemory(&pem.Block{
+			Type:  "RSA PRIVATE KEY",
+			Bytes: x509.MarshalPKCS1PrivateKey(key),
+		}))
+
+		jumpbox = newTestServer("jumpbox")
+
+		echo, err = net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		go func() {
+			for {
+				conn, err := echo.Accept()
+				if err != nil {
+					return
+				}
+				go io.Copy(conn, conn)
+			}
+		}()
+	})
+
+	AfterEach(func() {
+		jumpbox.Close()
+		echo.Close()
+	})
+
+	roundTrip := func(proxyAddr string) error {
+		dialer, err := proxy.SOCKS5("tcp", proxyAddr, nil, proxy.Direct)
+		if err != nil {
+			return err
+		}
+
+		conn, err := dialer.Dial("tcp", echo.Addr().String())
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		_, err = conn.Write([]byte("ping"))
+		if err != nil {
+			return err
+		}
+
+		reply := make([]byte, 4)
+		_, err = io.ReadFull(conn, reply)
+		if err != nil {
+			return err
+		}
+
+		if string(reply) != "ping" {
+			return fmt.Errorf("unexpected reply %q", reply)
+		}
+		return nil
+	}
+
+	It("proxies connections through the jumpbox", func() {
+		hostKey, err := tunnel.Connect(ssh.Hop{Address: jumpbox.Addr(), User: "jumpbox", PrivateKey: privateKey})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hostKey).To(Equal(jumpbox.HostKey()))
+
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		defer listener.Close()
+		go tunnel.Serve(listener)
+
+		Expect(roundTrip(listener.Addr().String())).To(Succeed())
+	})
+
+	It("reconnects when the connection to the jumpbox drops", func() {
+		_, err := tunnel.Connect(ssh.Hop{Address: jumpbox.Addr(), User: "jumpbox", PrivateKey: privateKey})
+		Expect(err).NotTo(HaveOccurred())
+
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		defer listener.Close()
+		go tunnel.Serve(listener)
+
+		jumpbox.DropConnections()
+
+		Eventually(func() error {
+			return roundTrip(listener.Addr().String())
+		}, "5s").Should(Succeed())
+		Eventually(stderr).Should(gbytes.Say("reconnected to %s", jumpbox.Addr()))
+	})
+
+	It("returns an error when the jumpbox host key does not match the pinned key", func() {
+		_, err := tunnel.Connect(ssh.Hop{Address: jumpbox.Addr(), User: "jumpbox", PrivateKey: privateKey, HostKey: "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIEbnC+Bn3Fw0Y9fJpr9J8Zj8pZ0rF3E1gLYu1Q9eQGxk"})
+		Expect(err).To(MatchError(ContainSubstring("does not match the key pinned in the bbl state")))
+	})
+})
@@ -1,7 +1,9 @@
 package storage
 
 type AWS struct {
-	AccessKeyID     string `json:"accessKeyId,omitempty"`
-	SecretAccessKey string `json:"secretAccessKey,omitempty"`
-	Region          string `json:"region"`
+	AccessKeyID     string   `json:"accessKeyId,omitempty"`
+	SecretAccessKey string   `json:"secretAccessKey,omitempty"`
+	Region          string   `json:"region"`
+	NATGateway      bool     `json:"natGateway,omitempty"`
+	AZs             []string `json:"azs,omitempty"`
 }
@@ -14,6 +14,7 @@ type BOSH struct {
 	State                  map[string]interface{} `json:"state"`
 	Manifest               string                 `json:"manifest"`
 	UserOpsFile            string                 `json:"userOpsFile"`
+	HostKey                string                 `json:"hostKey,omitempty"`
 }
 
 func (b BOSH) IsEmpty() bool {
@@ -7,6 +7,7 @@ type Jumpbox struct {
 	Variables string                 `json:"variables"`
 	Manifest  string                 `json:"manifest"`
 	State     map[string]interface{} `json:"state"`
+	HostKey   string                 `json:"hostKey,omitempty"`
 }
 
 func (j Jumpbox) IsEmpty() bool {
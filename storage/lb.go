@@ -6,4 +6,7 @@ type LB struct {
 	Key    string `json:"key"`
 	Chain  string `json:"chain"`
 	Domain string `json:"domain,omitempty"`
+
+	Flavor            string `json:"flavor,omitempty"`
+	ACMCertificateARN string `json:"acmCertificateArn,omitempty"`
 }
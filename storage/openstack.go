@@ -0,0 +1,12 @@
+package storage
+
+type OpenStack struct {
+	AuthURL             string `json:"authURL"`
+	AZ                  string `json:"az"`
+	ExternalNetworkName string `json:"externalNetworkName"`
+	Project             string `json:"project"`
+	Domain              string `json:"domain"`
+	Region              string `json:"region"`
+	Username            string `json:"username,omitempty"`
+	Password            string `json:"password,omitempty"`
+}
@@ -0,0 +1,16 @@
+package storage
+
+type RuntimeConfig struct {
+	Syslog      Syslog `json:"syslog,omitempty"`
+	UserOpsFile string `json:"userOpsFile,omitempty"`
+}
+
+type Syslog struct {
+	Address   string `json:"address,omitempty"`
+	Port      string `json:"port,omitempty"`
+	Transport string `json:"transport,omitempty"`
+}
+
+func (s Syslog) IsEmpty() bool {
+	return s.Address == ""
+}
@@ -90,6 +90,10 @@ func (s Store) Set(state State) error {
 	state.AWS.SecretAccessKey = ""
 	state.GCP.ServiceAccountKey = ""
 	state.GCP.ProjectID = ""
+	state.OpenStack.Username = ""
+	state.OpenStack.Password = ""
+	state.VSphere.VCenterUser = ""
+	state.VSphere.VCenterPassword = ""
 
 	jsonData, err := marshalIndent(state, "", "\t")
 	if err != nil {
@@ -111,6 +115,16 @@ func (s Store) GetCloudConfigDir() (string, error) {
 	return s.getDir(filepath.Join(".bbl", "cloudconfig"))
 }
 
+// GetCloudConfigOpsDir returns the directory users put cloud config ops
+// files in. Only users write to it, so it is not created.
+func (s Store) GetCloudConfigOpsDir() string {
+	return filepath.Join(s.dir, "cloud-config")
+}
+
+func (s Store) GetRuntimeConfigDir() (string, error) {
+	return s.getDir(filepath.Join(".bbl", "runtimeconfig"))
+}
+
 func (s Store) GetBblDir() (string, error) {
 	return s.getDir(".bbl")
 }
@@ -44,7 +44,10 @@ var _ = Describe("Store", func() {
 						0x13, 0x14, 0x15, 0x16}, nil
 				})
 				err := store.Set(storage.State{
-					IAAS: "aws",
+					IAAS:                "aws",
+					ExistingNetworkID:   "some-existing-network-id",
+					NetworkCIDR:         "172.16.0.0/16",
+					AllowedIngressCIDRs: []string{"10.0.0.0/8", "192.168.0.0/16"},
 					AWS: storage.AWS{
 						AccessKeyID:     "some-aws-access-key-id",
 						SecretAccessKey: "some-aws-secret-access-key",
@@ -64,6 +67,27 @@ var _ = Describe("Store", func() {
 						Region:            "some-region",
 						Zones:             []string{"some-zone", "some-other-zone"},
 					},
+					OpenStack: storage.OpenStack{
+						AuthURL:             "some-auth-url",
+						AZ:                  "some-az",
+						ExternalNetworkName: "some-external-network",
+						Project:             "some-project",
+						Domain:              "some-domain",
+						Region:              "some-region",
+						Username:            "some-username",
+						Password:            "some-password",
+					},
+					VSphere: storage.VSphere{
+						VCenterIP:       "some-vcenter-ip",
+						VCenterDC:       "some-vcenter-dc",
+						VCenterCluster:  "some-vcenter-cluster",
+						VCenterDS:       "some-vcenter-ds",
+						Network:         "some-network",
+						Subnet:          "some-subnet",
+						Gateway:         "some-gateway",
+						VCenterUser:     "some-vcenter-user",
+						VCenterPassword: "some-vcenter-password",
+					},
 					LB: storage.LB{
 						Type:   "some-type",
 						Cert:   "some-cert",
@@ -78,6 +102,7 @@ var _ = Describe("Store", func() {
 						State: map[string]interface{}{
 							"key": "value",
 						},
+						HostKey: "ssh-rsa some-jumpbox-host-key",
 					},
 					BOSH: storage.BOSH{
 						DirectorName:           "some-director-name",
@@ -93,9 +118,18 @@ var _ = Describe("Store", func() {
 						Variables:   "some-vars",
 						Manifest:    "name: bosh",
 						UserOpsFile: "some-ops-file",
+						HostKey:     "ssh-rsa some-director-host-key",
 					},
 					EnvID:   "some-env-id",
 					TFState: "some-tf-state",
+					RuntimeConfig: storage.RuntimeConfig{
+						Syslog: storage.Syslog{
+							Address:   "some-syslog-address",
+							Port:      "514",
+							Transport: "udp",
+						},
+						UserOpsFile: "some-runtime-config-ops-file",
+					},
 				})
 				Expect(err).NotTo(HaveOccurred())
 
@@ -105,6 +139,9 @@ var _ = Describe("Store", func() {
 				"version": 12,
 				"iaas": "aws",
 				"noDirector": false,
+				"existingNetworkID": "some-existing-network-id",
+				"networkCIDR": "172.16.0.0/16",
+				"allowedIngressCIDRs": ["10.0.0.0/8", "192.168.0.0/16"],
 				"aws": {
 					"region": "some-region"
 				},
@@ -120,6 +157,23 @@ var _ = Describe("Store", func() {
 					"region": "some-region",
 					"zones": ["some-zone", "some-other-zone"]
 				},
+				"openstack": {
+					"authURL": "some-auth-url",
+					"az": "some-az",
+					"externalNetworkName": "some-external-network",
+					"project": "some-project",
+					"domain": "some-domain",
+					"region": "some-region"
+				},
+				"vsphere": {
+					"vcenterIP": "some-vcenter-ip",
+					"vcenterDC": "some-vcenter-dc",
+					"vcenterCluster": "some-vcenter-cluster",
+					"vcenterDS": "some-vcenter-ds",
+					"network": "some-network",
+					"subnet": "some-subnet",
+					"gateway": "some-gateway"
+				},
 				"lb": {
 					"type": "some-type",
 					"cert": "some-cert",
@@ -133,7 +187,8 @@ var _ = Describe("Store", func() {
 					"manifest": "name: jumpbox",
 					"state": {
 						"key": "value"
-					}
+					},
+					"hostKey": "ssh-rsa some-jumpbox-host-key"
 				},
 				"bosh":{
 					"directorName": "some-director-name",
@@ -148,12 +203,21 @@ var _ = Describe("Store", func() {
 					"userOpsFile": "some-ops-file",
 					"state": {
 						"key": "value"
-					}
+					},
+					"hostKey": "ssh-rsa some-director-host-key"
 				},
 				"envID": "some-env-id",
 				"tfState": "some-tf-state",
 				"id": "01020304-0506-0708-0910-111213141516",
-				"latestTFOutput": ""
+				"latestTFOutput": "",
+				"runtimeConfig": {
+					"syslog": {
+						"address": "some-syslog-address",
+						"port": "514",
+						"transport": "udp"
+					},
+					"userOpsFile": "some-runtime-config-ops-file"
+				}
 		    	}`))
 
 				fileInfo, err := os.Stat(filepath.Join(tempDir, "bbl-state.json"))
@@ -312,6 +376,8 @@ var _ = Describe("Store", func() {
 			os.RemoveAll(expectedDir)
 		},
 		Entry("cloudconfig", filepath.Join(".bbl", "cloudconfig"), func() (string, error) { return store.GetCloudConfigDir() }),
+		Entry("cloud-config", "cloud-config", func() (string, error) { return store.GetCloudConfigOpsDir(), nil }),
+		Entry("runtimeconfig", filepath.Join(".bbl", "runtimeconfig"), func() (string, error) { return store.GetRuntimeConfigDir() }),
 		Entry("state", "", func() (string, error) { return store.GetStateDir(), nil }),
 		Entry("dot-bbl", ".bbl", func() (string, error) { return store.GetBblDir() }),
 		Entry("vars", "vars", func() (string, error) { return store.GetVarsDir() }),
@@ -334,6 +400,7 @@ var _ = Describe("Store", func() {
 			os.RemoveAll(expectedDir)
 		},
 		Entry("cloudconfig", filepath.Join(".bbl", "cloudconfig"), func() (string, error) { return store.GetCloudConfigDir() }),
+		Entry("runtimeconfig", filepath.Join(".bbl", "runtimeconfig"), func() (string, error) { return store.GetRuntimeConfigDir() }),
 		Entry("dot-bbl", ".bbl", func() (string, error) { return store.GetBblDir() }),
 		Entry("vars", "vars", func() (string, error) { return store.GetVarsDir() }),
 		Entry("terraform", "terraform", func() (string, error) { return store.GetTerraformDir() }),
@@ -359,6 +426,16 @@ var _ = Describe("Store", func() {
 		Entry("jumpbox-deployment", "jumpbox-deployment", func() (string, error) { return store.GetJumpboxDeploymentDir() }),
 	)
 
+	Describe("GetCloudConfigOpsDir", func() {
+		It("does not create the directory", func() {
+			dir := store.GetCloudConfigOpsDir()
+			Expect(dir).To(Equal(filepath.Join(tempDir, "cloud-config")))
+
+			_, err := os.Stat(dir)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+
 	Describe("GetCloudConfigDir", func() {
 		var expectedDir string
 
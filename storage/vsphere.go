@@ -0,0 +1,13 @@
+package storage
+
+type VSphere struct {
+	VCenterIP       string `json:"vcenterIP"`
+	VCenterDC       string `json:"vcenterDC"`
+	VCenterCluster  string `json:"vcenterCluster"`
+	VCenterDS       string `json:"vcenterDS"`
+	Network         string `json:"network"`
+	Subnet          string `json:"subnet"`
+	Gateway         string `json:"gateway"`
+	VCenterUser     string `json:"vcenterUser,omitempty"`
+	VCenterPassword string `json:"vcenterPassword,omitempty"`
+}
@@ -123,88 +123,6 @@ output "bosh_iam_instance_profile" {
   value = "${aws_iam_instance_profile.bosh.name}"
 }
 
-variable "nat_ami_map" {
-  type = "map"
-
-  default = {
-    ap-northeast-1 = "ami-10dfc877"
-    ap-northeast-2 = "ami-1a1bc474"
-    ap-south-1 = "ami-74c1861b"
-    ap-southeast-1 = "ami-36af2055"
-    ap-southeast-2 = "ami-1e91817d"
-    ca-central-1 = "ami-12d36a76"
-    eu-central-1 = "ami-9ebe18f1"
-    eu-west-1 = "ami-3a849f5c"
-    eu-west-2 = "ami-21120445"
-    us-east-1 = "ami-d4c5efc2"
-    us-east-2 = "ami-f27b5a97"
-    us-gov-west-1 = "ami-c39610a2"
-    us-west-1 = "ami-b87f53d8"
-    us-west-2 = "ami-8bfce8f2"
-  }
-}
-
-resource "aws_security_group" "nat_security_group" {
-  description = "NAT"
-  vpc_id      = "${aws_vpc.vpc.id}"
-
-  ingress {
-    protocol    = "tcp"
-    from_port   = 0
-    to_port     = 65535
-    security_groups = ["${aws_security_group.internal_security_group.id}"]
-  }
-
-  ingress {
-    protocol    = "udp"
-    from_port   = 0
-    to_port     = 65535
-    security_groups = ["${aws_security_group.internal_security_group.id}"]
-  }
-
-  ingress {
-    protocol    = "icmp"
-    from_port   = -1
-    to_port     = -1
-    security_groups = ["${aws_security_group.internal_security_group.id}"]
-  }
-
-  egress {
-    from_port = 0
-    to_port = 0
-    protocol = "-1"
-    cidr_blocks = ["0.0.0.0/0"]
-  }
-
-  tags {
-    Name = "${var.env_id}-nat-security-group"
-  }
-}
-
-resource "aws_instance" "nat" {
-  private_ip             = "10.0.0.7"
-  instance_type          = "t2.medium"
-  subnet_id              = "${aws_subnet.bosh_subnet.id}"
-  source_dest_check      = false
-  ami                    = "${lookup(var.nat_ami_map, var.region)}"
-  vpc_security_group_ids = ["${aws_security_group.nat_security_group.id}"]
-
-  tags {
-    Name = "${var.env_id}-nat",
-    EnvID = "${var.env_id}"
-  }
-}
-
-resource "aws_eip" "nat_eip" {
-  depends_on = ["aws_internet_gateway.ig"]
-  instance = "${aws_instance.nat.id}"
-  vpc      = true
-}
-
-output "nat_eip" {
-  value = "${aws_eip.nat_eip.public_ip}"
-}
-
 variable "access_key" {
   type = "string"
 }
@@ -285,8 +203,9 @@ output "internal_security_group" {
   value="${aws_security_group.internal_security_group.id}"
 }
 
-variable "bosh_inbound_cidr" {
-  default = "0.0.0.0/0"
+variable "allowed_ingress_cidrs" {
+  type    = "list"
+  default = ["0.0.0.0/0"]
 }
 
 resource "aws_security_group" "bosh_security_group" {
@@ -308,7 +227,7 @@ resource "aws_security_group_rule" "bosh_security_group_rule_tcp_ssh" {
   protocol                 = "tcp"
   from_port                = 22
   to_port                  = 22
-  cidr_blocks              = ["${var.bosh_inbound_cidr}"]
+  cidr_blocks              = ["${var.allowed_ingress_cidrs}"]
 }
 
 resource "aws_security_group_rule" "bosh_security_group_rule_tcp_bosh_agent" {
@@ -317,7 +236,7 @@ resource "aws_security_group_rule" "bosh_security_group_rule_tcp_bosh_agent" {
   protocol                 = "tcp"
   from_port                = 6868
   to_port                  = 6868
-  cidr_blocks              = ["${var.bosh_inbound_cidr}"]
+  cidr_blocks              = ["${var.allowed_ingress_cidrs}"]
 }
 
 resource "aws_security_group_rule" "bosh_security_group_rule_uaa" {
@@ -326,7 +245,7 @@ resource "aws_security_group_rule" "bosh_security_group_rule_uaa" {
   protocol                 = "tcp"
   from_port                = 8443
   to_port                  = 8443
-  cidr_blocks              = ["${var.bosh_inbound_cidr}"]
+  cidr_blocks              = ["${var.allowed_ingress_cidrs}"]
 }
 
 resource "aws_security_group_rule" "bosh_security_group_rule_tcp_director_api" {
@@ -335,7 +254,16 @@ resource "aws_security_group_rule" "bosh_security_group_rule_tcp_director_api" {
   protocol                 = "tcp"
   from_port                = 25555
   to_port                  = 25555
-  cidr_blocks              = ["${var.bosh_inbound_cidr}"]
+  cidr_blocks              = ["${var.allowed_ingress_cidrs}"]
+}
+
+resource "aws_security_group_rule" "bosh_security_group_rule_jumpbox" {
+  security_group_id        = "${aws_security_group.bosh_security_group.id}"
+  type                     = "ingress"
+  protocol                 = "tcp"
+  from_port                = 0
+  to_port                  = 65535
+  source_security_group_id = "${aws_security_group.jumpbox.id}"
 }
 
 resource "aws_security_group_rule" "bosh_security_group_rule_tcp" {
@@ -384,7 +312,7 @@ resource "aws_security_group_rule" "jumpbox_ssh" {
   protocol                 = "tcp"
   from_port                = 22
   to_port                  = 22
-  cidr_blocks              = ["${var.bosh_inbound_cidr}"]
+  cidr_blocks              = ["${var.allowed_ingress_cidrs}"]
 }
 
 resource "aws_security_group_rule" "jumpbox_agent" {
@@ -393,7 +321,7 @@ resource "aws_security_group_rule" "jumpbox_agent" {
   protocol                 = "tcp"
   from_port                = 6868
   to_port                  = 6868
-  cidr_blocks              = ["${var.bosh_inbound_cidr}"]
+  cidr_blocks              = ["${var.allowed_ingress_cidrs}"]
 }
 
 resource "aws_security_group_rule" "jumpbox_credhub" {
@@ -402,7 +330,7 @@ resource "aws_security_group_rule" "jumpbox_credhub" {
   protocol                 = "tcp"
   from_port                = 8844
   to_port                  = 8844
-  cidr_blocks              = ["${var.bosh_inbound_cidr}"]
+  cidr_blocks              = ["${var.allowed_ingress_cidrs}"]
 }
 
 resource "aws_security_group_rule" "jumpbox_director" {
@@ -411,7 +339,7 @@ resource "aws_security_group_rule" "jumpbox_director" {
   protocol                 = "tcp"
   from_port                = 25555
   to_port                  = 25555
-  cidr_blocks              = ["${var.bosh_inbound_cidr}"]
+  cidr_blocks              = ["${var.allowed_ingress_cidrs}"]
 }
 
 resource "aws_security_group_rule" "jumpbox_egress" {
@@ -441,9 +369,9 @@ resource "aws_security_group_rule" "bosh_internal_security_rule_udp" {
   source_security_group_id = "${aws_security_group.bosh_security_group.id}"
 }
 
-variable "bosh_subnet_cidr" {
+variable "network_cidr" {
   type    = "string"
-  default = "10.0.0.0/24"
+  default = "10.0.0.0/16"
 }
 
 variable "bosh_availability_zone" {
@@ -452,7 +380,7 @@ variable "bosh_availability_zone" {
 
 resource "aws_subnet" "bosh_subnet" {
   vpc_id            = "${aws_vpc.vpc.id}"
-  cidr_block        = "${var.bosh_subnet_cidr}"
+  cidr_block        = "${cidrsubnet(var.network_cidr, 8, 0)}"
 
   tags {
     Name = "${var.env_id}-bosh-subnet"
@@ -489,7 +417,7 @@ variable "availability_zones" {
 resource "aws_subnet" "internal_subnets" {
   count             = "${length(var.availability_zones)}"
   vpc_id            = "${aws_vpc.vpc.id}"
-  cidr_block        = "${cidrsubnet("10.0.0.0/16", 4, count.index+1)}"
+  cidr_block        = "${cidrsubnet(var.network_cidr, 4, count.index+1)}"
   availability_zone = "${element(var.availability_zones, count.index)}"
 
   tags {
@@ -501,22 +429,6 @@ resource "aws_subnet" "internal_subnets" {
   }
 }
 
-resource "aws_route_table" "internal_route_table" {
-  vpc_id = "${aws_vpc.vpc.id}"
-}
-
-resource "aws_route" "internal_route_table" {
-  destination_cidr_block = "0.0.0.0/0"
-  instance_id = "${aws_instance.nat.id}"
-  route_table_id = "${aws_route_table.internal_route_table.id}"
-}
-
-resource "aws_route_table_association" "route_internal_subnets" {
-  count          = "${length(var.availability_zones)}"
-  subnet_id      = "${element(aws_subnet.internal_subnets.*.id, count.index)}"
-  route_table_id = "${aws_route_table.internal_route_table.id}"
-}
-
 output "internal_az_subnet_id_mapping" {
 	value = "${
 	  zipmap("${aws_subnet.internal_subnets.*.availability_zone}", "${aws_subnet.internal_subnets.*.id}")
@@ -537,29 +449,6 @@ variable "short_env_id" {
   type = "string"
 }
 
-variable "vpc_cidr" {
-  type = "string"
-  default = "10.0.0.0/16"
-}
-
-resource "aws_vpc" "vpc" {
-  cidr_block           = "${var.vpc_cidr}"
-  instance_tenancy     = "default"
-  enable_dns_hostnames = true
-
-  tags {
-    Name = "${var.env_id}-vpc"
-  }
-}
-
-resource "aws_internet_gateway" "ig" {
-  vpc_id = "${aws_vpc.vpc.id}"
-}
-
-output "vpc_id" {
-  value = "${aws_vpc.vpc.id}"
-}
-
 resource "aws_flow_log" "bbl" {
   log_group_name = "${aws_cloudwatch_log_group.bbl.name}"
   iam_role_arn   = "${aws_iam_role.flow_logs.arn}"
@@ -622,3 +511,118 @@ resource "aws_kms_key" "kms_key" {
 output "kms_key_arn" {
   value = "${aws_kms_key.kms_key.arn}"
 }
+
+data "aws_ami" "nat_ami" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-vpc-nat-hvm-*"]
+  }
+
+  filter {
+    name   = "virtualization-type"
+    values = ["hvm"]
+  }
+}
+
+resource "aws_security_group" "nat_security_group" {
+  description = "NAT"
+  vpc_id      = "${aws_vpc.vpc.id}"
+
+  ingress {
+    protocol    = "tcp"
+    from_port   = 0
+    to_port     = 65535
+    security_groups = ["${aws_security_group.internal_security_group.id}"]
+  }
+
+  ingress {
+    protocol    = "udp"
+    from_port   = 0
+    to_port     = 65535
+    security_groups = ["${aws_security_group.internal_security_group.id}"]
+  }
+
+  ingress {
+    protocol    = "icmp"
+    from_port   = -1
+    to_port     = -1
+    security_groups = ["${aws_security_group.internal_security_group.id}"]
+  }
+
+  egress {
+    from_port = 0
+    to_port = 0
+    protocol = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+
+  tags {
+    Name = "${var.env_id}-nat-security-group"
+  }
+}
+
+resource "aws_instance" "nat" {
+  private_ip             = "${cidrhost(cidrsubnet(var.network_cidr, 8, 0), 7)}"
+  instance_type          = "t2.medium"
+  subnet_id              = "${aws_subnet.bosh_subnet.id}"
+  source_dest_check      = false
+  ami                    = "${data.aws_ami.nat_ami.id}"
+  vpc_security_group_ids = ["${aws_security_group.nat_security_group.id}"]
+
+  tags {
+    Name = "${var.env_id}-nat",
+    EnvID = "${var.env_id}"
+  }
+
+  lifecycle {
+    ignore_changes = ["ami"]
+  }
+}
+
+resource "aws_eip" "nat_eip" {
+  depends_on = ["aws_internet_gateway.ig"]
+  instance = "${aws_instance.nat.id}"
+  vpc      = true
+}
+
+output "nat_eip" {
+  value = "${aws_eip.nat_eip.public_ip}"
+}
+
+resource "aws_route_table" "internal_route_table" {
+  vpc_id = "${aws_vpc.vpc.id}"
+}
+
+resource "aws_route" "internal_route_table" {
+  destination_cidr_block = "0.0.0.0/0"
+  instance_id = "${aws_instance.nat.id}"
+  route_table_id = "${aws_route_table.internal_route_table.id}"
+}
+
+resource "aws_route_table_association" "route_internal_subnets" {
+  count          = "${length(var.availability_zones)}"
+  subnet_id      = "${element(aws_subnet.internal_subnets.*.id, count.index)}"
+  route_table_id = "${aws_route_table.internal_route_table.id}"
+}
+
+
+resource "aws_vpc" "vpc" {
+  cidr_block           = "${var.network_cidr}"
+  instance_tenancy     = "default"
+  enable_dns_hostnames = true
+
+  tags {
+    Name = "${var.env_id}-vpc"
+  }
+}
+
+resource "aws_internet_gateway" "ig" {
+  vpc_id = "${aws_vpc.vpc.id}"
+}
+
+output "vpc_id" {
+  value = "${aws_vpc.vpc.id}"
+}
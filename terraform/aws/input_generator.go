@@ -28,6 +28,16 @@ func (i InputGenerator) Generate(state storage.State) (map[string]string, error)
 	if err != nil {
 		return map[string]string{}, err
 	}
+
+	if len(state.AWS.AZs) > 0 {
+		for _, az := range state.AWS.AZs {
+			if !contains(azs, az) {
+				return map[string]string{}, fmt.Errorf("availability zone %s is not available in region %s", az, state.AWS.Region)
+			}
+		}
+		azs = state.AWS.AZs
+	}
+
 	zones, err := jsonMarshal(azs)
 	if err != nil {
 		return map[string]string{}, err
@@ -49,10 +59,30 @@ func (i InputGenerator) Generate(state storage.State) (map[string]string, error)
 		"availability_zones":     string(zones),
 	}
 
+	if state.ExistingNetworkID != "" {
+		inputs["existing_vpc_id"] = state.ExistingNetworkID
+	}
+
+	if state.NetworkCIDR != "" {
+		inputs["network_cidr"] = state.NetworkCIDR
+	}
+
+	if len(state.AllowedIngressCIDRs) > 0 {
+		cidrs, err := jsonMarshal(state.AllowedIngressCIDRs)
+		if err != nil {
+			return map[string]string{}, err
+		}
+		inputs["allowed_ingress_cidrs"] = string(cidrs)
+	}
+
 	if state.LB.Type == "cf" || state.LB.Type == "concourse" {
-		inputs["ssl_certificate"] = state.LB.Cert
-		inputs["ssl_certificate_private_key"] = state.LB.Key
-		inputs["ssl_certificate_chain"] = state.LB.Chain
+		if state.LB.ACMCertificateARN != "" {
+			inputs["acm_certificate_arn"] = state.LB.ACMCertificateARN
+		} else {
+			inputs["ssl_certificate"] = state.LB.Cert
+			inputs["ssl_certificate_private_key"] = state.LB.Key
+			inputs["ssl_certificate_chain"] = state.LB.Chain
+		}
 
 		if state.LB.Domain != "" {
 			inputs["system_domain"] = state.LB.Domain
@@ -61,3 +91,12 @@ func (i InputGenerator) Generate(state storage.State) (map[string]string, error)
 
 	return inputs, nil
 }
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
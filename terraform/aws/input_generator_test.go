@@ -68,6 +68,77 @@ var _ = Describe("InputGenerator", func() {
 		})
 	})
 
+	Context("when an existing vpc id is provided", func() {
+		It("returns a map with the existing vpc id", func() {
+			inputs, err := inputGenerator.Generate(storage.State{
+				EnvID:             "some-env-id",
+				ExistingNetworkID: "some-vpc-id",
+				AWS: storage.AWS{
+					Region: "some-region",
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(inputs["existing_vpc_id"]).To(Equal("some-vpc-id"))
+		})
+	})
+
+	Context("when a network cidr is provided", func() {
+		It("returns a map with the network cidr", func() {
+			inputs, err := inputGenerator.Generate(storage.State{
+				EnvID:       "some-env-id",
+				NetworkCIDR: "172.16.0.0/16",
+				AWS: storage.AWS{
+					Region: "some-region",
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(inputs["network_cidr"]).To(Equal("172.16.0.0/16"))
+		})
+	})
+
+	Context("when availability zones are pinned", func() {
+		It("uses only the pinned zones", func() {
+			inputs, err := inputGenerator.Generate(storage.State{
+				EnvID: "some-env-id",
+				AWS: storage.AWS{
+					Region: "some-region",
+					AZs:    []string{"z3", "z1"},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(inputs["availability_zones"]).To(Equal(`["z3","z1"]`))
+		})
+
+		It("returns an error when a pinned zone is not in the region", func() {
+			_, err := inputGenerator.Generate(storage.State{
+				EnvID: "some-env-id",
+				AWS: storage.AWS{
+					Region: "some-region",
+					AZs:    []string{"z1", "z4"},
+				},
+			})
+			Expect(err).To(MatchError("availability zone z4 is not available in region some-region"))
+		})
+	})
+
+	Context("when allowed ingress cidrs are provided", func() {
+		It("returns a map with the cidrs as a list", func() {
+			inputs, err := inputGenerator.Generate(storage.State{
+				EnvID:               "some-env-id",
+				AllowedIngressCIDRs: []string{"10.0.0.0/8", "192.168.0.0/16"},
+				AWS: storage.AWS{
+					Region: "some-region",
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(inputs["allowed_ingress_cidrs"]).To(Equal(`["10.0.0.0/8","192.168.0.0/16"]`))
+		})
+	})
+
 	Context("when a cf lb exists", func() {
 		var state storage.State
 
@@ -138,6 +209,35 @@ var _ = Describe("InputGenerator", func() {
 		})
 	})
 
+	Context("when an acm certificate arn is provided", func() {
+		It("passes the arn instead of the certificate contents", func() {
+			inputs, err := inputGenerator.Generate(storage.State{
+				EnvID: "some-env-id",
+				AWS: storage.AWS{
+					AccessKeyID:     "some-access-key-id",
+					SecretAccessKey: "some-secret-access-key",
+					Region:          "some-region",
+				},
+				LB: storage.LB{
+					Type:              "cf",
+					ACMCertificateARN: "some-certificate-arn",
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(inputs).To(Equal(map[string]string{
+				"env_id":                 "some-env-id",
+				"short_env_id":           "some-env-id",
+				"access_key":             "some-access-key-id",
+				"secret_key":             "some-secret-access-key",
+				"region":                 "some-region",
+				"bosh_availability_zone": "",
+				"availability_zones":     `["z1","z2","z3"]`,
+				"acm_certificate_arn":    "some-certificate-arn",
+			}))
+		})
+	})
+
 	Context("when a concourse lb exists", func() {
 		var state storage.State
 
@@ -2,30 +2,13 @@ package aws
 
 import (
 	"bytes"
-	"encoding/json"
+	"fmt"
 	"strings"
 	"text/template"
 
 	"github.com/cloudfoundry/bosh-bootloader/storage"
 )
 
-var AMIs = `{
-  "ap-northeast-1": "ami-10dfc877",
-  "ap-northeast-2": "ami-1a1bc474",
-  "ap-south-1":     "ami-74c1861b",
-  "ap-southeast-1": "ami-36af2055",
-  "ap-southeast-2": "ami-1e91817d",
-  "ca-central-1":   "ami-12d36a76",
-  "eu-central-1":   "ami-9ebe18f1",
-  "eu-west-1":      "ami-3a849f5c",
-  "eu-west-2":      "ami-21120445",
-  "us-east-1":      "ami-d4c5efc2",
-  "us-east-2":      "ami-f27b5a97",
-  "us-gov-west-1":  "ami-c39610a2",
-  "us-west-1":      "ami-b87f53d8",
-  "us-west-2":      "ami-8bfce8f2"
-}`
-
 type TemplateGenerator struct{}
 
 type TemplateData struct {
@@ -42,16 +25,31 @@ type TemplateData struct {
 	TCPLBInternalDescription       string
 	SSLCertificateNameProperty     string
 	IgnoreSSLCertificateProperties string
-	AWSNATAMIs                     map[string]string
+	NATGateway                     bool
+	VPCID                          string
+	ExistingNetworkID              string
+	InternetGateway                string
+	InternetGatewayID              string
+	LBFlavor                       string
+	LBCertificateARN               string
+	RouterLBDNSName                string
+	SSHLBDNSName                   string
+	PrivateDirector                bool
+	PrivateRouteTableID            string
 }
 
 type templates struct {
-	base           string
-	lbSubnet       string
-	cfLB           string
-	cfDNS          string
-	concourseLB    string
-	sslCertificate string
+	base            string
+	nat             string
+	vpc             string
+	existingVPC     string
+	lbSubnet        string
+	cfLB            string
+	cfDNS           string
+	concourseLB     string
+	sslCertificate  string
+	acmCertificate  string
+	privateDirector string
 }
 
 func NewTemplateGenerator() TemplateGenerator {
@@ -60,27 +58,51 @@ func NewTemplateGenerator() TemplateGenerator {
 
 func (tg TemplateGenerator) Generate(state storage.State) string {
 	tmpls := readTemplates()
-	tmpl := tmpls.base
+
+	vpc := tmpls.vpc
+	vpcResource := "aws_vpc.vpc"
+	internetGateway := "aws_internet_gateway.ig"
+	if state.ExistingNetworkID != "" {
+		vpc = tmpls.existingVPC
+		vpcResource = "data.aws_vpc.vpc"
+		internetGateway = "data.aws_internet_gateway.ig"
+	}
+
+	tmpl := strings.Join([]string{tmpls.base, tmpls.nat, vpc}, "\n")
+
+	privateRouteTableID := "${aws_route_table.internal_route_table.id}"
+	if state.AWS.NATGateway {
+		privateRouteTableID = "${element(aws_route_table.internal_route_tables.*.id, 0)}"
+	}
+
+	if state.PrivateDirector {
+		tmpl = strings.Join([]string{tmpl, tmpls.privateDirector}, "\n")
+	}
+
+	certificate := tmpls.sslCertificate
+	certificateARN := "${aws_iam_server_certificate.lb_cert.arn}"
+	if state.LB.ACMCertificateARN != "" {
+		certificate = tmpls.acmCertificate
+		certificateARN = "${var.acm_certificate_arn}"
+	}
+
+	lbResource := "aws_elb"
+	if state.LB.Flavor != "" {
+		lbResource = "aws_lb"
+	}
 
 	switch state.LB.Type {
 	case "concourse":
-		tmpl = strings.Join([]string{tmpl, tmpls.lbSubnet, tmpls.concourseLB, tmpls.sslCertificate}, "\n")
+		tmpl = strings.Join([]string{tmpl, tmpls.lbSubnet, tmpls.concourseLB, certificate}, "\n")
 	case "cf":
-		tmpl = strings.Join([]string{tmpl, tmpls.lbSubnet, tmpls.cfLB, tmpls.sslCertificate}, "\n")
+		tmpl = strings.Join([]string{tmpl, tmpls.lbSubnet, tmpls.cfLB, certificate}, "\n")
 
 		if state.LB.Domain != "" {
 			tmpl = strings.Join([]string{tmpl, tmpls.cfDNS}, "\n")
 		}
 	}
 
-	var ami map[string]string
-	err := json.Unmarshal([]byte(AMIs), &ami)
-	if err != nil {
-		panic(err)
-	}
-
 	templateData := TemplateData{
-		AWSNATAMIs:                   ami,
 		BOSHDescription:              "Bosh",
 		ConcourseDescription:         "Concourse",
 		ConcourseInternalDescription: "Concourse Internal",
@@ -93,10 +115,21 @@ func (tg TemplateGenerator) Generate(state storage.State) string {
 		SSLCertificateNameProperty:   `name_prefix       = "${var.ssl_certificate_name_prefix}"`,
 		TCPLBDescription:             "CF TCP",
 		TCPLBInternalDescription:     "CF TCP Internal",
+		VPCID:                        fmt.Sprintf("${%s.id}", vpcResource),
+		ExistingNetworkID:            state.ExistingNetworkID,
+		InternetGateway:              internetGateway,
+		InternetGatewayID:            fmt.Sprintf("${%s.id}", internetGateway),
+		NATGateway:                   state.AWS.NATGateway,
+		LBFlavor:                     state.LB.Flavor,
+		LBCertificateARN:             certificateARN,
+		RouterLBDNSName:              fmt.Sprintf("${%s.cf_router_lb.dns_name}", lbResource),
+		SSHLBDNSName:                 fmt.Sprintf("${%s.cf_ssh_lb.dns_name}", lbResource),
+		PrivateDirector:              state.PrivateDirector,
+		PrivateRouteTableID:          privateRouteTableID,
 	}
 
 	t := template.New("descriptions")
-	t, err = t.Parse(tmpl)
+	t, err := t.Parse(tmpl)
 	if err != nil {
 		panic(err)
 	}
@@ -114,11 +147,16 @@ func (tg TemplateGenerator) Generate(state storage.State) string {
 func readTemplates() templates {
 	tmpls := templates{}
 	tmpls.base = string(MustAsset("templates/base.tf"))
+	tmpls.nat = string(MustAsset("templates/nat.tf"))
+	tmpls.vpc = string(MustAsset("templates/vpc.tf"))
+	tmpls.existingVPC = string(MustAsset("templates/existing_vpc.tf"))
 	tmpls.lbSubnet = string(MustAsset("templates/lb_subnet.tf"))
 	tmpls.concourseLB = string(MustAsset("templates/concourse_lb.tf"))
 	tmpls.sslCertificate = string(MustAsset("templates/ssl_certificate.tf"))
+	tmpls.acmCertificate = string(MustAsset("templates/acm_certificate.tf"))
 	tmpls.cfLB = string(MustAsset("templates/cf_lb.tf"))
 	tmpls.cfDNS = string(MustAsset("templates/cf_dns.tf"))
+	tmpls.privateDirector = string(MustAsset("templates/private_director.tf"))
 
 	return tmpls
 }
@@ -22,23 +22,44 @@ var _ = Describe("TemplateGenerator", func() {
 
 	Describe("Generate", func() {
 		DescribeTable("generates a terraform template for aws",
-			func(fixtureFilename, lbType, domain string) {
+			func(fixtureFilename string, state storage.State) {
 				expectedTemplate, err := ioutil.ReadFile(fixtureFilename)
 				Expect(err).NotTo(HaveOccurred())
 
-				template := templateGenerator.Generate(storage.State{
-					LB: storage.LB{
-						Type:   lbType,
-						Domain: domain,
-					},
-				})
+				template := templateGenerator.Generate(state)
 
 				Expect(template).To(Equal(string(expectedTemplate)))
 			},
-			Entry("when no lb type is provided", "fixtures/template_no_lb.tf", "", ""),
-			Entry("when a concourse lb type is provided", "fixtures/template_concourse_lb.tf", "concourse", ""),
-			Entry("when a cf lb type is provided", "fixtures/template_cf_lb.tf", "cf", ""),
-			Entry("when a cf lb type is provided with a system domain", "fixtures/template_cf_lb_with_domain.tf", "cf", "some-domain"),
+			Entry("when no lb type is provided", "fixtures/template_no_lb.tf", storage.State{}),
+			Entry("when a concourse lb type is provided", "fixtures/template_concourse_lb.tf",
+				storage.State{LB: storage.LB{Type: "concourse"}}),
+			Entry("when a cf lb type is provided", "fixtures/template_cf_lb.tf",
+				storage.State{LB: storage.LB{Type: "cf"}}),
+			Entry("when a cf lb type is provided with a system domain", "fixtures/template_cf_lb_with_domain.tf",
+				storage.State{LB: storage.LB{Type: "cf", Domain: "some-domain"}}),
+			Entry("when an existing vpc id is provided", "fixtures/template_existing_vpc.tf",
+				storage.State{ExistingNetworkID: "some-vpc-id"}),
+			Entry("when a nat gateway is requested", "fixtures/template_nat_gateway.tf",
+				storage.State{AWS: storage.AWS{NATGateway: true}}),
+			Entry("when a cf lb type is provided with an alb flavor and a system domain", "fixtures/template_cf_alb_with_domain.tf",
+				storage.State{LB: storage.LB{Type: "cf", Flavor: "alb", Domain: "some-domain"}}),
+			Entry("when a cf lb type is provided with an nlb flavor", "fixtures/template_cf_nlb.tf",
+				storage.State{LB: storage.LB{Type: "cf", Flavor: "nlb"}}),
+			Entry("when a concourse lb type is provided with an alb flavor", "fixtures/template_concourse_alb.tf",
+				storage.State{LB: storage.LB{Type: "concourse", Flavor: "alb"}}),
+			Entry("when a concourse lb type is provided with an nlb flavor", "fixtures/template_concourse_nlb.tf",
+				storage.State{LB: storage.LB{Type: "concourse", Flavor: "nlb"}}),
+			Entry("when an acm certificate arn is provided", "fixtures/template_cf_lb_acm.tf",
+				storage.State{LB: storage.LB{Type: "cf", ACMCertificateARN: "some-certificate-arn"}}),
+			Entry("when a private director is requested", "fixtures/template_private_director.tf",
+				storage.State{PrivateDirector: true}),
+			Entry("when a private director is requested with a nat gateway", "fixtures/template_private_director_nat_gateway.tf",
+				storage.State{PrivateDirector: true, AWS: storage.AWS{NATGateway: true}}),
 		)
+
+		It("leaves the default security group of an existing vpc alone", func() {
+			Expect(templateGenerator.Generate(storage.State{})).To(ContainSubstring(`resource "aws_default_security_group"`))
+			Expect(templateGenerator.Generate(storage.State{ExistingNetworkID: "some-vpc-id"})).NotTo(ContainSubstring("aws_default_security_group"))
+		})
 	})
 })
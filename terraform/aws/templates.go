@@ -1,11 +1,16 @@
 // Code generated by go-bindata.
 // sources:
+// templates/acm_certificate.tf
 // templates/base.tf
 // templates/cf_dns.tf
 // templates/cf_lb.tf
 // templates/concourse_lb.tf
+// templates/existing_vpc.tf
 // templates/lb_subnet.tf
+// templates/nat.tf
+// templates/private_director.tf
 // templates/ssl_certificate.tf
+// templates/vpc.tf
 // DO NOT EDIT!
 
 package aws
@@ -73,7 +78,27 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _templatesBaseTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x5b\xdd\x6f\xdb\x38\x12\x7f\x8e\xff\x0a\x42\xe8\x43\xdb\x73\xd4\x24\x9b\x64\x7b\xc1\xfa\x21\x6d\x73\x77\x3d\xf4\xba\x45\x12\xec\x3d\x14\x85\x40\x53\xb4\xcc\x8b\x44\x0a\x24\xe5\xd4\x35\xf4\xbf\x1f\xf8\xa5\x6f\x59\x8a\x9b\xb4\x09\x9a\xc5\xee\x26\xe4\x68\x66\xf8\xe3\x7c\x51\x1a\x72\x2c\x58\xc6\x11\x06\x1e\xbc\x15\x01\x26\xa9\x07\xbc\xff\x65\x49\x3a\x67\x5f\xcd\x5f\x9b\x09\x00\x21\x4e\x31\x0d\x45\xc0\x28\x98\x81\xcf\x9a\x92\x50\x89\x39\xc5\x32\x88\xa0\xc4\xb7\x70\xed\x93\xc8\xfb\x32\x01\x60\x95\x22\xa0\x7f\x66\x40\xf2\x0c\x4f\xf2\xc9\xa4\x14\x21\x63\x11\xa4\x9c\xac\xa0\xc4\xc1\x0d\x5e\x7b\xc0\x9b\x33\xb1\x0c\x56\x89\x30\x72\x60\x1c\x31\x4e\xe4\x32\x01\x33\xe0\x5d\x5e\x9d\x7b\x13\x00\xb8\x80\xc1\x9c\x48\x01\x66\xe0\xf8\xe0\xef\xa7\x75\x86\x4a\x93\x1b\xbc\x0e\x52\x48\x78\x8b\x9b\x9a\xa0\x30\xc1\x8a\xd9\xb3\xcd\x0a\x72\x1f\xd3\x55\x40\xc2\x3c\x28\xe8\x26\x00\xa4\xd9\x3c\x26\x48\x71\x31\x74\x0d\x1d\x7d\x47\xeb\x97\x84\x01\x4b\x31\x15\x62\x99\x7b\x4a\x1b\x96\xc9\x34\x93\xa5\xf0\xc0\xc9\x35\x5a\xac\x60\x9c\x59\x15\xaa\xda\x96\x7c\x1d\x79\x0f\xb7\x1a\x5e\x0d\x86\xfd\xba\x96\x83\x41\x8a\x93\x5c\x2d\x54\x60\x2a\x88\x24\x2b\x5c\xd9\x1a\x27\x0d\x7f\x55\xbb\x09\xe3\xc0\xed\x78\x43\x6b\x4c\x52\xbf\x62\x15\x0e\x0b\x92\xd6\x95\x76\x24\x19\x8f\x0d\x9b\x3b\x30\x3a\x3b\x3a\xaa\xf1\x0a\x09\xc7\x48\x32\x1e\xc0\x30\xe4\x58\x88\x86\x5e\x4b\x29\x53\x71\xf6\xea\xd5\x30\xdb\x93\x93\x93\x13\xaf\x6d\x36\x04\x26\x01\x67\x31\xb6\x66\x63\xd8\x6f\x31\x17\x4d\xab\xec\x05\xca\xa5\x22\x79\xa5\xfe\x88\xc9\x02\xa3\x35\x8a\xb1\x5d\x2d\xe2\x58\xc1\x3e\xc7\x0b\xc6\x71\x10\x62\x21\x39\x5b\x3b\xbc\x01\xc8\x27\xca\xc8\x85\xc8\x12\xac\xf9\x05\x29\x8b\x09\x52\x04\x7f\xfc\x71\xf1\xe7\x3f\x26\x8a\x89\xf7\x17\xe6\x82\x30\xea\x9d\x01\xef\xe8\xe0\xf0\x68\xff\xf0\x60\xff\xf0\x77\x6f\xaa\xa6\xae\x24\x94\x38\xc1\x54\x7a\x67\xe0\xb3\x16\x68\xc4\x02\xe0\x9d\x23\x69\x1f\x12\x52\x9c\x9d\x6b\x19\x97\x4a\xe5\xa9\xa3\xf8\xc4\x09\x45\x24\x85\xb1\x77\x56\x3c\xa6\x78\x62\xbe\x22\x08\xab\x27\x31\x3a\xf2\x61\x02\xbf\x31\x0a\x6f\x85\x8f\x58\xe2\x59\xb2\xbc\x60\x72\xb1\x58\x60\xa4\xc4\x7b\xe7\x71\xcc\x6e\x4b\xee\x57\x24\x54\xa3\xe6\x89\x7c\x02\xc0\x97\x49\x3e\x51\x6b\xea\x04\xde\xac\xbb\x0d\x3d\xe8\x01\xdf\xd2\x3b\xf8\x41\xb1\x01\x0f\x00\xe0\xe7\x12\x1b\x8c\x8e\x14\x94\x0c\x11\x28\xf1\xb9\xb5\xc3\x69\x63\x5e\x4a\x88\x96\x7f\xb1\x38\x4b\x70\x73\xee\xad\x36\x87\xee\xb9\x77\x38\xc6\x12\x5f\x51\x98\x8a\x25\x93\xdd\xb3\x7d\x4f\x0a\xc4\xc9\xdc\x29\x84\x5b\x2a\x39\x82\xf7\x09\x8c\xb6\xcc\x52\x21\x21\x45\xfd\x04\x97\x38\x22\x8c\xf6\x4e\x5f\x61\x94\x71\x22\xd7\xff\xe4\x2c\x4b\xfb\xa9\xec\x02\xfb\x09\xb2\x39\xc5\xfd\xd3\x06\x82\x8e\xe9\x21\xd4\xfb\x90\x35\xb3\xd7\x30\x6a\xf1\xbc\xcc\x68\x2f\x26\xd7\x98\x27\x84\x42\xd9\x8f\x9a\x42\x4b\x48\xcc\x35\xe8\x6d\x75\x79\x6d\x7a\xb2\x07\xc0\x97\xa9\xfa\x6f\x87\x47\xa9\xd1\x4b\xeb\x32\x6a\xfc\xa5\x75\xaa\xe9\x64\x6f\xa3\x27\x2b\xa6\xba\xa7\x45\x10\x98\x9c\x7d\x82\x42\x68\x87\xbf\x2b\xef\xbd\x2d\x8c\x71\x0c\x85\x24\x28\x66\x30\x9c\xc3\x18\x52\x44\x68\x74\xf6\x72\x07\x11\x43\x01\xa1\x12\x0d\x03\xa8\x3d\x4a\x7b\x69\x35\x40\x28\x92\xa1\xd8\x6c\x19\x70\x5a\x66\x9c\x32\xdc\xe8\xf4\xe8\x43\x4e\xf3\x9e\x74\x40\xec\xde\x06\x29\x67\x0b\xd2\x48\x0d\xa5\xf8\xaa\xce\x86\x67\x4f\xfa\xee\xe6\xd9\x91\x5e\xbb\x08\x9b\x9c\x57\x90\x13\x38\x8f\x31\xf0\x28\x94\x01\x4c\x48\x90\x40\x9b\xac\xe5\x3a\xd5\xcc\xd4\xc0\x44\x97\x6b\x0b\x98\xc5\x12\xcc\xf4\xec\x66\xc3\x21\x8d\x30\x78\x76\x83\xd7\x53\xf0\xcc\x88\x3e\x9b\x01\xff\xfc\xbf\x57\x1f\xcf\xaf\xcf\xff\xf3\x5e\xe4\xb9\x22\x53\x04\x79\xae\x18\x6d\x36\x86\x2c\xd7\x85\xc3\x66\x83\x69\x98\xe7\x79\x1b\x34\x61\x43\x40\x10\xa9\x18\xe0\x19\xd5\x9a\x83\xa6\x80\x54\xde\x9c\x2a\xeb\x32\xfc\xfd\x8f\xe7\xd7\xef\xca\x41\x23\x68\x95\xa2\x80\x84\xc6\x6d\x0a\x6c\x56\x29\xf2\xd5\xbf\x24\xcc\xf5\xe2\x08\x8d\x54\xd4\xb3\x81\x3b\xe5\x4c\x32\xc4\x62\xfb\x88\x44\xa9\x71\x96\x05\x67\x6a\xdb\xb9\xd4\xe3\x07\x7a\x4c\x32\x37\xa2\xc6\x4e\x4f\x4e\x7e\x3b\xd1\xe3\x75\x85\x85\x2e\x71\x8d\xec\xfa\x8c\x6f\x6a\x5e\x18\xb7\xc6\xc3\x5c\xd7\xbe\xf9\xa0\x7e\x59\xf8\xb8\xf5\x23\x28\xe9\x54\x70\xff\xb0\x43\x43\x3b\x78\xbf\xea\xe1\xaa\x76\xa5\x12\x4d\x8c\xdc\xdf\x85\xfe\x33\xe0\xed\x1f\x1a\xd5\x11\x09\x79\x30\x8f\x19\xba\x31\xca\x1c\xf8\xfa\x9f\x57\x07\xa5\x14\x09\x23\x27\xe3\x63\x57\xc9\xb7\x4f\xa1\xdc\x77\x6a\xee\x1b\x43\xd6\xcf\xb6\xe3\x86\x75\x5b\x63\xfc\xc6\xda\x5d\xf5\x4d\x52\x50\xfd\x99\x01\xef\xd0\xe8\xf2\xbb\xa7\x37\xc2\x3a\xbc\x76\xdf\x2a\x95\x3c\xf2\x13\x1c\x92\x4c\xd7\x5f\x42\xe7\xc7\xc2\x2f\x2a\x64\x16\x64\x3d\x6f\x4a\x7f\xfb\xbb\x76\x15\x00\x8c\x9a\xba\x0a\x0d\xd0\x12\xa3\x1b\xf7\xe4\x02\xc6\x42\x95\xa3\x30\x21\xa0\xe3\x47\xb3\x8e\x19\xbb\xc9\xd2\xe7\x0a\x93\x4a\xbc\x99\x02\x35\xc0\x75\x61\xf0\xa2\xf0\xd9\xfa\x7e\x06\x24\xdc\x62\x04\xed\x08\x61\xf7\x7f\xe4\xae\xd8\xcc\x7a\x41\x57\xef\xdf\xb5\x08\x7a\xf6\xc8\x9c\x6a\x95\xe4\x5d\x4e\xb4\x6e\x9f\x2a\xe1\xda\x8e\xa8\xc5\x38\xb0\x3b\xce\xbd\x2e\x17\xd4\x04\x77\x9c\x87\xec\x7c\xf3\x50\x55\x06\x7c\x88\x10\x16\xa2\x3c\x01\xba\x78\x2f\x24\x27\x34\x6a\x10\x0b\x8c\x38\x96\x23\x89\xcd\x4e\xf6\x12\xa6\x9c\xad\x48\x88\xb9\x86\xd1\x1e\xd1\x0b\x5d\x4a\xf4\xcb\x31\x7b\xd0\x74\x1a\x94\x24\xe5\x98\x26\x31\x72\x4b\x6b\x2b\xad\xaa\x2b\x37\xdb\x7c\xd6\x4e\x37\x7d\x13\x9b\xc9\x9e\xcd\x25\xdd\x69\x64\x38\x91\xf5\xc4\xaa\xbe\x6c\xf6\xde\x92\xef\x96\xd2\x06\xed\xde\x69\x33\x2e\x24\x35\xdc\x91\x67\xba\x92\xe9\x59\x91\x9e\x0e\x54\xde\xd4\x1a\xb4\x5c\xb9\x1d\x6f\xc6\x07\x75\x67\x54\x3d\x41\xc6\x66\x22\x5d\xb8\x55\xd2\x50\x93\xcc\xe6\xf4\x6a\x42\x6a\x90\xa8\x54\x50\x4d\x4d\x8d\x69\x97\x49\x05\x8e\x17\x3d\xba\xb4\x5f\x55\xed\x08\xa4\x4a\xf0\x8f\x15\x48\x5b\x7c\x3c\x0d\x20\x75\x25\xf2\x58\x91\x74\x65\xd2\x16\x28\x75\x71\xb4\x05\x4b\x3d\x5f\xad\x54\x1a\xf3\xf5\xb2\xe5\x3e\x10\x85\xea\x7c\x56\x64\xb9\x1f\x8f\x2d\x1e\x05\xad\x29\xe2\x76\xb7\xd1\x83\x1f\x0d\xab\x70\x07\xc4\x47\x68\xa7\xd7\x6f\x3f\x0d\xa0\x79\x74\xb4\x1d\x4e\x3d\x6f\x0b\xc9\xf6\x02\xfb\x56\x66\x5f\xc5\x16\xc9\xd6\x55\x42\x5b\xb3\xaa\xae\x8c\x66\x3b\x40\x55\xab\x68\xcc\xc1\x9b\xce\x59\x46\xc3\x40\x19\x82\x4b\xd9\xee\x48\x5c\x31\x80\x11\x75\x80\x29\xab\x47\xd5\x00\x6f\xfe\xbc\xfa\xd7\x03\xe5\x7f\xa5\x45\x5f\xee\xaf\xbd\x71\xb8\x2b\xae\x1d\x0f\x8d\xaa\x90\x9c\x67\x74\x3c\x5f\x14\x14\xdf\xe1\x19\xbd\x6a\xfd\xa0\x82\x62\x94\x57\x6c\x8d\x32\x66\xff\x5a\xc6\x98\x8f\x0f\x3a\x5b\xa1\xd5\x93\x30\xd2\xaf\xc8\x9e\x24\xc2\xa7\xaf\x4f\x5f\x0f\x14\x1b\x86\xe2\x67\xa1\x9c\x41\xf8\x44\xa1\x7d\x7d\x7c\xfc\xdb\x76\x68\x2d\xc5\xcf\x34\xe0\xf2\xeb\x5e\x4a\x9e\x28\xce\xfa\xc3\xe2\x40\x9c\xb0\x24\x3f\x11\xe9\x27\x0a\xee\xd8\x93\xc8\x5d\x2b\x93\xa1\x42\xe2\xfb\x62\xc6\xce\x87\xbf\x87\x85\xfb\xfe\x0e\x7e\x8f\x0a\xee\x7b\x39\xd0\xec\x88\xfc\xd3\x3b\xcc\x94\x7d\x3d\x9d\x05\x2c\xcc\x24\x4b\xa0\x24\x08\xc6\xf1\xda\xf6\x31\x84\xc0\x3e\x01\xe6\x6b\xf0\xe6\xcd\x87\xfb\x2b\x68\x2d\xdf\xa1\x9a\xd6\xb5\x74\xdc\xb5\xac\x6d\x9e\x3f\xc6\x98\x59\x21\x6b\xe7\xaa\xb5\x26\xf5\x17\xaa\x54\x1d\x72\xdf\x53\x8f\xfe\x0c\xec\x1e\x4b\x0d\xea\xf0\x43\x1c\x87\xcb\x6c\xfe\x84\x10\x7c\xfd\xfa\xf8\x78\xa0\xd4\x34\x14\x3f\x08\x41\x57\x55\x3e\x21\x08\x1f\x4f\x15\x59\xf4\xcf\x45\x65\xbb\xdd\x43\x42\xf8\xf4\x52\x68\xad\x22\x69\x97\x36\xbf\xd0\x97\x94\xbb\xd6\x81\xf7\xf2\x9e\xa9\x07\xf1\x5f\xe3\x93\xcb\x7d\x22\xde\x78\x5b\x6a\x3b\x0c\xca\x97\xa5\x6e\xe1\x95\x8f\xc1\xb5\x37\xa8\x87\xce\x67\x8e\x8e\xbb\xf8\xc1\x15\x24\x31\x9c\x93\x58\x49\xfe\xc6\x28\xee\xfd\xbe\xdc\xd8\x7a\xad\x87\x57\xd3\xca\xd6\x7a\x95\xa2\xb3\xb1\xa5\xb5\xd2\xb3\xea\xea\x35\xca\x22\x36\x56\xd6\x7a\xa7\x77\xaf\x46\x99\xee\xef\xad\x9c\x65\x12\x07\x52\x21\xe0\x94\xaf\x0d\x55\x56\x30\xf2\x73\xb4\x7e\xbc\x97\x57\x88\x85\x24\x14\xaa\xca\x3d\xa8\xac\xb7\xfe\x6a\x1b\x00\xdb\xc6\x50\x13\xdb\xd1\xe3\xe0\x90\xab\x88\xa9\x3d\x52\x19\xf7\x9b\xfa\x6c\x53\xdf\xb2\x82\xb6\x7d\x56\xb7\x19\x78\x66\xa6\xb2\x13\x2e\xcf\xd4\x9b\x5c\x46\x34\xb7\x7c\x97\xba\xf5\x77\xe6\x4e\x76\x67\x83\x46\x9f\x06\x3d\x5c\x7a\x6c\x7f\x98\x69\xeb\xc1\x56\x13\x48\x93\x40\xd4\xdd\x2a\x26\x42\x6e\x73\xaa\x32\xd8\x55\x81\x47\x2c\xa3\xb2\x19\xb5\x9e\x6d\x62\x4c\x23\xb9\xd4\xdd\x3f\x6d\xb9\x2f\x5a\x5f\x36\x76\xf2\x49\x35\x6c\x74\x79\x5e\x46\x94\xc3\x53\x6f\x0a\x8e\xa7\x46\x2f\x9f\xd0\x10\x7f\xfd\xdb\xa1\x11\xd8\x52\xc4\xb0\xc1\xb1\x6e\xe8\xee\xd1\xb5\xc6\xe9\xc5\x9d\xdb\x2d\xb4\x7a\xcf\x36\x15\x1e\xb6\xc7\xa8\xa3\xf7\x9f\x44\x94\x71\x1c\xa0\x25\xa4\x11\x36\x1d\x50\xe5\xca\xbd\x69\xc7\x0e\xda\x7e\xb4\x81\x80\x52\x6c\xdc\x3d\x05\x95\x7e\x7e\x23\x03\x4b\xd1\xb8\x56\x8f\x2c\xed\x9e\xa8\x31\x3e\xda\xa5\xcd\x8e\x61\x65\x94\x85\x8f\x35\xef\xae\x88\xe4\x4c\xad\xe2\xc2\x4d\x99\xfe\x4b\x9f\x84\x2d\xa3\xbb\x07\x28\x5a\x9f\x50\xe1\xb7\x32\x72\x05\x09\x4c\x53\x95\x54\x75\xe7\x53\x19\x6b\x26\x7b\x00\x7c\x23\x69\x02\xd3\xe7\xf5\xc8\xd3\xa1\x76\x47\x00\x9a\x82\xc1\xa7\x94\x7a\x2f\x26\x7b\x83\x3a\x6a\x73\xfa\x69\x5a\x96\xc6\x5c\xd1\xb6\x0c\xad\xc6\xef\xc7\xf4\xd6\x2d\x19\x97\xc1\x68\x72\xe5\x9f\x8d\xaa\x6a\xb0\xa4\x3a\x3c\xed\xb0\xfc\x55\x8a\x3c\xcd\xce\x9a\x74\x2b\xa0\x56\xeb\x1c\x27\x35\xaf\x37\x99\x62\x0a\x29\x5a\x3b\x52\x2b\x5a\x91\x60\xaa\xad\x32\xa4\x22\x58\x32\x21\x29\x4c\x74\xf4\xd2\x9d\x3c\x63\xa2\xa5\x52\xab\xaf\x37\xb6\x5e\x6d\xa8\xe0\x13\x8d\x0b\x5d\xce\x94\x0c\x5d\x67\x12\xdd\x1e\xed\x16\x31\xbb\x0d\x62\x16\xa9\x2a\x6a\x6e\x6f\xa3\xc5\x2c\xb2\x85\x73\x79\xcf\x4b\xd1\xa2\x98\x65\xe1\x2d\x94\x68\x19\x14\x24\xfe\x7c\x1e\xbb\xee\x7b\x00\x8a\x2b\x0a\x90\xd3\x6a\xa6\x2b\xae\x01\x38\x71\xc2\xde\x2f\x68\xe5\xc7\xbe\xe4\x28\x39\x5c\x2c\x08\x72\x4d\xc0\x33\xe0\x5d\x5e\xfc\xfb\xe2\xed\x75\xc7\x92\xba\xd4\xac\x2e\x4f\x69\x1b\xa4\x1c\x2f\xc8\xd7\x4a\xe3\x65\xc5\x64\xf3\xfd\x98\x45\xee\x55\xeb\xb6\x0b\x71\xc5\x6a\xb6\xdc\x8a\xdb\x57\x44\x8a\xa1\xd8\x37\xd7\x2f\x1e\xec\x6a\x9b\xbb\x5a\x36\x7c\x09\x6d\xf8\x8a\xdb\x2a\x45\xa5\xe2\x43\x97\xdd\x7a\xef\xd4\x8d\xbb\xe4\x56\x81\xe1\xee\x98\x96\x37\xde\x7a\x2e\x9e\x94\x16\xe7\xde\xba\x3f\xec\x5d\x38\x25\xca\x5e\x9e\xfa\xc0\x22\x7d\xe9\xab\x7a\xcb\xa9\x3e\x7d\x25\x39\x86\x49\x6b\xfe\x53\x26\x3f\xb0\xe8\x62\x85\x69\xfd\xc2\x97\x9e\x74\x37\xbe\x1c\xf7\xad\x14\x46\x80\x70\x7b\xf6\x65\xd8\x36\xba\x6e\x54\x6d\xdb\xc1\x9b\xc4\x76\x5c\x7b\xc5\x6f\x9b\x32\x5a\xde\xe0\x75\xc0\x99\x84\xf6\xf3\x49\xb3\xe5\xdb\x3e\xa2\xc2\x45\xf7\x2d\x60\x33\xef\xbb\xff\xbb\x4b\x49\xff\x0f\x00\x00\xff\xff\xe5\xce\x51\xcc\x8e\x3d\x00\x00")
+var _templatesAcm_certificateTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2b\x4b\x2c\xca\x4c\x4c\xca\x49\x55\x50\x4a\x4c\xce\x8d\x4f\x4e\x2d\x2a\xc9\x4c\xcb\x4c\x4e\x2c\x49\x8d\x4f\x2c\xca\x53\x52\xa8\xe6\x52\x50\x28\xa9\x2c\x48\x55\xb0\x55\x50\x2a\x2e\x29\xca\xcc\x4b\x57\xe2\xaa\xe5\x02\x00\xce\x7f\xe6\xc6\x35\x00\x00\x00")
+
+func templatesAcm_certificateTfBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesAcm_certificateTf,
+		"templates/acm_certificate.tf",
+	)
+}
+
+func templatesAcm_certificateTf() (*asset, error) {
+	bytes, err := templatesAcm_certificateTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/acm_certificate.tf", size: 53, mode: os.FileMode(420), modTime: time.Unix(1792360848, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesBaseTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x5b\xdd\x6f\xdb\x36\x10\x7f\x6e\xfe\x0a\x41\xd8\xc3\xda\xd9\x6a\x92\xb5\x59\x16\xac\x0f\x69\x9b\x75\x1d\x86\x36\x48\x8a\xbe\x14\x85\x40\x4b\xb4\xcd\x55\x12\x05\x92\x72\x92\x1a\xfe\xdf\x77\xfc\xb2\x3e\x69\xd9\x71\x9a\xc6\xe8\x52\x20\x8d\x75\xc7\xbb\xe3\x8f\x77\xc7\x23\x75\x66\x98\xd3\x82\x45\xd8\xf3\xd1\x15\x0f\x31\xc9\x7d\xcf\xff\xb7\x48\xf3\x11\xbd\xd6\x9f\xe6\x7b\x9e\x17\xe3\x1c\x67\x31\x0f\x69\xe6\xbd\xf0\x3e\xf9\xf3\x79\xf0\x36\x13\x98\x65\x58\xbc\x41\x02\x5f\xa1\x9b\xc5\xc2\xff\x0c\x7c\xb3\x3c\xf2\xd4\xcf\x0b\x4f\xb0\x02\xef\x2d\xf6\xf6\xd8\x52\xbe\x48\x78\x98\x33\x32\x83\x11\xe1\x17\x7c\x03\x7a\x46\x94\x4f\xc3\x59\xca\xb5\x12\x94\x4c\x28\x23\x62\x9a\xc2\x68\xff\xe2\xf2\xd4\x87\x67\x8c\xa3\x70\x44\x04\x87\x47\xcf\xf6\x7f\x3f\xaa\x0b\x94\x06\x83\xa0\x30\x47\x84\xb5\xa4\x49\x42\x86\x52\x2c\x85\xfd\x34\x9f\x21\x16\xe0\x6c\x16\x92\x78\x11\x2e\xf9\x80\x2b\x2f\x46\x09\x89\xa4\x14\xcd\xd7\xb0\x31\xb0\xbc\x41\xc9\x18\x52\xc0\x82\xf3\xe9\xc2\x97\xd6\xd0\x42\xe4\x85\x28\x95\x87\x56\xaf\xb6\x62\x86\x92\xc2\x98\x50\xb5\xb6\x94\x6b\xd9\x1d\xd2\x6a\x78\x35\x04\xba\x6d\x2d\x1f\x86\x39\x4e\x17\x72\xa2\x1c\x6c\x26\x82\xcc\x70\x65\x69\xac\x36\x7c\x2d\xd7\x12\x25\xa1\x5d\xee\x86\xd5\xe0\x06\x41\xc5\x25\x2c\x16\x24\xaf\x1b\x6d\x59\x0a\x96\x68\x31\x1b\x08\x3a\x39\x3c\xac\xc9\x8a\x09\xc3\x91\xa0\x2c\x44\x71\x0c\x0b\xce\x1b\x76\x4d\x85\xc8\xf9\xc9\xd3\xa7\xfd\x62\x9f\xc3\x8f\xdf\x76\x1b\x82\xd2\x90\xd1\x04\x1b\xb7\xd1\xe2\x57\xb8\x8b\xe2\x95\xfe\x82\xc4\x54\xb2\x3c\x95\x1f\x12\x32\xc6\xd1\x4d\x94\x60\x33\xdb\x88\x61\x09\xfb\x08\x8f\x29\xc3\x61\x8c\xb9\x60\xf4\xc6\xe2\xed\x79\x60\x04\x38\x39\xe7\x45\x8a\x95\xbc\x30\xa7\x60\xa6\x64\xf8\xe3\x8f\xb3\xf7\x7f\xee\x49\x21\xfe\x47\xcc\x38\xa1\x99\x7f\xe2\xf9\x87\xfb\x07\x87\xc3\x83\xfd\xe1\xc1\x6f\xfe\x40\x92\x2e\x05\x48\x4f\x71\x26\x80\xf8\x49\x29\xd4\x6a\x81\x74\x1a\x09\x33\x88\x0b\x7e\x72\xaa\x74\x5c\x48\x93\x07\x96\xe3\x9c\x91\x2c\x22\x39\x4a\x80\xc9\x0e\x93\x32\x31\x9b\x91\x08\xcb\x91\x38\x3a\x0c\x50\x8a\xbe\xd2\x0c\x00\x0a\x22\x9a\xfa\x86\x6d\xb1\x14\x72\x36\x86\x09\x4b\xf5\xfe\x69\x92\xd0\xab\x52\xfa\x25\x89\xe5\x53\x3d\x62\x01\xbf\x3f\x03\xe4\x72\x4e\x9d\xc0\xeb\x79\xb7\xa1\xf7\x1c\xe0\x1b\x7e\x0b\xbf\xb7\x5c\x80\x6f\x00\xe0\xa7\x12\x1b\x00\x44\x42\x49\x23\x02\xc3\x4e\x8d\x1f\x0e\x1a\x74\x21\x50\x34\xfd\x48\x13\x00\xbc\x49\x7b\xa5\xdc\xa1\x9b\xf6\x1a\x27\x58\xe0\xcb\x0c\xe5\x7c\x4a\x45\x37\xd5\x35\x92\x47\x8c\x8c\xac\x41\x98\xbb\x18\xde\xa6\x68\xb2\x82\x9a\x71\x81\xb2\xc8\xcd\x70\x81\x27\x80\x88\x93\x7c\x89\xa3\x02\x92\xf5\xcd\x1b\x46\x8b\xdc\xcd\x65\x26\xe8\x66\x28\x46\xb0\x89\x38\xc9\x1a\x82\x0e\x72\x1f\xea\x2e\x64\x35\xf5\x03\x9a\xb4\x64\x5e\x14\x99\x13\x93\x0f\x98\xa5\x24\x83\x81\x4e\x0e\x89\x16\x87\x2c\xaa\x40\x6f\x9b\xcb\x6a\xe4\xbd\x47\x10\x20\x03\xf9\xbb\x23\xa2\xe4\xd3\x0b\x13\x32\xf2\xf9\x13\x13\x54\x40\x99\x2b\x62\xc5\x55\x1f\x29\x15\x10\x52\x27\xe7\x90\x57\x54\xc0\x6f\x2a\xfb\xd1\x0a\xc1\x38\x41\x5c\x90\x28\xa1\x28\x1e\xa1\x04\xe6\x4d\xb2\xc9\xc9\x93\x5b\xa8\xe8\x4b\x08\x95\x6c\x18\x22\x15\x51\x2a\x4a\xab\x09\x42\xb2\xf4\xe5\x66\x23\x80\x65\xe5\x8e\x53\xa6\x1b\xb5\x3d\x06\x40\x5c\x38\xb6\x03\x62\xd6\x16\xf6\x54\x3a\x26\x8d\xad\xa1\x54\x5f\xb5\x59\xcb\x74\x6c\xdf\xdd\x32\x3b\xb6\xd7\x2e\xc6\xa6\x64\x98\x34\x41\xa3\x44\x9a\x1b\x81\xfb\xf1\xb2\x20\x10\x37\xb9\x92\x05\x9b\x0d\x2c\x4f\x83\x99\x63\xd8\x93\xc4\x9a\xcc\x4c\x45\xbc\x93\x11\x2c\x9b\x91\x18\x33\x85\x98\xa9\xd8\x96\xb6\x94\x4b\x53\x3e\x33\x75\x87\xb5\xa0\x64\x29\x9f\x29\x16\xad\xd7\x94\x8d\x86\x45\x3f\xd3\x73\x9f\xcf\xc9\xd8\xcb\xa8\xf0\x82\xb3\x6b\x88\x22\xb0\xe7\x1d\x16\x57\x94\x7d\x79\xfb\x7a\xb1\xa8\xaf\x63\x8c\xc7\xa8\x48\x44\xc8\x4d\x76\x0a\x27\x32\x3d\xc1\x4a\xba\x08\xe0\xf9\x50\xb3\x82\x2f\x49\xd5\x50\xd6\x7e\x3c\x7f\x25\xa5\x1a\xbd\x50\xf3\x36\x35\xb4\x24\x93\xcc\x14\x4f\x6d\xd1\xb2\x6e\x96\x79\x2c\x97\x71\x65\x14\xbc\x35\xec\xaf\x4b\xca\x42\xa1\x60\xcc\xb0\x30\x54\x6d\x91\xeb\x01\x19\xcb\xec\x56\xef\xba\xaa\x94\xa1\x35\x63\x68\xcd\x18\x6a\x33\x54\xdd\xd1\xf2\xf7\xba\xad\x21\x2b\x94\xbb\x3b\xa6\xa2\xc8\xa1\x88\xcc\x9c\x1a\x44\x6b\xb3\x57\x3a\x74\x9d\x23\x70\x88\x0d\xc0\x6c\xdf\xba\x5a\xd7\xcf\x0b\x69\xd1\x44\xed\xba\x32\xba\x19\x15\x34\xa2\x49\x17\x9b\xb4\x0d\x58\xc6\x8c\xca\x68\x67\xa2\xcd\xb2\x2f\x15\xd1\x4e\xa2\x24\x1f\x3d\x7f\xfe\xeb\x73\x35\xb7\x64\xec\xb0\xa5\x7d\x9e\xb9\x25\x90\x45\xfc\x70\x81\x94\xb6\xed\x0c\x90\x24\x4a\x1f\x2e\x92\xca\xb8\xd5\x50\x0e\x0f\x56\x63\xa9\xe8\x11\x89\x59\x38\x4a\x68\xf4\x85\x37\xe9\x9f\xfc\xfd\x40\xfd\x7b\xba\xef\x7f\xbe\x13\x44\x91\xdc\xc4\x43\x62\x8e\xf6\xf7\x8f\x2d\x5e\x0b\xda\xe1\xc1\x76\x3e\xba\x7f\xdf\xb0\x72\x5b\x45\x3c\x40\x3f\xfd\xf0\xea\xbc\x07\xcd\xc3\xc3\xd5\x70\x2a\xba\x46\x28\x6c\x4f\xd0\x35\x33\x73\x5e\xd7\x33\xa9\x94\x4e\x2b\xb7\x53\x55\x38\xbd\xb8\x05\x54\xf5\x0a\x4a\x7a\x39\x8e\x43\x83\x50\x28\x7d\x81\x57\xca\x1e\x0d\x4c\x02\xa5\x86\xaf\xb6\x70\x55\x39\x6c\xea\x18\xf6\x3e\x6a\xbd\xaa\xe0\xe5\xfb\xcb\xbf\xee\xba\x22\x90\xea\x5d\xd5\x40\xad\x50\xdd\x14\xe9\x8e\x41\x4b\x94\xcb\x5a\xed\x5c\x5f\x42\xbd\x36\x17\x39\xab\xeb\x28\x1b\x46\x1d\xa2\x97\xd5\xc7\x16\x61\xe4\xb4\xf8\x9e\xaa\x8f\xb5\x42\x68\x65\x4a\x32\xb5\x75\x97\xe7\x2e\xd6\xcf\x52\x2b\xe1\x55\x44\x38\x9e\x66\x62\x47\x51\x3e\x3a\x3e\x3a\xee\xa9\x4e\x34\xc7\xf7\x44\xba\x40\x68\x47\xe1\x3d\x7e\xf6\xec\xd7\xd5\xf0\x1a\x8e\xef\xed\xc8\xe5\xdd\x71\x4e\x76\x35\x61\xc8\x6b\xeb\x9e\x9c\x61\x58\xb6\x44\x7b\x8d\x43\x6e\x2f\xe8\x66\x2f\xdf\x51\xac\xd7\x3d\xd1\x6c\x5b\xe1\x6c\xeb\xd7\xff\xc3\xbb\x51\xbd\xb7\x5d\x96\x8e\x1f\x26\xdc\x77\x77\x3e\x7f\x50\x70\xdf\xc9\xb9\xf3\x96\xc8\xef\xde\x99\xb3\x7c\x41\xdf\x79\x9c\x40\x85\xa0\x29\x12\x24\x02\x54\x6f\xcc\x3b\xc9\xd8\x33\x23\xbc\xd1\x8d\xf7\xf2\xe5\x3f\x77\x70\xbc\x30\x02\xfb\x4e\x18\xf6\xbd\xec\xa6\x87\x8c\xdb\x64\xcf\xa5\xae\x5b\x1f\x14\x6a\x5a\x7f\xb0\xc3\x81\x45\x6f\x9b\x23\xc0\xf7\xc0\xef\x21\x95\xfd\x16\x43\x88\xba\x78\x5a\x8c\x76\x08\xc5\x63\x28\xde\x7b\xaa\x7b\xcd\x71\x8f\x28\xda\x42\x7e\x97\x82\xf9\xde\x0a\xf7\x4d\x80\x34\x7b\xdc\x37\x87\x71\x77\xef\x6f\xf5\x1b\xdb\x56\x8d\xf3\x03\xbd\xf9\xda\xb4\x20\x5c\x75\x0b\xb8\x2d\xe2\x3f\xc6\x2b\xb2\xbb\x44\xbc\xbc\xdd\xce\xf4\xbb\x71\x95\x2b\x5a\x97\xda\xf6\x75\x7e\xf5\x5a\xdb\x3f\xb0\xf1\x72\x70\xd4\x90\xa5\xaf\x04\x67\x88\x24\x68\x44\x12\xa9\xf5\x2b\xcd\xb0\xb3\x43\xa0\xb1\xec\xaa\xb5\x67\x59\xf6\x9b\x4f\xf3\x46\xe5\xd9\x59\x7f\x56\xe3\xbb\xb6\xe2\x2a\x01\x2a\x49\x3f\xcb\xfc\x58\x9d\xeb\xc0\x3b\x1e\x78\xfb\x8f\x37\xba\x1c\xd7\x36\x75\xbf\x22\x07\x78\x05\xc4\xbe\x04\xc2\xce\xa1\xf6\xa8\x32\x91\x76\xeb\x40\x87\x28\xa7\x10\xd9\x27\x28\x9b\x8b\xa0\x7c\x0f\x2b\xd3\x06\xa1\x65\x1e\x03\xb6\x89\x6e\xb4\x2d\xf5\x35\x3a\x70\x2d\x72\x15\xf9\x35\x67\xaa\x3c\x0f\x9a\x86\x38\x02\xb7\x2a\x0a\x99\x46\x38\xd5\x21\xe2\x6b\x4a\x65\x61\xed\xe6\xa2\x3e\x54\x8f\x15\xc6\x93\xd5\xf3\xa0\x32\xc0\x46\xe4\x56\xe6\xd6\x5f\x63\x58\xdd\x9d\x4d\x36\x2e\x0b\x1c\x52\x1c\x4e\xdf\x2f\xb4\x35\xb0\xf5\xf6\xa9\xc9\xc0\xeb\xf1\xa4\xdf\x3b\xb9\xa3\xa9\xcc\x70\x55\xe0\x23\x5a\x64\xa2\x99\xaa\x7e\x9a\x27\x38\x9b\x88\xa9\x8a\x95\xb6\xde\xc7\xad\xb7\x4c\x77\x18\x8c\xcf\x06\xda\x26\xc8\xc8\x31\xbe\xfe\xe5\x40\x2b\x6b\x19\xa1\x25\xe1\x44\xb5\x65\x3a\xec\xac\x49\x7a\xbc\x71\x3f\x8c\xb2\x10\xac\x2d\x65\x2c\x7c\xdb\x8d\xdb\xec\xe0\x25\x93\x4c\xb6\xee\x46\x53\x94\x4d\x30\x57\xc5\x44\x39\x79\x7f\xd0\xb1\x7a\xaa\xed\x7d\xd1\xf9\x06\x13\x7d\x2d\x7d\x32\x4c\x51\x9e\xcb\x3c\xa9\x3a\x8e\x4a\x2f\x92\xfd\x72\x5f\x49\x0e\xd4\x9f\xeb\x3e\xd5\x5c\xe6\xe0\x49\x97\x6b\x0d\xbc\xde\x51\xd2\xcb\x1f\xef\x3d\x72\xbc\x65\x2d\x6d\x54\xf3\xfc\x6e\x56\x96\x28\x57\xac\x2d\x83\x46\xaf\xea\x3a\x0d\x6f\x53\xd8\x75\xc3\x1e\xf6\x7a\x64\x8d\xe5\xdd\x53\x42\x27\x32\x41\x8f\x4c\xe7\x3a\x7c\x34\xfb\x71\xd9\x13\x2e\x79\xa3\x84\x16\xf1\x15\x12\xd1\x34\x5c\xb2\x04\x30\xca\x76\xea\x81\x07\xd9\x76\x46\xd9\x86\xe8\x75\xb4\x0c\x5a\x75\xdc\xf4\x22\xb6\x22\xb0\x15\x7e\x82\xa1\xf1\x98\x44\xa1\xd9\xc7\xe5\x97\x23\xce\xfe\x3e\x7b\xf5\xa1\x63\x2e\x5d\xf6\x55\xe7\x25\xcd\x0c\x73\x86\xc7\xe4\xba\xd2\x8e\x57\xc1\x6c\x31\x84\x71\xf6\x2a\x67\x55\xd7\xfc\x72\x1a\x2b\x5a\xe7\x87\x92\x49\x0a\xe4\x43\xdd\xa3\xf9\xcd\xfa\xdf\x6d\xff\x79\x7f\xa7\x7a\x7f\x1f\x3c\x2c\x47\x69\x78\x5f\x47\xbc\xb3\xf1\x7e\xbd\x4e\xf8\x0a\x0c\x9b\x63\x5a\xb6\xc5\x3b\xba\x53\x4b\x57\x53\x5b\xdd\x37\x6f\x98\x97\xaa\x4c\x87\xf5\x3f\x74\xa2\x3a\xc3\xab\xad\xd0\x75\xf2\xa5\x80\xbf\xd2\x16\xfd\xbc\x10\x40\x3c\x9b\x81\x52\xde\x22\xda\xb6\x70\x2b\x7d\x25\x87\x56\xc0\xed\x9a\x7d\xee\xf7\x8d\xae\xb6\xeb\x55\x2b\xf8\x25\x35\x7d\xb8\xfe\xf2\x2f\x89\x0f\xce\x54\x35\x23\xbf\x7d\x03\xe7\x08\x64\xee\x65\x9b\x5f\xba\x31\x43\x64\x9e\xe8\xfe\xaa\x90\xa6\x07\xf6\x7f\xdb\xb9\xfc\x1f\xf8\x2c\x74\xaa\xb0\x35\x00\x00")
 
 func templatesBaseTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -88,12 +113,12 @@ func templatesBaseTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/base.tf", size: 15758, mode: os.FileMode(420), modTime: time.Unix(1508261740, 0)}
+	info := bindataFileInfo{name: "templates/base.tf", size: 13744, mode: os.FileMode(420), modTime: time.Unix(1792369804, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesCf_dnsTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x91\xc1\x6a\xf3\x30\x0c\xc7\xef\x79\x0a\x61\xbe\xd3\x07\x35\x85\xb2\x63\x0f\x65\xec\xb8\xbe\xc0\x18\xc6\xb1\xb5\xc6\xc3\x89\x8d\x65\xa7\xeb\x8a\xdf\x7d\x38\xc9\xa0\xdd\xc6\xc8\x20\xbd\x25\x46\xfa\xff\xf4\x93\x7a\x19\x8c\xac\x2d\x02\xa3\x13\x45\x6c\x85\x76\xad\x34\x1d\x83\x73\x05\x10\x4f\x1e\x61\x0b\x8c\x62\x30\xdd\x81\x55\xb9\xaa\x02\x92\x4b\x41\x21\x30\x79\x24\x11\x5c\x8a\x78\xb7\x11\xef\xae\x43\x06\x0c\xbb\x5e\xe8\x8e\xa6\xdf\x92\xd0\xc9\x76\x48\xf8\x77\xee\x65\xe0\x57\x88\xcc\xaa\x82\x90\x07\x1a\x2a\x01\xf6\x57\xb5\x25\xcb\xe8\xbc\x6a\x1c\x45\xd4\xab\x21\xb2\x02\xc8\x65\x08\x97\xa2\x4f\xf1\x9a\x27\x0a\x4a\x10\x86\x1e\x03\x8d\xf0\x5e\xda\x34\x25\x7e\x1d\x96\x5f\xb6\xf2\xcb\xd6\xfc\x8b\x66\x40\xe5\x82\x66\xc0\x8e\xc6\x6a\x25\x83\x2e\x11\x23\x6b\x18\xc1\xe8\x39\x34\xa3\x33\xfb\x5c\x0d\x40\xe9\xf8\xcf\x7f\xde\xcf\x74\x81\xb1\xe8\x7e\xbf\x7b\x7c\x18\xde\xa2\x85\xf1\x6d\xb3\x5e\x97\x1d\x8e\x63\x11\x6c\xe1\x69\x82\xa3\xad\xb9\x7a\x19\x67\x08\xc2\xd6\xbc\xc0\x0b\x30\xb3\xe7\x19\x7a\x44\xcd\x02\x56\x44\xcd\x8d\xbc\x88\x9a\xbf\x4b\xd5\x6e\x11\xab\x12\x33\x47\x6b\x37\x57\xc9\x78\xfe\x9a\x5a\x5f\xbb\xb7\xe1\xdb\xa7\xda\x1a\x25\x8c\x9f\x67\x15\x95\x5f\x40\x2a\x2a\x7f\xa3\x53\x45\xe5\xbf\x9f\xea\x23\x00\x00\xff\xff\x30\x40\x15\x44\x75\x04\x00\x00")
+var _templatesCf_dnsTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xad\x91\x4d\x4b\xc4\x30\x10\x86\xef\xfd\x15\x21\x78\x12\x36\x2c\x2c\x1e\x3d\xac\x1f\xe0\x41\x7b\xb0\x47\x91\x90\x26\x71\x37\xd2\x36\x21\x49\xbb\xae\x25\xff\xdd\x49\x53\x61\x2b\xe2\x56\x68\x4f\x69\x3a\xf3\x3e\xf3\x4c\x3b\x66\x15\x2b\x2b\x89\xb0\x3b\x3a\x2f\x6b\x2a\x74\xcd\x54\x83\x51\x9f\x21\xe4\x8f\x46\xa2\x6b\xf8\xe4\xad\x6a\x76\x38\x0b\x59\x66\xa5\xd3\xad\xe5\x50\xcf\x0e\x8e\x5a\xdd\x7a\x79\xb5\xa1\x9f\xba\x91\x18\x61\xd9\x74\x54\x34\x6e\x7c\x8d\x09\x0d\xab\x87\x84\x8b\xbe\x63\x96\x4c\x10\x01\x67\x11\xc1\x76\x6e\xa8\x44\x28\x9f\xd4\xc6\x2c\x25\xc2\x6a\xaf\xa1\x47\xac\x86\x48\x28\x0b\x71\x08\xa0\x9a\xd6\x4f\x79\x34\xa2\xa8\x93\xb6\x93\xd6\x25\x78\xc7\xaa\x76\x4c\xfc\x39\x2c\x39\x6d\x25\xa7\xad\xe1\x0f\x4d\x2b\xb9\xb6\x02\x44\x0f\xaa\x12\x9c\x59\x11\x23\x12\x6b\x18\x41\x89\x39\x34\xb0\xc2\xdf\xab\x81\x07\x3a\x2e\xc9\xef\xfb\x19\xff\x40\x2a\xba\xcd\xb7\x4f\xf7\xc3\x9d\xaf\x50\xba\xdb\xac\xd7\x71\x87\x69\x2c\x07\x17\x2f\xb8\xef\xc9\x73\x24\xdb\xc7\x9b\xbb\xbc\x88\x2b\x0d\x01\xbf\xce\x50\x72\x6e\xbf\x80\x09\xa4\x2c\xea\x52\x14\x0f\xff\x15\x29\xf5\x22\x26\x31\x66\x8e\xca\xf6\xac\x46\x62\x4b\x65\xc8\x7b\x5b\x9b\x52\x7f\x0c\x67\xd3\x96\x95\xe2\x54\x99\x79\x56\x9e\x9b\x05\xa4\x20\x65\xb9\xdf\x33\x7a\x55\x25\xe1\x6f\x14\x92\x29\x9c\x22\x3b\xf2\x92\xd4\x17\x78\xb6\xb1\x56\x5d\x04\x00\x00")
 
 func templatesCf_dnsTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -108,12 +133,12 @@ func templatesCf_dnsTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/cf_dns.tf", size: 1141, mode: os.FileMode(420), modTime: time.Unix(1507852860, 0)}
+	info := bindataFileInfo{name: "templates/cf_dns.tf", size: 1117, mode: os.FileMode(420), modTime: time.Unix(1792360829, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesCf_lbTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\xdc\x5b\x6b\xe3\x46\x14\x07\xf0\x77\x7f\x0a\x61\xfa\x54\x88\xeb\xb1\xee\x05\xbf\x74\xfb\xd0\x42\x29\x4b\x77\xdf\x4a\x11\xb2\x3c\x89\xc5\x2a\x92\x99\x19\xbb\x6c\x83\xbf\x7b\xd1\xd5\xce\xc6\xd6\xe5\xbf\xff\x25\x69\x53\xfa\x10\x49\x67\xe6\x8c\x74\xf4\xd3\xb1\x89\x56\x49\x5d\x1c\x54\x22\xad\x79\xfc\xb7\x8e\xb4\x4c\x0e\x2a\x35\x9f\xa3\x07\x55\x1c\xf6\x73\x6b\x9e\xdc\x47\x5a\xef\xa2\x6c\xf3\x62\xd7\xd3\xcc\xb2\xb6\x52\x27\x2a\xdd\x9b\xb4\xc8\xad\xb5\x35\x7f\x7a\x5a\x7c\xf8\xf0\xcb\x6f\x3f\xfd\x7c\xde\x7c\x3a\xcd\x67\x96\x75\xdc\x27\x51\xba\xb5\xaa\x9f\xb5\x35\xff\xee\xa9\x9c\xeb\xb8\x4f\x16\xe5\xff\xe9\xf6\x34\x9f\xcd\x2c\x2b\xcd\x1f\x94\xd4\xba\x1a\xd8\xb2\x92\x74\xab\xa2\x4d\x56\x24\x9f\xb4\xb5\xb6\xfe\x9c\x2f\x17\xd5\x7f\x3f\x2c\xe7\x7f\x55\xfb\xf7\xaa\x30\x45\x52\x64\xcd\x90\x26\xd9\xcf\xab\xed\xf7\xaa\x78\x8c\xf6\x85\x32\xd5\xf6\xd5\x6a\xb5\xaa\x36\x9b\xa2\xdd\x78\xb1\xf9\x54\x4e\x2b\x2f\x67\x3d\x47\xaf\xad\xe5\xb3\xc0\xf6\xf7\x6e\xde\xb5\x35\xbf\x13\xf3\x11\xb9\x56\xb3\x98\xf8\xa1\x9d\xe3\xf7\xf8\x51\xd6\x67\xe1\x18\xab\x85\xcc\x8f\x51\xba\x3d\xdd\x25\xf7\x77\x5a\xef\xee\xb2\xcd\x5d\x7b\xa2\xef\xea\x13\x5d\x8d\x70\x9a\xcd\x8a\x83\xd9\x1f\xcc\xd0\x15\x39\xc6\xd9\x41\xae\x9b\x33\xfc\xfc\x80\xc5\xad\xc8\xfa\x0a\x9c\x66\xb3\xd1\xb5\x90\xe6\x46\xaa\x3c\xce\xa6\x14\xc5\xaf\x4d\x0c\xa3\x38\x9e\x4f\x5b\x9f\xf4\xe9\x4b\xfe\xbf\x17\x52\x7b\x95\xc6\x57\x54\xef\x75\x1d\x57\x5a\x37\x86\xb8\x51\x63\x32\xdb\x5c\x16\x56\x3d\x51\x5e\xae\xeb\xea\x4f\xb7\x58\xbd\x2b\x94\x89\x5e\x2c\xb9\x5c\x5a\xa2\x0a\xad\xa3\x7f\x8a\x5c\x46\x59\x11\x6f\xa3\x4d\x9c\xc5\x79\x92\xe6\x0f\xd6\xda\x32\xea\x20\xcb\x93\xb8\x93\x71\x66\x76\x51\xb2\x93\xc9\xa7\xe6\x64\xd6\x9b\x3e\x47\x66\xa7\xa4\xde\x15\xd9\xb6\x9a\xce\xad\xf6\x1d\xf2\x97\x7b\xd7\x56\x5d\x0f\xd5\x7a\x8f\x71\xf6\x3c\x4d\xaf\xbe\xe4\xb1\x7a\x90\xe6\xc5\x12\x3e\xbe\x7b\xff\x63\x59\x38\xf5\x35\x37\xe9\xa3\x2c\x0e\xe6\x8b\x83\xba\xaa\xca\x52\x6d\x64\x2e\x55\x93\x66\x9a\x6b\x13\xe7\x89\xbc\x52\x84\x97\x3b\x2f\x6a\xab\x2b\xe8\x6c\x73\x0e\xb2\xbe\x0c\x2d\x77\x5e\xdc\x08\xcf\xee\x85\x2a\x0f\xde\x2d\xa7\x0f\x9b\x5c\x1a\x7d\x91\x45\x37\x52\xb5\x67\x51\x86\xd6\xc7\x2c\xbe\x6f\xa2\xae\x56\x6b\x59\x27\x17\xa5\x79\xc6\x43\x66\x9b\x73\x1a\x8b\xf2\xb0\xba\xf6\x5e\x0e\x71\x50\xd9\x88\x11\xb6\xb9\x8e\xce\xa3\x0c\x2b\xa9\x8a\x83\x91\x6a\xfc\x43\xf3\x8f\xea\xf8\xb7\xf3\xd4\x0c\x96\x57\xa8\xab\x36\x9e\xbe\xd5\x94\x8e\x63\x5f\x99\xb3\xde\xfa\x0d\x27\xbd\x31\xeb\x79\xda\xb7\x83\x7a\x5d\x54\xe3\x1a\x84\xfe\x02\x1c\x80\xfc\x56\xf0\x84\x36\xe1\x3c\xc4\xc4\x4e\xa1\xbe\x13\x5e\xab\x55\xe8\x5d\x39\xf1\x06\x7a\x8b\x45\x35\xa1\x59\x18\x79\x75\x47\x97\x19\xd8\x32\x74\x03\xe0\x5d\x43\xb7\xfc\x37\xd3\x38\x88\xd5\x50\xe7\x10\x2c\x59\x7d\x43\x53\xa5\x57\xbb\x86\x9d\x31\x3d\x6d\x43\x13\x79\xb5\x69\x68\x23\xc7\x65\xd1\x97\xc6\x50\x1e\x17\x8f\x8d\x97\x99\xb4\xc1\xba\x8e\xd6\x3a\x8b\x12\xa9\x4c\x7a\x9f\x26\xb1\x91\xa5\x22\x1d\x20\x69\xfc\x18\x69\xa9\x8e\x52\x5d\x1e\x52\xb6\x21\xe5\xaf\x8b\x58\xe5\x27\xde\x82\x7a\xda\xb1\xcb\x27\xd2\xf5\x05\x69\x9d\x71\x97\x43\xd5\xf1\xeb\x1b\xbb\xf3\x14\x43\xbd\x5d\x77\xe4\xf5\xf6\xee\x3c\xd0\x40\x87\x77\x1e\x67\x6a\x93\x67\x92\xfd\xf8\x0e\xef\xe3\xbb\xf7\x6f\xe9\x6b\x11\xb1\x5c\x39\x57\x9e\x50\x42\xac\xde\x60\xe3\x63\x92\xfd\xb8\xae\xa7\xe7\x8a\x0c\x3c\x8b\xae\x46\x4e\xe8\x77\x9a\xf8\x89\xcd\x4e\x55\x14\xaf\xd5\xeb\xdc\x5e\x32\xb9\x90\x5e\x3b\xc5\xff\x4c\x2f\xd6\xd4\xf9\x84\x46\x6c\x4c\xd9\x8d\xab\x7c\xb0\x05\xab\xa3\xf1\xfe\xab\x5e\x32\xbd\xf9\xf2\x7a\x9a\x2f\xbb\xa7\xf9\x72\xbf\xae\xf7\xb2\x27\xf4\x5e\xdd\x8d\x33\xfd\x3b\x9b\x2e\x74\xf0\x3b\x9b\x71\x79\xb8\x78\x1e\x2e\x33\x0f\x0f\xcf\xc3\x63\xe6\xe1\xe3\x79\xf8\xcc\x3c\x02\x3c\x8f\x80\x99\x47\x88\xe7\x11\x12\xf3\xb0\x7b\x3e\xad\x0c\xe4\x61\xf7\x7c\x5c\x99\x9e\x87\xc0\xf3\x10\xcc\x3c\xd0\xef\x7c\xbb\x50\x52\x1e\x36\x9e\xc7\xad\xcf\x3a\x50\x1e\xb8\xa7\x36\xd3\x53\x1b\xf7\xd4\x66\x7a\x6a\xe3\x9e\xda\x4c\x4f\x6d\xdc\x53\x9b\xe9\xa9\x8d\x7b\x6a\x33\x3d\xb5\x71\x4f\x6d\xa6\xa7\x0e\xee\xa9\xc3\xf4\xd4\xc1\x3d\x75\x98\x9e\x3a\xb8\xa7\x0e\xd3\x53\x07\xf7\xf4\xe6\x77\x47\x50\x1e\xb8\xa7\x0e\xd3\x53\x07\xf7\xd4\x61\x7a\xea\xe0\x9e\x3a\x4c\x4f\x1d\xdc\x53\x87\xe9\xa9\x83\x7b\xea\x30\x3d\x75\x70\x4f\x1d\xa6\xa7\x2e\xee\xa9\xcb\xf4\xd4\xc5\x3d\x75\x99\x9e\xba\xb8\xa7\x2e\xd3\x53\x17\xf7\xd4\x65\x7a\xea\xe2\x9e\xba\x4c\x4f\x5d\xdc\x53\x97\xe9\xa9\x8b\x7b\xea\x32\x3d\x75\x71\x4f\x5d\xa6\xa7\x2e\xee\xa9\xcb\xf4\xd4\xc5\x3d\x75\x99\x9e\x7a\xb8\xa7\x1e\xd3\x53\x0f\xf7\xd4\x63\x7a\xea\xe1\x9e\x7a\x4c\x4f\x3d\xdc\x53\x8f\xe9\xa9\x87\x7b\xea\x31\x3d\xf5\x70\x4f\x3d\xa6\xa7\x1e\xee\xa9\xc7\xf4\xd4\xc3\x3d\xf5\x98\x9e\x7a\xb8\xa7\x1e\xd3\x53\x0f\xf7\xd4\x63\x7a\xea\xe3\x9e\xfa\x4c\x4f\x7d\xdc\x53\x9f\xe9\xa9\x8f\x7b\xea\x33\x3d\xf5\x71\x4f\x7d\xa6\xa7\x3e\xee\xa9\xcf\xf4\xd4\xc7\x3d\xf5\x99\x9e\xfa\xb8\xa7\x3e\xd3\x53\x1f\xf7\xd4\x67\x7a\xea\xe3\x9e\xfa\x4c\x4f\x7d\xdc\x53\x9f\xe9\x69\xdf\x5f\xcf\x0d\xe4\xd1\xf7\xe7\x73\xd3\xf3\xc0\x3d\x0d\x98\x9e\x06\xb8\xa7\x01\xd3\xd3\x00\xf7\x34\x60\x7a\x1a\xe0\x9e\x06\x4c\x4f\x03\xdc\xd3\x80\xe9\x69\x80\x7b\x1a\x30\x3d\x0d\x70\x4f\x03\xa6\xa7\x01\xee\x69\xc0\xf4\x34\xc0\x3d\x0d\x98\x9e\x86\xb8\xa7\x21\xd3\xd3\x10\xf7\x34\x64\x7a\x1a\xe2\x9e\x86\x4c\x4f\x43\xdc\xd3\x90\xe9\x69\x88\x7b\x1a\x32\x3d\x0d\x71\x4f\x43\xa6\xa7\x21\xee\x69\xc8\xf4\x34\xc4\x3d\x0d\x99\x9e\x86\xb8\xa7\x21\xd3\xd3\x10\xf7\x34\x24\x7a\x2a\x96\xb0\xa7\x6d\x28\x29\x0f\xd8\xd3\x36\x94\x94\x07\xec\x69\x1b\x4a\xca\x03\xf6\xb4\x0d\x25\xe5\x01\x7b\xda\x86\x92\xf2\x80\x3d\x6d\x43\x49\x79\xc0\x9e\xb6\xa1\xa4\x3c\x60\x4f\xdb\x50\x52\x1e\xb0\xa7\x6d\x28\x29\x0f\xd8\xd3\x36\x94\x93\x87\xc0\x3d\x15\x4c\x4f\x05\xee\xa9\x60\x7a\x2a\x70\x4f\x05\xd3\x53\x81\x7b\x2a\x98\x9e\x0a\xdc\x53\xc1\xf4\x54\xe0\x9e\x0a\xa6\xa7\x02\xf7\x54\x30\x3d\x15\xb8\xa7\x82\xe9\xa9\xc0\x3d\x15\x4c\x4f\x05\xee\xa9\x60\x7a\xba\xc2\x3d\x5d\x31\x3d\x5d\xe1\x9e\xae\x98\x9e\xc2\xff\x86\x4b\x17\x4a\xca\x03\xf7\x74\x35\xd2\x53\xde\x4b\x80\x5f\xff\xca\x71\x33\xfe\xd0\xfb\xc6\xf5\x61\xd7\x5f\x36\x6e\x86\x18\x78\xd3\xb8\x19\xe1\xd9\x6b\xc6\xff\x06\x00\x00\xff\xff\x86\x35\x6c\xe5\x7d\x4d\x00\x00")
+var _templatesCf_lbTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x9c\x4b\x6f\xdb\x46\x14\x85\xf7\xfd\x15\x02\xd1\x55\x01\x29\x1a\xbe\x59\x20\x8b\xda\x41\x91\x00\x46\x10\xc4\x41\x37\x41\x41\xd0\xd4\xc8\x22\x42\x93\x2a\x49\x39\x48\x05\xfd\xf7\x0e\x5f\x22\x29\xbe\xaf\x8f\x1b\xa7\xa8\xb3\x49\x34\x9c\x99\x33\x77\xce\xfd\x38\x77\x0c\x25\xe2\x71\x78\x88\x5c\xbe\x90\x9c\xaf\xb1\x1d\x73\xf7\x10\x79\xc9\x37\xfb\x3e\x0a\x0f\x7b\x69\x21\xb9\x5b\x3b\x8e\x77\xb6\x7f\xd7\x6a\x3a\xfe\xb4\x58\x6c\x78\xec\x46\xde\x3e\xf1\xc2\x60\xf1\x7a\x21\x1d\x8f\xab\xdb\xdb\xb7\x37\x57\x6f\xaa\x8f\x4f\x27\x49\x3c\xf7\xb8\x77\x6d\x6f\xb3\xc8\x7e\xf2\xe7\xfe\xf8\x70\xfd\xee\x4d\xda\x28\x5a\xbd\xe0\x3e\xe2\x71\x9c\x8d\xb8\x58\xb8\xde\x26\xb2\xef\xfc\xd0\xfd\x12\x8b\x67\x3f\x4b\xeb\x55\xf6\xe7\xd5\x5a\xfa\x33\x6b\xdf\x47\x61\x12\xba\xa1\x5f\x8c\x95\xb8\x7b\x29\xfb\x7c\x1b\x85\x0f\xf6\x3e\x8c\x92\xec\x73\x59\xfc\x64\x1f\x27\x61\xf9\x61\xed\xe3\x53\x3a\x2d\xaf\xcf\x5a\xf5\x7e\xbd\x58\x37\x3a\x96\xff\x3e\xcf\x2b\x26\x5d\x32\x69\x82\xd6\x6c\x96\xc4\xb9\x2f\xe7\x78\xef\x3c\xf0\xb4\xf7\xcf\xc7\x47\x27\x5a\xf1\xe0\x51\xc4\xe4\xb4\x74\xb7\x4b\x11\xe1\xa5\x7f\xb7\x2c\x23\xbc\xcc\x23\x9c\x8d\x20\xc6\x08\x0f\xc9\xfe\x90\x8c\x6d\xc5\xa3\xe3\x1f\xf8\x6b\x31\x76\x7b\x1b\x57\x7d\x3d\x57\x62\x7e\x29\x9d\x22\x9a\x6a\x02\x2f\x48\x78\x14\x38\xfe\x1c\x37\xbc\x2b\xfa\x90\x5d\x71\x3c\x7a\xdb\xc5\xea\xe6\xea\x77\xdf\x79\x0c\xa3\xd3\x69\x2c\xf2\xc7\x23\xf7\x63\x9e\x3f\xd7\xd4\x99\x3f\x3b\x3f\x46\xd9\x98\xc1\x26\x1f\xf2\xbf\x6b\xc0\x72\x77\xa7\x3b\x71\xd0\x0f\xd3\x2c\xd9\x33\xc4\xd9\x9b\x97\xbb\xdf\xf4\xaa\x7f\x57\xf7\x67\x3e\x6f\x90\x2e\xb3\xf9\x73\x5e\x74\xbc\x13\x21\xb5\x5b\x4b\x4f\x97\xe8\x87\xce\xc6\xbe\x73\x7c\x27\x70\x79\x64\x27\xdf\xf6\x59\xac\x02\x9e\x7c\x0d\xa3\x2f\xe9\x03\xf1\xe1\x4e\xfc\x2b\x6e\x8e\x7b\xb6\x53\xd6\xb8\x4a\xfd\x93\x3f\xb6\xfa\xa5\xb0\x4e\x2b\xbf\xc4\x33\x89\x13\xdd\xf3\xa4\x9d\x60\x17\x0b\x98\x22\xbb\x6d\xae\xba\x51\x3e\x5d\x7f\x68\xa4\x5a\x3b\xcf\x76\xdc\xf1\x93\x9d\xed\xee\xb8\xfb\xa5\xf0\x49\xdd\xe1\xb5\x08\x16\x63\x95\x5d\xbe\xd9\xc9\x4e\x2c\x6c\x17\xfa\xf9\xc0\x4a\xd6\x76\x08\xda\xad\x65\x5b\xb6\xd5\xc2\x15\xcd\x71\xd9\xba\xf4\x57\x2b\x4c\xbe\x17\x27\x3c\xe0\x51\x3b\x44\xcd\xcd\x72\xa2\x20\x8f\x55\xde\xaf\x72\xd7\x4a\xb4\x9c\x1a\x51\xaa\x4d\x7c\x19\xae\xd6\x5a\x33\xa6\x6d\x9d\x83\x9f\xd8\x8e\x9b\x61\x2d\x8f\x4f\xe6\x8d\x8b\xe0\x6c\xc3\xe8\xab\x13\x6d\xf2\x00\xd5\xb7\xf7\x52\x5c\x63\xef\x3b\x94\xf6\x64\x5a\xea\x89\x5a\x5a\xf5\x2c\x37\x7d\x2a\x4f\x9b\xf6\x08\x87\xc8\x1f\x1f\x60\x13\xc4\xf6\xd0\x20\x75\xf1\x71\x73\xb8\xcf\xa3\x4b\xcc\x07\x4e\x33\xa2\x44\x74\x73\xc7\xf9\xc4\x6c\x9e\x91\xd4\x6e\x14\xc6\xb1\xfd\x77\x18\x70\xbb\x66\x19\xf1\x6e\x11\xbd\x93\xe8\xc0\x7b\x32\xa0\xdb\xe1\xda\x80\xc3\xe5\x01\x87\xeb\x35\x53\xb4\x96\x20\x9c\xf6\x6b\xea\xc4\xc2\x39\xde\x03\x17\x51\xbf\x74\x6a\x09\xf3\x32\x21\x0a\x99\x5e\x10\x27\x69\x06\x74\xbc\x61\xea\x8d\x35\x1e\x9c\xdf\x56\x62\x97\x2e\x72\xa2\xd6\x35\x6d\x6c\x66\x45\xd5\x35\xd3\x81\x7a\xad\xb6\xa1\x3a\x91\xa8\x33\x12\x84\x3f\x39\x43\x78\x7f\x8a\x14\xe7\x82\x09\x27\x28\xf1\x37\xe1\x8d\xe9\x27\xe9\x8f\xd9\xf3\x2f\xe0\x28\x6d\xae\x3b\xce\x31\xe6\xba\xb4\xc2\x73\x4c\xa9\xaa\x4a\xc7\x9c\xf9\xa7\xcf\x38\x69\xcf\xac\xd5\xb4\x2f\xe7\xc4\x96\xbb\x69\x5a\xd5\x30\xec\xbc\x91\x53\x5a\x5f\xe7\x19\xb5\x43\x35\xc4\xcc\xf2\x21\x4f\x01\x4c\xfd\xc0\xff\xaa\x0e\x91\xe2\x54\x27\xde\x0e\xcf\x57\x48\x0c\xc6\x8c\x50\x4b\x8c\x64\xe0\x4b\x74\xe5\x8c\x52\x62\xa2\x3d\x26\xfb\x14\x53\x50\x9c\xc7\x23\xd4\x14\xe7\x30\x48\x5d\xde\x73\x0a\xef\x75\xd7\x1b\xce\x7e\xef\x7b\xae\x93\xba\x5c\xea\x78\xd1\x36\xdf\x90\x04\xe7\x15\x86\x1e\x29\x76\xce\x16\x7d\xd6\x9a\xa7\x2f\xc6\x93\x22\xdb\xa8\x7c\x44\x32\x0c\x44\xba\xee\xf8\xb7\x9f\x3e\x7d\x90\x6a\x81\x68\x15\x4b\xe7\xa5\x4f\xaf\x99\x06\xa6\xee\x2d\xa6\x72\x1d\xd9\x03\x4e\xb2\xeb\x38\xda\xbe\xca\x5b\x1f\x9c\x44\x4c\x13\x5d\xb6\xca\xeb\xf5\x52\xb5\x2c\xa9\xc1\xa8\xa1\xba\xad\x06\x9d\xef\x54\xbe\x55\xde\x34\xd7\x13\x8b\xb8\x73\x97\xc1\x3a\x6e\xe2\xe6\xb7\xa3\xdf\xe1\x82\xbe\xb8\xfd\x3b\xa5\x60\x7b\xbd\xf3\x02\x2b\x8e\x2a\xd0\xc8\xa6\x47\x1f\x4a\x68\x6f\xc7\x63\x7b\x73\x5b\xf3\xa4\x10\x99\x78\xdb\x14\x7b\x3c\x13\x5b\xe6\xdc\xcd\xd5\x75\xd5\xf2\xdb\xc7\xf7\x45\xfa\xfd\x10\x3b\x01\xdf\x8a\xff\xf7\xa2\xf7\x8e\xa4\x8a\xfb\xc8\x35\x49\x35\x54\x67\x1d\x58\x8d\x33\x7c\x59\x52\x0d\xd3\x7b\x5f\x52\x0d\x45\xbb\x32\xb9\x54\x3a\xe1\xd6\x64\xfc\xc8\x32\xe7\xe4\xf2\x42\xee\x4e\x98\x3c\x76\x79\x22\xde\x26\xa0\xab\x93\xe2\x9c\xdd\x79\x71\xb2\x4b\x92\x81\x9b\x93\xa2\x67\xe7\xbd\x49\xd9\x73\x9a\x8a\x21\x19\x63\x3a\x6a\x95\x73\x5b\x49\xd9\x39\xce\x7b\xc7\xb1\x6f\xd7\x53\xdd\xdb\x0c\xe4\x39\x4a\xfc\xc0\xed\x53\xbd\x00\xef\x16\x2f\x14\xd3\xa5\x03\xcb\x37\xc4\x9d\xd5\x04\x60\x71\x10\xb1\xf8\x20\xb2\xa6\xdf\x5f\x89\xbd\x9b\x7e\x79\x25\xf2\xf2\x45\xfc\x1a\x98\xad\x65\xb5\xa3\x76\x66\x4c\x7e\x81\x77\x3a\x62\x0d\xd3\x2e\x74\x06\xb6\x62\xa4\x4a\xee\xec\x39\xe3\x2a\xa7\xe8\x3f\xf3\x1e\x27\x73\xc3\x93\xaf\x71\xe6\xde\xc2\xf4\xaf\x15\xec\xa0\xef\x2d\xf1\x87\xb9\x1e\x2a\x0c\x3e\xe3\x6e\x68\x8a\xdf\xa6\x59\x7e\xec\x56\xa8\xe7\x4c\x95\xf7\xa6\x1f\xa8\xf2\x25\xc3\x4f\x53\x3a\xb1\x58\xd7\x9e\x76\x98\x52\x66\x1c\xa6\xce\x89\x33\xff\xf7\x50\xe7\xae\xa3\xbf\x87\x9a\xa6\x43\xa3\xeb\xd0\x90\x3a\x74\xba\x0e\x1d\xa9\xc3\xa0\xeb\x30\x90\x3a\x4c\xba\x0e\x13\xa9\xc3\xa2\xeb\xb0\x80\x3a\x94\x35\x59\x87\xb2\x46\xea\x60\x74\x1d\x0c\xa9\x43\xa6\xeb\x90\x91\x3a\x14\xba\x0e\x05\xa9\x83\xce\x53\x05\xc9\x53\x85\xce\x53\x05\xc9\x53\x85\xce\x53\x05\xc9\x53\x85\xce\x53\x05\xc9\x53\x85\xce\x53\x05\xc9\x53\x85\xce\x53\x05\xc9\x53\x95\xce\x53\x15\xc9\x53\x95\xce\x53\x15\xc9\x53\x95\xce\x53\x15\xc9\x53\x95\xce\x53\x15\xc9\x53\x95\xce\x53\x15\xc9\x53\x95\xce\x53\x15\xc9\x53\x95\xce\x53\x15\xc9\x53\x95\xce\x53\x15\xc9\x53\x95\xce\x53\x15\xc9\x53\x95\xce\x53\x15\xc9\x53\x8d\xce\x53\x0d\xc9\x53\x8d\xce\x53\x0d\xc9\x53\x8d\xce\x53\x0d\xc9\x53\x8d\xce\x53\x0d\xc9\x53\x8d\xce\x53\x0d\xc9\x53\x8d\xce\x53\x0d\xc9\x53\x8d\xce\x53\x0d\xc9\x53\x8d\xce\x53\x0d\xc9\x53\x8d\xce\x53\x0d\xc9\x53\x8d\xce\x53\x0d\xc9\x53\x9d\xce\x53\x1d\xc9\x53\x9d\xce\x53\x1d\xc9\x53\x9d\xce\x53\x1d\xc9\x53\x9d\xce\x53\x1d\xc9\x53\x9d\xce\x53\x1d\xc9\x53\x9d\xce\x53\x1d\xc9\x53\x9d\xce\x53\x1d\xc9\x53\x9d\xce\x53\x1d\xc9\x53\x9d\xce\x53\x1d\xc9\x53\x9d\xce\x53\x1d\xc9\x53\x83\xce\x53\x03\xc9\x53\x83\xce\x53\x03\xc9\x53\x83\xce\x53\x03\xc9\x53\x83\xce\x53\x03\xc9\x53\x83\xce\x53\x03\xc9\x53\x83\xce\x53\x03\xc9\x53\x83\xce\x53\x03\xc9\x53\x83\xce\x53\x03\xc9\x53\x83\xce\x53\x03\xc9\x53\x83\xce\x53\x03\xc9\x53\x93\xce\x53\x13\xc9\x53\x93\xce\x53\x13\xc9\x53\x93\xce\x53\x13\xc9\x53\x93\xce\x53\x13\xc9\x53\x93\xce\x53\x13\xc9\x53\x93\xce\x53\x13\xc9\x53\x93\xce\x53\x13\xc9\x53\x93\xce\x53\x13\xc9\x53\x93\xce\x53\x13\xc9\x53\x93\xce\x53\x13\xc9\x53\x8b\xce\x53\x0b\xc9\x53\x8b\xce\x53\x0b\xc9\x53\x8b\xce\x53\x0b\xc9\x53\x8b\xce\x53\x0b\xc9\x53\x8b\xce\x53\x0b\xc9\x53\x8b\xce\x53\x0b\xc9\x53\x8b\xce\x53\x0b\xc9\x53\x8b\xce\x53\x0b\xc9\x53\x8b\xce\x53\x0b\xc9\x53\x8b\xce\x53\x0b\xc8\x53\xb6\x26\xf3\xb4\xec\x0a\xd2\xc1\xe8\x3a\x18\x52\x87\x4c\xd7\x21\x23\x75\x28\x74\x1d\x0a\x52\x87\x4a\xd7\xa1\x22\x75\x68\x74\x1d\x1a\x52\x87\x4e\xd7\xa1\x23\x75\x18\x74\x1d\x06\x52\x87\x49\xd7\x61\x22\x75\x58\x74\x1d\x48\x9e\x32\x3a\x4f\x19\x92\xa7\x8c\xce\x53\x86\xe4\x29\xa3\xf3\x94\x21\x79\xca\xe8\x3c\x65\x48\x9e\x32\x3a\x4f\x19\x92\xa7\x8c\xce\x53\x86\xe4\x29\xa3\xf3\x94\x21\x79\xca\xe8\x3c\x65\x48\x9e\x32\x3a\x4f\x19\x92\xa7\x8c\xce\x53\x86\xe4\xa9\x4c\xe7\xa9\x8c\xe4\xa9\x4c\xe7\xa9\x8c\xe4\xa9\x4c\xe7\xa9\x8c\xe4\xa9\x4c\xe7\xa9\xac\xe0\xff\x7f\xbc\xe1\x2f\x01\x3e\xfd\xbb\xc6\xc5\xf8\x63\x5f\x34\xce\x1f\xeb\xfe\x96\x71\x31\xc4\xc8\x57\x8c\x8b\x11\x1a\xdf\x2f\xfe\x07\x3c\x56\x65\x7e\x5f\x5a\x00\x00")
 
 func templatesCf_lbTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -128,12 +153,12 @@ func templatesCf_lbTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/cf_lb.tf", size: 19837, mode: os.FileMode(420), modTime: time.Unix(1507852860, 0)}
+	info := bindataFileInfo{name: "templates/cf_lb.tf", size: 23135, mode: os.FileMode(420), modTime: time.Unix(1792360833, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesConcourse_lbTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x55\xc1\x6e\x9c\x30\x10\xbd\xf3\x15\x96\xd5\x53\xa5\xa5\x9b\x4d\x2a\x55\x95\x38\xa5\x97\x5e\xaa\x1e\x7a\xab\x2a\xcb\x98\xc9\x62\xc5\x6b\xa3\xb1\x4d\x95\xae\xf8\xf7\x0a\x1b\x58\x08\x6c\xc2\x56\x89\x1a\x56\x7b\x60\xc6\x33\xef\xcd\xf8\xcd\x80\x60\x8d\x47\x01\x84\xf2\xdf\x96\x59\x10\x1e\xa5\x7b\x60\x7b\x34\xbe\xa2\x84\x0a\xa3\x85\xf1\x68\x81\xa9\x7c\xe6\x3d\x26\x84\x14\x60\x05\xca\xca\x49\xa3\x49\x46\xe8\xf1\x98\xde\xf6\x21\x5f\x4e\xae\xa6\xa1\x09\x21\x75\x25\x98\x2c\x48\x78\x32\x42\xdf\x1d\x5b\xc8\xba\x12\x69\xfb\x97\x45\x43\x93\x84\x10\xa9\xf7\x08\xd6\x86\xe4\x84\x08\x59\x20\xcb\x95\x11\xf7\x96\x64\xe4\x27\xdd\xa6\xe1\xf7\x61\x4b\x7f\x05\x7f\x85\xc6\x19\x61\x54\x97\xd2\x89\x8a\x06\xfb\x1d\x9a\x03\xab\x0c\xba\x60\xff\xb4\x0d\x46\x67\x7a\xd3\x60\x6c\x5e\x0b\x72\xb7\xdb\xed\x16\x40\x3b\xf3\xab\xc1\xde\xdc\x5c\x2f\xa0\x46\x6b\x00\x85\x31\xe6\x29\x36\x23\xd3\x16\xf5\xef\x03\x6a\x46\xe8\xe6\x8a\xae\x60\x1a\x50\x1c\xdf\xf7\x18\xdf\xf8\x01\xe2\x6d\xd7\x1c\x53\xd0\x35\x93\x45\xb3\x19\x74\xb5\x51\xf9\xa6\xd7\xd5\x26\xea\x2a\x24\x69\x92\xe4\x12\x69\x4a\xed\x00\x35\x57\x97\x6a\xf4\x6b\x17\xf7\x12\x5a\x9d\x42\xc7\xde\xc4\xb8\xa9\x27\x7d\x62\xa8\x42\xea\xcb\xa4\x7d\x46\xdc\xe7\xe4\xfd\x5f\x58\xae\x98\x86\xb7\x28\xcc\x5e\x55\xe7\x14\x6a\xbc\xab\xbc\xbb\x44\x8a\x35\x57\x1e\xb2\x15\x0d\x3f\x93\x25\x4a\x6f\x36\x1c\xa0\xf2\x47\x13\x11\xe1\x74\x5b\xe3\xe2\x33\x14\x6e\x4b\x83\x8e\x2d\x95\xdf\x96\x29\xd0\x58\xcb\xfe\x18\x0d\x4c\x19\x5e\xb0\x9c\x2b\xae\x85\xd4\x7b\x92\x11\x87\x1e\xda\x9e\x96\xc0\x95\x2b\x99\x28\x41\xdc\x77\xbd\x8d\xa6\x07\xe6\x4a\x04\x5b\x1a\x55\xc4\xeb\x0e\x3e\xaf\xe7\xde\x8c\x5c\xc5\x6b\x0d\x65\xd7\x5c\x4d\xa9\x5e\x77\x1a\xe0\xb8\x07\x37\xab\xe3\xc7\xed\xf7\xcf\xad\xde\xa3\x08\x9c\x3c\x80\xf1\xee\xd1\xa1\x8f\xbd\x00\x94\xb4\x0e\x34\x60\x47\x54\x6a\xeb\xb8\x16\xb0\x30\x3b\x63\xe7\x48\x6c\x83\xc2\x55\x7e\x0a\x22\xe3\x6f\x4a\x74\x8d\xe6\x62\x32\x1a\xeb\x58\x8c\x67\x66\x4e\xe3\x19\x1e\xe3\xe0\x39\x95\x7f\xe1\xf2\x44\x4b\x9e\xe7\xd2\x7f\x93\x96\xa9\x58\xab\x62\xac\xb5\x8a\x09\x40\x27\xef\xa4\xe0\x0e\xda\xed\x3b\x2c\x5e\xc9\x0f\xcc\x02\xd6\x80\xe3\x23\xa9\xca\xc3\x6b\xca\x51\x37\x43\x3d\x2f\xba\xe0\xac\xcf\x35\x38\x3b\xaa\x66\x48\x16\x3c\x2d\x85\xee\x4c\xfa\xbe\x8b\x3a\xb7\x18\xda\x61\x1c\x6d\x81\x53\x75\xa0\xf2\x09\x99\xb4\x3d\x19\xc7\x7c\x31\x91\x47\xb5\x2e\x4f\xa1\x2d\x1b\x72\xfd\x0d\x00\x00\xff\xff\xfb\x9d\x40\x3e\xea\x09\x00\x00")
+var _templatesConcourse_lbTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd5\x58\x5b\x4f\xdb\x30\x14\x7e\xe7\x57\x44\x11\x4f\xd3\x1a\xca\x65\x12\x9a\xc4\xc3\x28\x9a\x86\x84\x10\x1a\x68\x2f\x08\x45\x6e\xea\xb6\x11\xc6\xce\x6c\xa7\x15\x8b\xfa\xdf\xe7\x4b\x92\xda\x89\x73\x83\xc2\x46\x78\xa1\xbe\x9c\x73\xfc\x9d\xef\x3b\x3e\x09\x85\x8c\xa4\x34\x82\x9e\x0f\xd6\x2c\x64\x30\x4a\x69\xcc\x9f\xc3\x05\x25\x69\xe2\x7b\x7e\x44\x70\x24\xe6\x19\x0c\xd1\xb4\x36\x9b\xed\x79\xde\x0c\xb2\x88\xc6\x09\x8f\x09\xf6\xce\x3c\x3f\xcb\x82\x49\xb1\xe5\x62\x3b\xb5\xd9\xf8\x62\xed\x2a\x89\xc2\x78\xe6\xa9\x47\xaf\xfd\x75\x33\xb9\xbc\x90\x93\x62\x36\xc6\x0b\x0a\x19\x53\x56\x3d\x2f\x8a\x67\x34\x9c\x22\x12\x3d\x32\xb1\xf6\xde\x1f\x07\xea\xef\x60\xec\x3f\xa8\xf9\x84\x12\x4e\x22\x82\x72\x5b\x3c\x4a\x7c\x35\x3e\xa7\xe4\x29\x4c\x08\xe5\x6a\xfc\x74\xac\x06\x39\x29\x86\xca\xc1\xcd\x5b\xb9\x3c\x12\x8f\xc3\x69\x3e\xfc\x66\x6e\x4f\x4e\x8e\x1d\x5e\xf5\xa8\x72\x0a\x4d\x9f\xdb\xbd\x67\x9e\x0d\x51\xf1\xbb\xf4\x2a\x5c\x8e\x0e\xfd\x1e\x91\x2a\x2f\x1c\x2c\x0a\x1f\xd7\xe0\x09\xca\xdd\xfb\xd9\x0a\xd0\x00\xe2\x95\xc8\xfd\x66\x54\x12\x6a\x84\xa6\xa3\x82\x50\x23\x4d\x28\x65\x44\x98\xa1\x03\x38\x19\x63\x0e\x29\x06\x68\x28\x39\x2f\xf3\x7d\x2f\x26\x69\x96\xc5\x73\x0f\xfe\xf6\x82\xab\xf3\xef\x08\xac\x08\xf5\x7c\x8c\xa6\xfe\x66\xd3\x85\x54\x96\x41\xc4\xa0\x5e\x67\x07\xad\xd7\xee\x67\xf5\x53\x07\x2d\x3a\x0c\x04\xac\xda\x2c\x9e\x69\xab\x7d\xb5\xd1\xa0\x0e\x97\x3e\xd4\x69\xcb\xa3\x7e\x9c\x43\xf6\x50\xe3\xff\x28\x8c\x82\xd5\x4d\x0a\x21\x29\x4f\x52\x3e\x44\x0a\x2b\x80\x52\x78\xd6\x03\xf7\x06\x2b\x2a\x01\xd2\x75\x95\x09\xb6\x58\x85\x02\xec\xa8\xb4\x77\x2c\x8f\x6c\x3f\x25\x00\x6c\x29\xe0\x0d\x5d\x30\xf8\x2e\x91\x81\x5c\x64\x88\x80\x59\x38\x05\x08\xe0\x08\xd2\x90\x3f\x27\x0a\x53\x90\x24\x28\x8e\x80\x94\xb3\x04\xab\x4a\x3d\xe5\xf7\x35\xec\xcb\x49\xed\x76\x8e\x21\x5f\x13\xfa\xe8\x6f\x69\xca\xd2\xa9\x18\x64\xf6\xb9\x4b\xff\x6a\x32\x90\xde\xf4\xb2\xe0\x53\xee\xa8\x56\x03\xc5\x1a\x0e\xe8\x02\x72\x77\x11\x5c\xc3\x2a\xce\x9d\xe8\xca\x2d\x62\x43\x55\xf8\x2d\x80\x9b\xc4\xff\x71\x77\x77\xe3\x1b\x78\x98\x73\x77\x93\x1b\x03\x81\xb2\x9c\xd6\x6b\xe9\x12\x02\xc4\x97\x61\xb4\x84\xd1\xa3\xbb\xa0\x82\xb2\xa0\x9a\x6a\x37\x18\xa4\xe3\x50\x0b\x00\x5f\x7a\x75\x8a\x1d\xf8\x56\x29\x6a\x30\x63\x87\x5c\x44\xf6\x1c\xf2\xa5\x48\xc3\x92\x20\x1d\xbf\xbe\x60\x53\x5c\x9f\x2d\xe6\x94\x76\x84\xd2\x6c\xf3\xc7\x63\xf7\xc5\xd6\x91\x54\xce\xc0\xd0\xa4\xca\x2d\x76\x52\xf3\x42\x57\xcb\xcf\x90\xc4\x74\x01\xf7\xae\x88\xa1\x98\x71\x88\x21\xad\xa2\x75\x3a\xd6\x60\xd9\xca\x04\x14\x6b\xd4\xf4\x6e\x4b\xe3\x81\x98\xdc\x58\x70\x19\x11\xf4\x94\x42\x9d\x8b\x0e\x4d\x34\x31\x4d\x35\x28\x73\x90\x22\x1e\x82\x48\xf5\x28\x1a\x6b\x55\x4e\x2a\x40\xcf\x09\x5d\x03\x3a\xd3\x60\x9b\xac\xa9\x1e\xd1\xa2\x54\x50\xad\x12\xe5\x99\x87\x81\x2b\xda\xc8\x9d\xa2\x2b\xdb\xd2\x97\xc0\x7b\xdb\x8d\xef\xd5\xad\xa1\x64\x11\x24\x8f\xe7\xf2\x3e\x80\x2a\xd8\x82\xec\x57\xe7\x93\xed\xcc\xb7\x9f\xd7\x39\xef\xff\x69\x36\x1a\xf1\xd8\x6b\xbd\x61\x85\xe4\x5f\x75\xcb\xe6\x25\xa3\xe3\x42\xdb\xe9\x4d\xd6\xc4\x32\x59\xab\x86\xd1\x4c\x1f\xbe\x95\x6a\xd5\x02\x58\x13\xe3\x7b\xe7\x5d\xc4\x6c\xab\xb0\xde\xcb\xe9\x63\x85\x29\x45\x46\xef\xd6\x0e\xc1\x0c\xb3\x50\xa6\x5f\xf5\x68\x85\x46\xde\x12\xfa\x8f\x0e\x7b\x51\x83\x9d\x9d\xb4\x44\xb2\x1b\x7a\xf1\xa3\x84\xdc\x6d\xa7\x57\x06\xab\xd9\x73\x9b\x32\x4f\xc6\x6c\xa3\xf7\x7d\xcb\x8d\x76\xf1\xb9\x37\x5e\x7a\xfd\x83\xc1\x28\x9b\x50\xb0\x7f\xb7\x3f\xa4\xe9\x17\x75\x9b\x12\xc6\xc2\x3f\x04\x0b\x9b\x5b\x3e\x8a\xb7\x51\x61\x80\xd3\x14\x36\x34\x29\xee\x26\xe4\xa8\xa5\x09\x39\x1c\x77\x75\x21\x05\xe1\x6a\xe7\x10\x2c\xfe\x2a\x5b\xe6\x9c\x95\xf1\x13\x14\x79\xab\x2c\xfa\x52\xbc\xfd\x15\xba\xcb\x03\x8d\x31\xe3\x52\x60\x8e\xf7\x6e\x73\xd2\x68\xda\xca\xd7\x5b\x91\x9a\x8a\xde\xca\xaf\x5c\x72\xca\xd6\xdb\x76\x63\xbf\x28\xcc\x17\xe6\x7a\x18\x1d\x71\x98\x9b\xeb\xa1\xbc\x24\x96\x16\x48\xba\x63\x29\x3e\x88\xb9\x43\x61\x0c\xe9\xbd\xe2\x9f\xd0\x6c\x13\xe2\x59\x4b\x8f\x90\xc7\xbe\xc3\x0f\x1a\xf5\x8b\xb5\xe7\xad\x3a\xac\x6e\xc1\x1d\x15\x2e\xd8\x5a\xb9\xb2\x6c\xe4\xe9\xaa\xfa\x17\xd6\x39\x7f\x6f\x56\x16\x00\x00")
 
 func templatesConcourse_lbTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -148,12 +173,32 @@ func templatesConcourse_lbTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/concourse_lb.tf", size: 2538, mode: os.FileMode(420), modTime: time.Unix(1507852860, 0)}
+	info := bindataFileInfo{name: "templates/concourse_lb.tf", size: 5718, mode: os.FileMode(420), modTime: time.Unix(1792360853, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesLb_subnetTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x93\x51\x6e\x9c\x30\x10\x86\xdf\x39\xc5\x68\x94\x87\xb4\xdd\xb8\xdb\xa8\xaf\x7b\x85\x5e\xa0\x8a\x2c\x63\x4f\xc9\xa8\x8e\xbd\xc2\x86\x74\x8b\xb8\x7b\x85\x4d\x0a\x16\x6c\xbb\x52\x40\x48\xc8\xf6\x7c\xf3\x8f\xe7\x9f\x96\x82\xef\x5a\x4d\x80\xea\x35\xc8\xd0\xd5\x8e\x22\x02\xda\x7a\xfe\x0f\x08\x43\x05\xa0\x7d\xe7\x22\xac\x9f\x13\xe0\xdd\x60\xc9\x35\xf1\xf9\xbe\x57\xad\x50\xbd\x62\xab\x6a\xb6\x1c\x2f\xf2\xb7\x77\x14\x3e\x8c\x58\x01\xf4\x67\x2d\xd9\x6c\x22\xa7\x6c\xfd\x59\x8b\xe9\x63\x93\x4e\x6a\x36\xad\xac\xad\xd7\x3f\x8b\x93\xd3\x72\xd6\x72\x8f\x5f\x8e\x22\xbd\x9f\x1f\x8f\x78\x80\xaf\x87\xac\x4b\xb0\x33\xf4\xeb\xd3\x63\x4e\xb8\x11\x92\x31\x64\xe9\x85\x5c\xbc\xa2\xb5\x20\x4d\x9c\x0a\x20\xaa\x26\xa4\xe2\x01\xbe\xa9\x97\x19\x33\x85\x93\xeb\x25\x9b\xf1\xc1\xd6\x0f\x59\xd8\xdd\xb0\x8a\x4e\x22\xc6\x09\x60\xf9\x07\xe9\x8b\xb6\x34\x53\xb8\x71\xbe\x25\xa9\x9f\x95\x6b\x28\xc0\x09\xbe\xe3\x52\x33\x1e\x00\x37\xba\xf0\x29\xb1\xc6\xaa\x2a\xfb\xd4\xfa\x2e\x92\x8c\xaa\xb6\x94\x9b\x55\x2c\x0c\xcb\xb5\xef\xdf\xf5\x3e\xef\x0a\xc9\x50\x88\xec\x54\x64\xef\xe4\xaa\x45\x27\xc0\xb7\x66\x1c\xa7\x8a\x1b\x15\xe9\x55\x5d\x8a\xa4\xec\x22\xb5\x8e\xa2\x9c\x37\x05\x37\x6f\xcd\x5e\xa5\x29\x42\x56\xeb\xa2\x54\xf3\x2f\xe9\x33\x48\x85\xe0\x35\x27\xa9\x08\x98\x77\xfe\xe3\xe4\x5b\x6d\x9c\x19\x7f\x9d\x5c\x58\x6a\x99\x1c\xb1\x64\x13\x1f\x05\x9b\x8d\xad\xde\x55\xb8\xef\xe2\xb9\x8b\xab\xe1\x94\x6c\xe6\xaa\x7a\x65\x3b\x4a\x8e\xca\xb4\x7d\x39\x23\x3e\xed\x73\xb6\x55\xdf\x8e\xdd\xc4\x5e\xcd\x92\x26\xf9\x76\xf0\x62\xb6\x4c\xfc\x13\x00\x00\xff\xff\x29\xc2\x4a\x36\xab\x04\x00\x00")
+var _templatesExisting_vpcTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x75\x8f\xd1\x0a\x83\x30\x0c\x45\xdf\xfb\x15\xa1\xec\x75\xfd\x83\x7d\xc9\x18\x92\x69\x74\x01\xed\xa4\x8d\x75\x22\xfe\xfb\xd2\x2a\x7b\x10\xf6\x92\x04\x72\x72\xef\x4d\xc2\xc0\xf8\xec\x09\x2c\x7d\x38\x0a\xfb\xae\x4a\x63\x5d\x71\x63\x61\x35\x00\xb2\x8c\x04\x37\xb0\x51\x82\xae\xac\xd9\x8c\x69\x50\x10\x2c\xce\x31\x83\x16\x6c\xa9\x99\xe5\x26\x93\x97\x35\x61\x70\x27\xb1\xed\x74\xc9\x5e\x28\x78\x92\xaa\x43\xa1\x19\x17\x95\xe1\x6e\x57\x69\xb9\xd7\x5d\x19\x01\x3c\x0e\xa4\x4d\x65\x51\x04\xeb\xd7\x40\x5e\x9c\x4a\x5e\x35\x5f\x01\x12\xf6\x13\x45\x05\xee\xff\x8c\x1f\xca\x6d\xd9\xfd\x3d\xc9\x38\x49\xc9\xfb\x7b\xaf\x9c\xef\xa9\x73\x38\x77\x7c\x95\x2d\xdc\x91\xfa\x0b\x07\x95\xcb\x74\x21\x01\x00\x00")
+
+func templatesExisting_vpcTfBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesExisting_vpcTf,
+		"templates/existing_vpc.tf",
+	)
+}
+
+func templatesExisting_vpcTf() (*asset, error) {
+	bytes, err := templatesExisting_vpcTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/existing_vpc.tf", size: 289, mode: os.FileMode(420), modTime: time.Unix(1792360325, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesLb_subnetTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa5\x93\xcf\x4f\xc3\x20\x14\xc7\xef\xfe\x15\x84\xec\xb0\xe9\x86\x8b\xf1\xea\x49\x13\xb3\x8b\xf1\xe4\xc5\x18\x42\xe9\xb3\x23\x63\xb0\x00\xed\x9c\x4d\xff\x77\x29\x54\xfb\x6b\xd3\x25\xb6\x07\xc8\x83\xf7\x79\xdf\xf7\x03\x03\x56\xe7\x86\x03\xc2\x6c\x6f\xa9\xcd\x13\x05\x0e\x23\x2c\x93\x66\x6f\x31\x2a\x2f\x10\xe2\x3a\x57\x0e\x75\xbf\x3b\x84\x27\xa5\x04\x95\xb9\xf5\xb4\x60\x86\xb0\x82\x09\xc9\x12\x21\x85\x3b\xd0\x4f\xad\xc0\xce\x2a\xec\x3d\x8b\x1d\xa7\x22\x1d\x78\x96\x25\x79\x79\xbe\x5f\x3d\x54\xe1\x0a\x17\xa9\xa1\x89\xd4\x7c\xd3\x83\xd7\xe6\x28\x62\xda\xd9\xd6\xb1\xfc\xba\xd7\x66\x43\x6b\xf3\x1c\xdd\xce\xd1\x72\x16\x96\xa0\x92\x08\x95\xc2\xc7\xd5\x4d\x0c\x3f\x92\x15\xd9\x20\x61\x0b\xca\x9d\x50\xde\x23\xd5\x1c\x0f\x72\x2c\xb3\xa1\x14\x08\x3d\xb1\x6d\x83\xa9\xdd\x41\x15\x3e\xc1\x6a\x21\x93\x45\x94\xe8\x95\xb7\xde\x41\x44\x55\x03\xa4\x78\x07\x7e\xe0\x12\x1a\x8a\xc8\x94\x36\x40\xf9\x9a\xa9\x0c\xac\xe7\xbd\xe2\xb6\x10\x78\xee\x1b\x32\xd4\x85\xdf\x02\xcb\xd3\x4c\xaf\x6b\x46\xe7\x0e\xa8\x63\x89\x84\xd8\xba\x9e\xa1\x6c\x9b\x30\xa8\xfc\x71\xd0\x09\x44\x0a\xd6\x09\xc5\x9c\xd0\x8a\x76\x1a\xe6\x91\x4b\x12\xfe\xeb\x65\x9d\x6a\xc6\x1c\xec\xd9\xa1\x8d\xb6\x52\x0e\x8c\xaf\xca\x63\x3c\xf8\xee\x79\x87\xdf\xdc\x9d\x94\x83\x5c\x48\x5f\x06\xf1\x45\xc6\xbf\x26\x4f\x99\xb5\x9a\x8b\xa0\xd1\x67\x11\x4f\xfe\x98\xe4\x73\xc7\x38\x32\x7e\x26\xb9\x37\x44\xed\xcb\x21\x6d\x34\x72\xe9\x05\x8f\x06\xe9\x5f\x89\x7b\xd3\x2e\x77\x9d\xc7\xe9\x01\x4d\x56\x05\x93\x39\x84\x19\x8a\xb4\xe3\x72\x2a\x3f\x41\x47\x39\xe3\xac\xcf\xc7\x8e\x7c\x4f\x46\x09\xaf\xf8\x7c\x70\x3b\x65\x91\xf8\x05\x62\x74\x0c\xbb\xab\x04\x00\x00")
 
 func templatesLb_subnetTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -168,7 +213,47 @@ func templatesLb_subnetTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/lb_subnet.tf", size: 1195, mode: os.FileMode(420), modTime: time.Unix(1507852860, 0)}
+	info := bindataFileInfo{name: "templates/lb_subnet.tf", size: 1195, mode: os.FileMode(420), modTime: time.Unix(1792369804, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesNatTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe5\x57\x4d\x6f\xd4\x30\x10\xbd\xf7\x57\x58\x11\x07\x5a\x36\x61\x0b\x14\xb8\x70\x40\x14\xa1\x5e\xaa\x1e\x10\x17\x84\x22\x6f\xe2\xdd\xb5\x48\xec\xc8\x76\x52\xda\x28\xff\x9d\xf1\x47\x12\xe7\x63\x4b\x16\x10\x42\xb0\x7b\xd8\xec\x24\x7e\x9e\x79\x33\x7e\x33\xa9\x6b\xba\x45\xd1\xf5\xdb\x8f\x1f\xb0\x22\xb7\xf8\xae\x69\x04\x91\xbc\x14\x09\x41\x01\xbe\x95\xb1\x2c\x37\x8c\xa8\x00\x05\x0c\x2b\xf7\x47\x06\xa8\x3e\x41\x28\xe1\x25\x53\xc8\xff\xbc\x41\xc1\xa3\x3a\x23\x6c\xa7\xf6\x8f\x2b\x2c\x22\x5c\x61\x9a\xe1\x0d\xcd\xa8\xba\x8b\xef\x39\x23\xf2\xb4\x09\x60\x65\x55\x24\x31\x4d\x47\x2b\xeb\x3a\xfa\x74\xf3\xee\xea\xb2\x31\x8f\x24\x34\x15\xf1\x26\xe3\xc9\xd7\x01\xb8\x36\x5b\x27\x1e\x7b\x97\x7a\x2f\xf8\xbd\xe5\xe2\x6b\xac\xcd\x2b\xf4\x62\x85\xd6\xa7\xe6\xc7\x78\x19\x51\x96\x92\x6f\x4f\xce\xd7\x76\xff\x89\x5f\x16\x9c\x64\x24\x27\x4c\x1d\x70\x7d\x00\xa5\x71\x00\x48\xe1\x9d\x34\x5c\x20\x74\x8d\x73\x07\xa3\x97\x13\x56\x41\x84\x4d\x08\xa4\x85\xd6\x49\xf0\xbd\x5f\x6e\xbc\x68\x34\x42\x46\xb7\x24\xb9\x4b\x32\xe2\x60\xe8\x8e\x71\x41\xe2\x64\x8f\xd9\x8e\x48\x00\xfc\x1c\xf4\x54\x04\x2b\xc8\xc9\xd8\xb1\xe0\x8b\xc1\x02\xb4\x61\xe2\x04\x2f\x15\x89\x15\xde\x64\x24\xc6\x52\xf2\x84\x62\x45\x39\x83\x4c\xda\x3b\x3f\xca\xe7\xd2\x64\x5a\x8c\x2e\x9f\x03\x26\xfb\x02\x8a\xbc\xed\xa2\xb3\x88\xa6\x13\x3a\x11\xf2\x3d\x06\x38\x83\x34\x8a\x24\xda\x70\xb9\x1f\x18\x80\xe5\x60\x1a\x3c\xa1\x85\x2b\x59\x73\x35\x0a\x6f\x69\x68\x29\x29\x08\x4b\x65\xcc\x99\x49\x04\x54\xe8\x15\x53\x44\x40\x0c\xdd\x61\x31\xec\x43\x3d\xf7\xac\x29\x51\x92\xa9\x43\xda\x95\x9d\x5d\x64\x1d\x9b\xe3\x7c\xa9\x5f\x38\x83\x6a\x30\xd9\xec\x78\xf2\x19\x87\x90\x23\x17\xfa\x21\xaa\x87\x49\xfb\xe9\x9c\x3d\x54\x74\x10\x26\x35\x6c\xe1\xcc\x37\x0f\x8b\xed\x48\xc5\x18\xc9\xc4\xfc\xf6\x8b\x36\x1e\x7f\x96\x97\x84\x54\x94\x59\xee\x3d\x8d\x82\xe5\xeb\xc8\x7c\x9f\xae\xf5\x63\x5e\xba\x7d\xa5\x9b\x10\xed\x3d\xa7\xd9\x5e\x78\x32\x0e\xc2\x0d\x0e\xc6\x1c\x07\xc7\xa7\x71\x56\x3b\x3a\xec\x3f\x28\x20\xe3\x3d\x8f\x51\x91\xdf\xc3\x11\x3c\x53\x94\xaa\x93\x15\x17\x75\x85\xb3\x92\x18\x7d\xb0\x6a\x35\x3c\x7d\x45\xb9\xc9\x28\xd4\x6e\xa1\x95\xa2\x39\xa9\xc1\x15\x49\x9a\x26\xc5\x0a\x5b\xaa\x71\x4e\x9d\x52\x99\x2b\x8d\x98\x73\xa9\x62\x41\x12\xf0\xb8\xd5\x13\x84\xf8\x2d\x23\x42\xb6\x14\x7d\x0e\x70\x8e\x81\x43\x00\x85\x7b\x5b\x9a\x41\x1c\xae\x83\x30\xdd\x88\x0c\x8d\xfa\x2a\x30\x36\xe3\xa3\x74\xeb\xee\x59\x08\x5b\x85\x70\xa4\x4c\x7b\xda\x57\x79\x78\xe6\x9a\xc8\x61\xac\x8a\x0a\x55\xe2\x8c\xde\x9b\x42\x08\xd5\x5d\x31\x85\x06\xa4\x43\xcd\x48\x92\xa4\x14\x3a\xef\x3b\x60\xba\x95\xe6\xb1\xb1\xb6\xe7\x2b\x11\xb4\xd0\x9b\xb8\xd3\x0e\xa3\xc9\x65\x6f\x6c\x26\x03\xc4\x48\x13\xe0\x2e\x65\x3b\xd8\xbd\x6d\xcc\x85\xe0\x8a\x27\x3c\x73\xcf\xaa\xa4\xb0\x8e\x6f\x05\xcf\xe3\x82\x0b\x65\xec\x6b\x63\x53\xbc\xb5\x68\xdb\xcb\x8b\x8b\xe7\x17\xc6\x3e\xf4\x54\x7a\xd9\x1e\xde\xf1\x8a\x74\x64\x87\x2e\x55\x9b\x49\xeb\x46\xd0\x0a\x0e\xfc\x25\x85\x04\x2b\x2e\x9a\x66\x85\xe6\xa1\x4c\xa7\x9b\x85\x81\x9e\xd4\x34\x5d\xc2\x1e\x8c\xb6\x4c\xff\xa7\x68\x69\x92\xcf\x86\x1b\x9e\xcf\xc4\xeb\x8c\x7f\x73\xb0\xc4\x8f\xb5\x0f\x69\x9c\xbf\xf6\x7f\xc7\x06\x50\x11\x9e\x5b\x22\xfa\x1e\x65\x43\xeb\xbb\x54\xb7\xcb\xb2\x21\xd6\x79\x1b\xda\xb3\x3a\x7f\xca\x29\x93\x0a\xb3\x84\xf8\x13\x4e\x61\x49\x01\x05\x9c\xf6\x5b\xed\xdc\x1e\xc4\xee\xe1\x59\xfe\xb5\x9d\xe5\x5f\x59\x79\x6f\xf7\x88\xb5\x04\x0d\xf0\xd4\xb3\x28\x27\x29\x2d\xf3\x99\xa6\x32\xd8\xd6\x6b\x2a\x36\x13\xae\xc1\xa4\xb6\x1d\x99\x80\x62\xdd\xe8\x61\x0c\x27\xed\x0b\xc8\x1b\xb4\xc5\x20\xdd\x7a\xfa\xca\x29\x42\x07\x26\x08\xad\xeb\x91\x93\xf5\xc8\x89\x7a\x0b\xac\x45\x6b\x98\x74\x70\xef\x81\x82\x9b\x4a\xa4\x41\xfa\xb2\x34\x67\xc1\xca\xdc\x7f\xcf\xaa\xab\xcb\xc9\x03\xc7\xbc\x80\xe8\xc6\x74\x40\xd7\x67\xe6\xec\xa5\x43\x73\x9b\xc8\x3e\x27\xad\xc5\x4c\x42\x3d\x69\xe3\xc1\x7a\xd4\x86\x87\x5d\x78\xa6\x09\x7b\x2d\xf8\x67\x06\x56\x87\xff\xeb\x03\x68\xd7\xe0\x96\x0c\x90\x5d\x99\xfb\x2f\x42\x73\x04\x2d\x79\x69\x9a\xf3\xe6\xc0\x8b\xd3\xbf\x36\xf9\x1d\x43\x85\x93\xdf\x93\xef\xc2\x92\xe5\x6e\x13\x11\x00\x00")
+
+func templatesNatTfBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesNatTf,
+		"templates/nat.tf",
+	)
+}
+
+func templatesNatTf() (*asset, error) {
+	bytes, err := templatesNatTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/nat.tf", size: 4371, mode: os.FileMode(420), modTime: time.Unix(1792369804, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesPrivate_directorTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\x51\x3d\x4f\x03\x31\x0c\xdd\xf3\x2b\xa2\xa8\x03\x48\xed\x49\x6c\x2c\x4c\xb0\xb0\xa0\x0a\x55\xac\x91\x93\xb3\x20\x6a\x7a\xa9\xf2\x55\xc1\x29\xff\x1d\xa7\xb9\x96\xa3\x65\x20\x4b\x2c\xdb\xef\xf9\xf9\xd9\x63\x70\xc9\x6b\xe4\x02\x0e\x41\x86\xa4\x06\x8c\x82\x8b\xde\x78\xd4\xd1\xf9\x73\x66\x64\x9c\xe7\xbd\x96\xa6\xe7\xb3\xf7\xc0\xc5\x38\x76\x6f\xeb\xc7\xe7\xa7\x52\x04\xb5\x68\xd3\x7b\xa9\xac\xd3\xdb\x59\xcb\x62\xac\xe9\xc6\x74\x93\xc1\x77\xf4\x1f\x9c\xdf\xca\x9a\x5e\xf2\xfb\x25\xbf\xbb\x3d\xa2\x21\x83\xb1\xa0\x8c\x35\xf1\x53\x7e\xb9\x01\x1b\xfa\x47\x59\xa7\x5c\xf8\x38\xc5\x57\xdd\xc4\x41\x24\x11\xde\xc3\x51\x2e\xe7\x2f\xb0\x9b\x28\xea\x54\x1c\x32\xc9\x2f\xab\xd3\x6e\xab\x69\x37\x6a\x2d\xac\x30\xe6\x7f\x59\xe1\x5d\x8a\x28\x23\x28\x8b\x12\x42\x70\xda\x40\x34\x6e\x20\x6f\x5a\xe5\x4f\x87\x5a\x7c\x36\xe9\x52\xfd\x05\xa6\x23\x35\x75\xfa\x7c\x14\x41\x9b\xa9\x6b\x6f\x32\x44\x7c\xad\xb5\x4d\x2d\x35\x8b\x49\x27\x65\xf6\x29\x5e\xdd\x88\xa0\xd3\x99\xc0\x26\xfc\xdf\xec\xc2\xbe\x01\x3d\x54\x86\xd1\x00\x02\x00\x00")
+
+func templatesPrivate_directorTfBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesPrivate_directorTf,
+		"templates/private_director.tf",
+	)
+}
+
+func templatesPrivate_directorTf() (*asset, error) {
+	bytes, err := templatesPrivate_directorTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/private_director.tf", size: 512, mode: os.FileMode(420), modTime: time.Unix(1792369804, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -193,6 +278,26 @@ func templatesSsl_certificateTf() (*asset, error) {
 	return a, nil
 }
 
+var _templatesVpcTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x75\x8f\x41\x0e\xc2\x20\x10\x45\xf7\x9c\x82\x10\xb7\xf6\x06\x5e\xc1\x2b\x90\x29\x8c\x95\x14\xa1\x81\xa1\x8d\x69\xb8\xbb\x43\x5b\xd3\xb8\x90\x04\x58\xfc\xf7\xff\xcc\x4f\x98\x63\x49\x06\xa5\x82\x25\xeb\x79\x32\x4a\xaa\xed\x5d\x85\x94\xc6\xd9\xa4\x7b\x1f\xcd\x28\xcf\x73\x93\xea\xb2\xce\x90\xba\x80\xb4\xc4\x34\xea\x46\x55\xc5\xb8\x0b\x99\x20\x18\xd4\x84\x81\xff\xf7\x17\xb7\xf8\x80\xe2\xa9\x21\x2c\xf4\x1e\xb5\x0d\x59\x3f\x63\xa6\x00\x2f\xcc\x8c\x50\x2a\x28\x58\x26\x18\xf2\x36\x59\xca\x3b\x4b\xe7\x2c\x0c\xb3\x76\xb6\x5e\xdb\x6a\x2c\x57\x51\x85\x48\x3f\xab\xbb\x40\x98\x78\x25\x3d\x00\xe1\x02\x6f\xee\xe1\x86\xbd\x06\x9b\xd8\xbc\x87\x1d\x2d\xbb\x76\x39\x50\xb5\xa0\x58\x68\x2a\xb4\xf5\x66\xee\xf0\x80\x2f\xf8\xd7\xf2\x01\x03\x17\x20\x64\x36\x01\x00\x00")
+
+func templatesVpcTfBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesVpcTf,
+		"templates/vpc.tf",
+	)
+}
+
+func templatesVpcTf() (*asset, error) {
+	bytes, err := templatesVpcTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/vpc.tf", size: 310, mode: os.FileMode(420), modTime: time.Unix(1792369804, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 // Asset loads and returns the asset for the given name.
 // It returns an error if the asset could not be found or
 // could not be loaded.
@@ -245,12 +350,17 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
+	"templates/acm_certificate.tf": templatesAcm_certificateTf,
 	"templates/base.tf": templatesBaseTf,
 	"templates/cf_dns.tf": templatesCf_dnsTf,
 	"templates/cf_lb.tf": templatesCf_lbTf,
 	"templates/concourse_lb.tf": templatesConcourse_lbTf,
+	"templates/existing_vpc.tf": templatesExisting_vpcTf,
 	"templates/lb_subnet.tf": templatesLb_subnetTf,
+	"templates/nat.tf": templatesNatTf,
+	"templates/private_director.tf": templatesPrivate_directorTf,
 	"templates/ssl_certificate.tf": templatesSsl_certificateTf,
+	"templates/vpc.tf": templatesVpcTf,
 }
 
 // AssetDir returns the file names below a certain
@@ -294,12 +404,17 @@ type bintree struct {
 }
 var _bintree = &bintree{nil, map[string]*bintree{
 	"templates": &bintree{nil, map[string]*bintree{
+		"acm_certificate.tf": &bintree{templatesAcm_certificateTf, map[string]*bintree{}},
 		"base.tf": &bintree{templatesBaseTf, map[string]*bintree{}},
 		"cf_dns.tf": &bintree{templatesCf_dnsTf, map[string]*bintree{}},
 		"cf_lb.tf": &bintree{templatesCf_lbTf, map[string]*bintree{}},
 		"concourse_lb.tf": &bintree{templatesConcourse_lbTf, map[string]*bintree{}},
+		"existing_vpc.tf": &bintree{templatesExisting_vpcTf, map[string]*bintree{}},
 		"lb_subnet.tf": &bintree{templatesLb_subnetTf, map[string]*bintree{}},
+		"nat.tf": &bintree{templatesNatTf, map[string]*bintree{}},
+		"private_director.tf": &bintree{templatesPrivate_directorTf, map[string]*bintree{}},
 		"ssl_certificate.tf": &bintree{templatesSsl_certificateTf, map[string]*bintree{}},
+		"vpc.tf": &bintree{templatesVpcTf, map[string]*bintree{}},
 	}},
 }}
 
@@ -20,7 +20,7 @@ resource "aws_route53_record" "wildcard_dns" {
   type    = "CNAME"
   ttl     = 300
 
-  records = ["${aws_elb.cf_router_lb.dns_name}"]
+  records = ["{{.RouterLBDNSName}}"]
 }
 
 resource "aws_route53_record" "ssh" {
@@ -29,7 +29,7 @@ resource "aws_route53_record" "ssh" {
   type    = "CNAME"
   ttl     = 300
 
-  records = ["${aws_elb.cf_ssh_lb.dns_name}"]
+  records = ["{{.SSHLBDNSName}}"]
 }
 
 resource "aws_route53_record" "bosh" {
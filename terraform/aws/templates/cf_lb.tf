@@ -1,6 +1,6 @@
 resource "aws_security_group" "cf_ssh_lb_security_group" {
   description = "{{.SSHLBDescription}}"
-  vpc_id      = "${aws_vpc.vpc.id}"
+  vpc_id      = "{{.VPCID}}"
 
   ingress {
     cidr_blocks = ["0.0.0.0/0"]
@@ -27,11 +27,12 @@ output "cf_ssh_lb_security_group" {
 
 resource "aws_security_group" "cf_ssh_lb_internal_security_group" {
   description = "{{.SSHLBInternalDescription}}"
-  vpc_id      = "${aws_vpc.vpc.id}"
+  vpc_id      = "{{.VPCID}}"
 
   ingress {
-    security_groups = ["${aws_security_group.cf_ssh_lb_security_group.id}"]
-    protocol    = "tcp"
+{{if .LBFlavor}}    cidr_blocks = ["0.0.0.0/0"]
+{{else}}    security_groups = ["${aws_security_group.cf_ssh_lb_security_group.id}"]
+{{end}}    protocol    = "tcp"
     from_port   = 2222
     to_port     = 2222
   }
@@ -52,7 +53,49 @@ output "cf_ssh_lb_internal_security_group" {
   value="${aws_security_group.cf_ssh_lb_internal_security_group.id}"
 }
 
-resource "aws_elb" "cf_ssh_lb" {
+{{if .LBFlavor}}resource "aws_lb" "cf_ssh_lb" {
+  name               = "${var.short_env_id}-cf-ssh-lb"
+  load_balancer_type = "network"
+  subnets            = ["${aws_subnet.lb_subnets.*.id}"]
+}
+
+resource "aws_lb_target_group" "cf_ssh_lb" {
+  name     = "${var.short_env_id}-cf-ssh-lb"
+  port     = 2222
+  protocol = "TCP"
+  vpc_id   = "{{.VPCID}}"
+
+  health_check {
+    protocol            = "TCP"
+    healthy_threshold   = 3
+    unhealthy_threshold = 3
+    interval            = 10
+  }
+}
+
+resource "aws_lb_listener" "cf_ssh_lb" {
+  load_balancer_arn = "${aws_lb.cf_ssh_lb.arn}"
+  port              = 2222
+  protocol          = "TCP"
+
+  default_action {
+    type             = "forward"
+    target_group_arn = "${aws_lb_target_group.cf_ssh_lb.arn}"
+  }
+}
+
+output "cf_ssh_lb_name" {
+  value = "${aws_lb.cf_ssh_lb.name}"
+}
+
+output "cf_ssh_lb_url" {
+  value = "${aws_lb.cf_ssh_lb.dns_name}"
+}
+
+output "cf_ssh_lb_target_groups" {
+  value = ["${aws_lb_target_group.cf_ssh_lb.name}"]
+}
+{{else}}resource "aws_elb" "cf_ssh_lb" {
   name                      = "${var.short_env_id}-cf-ssh-lb"
   cross_zone_load_balancing = true
 
@@ -82,10 +125,10 @@ output "cf_ssh_lb_name" {
 output "cf_ssh_lb_url" {
   value = "${aws_elb.cf_ssh_lb.dns_name}"
 }
-
+{{end}}
 resource "aws_security_group" "cf_router_lb_security_group" {
   description = "{{.RouterDescription}}"
-  vpc_id      = "${aws_vpc.vpc.id}"
+  vpc_id      = "{{.VPCID}}"
 
   ingress {
     cidr_blocks = ["0.0.0.0/0"]
@@ -126,11 +169,12 @@ output "cf_router_lb_security_group" {
 
 resource "aws_security_group" "cf_router_lb_internal_security_group" {
   description = "{{.RouterInternalDescription}}"
-  vpc_id      = "${aws_vpc.vpc.id}"
+  vpc_id      = "{{.VPCID}}"
 
   ingress {
-    security_groups = ["${aws_security_group.cf_router_lb_security_group.id}"]
-    protocol    = "tcp"
+{{if eq .LBFlavor "nlb"}}    cidr_blocks = ["0.0.0.0/0"]
+{{else}}    security_groups = ["${aws_security_group.cf_router_lb_security_group.id}"]
+{{end}}    protocol    = "tcp"
     from_port   = 80
     to_port     = 80
   }
@@ -151,7 +195,82 @@ output "cf_router_lb_internal_security_group" {
   value="${aws_security_group.cf_router_lb_internal_security_group.id}"
 }
 
-resource "aws_elb" "cf_router_lb" {
+{{if .LBFlavor}}resource "aws_lb" "cf_router_lb" {
+  name               = "${var.short_env_id}-cf-router-lb"
+{{if eq .LBFlavor "alb"}}  load_balancer_type = "application"
+  security_groups    = ["${aws_security_group.cf_router_lb_security_group.id}"]
+{{else}}  load_balancer_type = "network"
+{{end}}  subnets            = ["${aws_subnet.lb_subnets.*.id}"]
+}
+
+resource "aws_lb_target_group" "cf_router_lb" {
+  name     = "${var.short_env_id}-cf-router-lb"
+  port     = 80
+{{if eq .LBFlavor "alb"}}  protocol = "HTTP"
+{{else}}  protocol = "TCP"
+{{end}}  vpc_id   = "{{.VPCID}}"
+
+  health_check {
+{{if eq .LBFlavor "alb"}}    protocol            = "HTTP"
+    path                = "/"
+    matcher             = "200-499"
+{{else}}    protocol            = "TCP"
+{{end}}    healthy_threshold   = 3
+    unhealthy_threshold = 3
+    interval            = 10
+  }
+}
+
+resource "aws_lb_listener" "cf_router_lb_80" {
+  load_balancer_arn = "${aws_lb.cf_router_lb.arn}"
+  port              = 80
+{{if eq .LBFlavor "alb"}}  protocol          = "HTTP"
+{{else}}  protocol          = "TCP"
+{{end}}
+  default_action {
+    type             = "forward"
+    target_group_arn = "${aws_lb_target_group.cf_router_lb.arn}"
+  }
+}
+
+resource "aws_lb_listener" "cf_router_lb_443" {
+  load_balancer_arn = "${aws_lb.cf_router_lb.arn}"
+  port              = 443
+{{if eq .LBFlavor "alb"}}  protocol          = "HTTPS"
+{{else}}  protocol          = "TLS"
+{{end}}  certificate_arn   = "{{.LBCertificateARN}}"
+
+  default_action {
+    type             = "forward"
+    target_group_arn = "${aws_lb_target_group.cf_router_lb.arn}"
+  }
+}
+
+resource "aws_lb_listener" "cf_router_lb_4443" {
+  load_balancer_arn = "${aws_lb.cf_router_lb.arn}"
+  port              = 4443
+{{if eq .LBFlavor "alb"}}  protocol          = "HTTPS"
+{{else}}  protocol          = "TLS"
+{{end}}  certificate_arn   = "{{.LBCertificateARN}}"
+
+  default_action {
+    type             = "forward"
+    target_group_arn = "${aws_lb_target_group.cf_router_lb.arn}"
+  }
+}
+
+output "cf_router_lb_name" {
+  value = "${aws_lb.cf_router_lb.name}"
+}
+
+output "cf_router_lb_url" {
+  value = "${aws_lb.cf_router_lb.dns_name}"
+}
+
+output "cf_router_lb_target_groups" {
+  value = ["${aws_lb_target_group.cf_router_lb.name}"]
+}
+{{else}}resource "aws_elb" "cf_router_lb" {
   name                      = "${var.short_env_id}-cf-router-lb"
   cross_zone_load_balancing = true
 
@@ -175,7 +294,7 @@ resource "aws_elb" "cf_router_lb" {
     instance_protocol  = "http"
     lb_port            = 443
     lb_protocol        = "https"
-    ssl_certificate_id = "${aws_iam_server_certificate.lb_cert.arn}"
+    ssl_certificate_id = "{{.LBCertificateARN}}"
   }
 
   listener {
@@ -183,7 +302,7 @@ resource "aws_elb" "cf_router_lb" {
     instance_protocol  = "tcp"
     lb_port            = 4443
     lb_protocol        = "ssl"
-    ssl_certificate_id = "${aws_iam_server_certificate.lb_cert.arn}"
+    ssl_certificate_id = "{{.LBCertificateARN}}"
   }
 
   security_groups = ["${aws_security_group.cf_router_lb_security_group.id}"]
@@ -197,10 +316,10 @@ output "cf_router_lb_name" {
 output "cf_router_lb_url" {
   value = "${aws_elb.cf_router_lb.dns_name}"
 }
-
+{{end}}
 resource "aws_security_group" "cf_tcp_lb_security_group" {
   description = "{{.TCPLBDescription}}"
-  vpc_id      = "${aws_vpc.vpc.id}"
+  vpc_id      = "{{.VPCID}}"
 
   ingress {
     cidr_blocks = ["0.0.0.0/0"]
@@ -227,7 +346,7 @@ output "cf_tcp_lb_security_group" {
 
 resource "aws_security_group" "cf_tcp_lb_internal_security_group" {
   description = "{{.TCPLBInternalDescription}}"
-  vpc_id      = "${aws_vpc.vpc.id}"
+  vpc_id      = "{{.VPCID}}"
 
   ingress {
     security_groups = ["${aws_security_group.cf_tcp_lb_security_group.id}"]
@@ -1,6 +1,6 @@
 resource "aws_security_group" "concourse_lb_security_group" {
   description = "{{.ConcourseDescription}}"
-  vpc_id      = "${aws_vpc.vpc.id}"
+  vpc_id      = "{{.VPCID}}"
 
   ingress {
     cidr_blocks = ["0.0.0.0/0"]
@@ -37,18 +37,20 @@ resource "aws_security_group" "concourse_lb_security_group" {
 
 resource "aws_security_group" "concourse_lb_internal_security_group" {
   description = "{{.ConcourseInternalDescription}}"
-  vpc_id      = "${aws_vpc.vpc.id}"
+  vpc_id      = "{{.VPCID}}"
 
   ingress {
-    security_groups = ["${aws_security_group.concourse_lb_security_group.id}"]
-    protocol    = "tcp"
+{{if eq .LBFlavor "nlb"}}    cidr_blocks = ["0.0.0.0/0"]
+{{else}}    security_groups = ["${aws_security_group.concourse_lb_security_group.id}"]
+{{end}}    protocol    = "tcp"
     from_port   = 8080
     to_port     = 8080
   }
 
   ingress {
-    security_groups = ["${aws_security_group.concourse_lb_security_group.id}"]
-    protocol    = "tcp"
+{{if .LBFlavor}}    cidr_blocks = ["0.0.0.0/0"]
+{{else}}    security_groups = ["${aws_security_group.concourse_lb_security_group.id}"]
+{{end}}    protocol    = "tcp"
     from_port   = 2222
     to_port     = 2222
   }
@@ -69,7 +71,114 @@ output "concourse_lb_internal_security_group" {
   value="${aws_security_group.concourse_lb_internal_security_group.id}"
 }
 
-resource "aws_elb" "concourse_lb" {
+{{if .LBFlavor}}resource "aws_lb" "concourse_lb" {
+  name               = "${var.short_env_id}-concourse-lb"
+{{if eq .LBFlavor "alb"}}  load_balancer_type = "application"
+  security_groups    = ["${aws_security_group.concourse_lb_security_group.id}"]
+{{else}}  load_balancer_type = "network"
+{{end}}  subnets            = ["${aws_subnet.lb_subnets.*.id}"]
+}
+
+resource "aws_lb_target_group" "concourse_lb_web" {
+  name     = "${var.short_env_id}-concourse-web"
+  port     = 8080
+{{if eq .LBFlavor "alb"}}  protocol = "HTTP"
+{{else}}  protocol = "TCP"
+{{end}}  vpc_id   = "{{.VPCID}}"
+
+  health_check {
+{{if eq .LBFlavor "alb"}}    protocol            = "HTTP"
+    path                = "/"
+{{else}}    protocol            = "TCP"
+{{end}}    healthy_threshold   = 3
+    unhealthy_threshold = 3
+    interval            = 30
+  }
+}
+
+resource "aws_lb_target_group" "concourse_lb_tsa" {
+  name     = "${var.short_env_id}-concourse-tsa"
+  port     = 2222
+  protocol = "TCP"
+  vpc_id   = "{{.VPCID}}"
+
+  health_check {
+    protocol            = "TCP"
+    healthy_threshold   = 3
+    unhealthy_threshold = 3
+    interval            = 30
+  }
+}
+
+resource "aws_lb_listener" "concourse_lb_80" {
+  load_balancer_arn = "${aws_lb.concourse_lb.arn}"
+  port              = 80
+{{if eq .LBFlavor "alb"}}  protocol          = "HTTP"
+{{else}}  protocol          = "TCP"
+{{end}}
+  default_action {
+    type             = "forward"
+    target_group_arn = "${aws_lb_target_group.concourse_lb_web.arn}"
+  }
+}
+
+resource "aws_lb_listener" "concourse_lb_443" {
+  load_balancer_arn = "${aws_lb.concourse_lb.arn}"
+  port              = 443
+{{if eq .LBFlavor "alb"}}  protocol          = "HTTPS"
+{{else}}  protocol          = "TLS"
+{{end}}  certificate_arn   = "{{.LBCertificateARN}}"
+
+  default_action {
+    type             = "forward"
+    target_group_arn = "${aws_lb_target_group.concourse_lb_web.arn}"
+  }
+}
+{{if eq .LBFlavor "alb"}}
+resource "aws_lb" "concourse_tsa_lb" {
+  name               = "${var.short_env_id}-concourse-tsa"
+  load_balancer_type = "network"
+  subnets            = ["${aws_subnet.lb_subnets.*.id}"]
+}
+
+resource "aws_lb_listener" "concourse_lb_2222" {
+  load_balancer_arn = "${aws_lb.concourse_tsa_lb.arn}"
+  port              = 2222
+  protocol          = "TCP"
+
+  default_action {
+    type             = "forward"
+    target_group_arn = "${aws_lb_target_group.concourse_lb_tsa.arn}"
+  }
+}
+
+output "concourse_tsa_lb_url" {
+  value = "${aws_lb.concourse_tsa_lb.dns_name}"
+}
+{{else}}
+resource "aws_lb_listener" "concourse_lb_2222" {
+  load_balancer_arn = "${aws_lb.concourse_lb.arn}"
+  port              = 2222
+  protocol          = "TCP"
+
+  default_action {
+    type             = "forward"
+    target_group_arn = "${aws_lb_target_group.concourse_lb_tsa.arn}"
+  }
+}
+{{end}}
+output "concourse_lb_name" {
+  value = "${aws_lb.concourse_lb.name}"
+}
+
+output "concourse_lb_url" {
+  value = "${aws_lb.concourse_lb.dns_name}"
+}
+
+output "concourse_lb_target_groups" {
+  value = ["${aws_lb_target_group.concourse_lb_web.name}", "${aws_lb_target_group.concourse_lb_tsa.name}"]
+}
+{{else}}resource "aws_elb" "concourse_lb" {
   name                      = "${var.short_env_id}-concourse-lb"
   cross_zone_load_balancing = true
 
@@ -100,7 +209,7 @@ resource "aws_elb" "concourse_lb" {
     instance_protocol  = "tcp"
     lb_port            = 443
     lb_protocol        = "ssl"
-    ssl_certificate_id = "${aws_iam_server_certificate.lb_cert.arn}"
+    ssl_certificate_id = "{{.LBCertificateARN}}"
   }
 
   security_groups = ["${aws_security_group.concourse_lb_security_group.id}"]
@@ -114,3 +223,4 @@ output "concourse_lb_name" {
 output "concourse_lb_url" {
   value = "${aws_elb.concourse_lb.dns_name}"
 }
+{{- end}}
@@ -0,0 +1,18 @@
+variable "existing_vpc_id" {
+  type = "string"
+}
+
+data "aws_vpc" "vpc" {
+  id = "${var.existing_vpc_id}"
+}
+
+data "aws_internet_gateway" "ig" {
+  filter {
+    name   = "attachment.vpc-id"
+    values = ["${var.existing_vpc_id}"]
+  }
+}
+
+output "vpc_id" {
+  value = "${data.aws_vpc.vpc.id}"
+}
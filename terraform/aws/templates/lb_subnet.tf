@@ -1,7 +1,7 @@
 resource "aws_subnet" "lb_subnets" {
   count             = "${length(var.availability_zones)}"
-  vpc_id            = "${aws_vpc.vpc.id}"
-  cidr_block        = "${cidrsubnet("10.0.0.0/20", 4, count.index+2)}"
+  vpc_id            = "{{.VPCID}}"
+  cidr_block        = "${cidrsubnet(cidrsubnet(var.network_cidr, 4, 0), 4, count.index+2)}"
   availability_zone = "${element(var.availability_zones, count.index)}"
 
   tags {
@@ -14,12 +14,12 @@ resource "aws_subnet" "lb_subnets" {
 }
 
 resource "aws_route_table" "lb_route_table" {
-  vpc_id = "${aws_vpc.vpc.id}"
+  vpc_id = "{{.VPCID}}"
 }
 
 resource "aws_route" "lb_route_table" {
   destination_cidr_block = "0.0.0.0/0"
-  gateway_id = "${aws_internet_gateway.ig.id}"
+  gateway_id = "{{.InternetGatewayID}}"
   route_table_id = "${aws_route_table.lb_route_table.id}"
 }
 
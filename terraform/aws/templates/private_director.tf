@@ -0,0 +1,18 @@
+resource "aws_subnet" "director_subnet" {
+  vpc_id            = "{{.VPCID}}"
+  cidr_block        = "${cidrsubnet(var.network_cidr, 8, 1)}"
+  availability_zone = "${aws_subnet.bosh_subnet.availability_zone}"
+
+  tags {
+    Name = "${var.env_id}-director-subnet"
+  }
+}
+
+resource "aws_route_table_association" "route_director_subnet" {
+  subnet_id      = "${aws_subnet.director_subnet.id}"
+  route_table_id = "{{.PrivateRouteTableID}}"
+}
+
+output "director_subnet_id" {
+  value = "${aws_subnet.director_subnet.id}"
+}
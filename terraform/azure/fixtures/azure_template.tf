@@ -26,6 +26,16 @@ variable "client_secret" {
 	type = "string"
 }
 
+variable "allowed_ingress_cidrs" {
+	type = "list"
+	default = ["0.0.0.0/0"]
+}
+
+variable "network_cidr" {
+	type = "string"
+	default = "10.0.0.0/16"
+}
+
 provider "azurerm" {
   subscription_id  = "${var.subscription_id}"
   tenant_id        = "${var.tenant_id}"
@@ -55,18 +65,22 @@ resource "azurerm_public_ip" "bosh" {
 
 resource "azurerm_virtual_network" "bosh" {
   name                = "${var.env_id}-bosh-vn"
-  address_space       = ["10.0.0.0/16"]
+  address_space       = ["${var.network_cidr}"]
   location            = "${var.location}"
   resource_group_name = "${azurerm_resource_group.bosh.name}"
 }
 
 resource "azurerm_subnet" "bosh" {
   name                 = "${var.env_id}-bosh-sn"
-  address_prefix       = "10.0.0.0/16"
+  address_prefix       = "${var.network_cidr}"
   resource_group_name  = "${azurerm_resource_group.bosh.name}"
   virtual_network_name = "${azurerm_virtual_network.bosh.name}"
 }
 
+output "bosh_network_name" {
+    value = "${azurerm_virtual_network.bosh.name}"
+}
+
 resource "azurerm_storage_account" "bosh" {
   name                = "${var.simple_env_id}"
   resource_group_name = "${azurerm_resource_group.bosh.name}"
@@ -122,7 +136,7 @@ resource "azurerm_network_security_rule" "ssh" {
   protocol                   = "Tcp"
   source_port_range          = "*"
   destination_port_range     = "22"
-  source_address_prefix      = "*"
+  source_address_prefixes    = ["${var.allowed_ingress_cidrs}"]
   destination_address_prefix = "*"
   resource_group_name         = "${azurerm_resource_group.bosh.name}"
   network_security_group_name = "${azurerm_network_security_group.bosh.name}"
@@ -136,7 +150,7 @@ resource "azurerm_network_security_rule" "bosh-agent" {
   protocol                   = "Tcp"
   source_port_range          = "*"
   destination_port_range     = "6868"
-  source_address_prefix      = "*"
+  source_address_prefixes    = ["${var.allowed_ingress_cidrs}"]
   destination_address_prefix = "*"
   resource_group_name         = "${azurerm_resource_group.bosh.name}"
   network_security_group_name = "${azurerm_network_security_group.bosh.name}"
@@ -150,7 +164,7 @@ resource "azurerm_network_security_rule" "bosh-director" {
   protocol                   = "Tcp"
   source_port_range          = "*"
   destination_port_range     = "25555"
-  source_address_prefix      = "*"
+  source_address_prefixes    = ["${var.allowed_ingress_cidrs}"]
   destination_address_prefix = "*"
   resource_group_name         = "${azurerm_resource_group.bosh.name}"
   network_security_group_name = "${azurerm_network_security_group.bosh.name}"
@@ -212,10 +226,6 @@ resource "azurerm_network_security_rule" "cf-log" {
   network_security_group_name = "${azurerm_network_security_group.cf.name}"
 }
 
-output "bosh_network_name" {
-    value = "${azurerm_virtual_network.bosh.name}"
-}
-
 output "bosh_subnet_name" {
     value = "${azurerm_subnet.bosh.name}"
 }
@@ -0,0 +1,406 @@
+variable "env_id" {
+	type = "string"
+}
+
+variable "location" {
+	type = "string"
+}
+
+variable "simple_env_id" {
+	type = "string"
+}
+
+variable "subscription_id" {
+	type = "string"
+}
+
+variable "tenant_id" {
+	type = "string"
+}
+
+variable "client_id" {
+	type = "string"
+}
+
+variable "client_secret" {
+	type = "string"
+}
+
+variable "allowed_ingress_cidrs" {
+	type = "list"
+	default = ["0.0.0.0/0"]
+}
+
+variable "network_cidr" {
+	type = "string"
+	default = "10.0.0.0/16"
+}
+
+provider "azurerm" {
+  subscription_id  = "${var.subscription_id}"
+  tenant_id        = "${var.tenant_id}"
+  client_id        = "${var.client_id}"
+  client_secret    = "${var.client_secret}"
+}
+
+resource "azurerm_resource_group" "bosh" {
+  name     = "${var.env_id}-bosh"
+  location = "${var.location}"
+
+  tags {
+    environment = "${var.env_id}"
+  }
+}
+
+resource "azurerm_public_ip" "bosh" {
+  name                         = "${var.env_id}-bosh"
+  location                     = "${var.location}"
+  resource_group_name          = "${azurerm_resource_group.bosh.name}"
+  public_ip_address_allocation = "static"
+
+  tags {
+    environment = "${var.env_id}"
+  }
+}
+
+resource "azurerm_virtual_network" "bosh" {
+  name                = "${var.env_id}-bosh-vn"
+  address_space       = ["${var.network_cidr}"]
+  location            = "${var.location}"
+  resource_group_name = "${azurerm_resource_group.bosh.name}"
+}
+
+resource "azurerm_subnet" "bosh" {
+  name                 = "${var.env_id}-bosh-sn"
+  address_prefix       = "${var.network_cidr}"
+  resource_group_name  = "${azurerm_resource_group.bosh.name}"
+  virtual_network_name = "${azurerm_virtual_network.bosh.name}"
+}
+
+output "bosh_network_name" {
+    value = "${azurerm_virtual_network.bosh.name}"
+}
+
+resource "azurerm_storage_account" "bosh" {
+  name                = "${var.simple_env_id}"
+  resource_group_name = "${azurerm_resource_group.bosh.name}"
+
+  location     = "westus"
+  account_tier = "Standard"
+  account_replication_type = "GRS"
+
+  tags {
+    environment = "${var.env_id}"
+  }
+}
+
+resource "azurerm_storage_container" "bosh" {
+  name                  = "bosh"
+  resource_group_name   = "${azurerm_resource_group.bosh.name}"
+  storage_account_name  = "${azurerm_storage_account.bosh.name}"
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_container" "stemcell" {
+  name                  = "stemcell"
+  resource_group_name   = "${azurerm_resource_group.bosh.name}"
+  storage_account_name  = "${azurerm_storage_account.bosh.name}"
+  container_access_type = "blob"
+}
+
+resource "azurerm_network_security_group" "bosh" {
+  name                = "${var.env_id}-bosh"
+  location            = "${var.location}"
+  resource_group_name = "${azurerm_resource_group.bosh.name}"
+
+  tags {
+    environment = "${var.env_id}"
+  }
+}
+
+resource "azurerm_network_security_group" "cf" {
+  name                = "${var.env_id}-cf"
+  location            = "${var.location}"
+  resource_group_name = "${azurerm_resource_group.bosh.name}"
+
+  tags {
+    environment = "${var.env_id}"
+  }
+}
+
+resource "azurerm_network_security_rule" "ssh" {
+  name                       = "${var.env_id}-ssh"
+  priority                   = 200
+  direction                  = "Inbound"
+  access                     = "Allow"
+  protocol                   = "Tcp"
+  source_port_range          = "*"
+  destination_port_range     = "22"
+  source_address_prefixes    = ["${var.allowed_ingress_cidrs}"]
+  destination_address_prefix = "*"
+  resource_group_name         = "${azurerm_resource_group.bosh.name}"
+  network_security_group_name = "${azurerm_network_security_group.bosh.name}"
+}
+
+resource "azurerm_network_security_rule" "bosh-agent" {
+  name                       = "${var.env_id}-bosh-agent"
+  priority                   = 201
+  direction                  = "Inbound"
+  access                     = "Allow"
+  protocol                   = "Tcp"
+  source_port_range          = "*"
+  destination_port_range     = "6868"
+  source_address_prefixes    = ["${var.allowed_ingress_cidrs}"]
+  destination_address_prefix = "*"
+  resource_group_name         = "${azurerm_resource_group.bosh.name}"
+  network_security_group_name = "${azurerm_network_security_group.bosh.name}"
+}
+
+resource "azurerm_network_security_rule" "bosh-director" {
+  name                       = "${var.env_id}-bosh-director"
+  priority                   = 202
+  direction                  = "Inbound"
+  access                     = "Allow"
+  protocol                   = "Tcp"
+  source_port_range          = "*"
+  destination_port_range     = "25555"
+  source_address_prefixes    = ["${var.allowed_ingress_cidrs}"]
+  destination_address_prefix = "*"
+  resource_group_name         = "${azurerm_resource_group.bosh.name}"
+  network_security_group_name = "${azurerm_network_security_group.bosh.name}"
+}
+
+resource "azurerm_network_security_rule" "dns" {
+  name                       = "${var.env_id}-dns"
+  priority                   = 203
+  direction                  = "Inbound"
+  access                     = "Allow"
+  protocol                   = "*"
+  source_port_range          = "*"
+  destination_port_range     = "53"
+  source_address_prefix      = "*"
+  destination_address_prefix = "*"
+  resource_group_name         = "${azurerm_resource_group.bosh.name}"
+  network_security_group_name = "${azurerm_network_security_group.bosh.name}"
+}
+
+resource "azurerm_network_security_rule" "credhub" {
+  name                       = "${var.env_id}-credhub"
+  priority                   = 204
+  direction                  = "Inbound"
+  access                     = "Allow"
+  protocol                   = "Tcp"
+  source_port_range          = "*"
+  destination_port_range     = "8844"
+  source_address_prefix      = "*"
+  destination_address_prefix = "*"
+  resource_group_name         = "${azurerm_resource_group.bosh.name}"
+  network_security_group_name = "${azurerm_network_security_group.bosh.name}"
+}
+
+resource "azurerm_network_security_rule" "cf-https" {
+  name                       = "${var.env_id}-dns"
+  priority                   = 201
+  direction                  = "Inbound"
+  access                     = "Allow"
+  protocol                   = "Tcp"
+  source_port_range          = "*"
+  destination_port_range     = "443"
+  source_address_prefix      = "*"
+  destination_address_prefix = "*"
+  resource_group_name         = "${azurerm_resource_group.bosh.name}"
+  network_security_group_name = "${azurerm_network_security_group.cf.name}"
+}
+
+resource "azurerm_network_security_rule" "cf-log" {
+  name                       = "${var.env_id}-cf-log"
+  priority                   = 202
+  direction                  = "Inbound"
+  access                     = "Allow"
+  protocol                   = "Tcp"
+  source_port_range          = "*"
+  destination_port_range     = "4443"
+  source_address_prefix      = "*"
+  destination_address_prefix = "*"
+  resource_group_name         = "${azurerm_resource_group.bosh.name}"
+  network_security_group_name = "${azurerm_network_security_group.cf.name}"
+}
+
+output "bosh_subnet_name" {
+    value = "${azurerm_subnet.bosh.name}"
+}
+
+output "bosh_resource_group_name" {
+    value = "${azurerm_resource_group.bosh.name}"
+}
+
+output "bosh_storage_account_name" {
+    value = "${azurerm_storage_account.bosh.name}"
+}
+
+output "bosh_default_security_group" {
+    value = "${azurerm_network_security_group.bosh.name}"
+}
+
+output "external_ip" {
+    value = "${azurerm_public_ip.bosh.ip_address}"
+}
+
+output "director_address" {
+	value = "https://${azurerm_public_ip.bosh.ip_address}:25555"
+}
+
+output "bosh_vms_private_key" {
+  value = "${tls_private_key.bosh_vms.private_key_pem}"
+  sensitive = true
+}
+
+output "bosh_vms_public_key" {
+  value = "${tls_private_key.bosh_vms.public_key_openssh}"
+  sensitive = false
+}
+
+output "jumpbox_url" {
+	value = "${azurerm_public_ip.bosh.ip_address}:22"
+}
+
+resource "tls_private_key" "bosh_vms" {
+  algorithm = "RSA"
+  rsa_bits = 4096
+}
+
+resource "azurerm_network_security_group" "concourse" {
+  name                = "${var.env_id}-concourse"
+  location            = "${var.location}"
+  resource_group_name = "${azurerm_resource_group.bosh.name}"
+
+  tags {
+    environment = "${var.env_id}"
+  }
+}
+
+resource "azurerm_network_security_rule" "concourse-http" {
+  name                        = "${var.env_id}-concourse-http"
+  priority                    = 201
+  direction                   = "Inbound"
+  access                      = "Allow"
+  protocol                    = "Tcp"
+  source_port_range           = "*"
+  destination_port_range      = "80"
+  source_address_prefix       = "*"
+  destination_address_prefix  = "*"
+  resource_group_name         = "${azurerm_resource_group.bosh.name}"
+  network_security_group_name = "${azurerm_network_security_group.concourse.name}"
+}
+
+resource "azurerm_network_security_rule" "concourse-https" {
+  name                        = "${var.env_id}-concourse-https"
+  priority                    = 202
+  direction                   = "Inbound"
+  access                      = "Allow"
+  protocol                    = "Tcp"
+  source_port_range           = "*"
+  destination_port_range      = "443"
+  source_address_prefix       = "*"
+  destination_address_prefix  = "*"
+  resource_group_name         = "${azurerm_resource_group.bosh.name}"
+  network_security_group_name = "${azurerm_network_security_group.concourse.name}"
+}
+
+resource "azurerm_network_security_rule" "concourse-tsa" {
+  name                        = "${var.env_id}-concourse-tsa"
+  priority                    = 203
+  direction                   = "Inbound"
+  access                      = "Allow"
+  protocol                    = "Tcp"
+  source_port_range           = "*"
+  destination_port_range      = "2222"
+  source_address_prefix       = "*"
+  destination_address_prefix  = "*"
+  resource_group_name         = "${azurerm_resource_group.bosh.name}"
+  network_security_group_name = "${azurerm_network_security_group.concourse.name}"
+}
+
+resource "azurerm_public_ip" "concourse-lb" {
+  name                         = "${var.env_id}-concourse-lb"
+  location                     = "${var.location}"
+  resource_group_name          = "${azurerm_resource_group.bosh.name}"
+  public_ip_address_allocation = "static"
+
+  tags {
+    environment = "${var.env_id}"
+  }
+}
+
+resource "azurerm_lb" "concourse" {
+  name                = "${var.env_id}-concourse-lb"
+  location            = "${var.location}"
+  resource_group_name = "${azurerm_resource_group.bosh.name}"
+
+  frontend_ip_configuration {
+    name                 = "${var.env_id}-concourse-frontend-ip-configuration"
+    public_ip_address_id = "${azurerm_public_ip.concourse-lb.id}"
+  }
+}
+
+resource "azurerm_lb_backend_address_pool" "concourse" {
+  name                = "${var.env_id}-concourse-backend-pool"
+  resource_group_name = "${azurerm_resource_group.bosh.name}"
+  loadbalancer_id     = "${azurerm_lb.concourse.id}"
+}
+
+resource "azurerm_lb_probe" "concourse" {
+  name                = "${var.env_id}-concourse-probe"
+  resource_group_name = "${azurerm_resource_group.bosh.name}"
+  loadbalancer_id     = "${azurerm_lb.concourse.id}"
+  protocol            = "Tcp"
+  port                = 443
+}
+
+resource "azurerm_lb_rule" "concourse-http" {
+  name                           = "${var.env_id}-concourse-http"
+  resource_group_name            = "${azurerm_resource_group.bosh.name}"
+  loadbalancer_id                = "${azurerm_lb.concourse.id}"
+  protocol                       = "Tcp"
+  frontend_port                  = 80
+  backend_port                   = 80
+  frontend_ip_configuration_name = "${var.env_id}-concourse-frontend-ip-configuration"
+  backend_address_pool_id        = "${azurerm_lb_backend_address_pool.concourse.id}"
+  probe_id                       = "${azurerm_lb_probe.concourse.id}"
+}
+
+resource "azurerm_lb_rule" "concourse-https" {
+  name                           = "${var.env_id}-concourse-https"
+  resource_group_name            = "${azurerm_resource_group.bosh.name}"
+  loadbalancer_id                = "${azurerm_lb.concourse.id}"
+  protocol                       = "Tcp"
+  frontend_port                  = 443
+  backend_port                   = 443
+  frontend_ip_configuration_name = "${var.env_id}-concourse-frontend-ip-configuration"
+  backend_address_pool_id        = "${azurerm_lb_backend_address_pool.concourse.id}"
+  probe_id                       = "${azurerm_lb_probe.concourse.id}"
+}
+
+resource "azurerm_lb_rule" "concourse-tsa" {
+  name                           = "${var.env_id}-concourse-tsa"
+  resource_group_name            = "${azurerm_resource_group.bosh.name}"
+  loadbalancer_id                = "${azurerm_lb.concourse.id}"
+  protocol                       = "Tcp"
+  frontend_port                  = 2222
+  backend_port                   = 2222
+  frontend_ip_configuration_name = "${var.env_id}-concourse-frontend-ip-configuration"
+  backend_address_pool_id        = "${azurerm_lb_backend_address_pool.concourse.id}"
+  probe_id                       = "${azurerm_lb_probe.concourse.id}"
+}
+
+output "concourse_security_group" {
+  value = "${azurerm_network_security_group.concourse.name}"
+}
+
+output "concourse_lb_ip" {
+  value = "${azurerm_public_ip.concourse-lb.ip_address}"
+}
+
+output "concourse_lb_name" {
+  value = "${azurerm_lb.concourse.name}"
+}
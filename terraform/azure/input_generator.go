@@ -1,6 +1,8 @@
 package azure
 
 import (
+	"encoding/json"
+	"fmt"
 	"strings"
 
 	"github.com/cloudfoundry/bosh-bootloader/storage"
@@ -28,5 +30,42 @@ func (i InputGenerator) Generate(state storage.State) (map[string]string, error)
 		"client_secret":   state.Azure.ClientSecret,
 	}
 
+	if state.LB.Domain != "" {
+		input["system_domain"] = state.LB.Domain
+	}
+
+	if state.ExistingNetworkID != "" {
+		resourceGroupName, networkName, err := parseVirtualNetworkID(state.ExistingNetworkID)
+		if err != nil {
+			return map[string]string{}, err
+		}
+		input["existing_network_name"] = networkName
+		input["existing_network_resource_group_name"] = resourceGroupName
+	}
+
+	if state.NetworkCIDR != "" {
+		input["network_cidr"] = state.NetworkCIDR
+	}
+
+	if len(state.AllowedIngressCIDRs) > 0 {
+		cidrs, err := json.Marshal(state.AllowedIngressCIDRs)
+		if err != nil {
+			return map[string]string{}, err
+		}
+		input["allowed_ingress_cidrs"] = string(cidrs)
+	}
+
 	return input, nil
 }
+
+// parseVirtualNetworkID splits an Azure resource id of the form
+// /subscriptions/<id>/resourceGroups/<group>/providers/Microsoft.Network/virtualNetworks/<name>
+// into its resource group and virtual network names.
+func parseVirtualNetworkID(id string) (string, string, error) {
+	parts := strings.Split(strings.Trim(id, "/"), "/")
+	if len(parts) != 8 || !strings.EqualFold(parts[2], "resourceGroups") || !strings.EqualFold(parts[6], "virtualNetworks") {
+		return "", "", fmt.Errorf("invalid azure virtual network id: %s", id)
+	}
+
+	return parts[3], parts[7], nil
+}
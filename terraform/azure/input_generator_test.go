@@ -62,4 +62,53 @@ var _ = Describe("InputGenerator", func() {
 			}))
 		})
 	})
+
+	Context("when a domain is provided", func() {
+		It("includes the system domain", func() {
+			state.LB.Domain = "some-domain"
+			inputs, err := inputGenerator.Generate(state)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(inputs).To(HaveKeyWithValue("system_domain", "some-domain"))
+		})
+	})
+
+	Context("when an existing network id is provided", func() {
+		It("includes the network and resource group names", func() {
+			state.ExistingNetworkID = "/subscriptions/subscription-id/resourceGroups/some-resource-group/providers/Microsoft.Network/virtualNetworks/some-network"
+			inputs, err := inputGenerator.Generate(state)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(inputs).To(HaveKeyWithValue("existing_network_name", "some-network"))
+			Expect(inputs).To(HaveKeyWithValue("existing_network_resource_group_name", "some-resource-group"))
+		})
+
+		Context("when the network id is not a virtual network resource id", func() {
+			It("returns an error", func() {
+				state.ExistingNetworkID = "some-network"
+				_, err := inputGenerator.Generate(state)
+				Expect(err).To(MatchError("invalid azure virtual network id: some-network"))
+			})
+		})
+	})
+
+	Context("when a network cidr is provided", func() {
+		It("includes the network cidr", func() {
+			state.NetworkCIDR = "172.16.0.0/16"
+			inputs, err := inputGenerator.Generate(state)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(inputs).To(HaveKeyWithValue("network_cidr", "172.16.0.0/16"))
+		})
+	})
+
+	Context("when allowed ingress cidrs are provided", func() {
+		It("includes the cidrs as a list", func() {
+			state.AllowedIngressCIDRs = []string{"10.0.0.0/8", "192.168.0.0/16"}
+			inputs, err := inputGenerator.Generate(state)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(inputs).To(HaveKeyWithValue("allowed_ingress_cidrs", `["10.0.0.0/8","192.168.0.0/16"]`))
+		})
+	})
 })
@@ -6,6 +6,7 @@ import (
 	"github.com/cloudfoundry/bosh-bootloader/storage"
 	"github.com/cloudfoundry/bosh-bootloader/terraform/azure"
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 )
 
@@ -19,20 +20,31 @@ var _ = Describe("TemplateGenerator", func() {
 	})
 
 	Describe("Generate", func() {
-		It("generates a terraform template for azure", func() {
-			expectedTemplate, err := ioutil.ReadFile("fixtures/azure_template.tf")
+		DescribeTable("generates a terraform template for azure", func(fixture, lbType, domain, existingNetworkID string) {
+			expectedTemplate, err := ioutil.ReadFile(fixture)
 			Expect(err).NotTo(HaveOccurred())
 
 			template := templateGenerator.Generate(storage.State{
-				EnvID: "azure-environment",
+				EnvID:             "azure-environment",
+				ExistingNetworkID: existingNetworkID,
 				Azure: storage.Azure{
 					SubscriptionID: "subscription-id",
 					TenantID:       "tenant-id",
 					ClientID:       "client-id",
 					ClientSecret:   "client-secret",
 				},
+				LB: storage.LB{
+					Type:   lbType,
+					Domain: domain,
+				},
 			})
 			Expect(template).To(Equal(string(expectedTemplate)))
-		})
+		},
+			Entry("when no lb type is provided", "fixtures/azure_template.tf", "", "", ""),
+			Entry("when a cf lb type is provided", "fixtures/azure_template_cf_lb.tf", "cf", "", ""),
+			Entry("when a cf lb type and domain are provided", "fixtures/azure_template_cf_dns.tf", "cf", "some-domain", ""),
+			Entry("when a concourse lb type is provided", "fixtures/azure_template_concourse_lb.tf", "concourse", "", ""),
+			Entry("when an existing network is provided", "fixtures/azure_template_existing_network.tf", "", "", "/subscriptions/subscription-id/resourceGroups/some-resource-group/providers/Microsoft.Network/virtualNetworks/some-network"),
+		)
 	})
 })
@@ -1,5 +1,9 @@
 // Code generated by go-bindata.
 // sources:
+// templates/cf_dns.tf
+// templates/cf_lb.tf
+// templates/concourse_lb.tf
+// templates/existing_network.tf
 // templates/network.tf
 // templates/network_security_group.tf
 // templates/output.tf
@@ -74,7 +78,87 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _templatesNetworkTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x91\xcb\x4e\xc5\x20\x10\x40\xf7\x7c\xc5\x64\xe2\xb6\x68\x37\xee\xfc\x12\x63\x08\x6d\x47\x25\xb6\xd0\x0c\x0f\x8d\x0d\xff\x6e\x68\x52\xb5\xdc\x36\xf7\xc2\x92\xc3\xe4\x9c\x0c\x93\x77\x91\x7b\x02\xd4\xdf\x91\x89\x27\x95\x0c\x87\xa8\x47\x65\x29\x7c\x3a\xfe\x40\xc0\xce\xf9\x77\x84\x45\x00\x58\x3d\x11\x54\xe7\x09\xf0\x6e\x49\x9a\x25\xd9\xa4\xcc\x90\x9b\x82\x37\xc9\xa2\x00\xd0\xc3\xc0\xe4\xbd\xf2\xb3\xee\xe9\x97\x7f\xc6\xf6\x41\xae\xf7\xbe\x7d\xc4\x17\x01\x30\xba\x5e\x07\xe3\xec\xe1\xdc\xed\x31\x97\x89\x9b\xaf\x7a\x63\x17\x67\xb5\x0a\xad\xe4\xa6\xbf\x07\x64\x91\x91\x85\xca\x28\xb2\x10\x97\xb9\x3e\x76\x96\xc2\xd5\xca\x93\x4c\xbf\xcb\x9c\x99\x5e\xcd\xd7\xdf\x87\xff\x99\x27\xee\x37\xcb\x03\x54\x8b\x39\x68\xaf\x88\x2a\xfe\x27\x00\x00\xff\xff\x03\x56\xce\x9c\xeb\x01\x00\x00")
+var _templatesCf_dnsTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdd\x92\x41\x6b\xc4\x20\x10\x85\xef\xf9\x15\x22\x3d\x15\x22\x0b\x3d\xf7\x97\x94\x22\x46\xa7\xbb\x82\xab\x32\x6a\xda\xec\x92\xff\xde\xd1\x12\xc8\xa6\x10\x96\xf6\xb6\xb9\x99\x79\xbe\x79\xef\x4b\x46\x85\x56\x0d\x0e\x18\x4f\x53\xca\x70\x96\x26\x9c\x95\xf5\x9c\x5d\x3b\xc6\xf2\x14\x81\xbd\xd2\x28\xa3\xf5\x47\xde\xcd\x5d\x87\x90\x42\x41\x4d\x7a\x75\x29\x08\x48\x17\x7c\x92\x97\xe0\x81\x33\x0e\x7e\x5c\x1d\xab\x83\x57\x67\x60\x9b\x87\x0c\x9f\xae\xa3\x42\x71\xb3\x71\xe6\x24\x5f\xdc\xe5\x11\x43\x89\xb2\xdd\x6e\xf2\x65\xd9\xad\x40\x0c\x21\x9d\x44\x55\xcd\x2d\x5c\x28\x39\x96\xbc\xa9\xd2\x12\x25\xc0\x11\x30\xfd\x84\x1a\x95\x2b\x1b\xdf\x25\xb5\x58\x57\x68\xce\xcb\xd5\x79\xa7\xbe\xa2\x5c\x3a\xa0\x21\x04\x9f\xd6\x19\xad\xd0\xf4\xf4\x7e\x17\xc1\x73\xed\x5b\xb7\xc8\x8d\xe0\xce\x5c\xff\xe6\x45\x9f\x37\x3b\xf6\x3b\xd9\xcb\xe1\xd0\x9c\x6b\xa1\xb4\x99\xbd\xad\xac\x63\x19\x9c\xd5\xd2\x46\xa1\x3f\x7a\x72\xcf\x80\xbd\x1b\x84\x8d\x52\x19\x43\x7b\x89\xd8\xfb\x5d\xc8\x52\x3a\xed\x92\xaa\xf3\x47\x62\x45\x7d\xfa\x88\xe1\x6b\xfa\x1b\xae\xac\xe3\x2e\xae\x3a\x7f\x24\x5c\xd4\x67\xe7\xf7\xfa\x06\x79\x80\x66\xc3\xc0\x04\x00\x00")
+
+func templatesCf_dnsTfBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesCf_dnsTf,
+		"templates/cf_dns.tf",
+	)
+}
+
+func templatesCf_dnsTf() (*asset, error) {
+	bytes, err := templatesCf_dnsTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/cf_dns.tf", size: 1216, mode: os.FileMode(420), modTime: time.Unix(1792359169, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesCf_lbTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x59\x4d\x6f\xe2\x30\x14\xbc\xf3\x2b\xac\xa8\xa7\x5d\x05\x01\x65\xa5\x5e\xf6\xb0\xc7\xbd\xef\x3d\x72\x1c\x43\xad\x06\x3b\x72\x1c\x4a\x17\xf1\xdf\xd7\x4e\x48\x88\x13\x1b\x9c\x8f\x2d\x2d\x85\x13\x12\xcf\xe3\xf7\xc6\xf3\x3c\xb6\xe1\x38\x65\x19\x47\x18\x78\xf0\x6f\xc6\x31\xdf\x04\x49\x16\xc6\x04\x05\x24\xf1\x80\x87\x56\x3e\x67\x99\xc0\xdc\x8f\x43\x0f\xec\x27\x00\x50\xb8\xc1\xc0\xf6\xf9\x09\xbc\x87\xfd\x16\xf2\x29\xa6\xdb\x80\x44\x07\x5f\x1b\x2f\x47\xc7\x0c\x41\x41\x18\x3d\x3f\xba\x8c\x3a\xa8\x21\xfc\x98\x60\xb0\x96\x48\x49\xa0\xcf\x9f\x0f\x29\x13\xd7\x23\xa7\x21\x4b\x9f\xa7\x2a\x3c\x87\xa9\xaa\x0a\x60\x14\xc9\xc8\x34\x80\x71\x95\x8d\x84\x49\x85\xfc\x8a\xbc\x89\x0c\x15\x70\x9d\xe6\xb5\x02\x20\xeb\x20\x9c\xd1\x0d\xa6\xa2\x55\x9c\x42\x3d\x4c\x0e\x93\x09\x6f\x51\xa8\xc8\x3a\x71\x67\x27\xae\x17\x5f\xee\x34\xb9\xb2\x23\x87\xaf\x64\x95\x02\xd3\x48\x11\x84\x18\x5d\x91\x75\xc6\x8b\x99\x0b\x22\x8c\xcb\x7e\x26\xfd\x12\xcf\x27\x89\xaf\xe1\x79\x39\x5c\x7b\x35\x48\xa4\xa7\x5b\x45\x4c\xeb\x9c\x4c\x2f\xf1\x1e\x84\x10\xbd\xa8\x3a\x4a\xdc\x84\xb1\x78\xf0\x62\x1c\x41\xfd\x1c\x6c\x20\xd9\x6a\x51\x61\x14\xc2\x18\x52\x84\xb9\xaa\xbb\x25\x64\x59\x67\x35\x77\x51\xb1\xad\xda\x84\xb3\x10\x0f\x2e\xaf\x40\xb9\x46\x5d\x52\x09\x9c\x09\x86\x58\xdc\x48\xf5\x0f\x4a\xf2\x5f\x19\x17\xed\x42\x9e\x66\x56\x42\x78\x16\x6b\x7c\xf8\xcf\x42\x24\x0e\x3b\xd7\x59\x7e\x72\x8c\x4b\x5b\x11\x18\xc8\x94\x0d\xc8\x95\x34\x23\x7f\x55\x5b\x9b\x88\x3c\x52\x09\x40\xd9\x33\xe6\xa0\x32\xca\xba\x45\xd4\x74\xd2\x63\x33\x30\x35\x6c\x8d\x93\x06\x17\xc6\xfe\x36\x12\x14\x62\x03\xb3\x16\xd0\x3c\xdc\xb5\xe7\x8c\x12\x4b\xc7\xd0\x58\x7a\xa3\x22\x5b\x2e\x1f\x5d\x54\x56\x84\xdd\x65\x66\x91\x59\xcc\xd6\x43\x45\xa6\x20\x6e\x55\x62\x77\x8d\x75\xd4\x58\xe3\x9c\x9f\xa6\xcf\xea\x20\xb0\x7b\xeb\x7f\xd4\xd7\x20\xbe\xde\x69\xbf\x2a\xbf\xdb\x21\xcc\x85\xb5\x8f\x7d\xe6\x3f\x55\x30\xee\xb1\xbf\xce\xcc\x90\x93\xff\xe0\x85\xb9\xca\xf9\xbf\x9a\xde\xf9\x0a\x30\xb8\xce\xf7\xbd\x08\x34\x0a\xec\x77\x17\x58\xc8\x8f\x83\x87\x3a\x50\xe3\xca\xd2\x95\x1d\xd4\x89\xb5\x7e\x26\x9a\x73\xe9\x60\xa2\xc7\xb8\x1e\x2e\xea\xb8\x53\x8c\x64\xa4\x26\xaa\x7a\x79\xa9\x4b\x2b\x36\xec\x54\xa0\x64\xf0\xd3\x99\x8e\xf1\xf5\x0c\xf5\x54\x7f\xb7\x0d\xcd\x89\xb7\x8f\x6d\xa9\xb5\x12\xc6\xf5\x54\x8d\x9b\x21\xa6\x3a\xc2\xe2\x5c\xc5\x56\x4f\xf3\x3b\xfb\xea\x08\xa5\xbe\xaf\xb3\x36\x6b\xfc\x8f\xcf\x6c\x4d\x6e\x10\xcb\xa8\x38\xe7\xac\xf3\xd9\xac\x9f\x01\xd7\xd8\x7c\xd8\xcf\x67\x8b\x25\xf8\x5e\xcc\x36\x25\x34\xc2\xbb\xc3\x95\x7d\xd9\x8d\xf3\x7e\xc6\xec\x59\x0b\xbe\xe8\xd5\xf6\xa1\x3d\xec\xdb\x75\x57\x1a\xc9\xbf\x8d\x94\xf6\x32\x70\xa7\xa6\xa7\x58\xbc\x32\xfe\x12\xa4\x18\x65\x9c\x88\xb7\x9a\xca\xdd\x5e\x91\x4d\x94\x95\x6f\xc7\x09\x27\x4c\x81\x9a\xc7\x2d\x66\xea\x59\x22\x22\x1c\x23\x8b\xbf\x4b\xec\xdf\x34\x94\x4b\x18\x29\x34\x88\x90\x24\xca\x9a\xc5\x2f\xe9\xcd\xaf\x97\x24\x78\xd2\xdf\xb1\x0f\x94\x86\x02\x0e\xe9\x1a\xeb\x51\xdf\x54\x4c\x84\x53\x41\x68\xa1\x8f\x66\xa0\x8c\x79\x9a\xd5\x80\xaa\x75\xe4\x78\x45\x76\x67\x80\x9a\x81\x65\xcc\xb9\x36\x76\xef\xe1\xd6\x7a\xda\xb6\x5c\x73\xa0\xd4\x4d\x89\xd5\x55\x2e\x1d\x6e\x1b\x17\xaf\x1a\x97\x84\xb3\xfc\xdc\xc2\x51\x77\x89\xbb\x74\xec\x7e\xda\x55\x3b\xb5\xf1\x0e\xe2\xf9\xf1\xb9\xc5\xa3\x9c\xcd\x9f\xcf\x17\x8f\x37\xae\x20\xb9\x9e\x49\x26\x94\x3e\x1a\x61\x85\x46\xb6\x30\xce\x06\xa0\x16\x72\x51\x7e\x49\xec\x80\xb6\xff\xe3\xab\x6b\x87\x0d\x53\xcd\x67\x45\xd5\xfe\x14\x68\x67\x26\x37\xc2\x20\xdf\x08\xbb\x24\xa7\xbf\x1a\x5a\xf2\xd3\x90\x1d\x52\x3c\xbd\x00\xb4\xb3\x94\x2d\x17\x74\xe7\xb0\x71\x11\xb3\xe4\xa9\x63\x3b\x24\x5a\x3b\xe9\x54\x99\xfe\x03\x74\xfc\xd5\xb1\xe4\x23\x00\x00")
+
+func templatesCf_lbTfBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesCf_lbTf,
+		"templates/cf_lb.tf",
+	)
+}
+
+func templatesCf_lbTf() (*asset, error) {
+	bytes, err := templatesCf_lbTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/cf_lb.tf", size: 9188, mode: os.FileMode(420), modTime: time.Unix(1792358983, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesConcourse_lbTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x58\x3d\x6f\xdb\x30\x10\xdd\xfd\x2b\x08\xa1\x53\x00\x09\xae\x93\x21\x4b\x86\x8c\xdd\xbb\x0b\x14\x45\x3b\x44\x68\x52\xe0\x87\xd3\x36\xf0\x7f\xcf\x51\xb2\x64\xc9\x22\x65\x42\x6a\x51\xe4\x43\x93\x01\xbf\x7b\x77\xf7\xee\x91\x3a\x5b\x51\x2d\xad\x22\x14\x25\xf8\x8f\x55\x54\xed\x73\x41\xcd\x8b\x54\xcf\xb9\xa6\xc4\x2a\x66\x7e\xe7\x3b\x25\x6d\x95\xa0\x84\x48\x41\x00\xab\x69\x82\x5e\x57\x08\x09\xbc\xa7\xe8\xe2\x79\x40\xc9\xb7\xd7\x03\x56\x19\x15\x87\x9c\x95\xc7\xf4\x1c\x03\x11\x5c\x12\x6c\x98\x14\xde\x88\xf6\xcb\xa3\x43\xaa\x53\x55\x4d\xee\xbc\x4e\x55\x23\xdb\x22\x87\x80\xac\x90\xfa\x29\x73\x28\x88\x86\x70\x83\x77\xba\xae\x11\x21\x28\x84\x29\x29\xf6\x54\x98\x51\x75\x2e\xd3\x71\x75\x5c\xad\xd4\x75\x11\x94\xe5\xb4\xaf\x41\xfa\x64\x4c\x15\x16\xe2\xba\x20\x0d\x01\x84\x57\x8a\x49\x97\xc2\x1f\xbe\x59\x7f\x07\x4c\xc9\x14\x25\x97\xd2\x9d\x53\xfc\x10\x85\xb4\xa2\x74\x6c\x98\x10\xaa\x75\xb0\x98\x47\xce\xe5\x4b\x93\x55\x1a\x49\x24\x0f\xe0\x7e\x92\xba\xb6\x93\xc8\x95\x54\x26\x57\x58\xec\xe8\x10\x75\xe3\x30\x25\xd5\x86\x89\x7a\x76\x23\x20\x60\xee\xd7\x3d\x22\x5c\x96\xa0\xb5\xce\x2b\x45\xb7\xec\xd7\x04\xd1\x25\xb0\xc5\xf8\x8c\x31\xd0\x3a\xc2\x20\x30\x31\xaf\xc5\x3d\x36\xf3\x03\xb3\x6e\x88\x2d\xe5\x02\x0f\xe9\xc5\x26\xd2\x31\x2e\xda\xbc\x6f\x17\xdd\xdd\xdd\x7e\xd9\x28\x64\x23\xa3\xf1\x22\x13\xb9\xf8\x08\x0b\xdd\xbe\x6f\x0b\x6d\xe0\xf9\xac\x1e\xaa\x6c\xc1\x19\xc9\xd9\xe0\x35\x9e\xf2\xe2\xba\x6d\xa6\x7c\x03\xf1\x81\xb7\xfa\x8c\xd7\xfb\x0c\xed\xba\xae\xba\x19\x61\xde\x55\x03\x34\xda\xc0\x47\xf2\x97\x36\x02\x27\xd6\xb2\x15\x68\x42\xaf\x7f\xb2\x05\x6d\xa1\x4b\x43\x45\xe9\x04\x82\x22\xb6\x6c\x67\x55\x93\xb9\x11\xc2\x3b\xf6\x89\xf2\x5b\xbe\x94\x55\xe9\x80\x2f\xa9\xe9\xc6\xd3\x60\xe5\xb0\xdc\x0e\x91\xf5\x35\xc9\xae\xe9\x9e\x17\x98\x3c\xbb\x3e\xba\x93\x28\x25\x5f\x3c\x8c\x13\x69\x5a\x93\x2d\x14\xdb\x0d\x15\x97\x05\xe6\x58\x10\xaa\x5c\xdf\x23\x23\x43\x9f\xe7\xa3\x5a\x77\x1c\xea\x16\x2e\xc4\x82\x2e\x6e\xaf\x61\xf9\x1f\x7d\xf9\xaf\xf4\xf3\x55\xee\xae\xe6\x71\x23\xf0\x82\x0f\x2a\x32\x73\xf7\x8e\x5b\xbf\x27\xef\x22\xb4\x50\xaa\x10\x51\xac\x6a\x5e\x01\xbb\x73\xed\x53\xd2\x01\xef\xd7\x80\x6a\x0f\x8d\x1f\xd4\xa2\x82\x77\x44\xcf\x28\x33\x6e\x03\xdf\x89\xed\x69\x72\xa1\x85\xf7\x80\x7b\x05\x2a\xa8\x47\xd9\x00\x69\x0d\x8f\x3d\x74\x73\x57\xf3\xc8\xed\xfc\x03\x9a\xcc\x1d\xd8\x08\x97\x35\xb0\x2f\x9b\x05\x6c\x16\xb5\xba\x47\x6d\xef\x1f\xd0\x62\x6e\x63\x8f\xf1\xd8\x09\xf7\x09\x4d\x26\xad\xa9\xac\xe9\x19\x6a\xf4\xaf\x9d\xf3\xd6\x01\x73\x3b\xff\xd7\xc4\x38\x07\x14\xc4\xc2\xd4\xa1\x2d\xaf\xdb\x0b\x27\x68\x5d\xd6\x20\xf1\xc0\x6c\x5d\x7d\x6f\xab\x95\x72\xc1\xc1\x14\x00\x00")
+
+func templatesConcourse_lbTfBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesConcourse_lbTf,
+		"templates/concourse_lb.tf",
+	)
+}
+
+func templatesConcourse_lbTf() (*asset, error) {
+	bytes, err := templatesConcourse_lbTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/concourse_lb.tf", size: 5313, mode: os.FileMode(420), modTime: time.Unix(1792358983, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesExisting_networkTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x95\x92\xdd\x0e\xc2\x20\x0c\x46\xef\xf7\x14\x0d\xf1\x76\x7b\x03\x9f\x85\x74\xa3\x4e\xe2\x84\xa5\xc0\x9c\x9a\xbd\xbb\xa0\x4e\xcd\xb2\x9f\xc8\x2d\xfd\x0e\xa7\x2d\x1d\xb2\xc6\xb2\x21\x10\xd4\x6b\xe7\xb5\xa9\xa5\x21\x7f\xb1\x7c\x92\x06\xcf\x24\xe0\x9e\x01\xf8\x6b\x4b\xb0\x07\xe1\x3c\xc7\x02\x91\x0d\x59\xd6\x2d\xe7\x98\x9c\x0d\x5c\x91\xac\xd9\x86\x76\x1d\xa3\xd0\x23\x08\xbc\x05\x26\x3e\xcb\x4e\xb3\x0f\xd8\x8c\x24\x01\xa2\xb4\xee\xf8\x0a\x27\x0c\x4c\x4e\x64\xed\xee\xd1\xa4\x98\x75\x1f\x44\x8c\xcd\xc8\xac\xc4\x66\xaa\x87\xa7\xe7\x78\xf1\x75\x75\xa1\x8c\xa9\x4d\xc5\x9f\xc7\x4c\x27\xb5\x1a\xf2\x54\x9f\x3b\x93\xe4\x50\xa9\x08\x76\xb2\x65\x3a\xe8\x7e\x1a\x18\xa5\x2a\xad\x78\xb1\x97\xbf\x9b\x01\x98\x4c\x79\x6b\x26\xdf\x21\xd8\xe0\xdb\xe0\x5f\x1d\xcf\xfc\x92\x48\xc6\x26\xbc\x51\x69\xb1\xc5\xc2\x5e\x8b\x04\x28\x3e\xdc\x07\x6c\x40\x85\x97\x83\x02\x00\x00")
+
+func templatesExisting_networkTfBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesExisting_networkTf,
+		"templates/existing_network.tf",
+	)
+}
+
+func templatesExisting_networkTf() (*asset, error) {
+	bytes, err := templatesExisting_networkTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/existing_network.tf", size: 643, mode: os.FileMode(420), modTime: time.Unix(1792369821, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesNetworkTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x95\x91\x5b\x0e\xc2\x20\x10\x45\xff\xbb\x8a\x09\xf1\xb7\xdd\x81\x2b\x31\x86\x20\x8c\x4a\x6c\xa1\x19\xa0\x1a\x9b\xee\x5d\xfa\x32\x29\xb6\x51\xf9\xe5\xcc\xe5\x9e\x81\xd0\xd9\x40\x12\x81\x89\x67\x20\xa4\x8a\x37\x9a\x7c\x10\x25\x37\xe8\xef\x96\x6e\x0c\xd8\xc9\xba\x2b\x83\x36\x03\x30\xa2\x42\x48\xce\x1e\xd8\xae\x6d\x04\x15\x68\x1a\xae\x55\x97\xf7\x78\xde\x18\x16\x79\xa1\x14\xa1\x73\xdc\xd5\x42\xe2\x9b\x3f\x4c\x03\xd3\x0b\x5c\x6a\x45\x1d\x3b\x46\xbe\xb4\x52\x78\x6d\xcd\x6a\xfe\x7c\xd9\xf5\xc9\x34\xf5\xe6\x17\xb2\xa1\xe6\x43\xb1\x81\x9c\x35\x96\x40\xd1\x97\x2a\x7a\x2a\x4e\x77\x59\x46\x1f\xda\x2e\x9c\x62\x9f\xaf\xb6\x1b\xba\x6e\xa1\x5b\x13\x9e\xf5\x23\x1d\x58\xea\x6e\x38\xfc\x2c\x01\x90\x7c\xd4\xca\x0e\x12\x22\x5d\x82\x0d\xbe\x0e\x7e\x34\x5e\xa4\x8c\xfa\xf1\x05\x51\x86\xbf\x22\x5f\x7a\xf9\xac\x03\x4e\x02\x00\x00")
 
 func templatesNetworkTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -89,12 +173,12 @@ func templatesNetworkTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/network.tf", size: 491, mode: os.FileMode(420), modTime: time.Unix(1507852860, 0)}
+	info := bindataFileInfo{name: "templates/network.tf", size: 590, mode: os.FileMode(420), modTime: time.Unix(1792369821, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesNetwork_security_groupTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x96\xcd\x8e\xda\x30\x10\x80\xef\x7e\x8a\x91\xd5\xd3\x4a\xa0\x6d\x36\x59\x71\xd9\x43\x8f\xbd\xf7\x1e\x19\x7b\x12\xac\x06\x4f\x34\x76\xd8\xb6\xab\xbc\x7b\xe5\x84\xac\x80\x86\xd2\xa0\xb6\x52\x10\x5c\xf9\xe6\xcf\xf3\x69\x14\x46\x4f\x0d\x6b\x04\xa9\x7e\x34\x8c\xbc\xcd\x1d\x86\x57\xe2\xaf\xb9\x47\xdd\xb0\x0d\xdf\xf3\x92\xa9\xa9\x25\xc8\x35\xf9\x8d\x84\x37\x01\xe0\xd4\x16\xe1\xe4\xf7\x02\xf2\xc3\xdb\x4e\xf1\x12\xdd\x2e\xb7\xa6\x5d\x74\xb8\x00\xa8\x48\xab\x60\xc9\x8d\xc2\xc3\x9f\x6d\x24\x87\x5e\xfa\x8a\x79\x57\xa5\x23\x87\xd6\x8e\x81\x65\xac\xb0\x8c\x54\x2b\x85\x00\x08\xaa\xf4\x5d\x7b\x00\xe8\x76\x96\xc9\x6d\xd1\x85\x5f\x1a\x8b\x95\x5a\xd1\x0a\x31\x61\x74\x5d\x4c\x18\x5c\x17\x73\x1f\x9b\x9b\x0a\x25\x48\xff\xbb\x7d\x9f\x9b\xde\xf7\x5b\xaf\xd9\x52\xcc\x35\x1a\x92\x3c\x3e\x0a\x00\x63\x19\xf5\xe9\x13\xbd\x67\xfd\xec\xd6\xd4\x38\x13\x73\x29\xad\xd1\xfb\x73\xe5\x3f\x55\x15\xbd\xf6\x25\x29\x90\xa6\x6a\x1c\xfb\xa2\xeb\x08\xed\x5f\xb2\x26\x0e\x39\x2b\x57\xe2\x11\xf4\x10\x11\x83\x3e\x58\xd7\xed\xe7\x94\x7b\x01\x99\x24\x07\x69\x94\x31\x8c\xde\xe7\x35\x63\x61\xbf\x9d\x4f\x73\xc2\x0d\xc8\xd8\xe6\x8f\x1e\xf6\x0f\x0c\x00\x18\xb7\x76\xc4\xa3\x71\xf0\x28\xdb\x24\x3f\x62\xe0\x42\x95\xe8\xc2\x74\x4d\x0e\x62\x2f\xdb\xf2\x71\xb6\xb6\x3c\xaf\x9e\x57\x77\x5f\x0e\x7d\xe9\xf7\x48\x7c\xa5\x32\xef\xe1\x97\xad\x49\x66\x6b\x4d\x92\x65\x59\x76\xd7\x66\xaf\x8d\x71\x7e\xba\x2c\x31\xe8\xb2\x22\x4f\xff\x5d\x91\x87\xbf\x22\x48\xf6\x74\xb7\x63\x6f\x87\x66\x34\x9b\x66\x3d\xdd\x90\x21\xf0\xb2\x25\xe9\x6c\x0f\xc9\x6a\x95\xa6\x77\x53\x06\x53\x8a\xc5\x26\x84\xfa\x9f\x1d\x93\xf9\x7e\xa5\xa4\xe9\xcd\xdd\x13\x5d\x5c\xeb\x48\x45\xe5\x15\xc7\xa4\x8f\xbb\xe5\x8f\x92\xf4\xc6\x2d\xf9\x19\x00\x00\xff\xff\xc3\x49\xda\x6d\x0a\x11\x00\x00")
+var _templatesNetwork_security_groupTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x96\x4f\x6b\xc2\x30\x18\xc6\xef\x7e\x8a\x50\x76\x12\x14\x57\xab\x78\xd9\x61\xc7\xdd\x77\x1b\xa3\xc4\xe4\xb5\x86\xd5\xa4\xbc\x49\x75\x9b\xf4\xbb\x2f\x69\x55\xac\xab\xb3\x95\x6d\xd0\x62\xae\x7d\x9e\xf7\xef\x8f\x97\x22\x68\x95\x22\x03\xe2\xd1\xcf\x14\x01\x57\xa1\x04\xb3\x51\xf8\x16\x6a\x60\x29\x0a\xf3\x11\x46\xa8\xd2\xc4\x23\xde\x5c\xe9\xa5\x47\xb6\x3d\x42\x24\x5d\x01\x39\x79\x0f\xc4\xbb\xdb\xae\x29\x0e\x41\xae\x43\xc1\xb3\x41\x2e\xb7\xe2\x58\x31\x6a\x84\x92\x95\xe2\xfd\xc7\xcc\x29\x71\x57\x4b\x91\x31\xcc\xb3\xe4\xca\x7d\x69\x65\xc1\xd0\x65\x18\x3a\x95\x75\x5b\xbb\xa1\x91\xce\xcb\x23\xc4\xd6\x20\x50\xc9\x15\x48\xf3\xad\x30\x97\x29\xeb\x65\xbd\x1e\xd6\x6f\x9d\x2d\x1a\x34\x6e\xc5\x2d\x6f\x1b\xd3\x18\x6c\xd7\xfa\xa7\x7d\x9f\xeb\x5e\x17\x5b\x4f\x50\x28\x17\xab\xd2\xe2\x8f\x46\x56\xc2\x05\x02\x3b\x1d\xd1\x21\xea\x93\x9c\xab\x54\x72\x17\x8b\x32\x06\x5a\x9f\x4b\xff\x18\xc7\x6a\x53\xa4\x54\x46\x31\x15\x57\xcb\x9e\x59\xe2\x44\xbb\x49\x26\x0a\x4d\x88\x54\x46\x50\x12\xf5\x9d\x84\x83\x36\x42\xe6\xfb\x39\xd5\x59\x89\xef\x1f\x85\xa1\x9c\xdb\x71\xea\x30\x41\x58\x88\x77\xd0\x85\xe6\x65\x37\x12\xea\x2a\x03\x1e\x0a\x19\xe5\x2a\x26\x38\xea\xcc\x7b\x3d\xc9\x51\x0e\x72\x28\xa3\x0a\x8b\xd2\xd4\x6b\xe0\x61\x57\x57\x89\x74\x05\x64\xd5\xc2\x52\xb4\x46\xf0\x38\xe3\x80\x46\x16\xc5\xe6\x0c\x1d\x79\x2f\xa3\x74\xdf\x5a\x94\xa6\xb3\xe9\xec\x06\x53\x6d\x98\x8a\x25\x2b\xbc\x92\xa7\x83\xfd\x32\x52\x7e\x7b\xaf\xd3\xc4\xbe\x1b\x53\x75\x98\xe2\x52\x37\x27\xc9\x99\x2e\xf3\x33\xfe\x77\x7e\xfa\xbf\x42\xcf\x64\x7c\x16\x9d\xf3\x61\xba\x49\x07\x43\xe0\xcb\x74\xde\x9c\x90\xbd\xf1\x32\x25\x41\x6b\xaf\xcc\x6c\x16\x04\x37\x52\xf6\xa4\x2c\x06\x4b\x63\x92\x3f\x3b\x26\xed\xfd\xbf\x09\x82\xce\xdd\x13\xb6\xb8\x96\x91\x58\x45\x57\x1c\x93\xc2\xd7\xe5\x3f\x96\xa0\xe3\x94\x7c\x01\x3e\x6d\x20\x92\x61\x11\x00\x00")
 
 func templatesNetwork_security_groupTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -109,12 +193,12 @@ func templatesNetwork_security_groupTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/network_security_group.tf", size: 4362, mode: os.FileMode(420), modTime: time.Unix(1508261740, 0)}
+	info := bindataFileInfo{name: "templates/network_security_group.tf", size: 4449, mode: os.FileMode(420), modTime: time.Unix(1792361261, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesOutputTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\xcf\x6a\xf3\x30\x10\xc4\xcf\x9f\x9f\x42\x98\xef\xec\x40\xc0\x97\x40\x9f\x65\x91\xed\x4d\xa2\x46\x96\xc4\xfe\x71\x93\x86\xbc\x7b\x31\xae\x43\x44\x5a\x3b\xbd\x6a\x67\x7e\x33\x8c\xa2\x4a\x52\x31\x65\x13\xf9\x08\x01\xe5\x23\xd2\x09\x82\xed\xb1\x34\xd7\xc2\x18\x63\x06\xeb\x15\xcd\x9b\x29\xff\x5f\xed\xa7\x12\x52\x0f\x83\x23\x51\xeb\x67\x79\x35\x7a\xab\xd1\x73\x2b\x8b\x5b\x51\x64\x48\xd6\x26\xa0\xac\x11\x27\xd5\x22\x88\x90\xa3\x52\x8b\x70\xa0\xa8\x69\x0d\x98\xab\x97\x1b\x4a\x24\x7b\x40\xb0\x6d\x1b\x35\xac\x57\xcd\xe5\x8b\xe8\x0e\xf7\x56\xbd\x00\x63\xab\xe4\xe4\x32\xb5\x59\x80\xcf\x1f\x90\x1b\x7e\xcb\xc0\xb3\x20\x05\xeb\xc1\x2d\x31\x93\x36\xde\xb5\xe0\xbe\x31\x2e\x81\xed\x3a\x42\xe6\x1c\xd6\x39\xc2\x56\x22\xcd\xd7\x91\xf8\xef\x8e\x3b\x8a\x24\xde\x6d\x36\xaf\x60\x77\xdb\xba\xae\xeb\xe7\x35\x86\x9e\x21\x91\x1b\xac\x20\x9c\xf0\x32\x55\x7e\x28\x2c\x3e\xbb\x57\xb3\xa9\x7a\x78\x84\x84\xfd\xad\x2c\x8c\x61\x0c\xec\xc4\x0d\xa3\x57\x48\xf1\xe7\xb4\xa9\xe3\xdf\xc2\xee\x1e\x88\x09\x03\xf3\xf1\x29\x6f\x6f\x3d\x67\x81\xef\xda\xa7\x26\x9e\x41\xc9\xe7\xb3\xbd\x36\xd7\x76\xdc\xea\x2b\x00\x00\xff\xff\xc4\x27\xe0\xd1\x89\x03\x00\x00")
+var _templatesOutputTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x95\x90\x4d\x6e\xc2\x30\x14\x84\xd7\xcd\x29\xac\xa8\xeb\x20\x21\x75\x83\xd4\xb3\x58\x8e\xf3\x20\x2e\x4e\x6c\xbd\x9f\x00\x45\xdc\xbd\x8e\x52\x50\x5c\xda\x40\xbd\xb4\x67\xbe\x19\x4f\x10\x8e\xc2\xaa\xac\x03\xb5\x9a\xa4\xee\x81\x75\x6f\x3a\x28\xd5\xb9\x50\xe9\x0c\xc6\x0b\xa8\x77\x55\xbe\x9e\xcd\xa7\x20\x60\xf7\xad\xaa\x46\x47\x35\x4a\x2f\x65\x71\x29\x8a\x30\x07\x21\x50\x10\xb4\xa0\x77\x18\x24\x3e\x02\xe6\xea\x45\x30\x71\x40\xb3\x03\x6d\xac\x0d\xd2\x3f\xae\x9a\xcb\x17\xd1\x0d\x6c\x8d\x78\xd6\x04\x56\xd0\xf1\x69\x6a\xb3\x00\x4f\x23\x1c\x02\xee\x7f\x18\xfe\xca\x80\x23\x03\xf6\xc6\x6b\xb7\xc4\x8c\x52\x7b\x67\x93\x66\xc2\xb8\xa8\x4d\xd3\xa4\x7d\x28\x87\x35\x0e\xc1\xa6\xbf\x5d\x5f\x47\xe2\xcb\x0d\xd7\x32\x47\xda\xac\x56\xcf\x60\x37\xeb\xb7\x74\xee\xd7\x18\x3a\xd2\x11\xdd\x60\x18\xf4\x1e\x4e\x53\xe5\x59\x61\xf6\xd9\x7b\x75\x35\x55\xb3\x4b\x1d\xa1\x4b\xbd\x95\x22\xe8\xc9\xb1\x1b\x46\x2f\xa3\xc0\xef\x69\x53\xc7\xff\x85\xdd\x3c\x3a\xc4\x14\x42\xed\x5d\xde\xd6\x78\xca\x02\x3f\xa4\x8b\x75\x38\x6a\x41\x9f\xcf\xf6\xdc\x5c\xeb\x71\xab\x2f\x87\xfa\x6d\xb7\x36\x03\x00\x00")
 
 func templatesOutputTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -129,7 +213,7 @@ func templatesOutputTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/output.tf", size: 905, mode: os.FileMode(420), modTime: time.Unix(1507852860, 0)}
+	info := bindataFileInfo{name: "templates/output.tf", size: 822, mode: os.FileMode(420), modTime: time.Unix(1792369675, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -194,7 +278,7 @@ func templatesTlsTf() (*asset, error) {
 	return a, nil
 }
 
-var _templatesVarsTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\xcf\xd1\xaa\xc2\x30\x0c\x06\xe0\xeb\xd3\xa7\x08\xe5\x5c\xfb\x06\x3e\xcb\xc8\xba\x20\x81\xae\x2b\x69\x56\xd0\xb1\x77\x97\x3a\xa9\x32\x15\x6b\x6e\xff\x2f\xe1\x4f\x46\x61\xec\x3d\x81\xa5\x90\x3b\x1e\x2c\x2c\xe6\x4f\xcf\x91\xe0\x08\x36\xa9\x70\x38\x59\xb3\x1a\xf3\x70\x7e\x72\xa8\x3c\x85\xef\x32\xf1\x18\x3d\x75\xad\x87\xd3\xdc\x27\x27\x1c\xcb\xf1\xa6\x05\xa5\x80\x41\x9b\xa8\xf3\x4c\xbf\xd1\x44\x4e\x48\x3f\xf1\x28\x53\xe6\x81\x04\x2c\x5e\x66\x21\x19\x0b\x04\xd8\xbd\x00\x65\xeb\x7f\xc9\x28\x87\x5d\xb2\x5a\x03\x50\xfb\xc3\x7d\xaa\xae\xc9\xcd\xd5\xf2\x2f\xae\x26\xcf\x6e\x6b\xfe\xce\x6d\xc9\x5a\x3e\xb8\x06\x00\x00\xff\xff\xb7\xcd\x6b\x24\xf8\x01\x00\x00")
+var _templatesVarsTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x95\x90\xcd\x6e\x02\x31\x0c\x84\xcf\xe4\x29\x22\xab\x67\x7e\x2e\xbd\xf1\x24\x15\x5a\x85\xc4\xad\x2c\x42\xb2\xb2\xbd\x8b\x5a\xb4\xef\xde\xec\xd2\x46\x40\x91\xd8\x26\xb7\xcc\x37\x63\x67\x7a\xc7\xe4\xf6\x11\x2d\x60\xea\x1b\x0a\x60\xcf\x66\xa1\x9f\x2d\xda\xad\x05\x51\xa6\xf4\x01\x66\x30\xa6\xaf\x5c\xcc\xde\x29\xe5\xf4\x9c\x14\x3a\xb6\x11\x9b\xb9\xc1\xd2\xed\xc5\x33\xb5\x63\xf8\x2c\x83\x62\x72\x49\x67\xa1\x3e\x12\xfe\x0f\x15\xf4\x8c\xfa\x1c\x77\x31\xe6\x13\x86\xa6\x28\x8c\x22\x8d\xa7\xc0\x72\x63\x8b\x24\x0a\x66\x11\xf0\xdd\x75\x51\xcb\xcb\x1b\xac\x97\xd3\x5d\xad\x61\x77\x9b\x96\x50\x4f\x99\x0f\x53\xca\xc3\xd9\x57\x31\xb0\xf9\x8d\xd9\xbc\x4e\x4b\xb5\x9c\x7b\x0a\xc8\x65\xa9\xaf\x8e\x91\x8f\x63\x82\xb5\x77\xbd\xda\xd1\xfa\x72\x2e\x33\x97\x77\xca\x00\x85\xae\xa5\xda\x9f\x53\xe9\xaa\x4c\x5c\x6d\xf4\x0f\x57\x95\x6b\xee\x52\xe7\x23\xee\xa2\x0c\xe3\x0f\xbe\x01\xaf\xd4\xa9\x68\x8d\x02\x00\x00")
 
 func templatesVarsTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -209,7 +293,7 @@ func templatesVarsTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/vars.tf", size: 504, mode: os.FileMode(420), modTime: time.Unix(1507852860, 0)}
+	info := bindataFileInfo{name: "templates/vars.tf", size: 653, mode: os.FileMode(420), modTime: time.Unix(1792369821, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -266,6 +350,10 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
+	"templates/cf_dns.tf": templatesCf_dnsTf,
+	"templates/cf_lb.tf": templatesCf_lbTf,
+	"templates/concourse_lb.tf": templatesConcourse_lbTf,
+	"templates/existing_network.tf": templatesExisting_networkTf,
 	"templates/network.tf": templatesNetworkTf,
 	"templates/network_security_group.tf": templatesNetwork_security_groupTf,
 	"templates/output.tf": templatesOutputTf,
@@ -316,6 +404,10 @@ type bintree struct {
 }
 var _bintree = &bintree{nil, map[string]*bintree{
 	"templates": &bintree{nil, map[string]*bintree{
+		"cf_dns.tf": &bintree{templatesCf_dnsTf, map[string]*bintree{}},
+		"cf_lb.tf": &bintree{templatesCf_lbTf, map[string]*bintree{}},
+		"concourse_lb.tf": &bintree{templatesConcourse_lbTf, map[string]*bintree{}},
+		"existing_network.tf": &bintree{templatesExisting_networkTf, map[string]*bintree{}},
 		"network.tf": &bintree{templatesNetworkTf, map[string]*bintree{}},
 		"network_security_group.tf": &bintree{templatesNetwork_security_groupTf, map[string]*bintree{}},
 		"output.tf": &bintree{templatesOutputTf, map[string]*bintree{}},
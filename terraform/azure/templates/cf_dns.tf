@@ -0,0 +1,36 @@
+variable "system_domain" {
+  type = "string"
+}
+
+resource "azurerm_dns_zone" "env_dns_zone" {
+  name                = "${var.system_domain}"
+  resource_group_name = "${azurerm_resource_group.bosh.name}"
+}
+
+output "system_domain_dns_servers" {
+  value = "${azurerm_dns_zone.env_dns_zone.name_servers}"
+}
+
+resource "azurerm_dns_a_record" "wildcard-dns" {
+  name                = "*"
+  zone_name           = "${azurerm_dns_zone.env_dns_zone.name}"
+  resource_group_name = "${azurerm_resource_group.bosh.name}"
+  ttl                 = 300
+  records             = ["${azurerm_public_ip.cf-router-lb.ip_address}"]
+}
+
+resource "azurerm_dns_a_record" "ssh" {
+  name                = "ssh"
+  zone_name           = "${azurerm_dns_zone.env_dns_zone.name}"
+  resource_group_name = "${azurerm_resource_group.bosh.name}"
+  ttl                 = 300
+  records             = ["${azurerm_public_ip.cf-ssh-proxy-lb.ip_address}"]
+}
+
+resource "azurerm_dns_a_record" "tcp" {
+  name                = "tcp"
+  zone_name           = "${azurerm_dns_zone.env_dns_zone.name}"
+  resource_group_name = "${azurerm_resource_group.bosh.name}"
+  ttl                 = 300
+  records             = ["${azurerm_public_ip.cf-tcp-router-lb.ip_address}"]
+}
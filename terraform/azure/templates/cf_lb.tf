@@ -0,0 +1,238 @@
+resource "azurerm_public_ip" "cf-router-lb" {
+  name                         = "${var.env_id}-cf-router-lb"
+  location                     = "${var.location}"
+  resource_group_name          = "${azurerm_resource_group.bosh.name}"
+  public_ip_address_allocation = "static"
+
+  tags {
+    environment = "${var.env_id}"
+  }
+}
+
+resource "azurerm_lb" "cf-router" {
+  name                = "${var.env_id}-cf-router-lb"
+  location            = "${var.location}"
+  resource_group_name = "${azurerm_resource_group.bosh.name}"
+
+  frontend_ip_configuration {
+    name                 = "${var.env_id}-cf-router-frontend-ip-configuration"
+    public_ip_address_id = "${azurerm_public_ip.cf-router-lb.id}"
+  }
+}
+
+resource "azurerm_lb_backend_address_pool" "cf-router" {
+  name                = "${var.env_id}-cf-router-backend-pool"
+  resource_group_name = "${azurerm_resource_group.bosh.name}"
+  loadbalancer_id     = "${azurerm_lb.cf-router.id}"
+}
+
+resource "azurerm_lb_probe" "cf-router" {
+  name                = "${var.env_id}-cf-router-probe"
+  resource_group_name = "${azurerm_resource_group.bosh.name}"
+  loadbalancer_id     = "${azurerm_lb.cf-router.id}"
+  protocol            = "Tcp"
+  port                = 80
+}
+
+resource "azurerm_lb_rule" "cf-router-http" {
+  name                           = "${var.env_id}-cf-router-http"
+  resource_group_name            = "${azurerm_resource_group.bosh.name}"
+  loadbalancer_id                = "${azurerm_lb.cf-router.id}"
+  protocol                       = "Tcp"
+  frontend_port                  = 80
+  backend_port                   = 80
+  frontend_ip_configuration_name = "${var.env_id}-cf-router-frontend-ip-configuration"
+  backend_address_pool_id        = "${azurerm_lb_backend_address_pool.cf-router.id}"
+  probe_id                       = "${azurerm_lb_probe.cf-router.id}"
+}
+
+resource "azurerm_lb_rule" "cf-router-https" {
+  name                           = "${var.env_id}-cf-router-https"
+  resource_group_name            = "${azurerm_resource_group.bosh.name}"
+  loadbalancer_id                = "${azurerm_lb.cf-router.id}"
+  protocol                       = "Tcp"
+  frontend_port                  = 443
+  backend_port                   = 443
+  frontend_ip_configuration_name = "${var.env_id}-cf-router-frontend-ip-configuration"
+  backend_address_pool_id        = "${azurerm_lb_backend_address_pool.cf-router.id}"
+  probe_id                       = "${azurerm_lb_probe.cf-router.id}"
+}
+
+resource "azurerm_lb_rule" "cf-router-log" {
+  name                           = "${var.env_id}-cf-router-log"
+  resource_group_name            = "${azurerm_resource_group.bosh.name}"
+  loadbalancer_id                = "${azurerm_lb.cf-router.id}"
+  protocol                       = "Tcp"
+  frontend_port                  = 4443
+  backend_port                   = 443
+  frontend_ip_configuration_name = "${var.env_id}-cf-router-frontend-ip-configuration"
+  backend_address_pool_id        = "${azurerm_lb_backend_address_pool.cf-router.id}"
+  probe_id                       = "${azurerm_lb_probe.cf-router.id}"
+}
+
+resource "azurerm_public_ip" "cf-ssh-proxy-lb" {
+  name                         = "${var.env_id}-cf-ssh-proxy-lb"
+  location                     = "${var.location}"
+  resource_group_name          = "${azurerm_resource_group.bosh.name}"
+  public_ip_address_allocation = "static"
+
+  tags {
+    environment = "${var.env_id}"
+  }
+}
+
+resource "azurerm_lb" "cf-ssh-proxy" {
+  name                = "${var.env_id}-cf-ssh-proxy-lb"
+  location            = "${var.location}"
+  resource_group_name = "${azurerm_resource_group.bosh.name}"
+
+  frontend_ip_configuration {
+    name                 = "${var.env_id}-cf-ssh-proxy-frontend-ip-configuration"
+    public_ip_address_id = "${azurerm_public_ip.cf-ssh-proxy-lb.id}"
+  }
+}
+
+resource "azurerm_lb_backend_address_pool" "cf-ssh-proxy" {
+  name                = "${var.env_id}-cf-ssh-proxy-backend-pool"
+  resource_group_name = "${azurerm_resource_group.bosh.name}"
+  loadbalancer_id     = "${azurerm_lb.cf-ssh-proxy.id}"
+}
+
+resource "azurerm_lb_probe" "cf-ssh-proxy" {
+  name                = "${var.env_id}-cf-ssh-proxy-probe"
+  resource_group_name = "${azurerm_resource_group.bosh.name}"
+  loadbalancer_id     = "${azurerm_lb.cf-ssh-proxy.id}"
+  protocol            = "Tcp"
+  port                = 2222
+}
+
+resource "azurerm_lb_rule" "cf-ssh-proxy" {
+  name                           = "${var.env_id}-cf-ssh-proxy"
+  resource_group_name            = "${azurerm_resource_group.bosh.name}"
+  loadbalancer_id                = "${azurerm_lb.cf-ssh-proxy.id}"
+  protocol                       = "Tcp"
+  frontend_port                  = 2222
+  backend_port                   = 2222
+  frontend_ip_configuration_name = "${var.env_id}-cf-ssh-proxy-frontend-ip-configuration"
+  backend_address_pool_id        = "${azurerm_lb_backend_address_pool.cf-ssh-proxy.id}"
+  probe_id                       = "${azurerm_lb_probe.cf-ssh-proxy.id}"
+}
+
+resource "azurerm_public_ip" "cf-tcp-router-lb" {
+  name                         = "${var.env_id}-cf-tcp-router-lb"
+  location                     = "${var.location}"
+  resource_group_name          = "${azurerm_resource_group.bosh.name}"
+  public_ip_address_allocation = "static"
+
+  tags {
+    environment = "${var.env_id}"
+  }
+}
+
+resource "azurerm_lb" "cf-tcp-router" {
+  name                = "${var.env_id}-cf-tcp-router-lb"
+  location            = "${var.location}"
+  resource_group_name = "${azurerm_resource_group.bosh.name}"
+
+  frontend_ip_configuration {
+    name                 = "${var.env_id}-cf-tcp-router-frontend-ip-configuration"
+    public_ip_address_id = "${azurerm_public_ip.cf-tcp-router-lb.id}"
+  }
+}
+
+resource "azurerm_lb_backend_address_pool" "cf-tcp-router" {
+  name                = "${var.env_id}-cf-tcp-router-backend-pool"
+  resource_group_name = "${azurerm_resource_group.bosh.name}"
+  loadbalancer_id     = "${azurerm_lb.cf-tcp-router.id}"
+}
+
+resource "azurerm_lb_probe" "cf-tcp-router" {
+  name                = "${var.env_id}-cf-tcp-router-probe"
+  resource_group_name = "${azurerm_resource_group.bosh.name}"
+  loadbalancer_id     = "${azurerm_lb.cf-tcp-router.id}"
+  protocol            = "Tcp"
+  port                = 80
+}
+
+resource "azurerm_lb_rule" "cf-tcp-router" {
+  count                          = 100
+  name                           = "${var.env_id}-cf-tcp-router-${1024 + count.index}"
+  resource_group_name            = "${azurerm_resource_group.bosh.name}"
+  loadbalancer_id                = "${azurerm_lb.cf-tcp-router.id}"
+  protocol                       = "Tcp"
+  frontend_port                  = "${1024 + count.index}"
+  backend_port                   = "${1024 + count.index}"
+  frontend_ip_configuration_name = "${var.env_id}-cf-tcp-router-frontend-ip-configuration"
+  backend_address_pool_id        = "${azurerm_lb_backend_address_pool.cf-tcp-router.id}"
+  probe_id                       = "${azurerm_lb_probe.cf-tcp-router.id}"
+}
+
+resource "azurerm_network_security_rule" "cf-http" {
+  name                        = "${var.env_id}-cf-http"
+  priority                    = 203
+  direction                   = "Inbound"
+  access                      = "Allow"
+  protocol                    = "Tcp"
+  source_port_range           = "*"
+  destination_port_range      = "80"
+  source_address_prefix       = "*"
+  destination_address_prefix  = "*"
+  resource_group_name         = "${azurerm_resource_group.bosh.name}"
+  network_security_group_name = "${azurerm_network_security_group.cf.name}"
+}
+
+resource "azurerm_network_security_rule" "cf-ssh-proxy" {
+  name                        = "${var.env_id}-cf-ssh-proxy"
+  priority                    = 204
+  direction                   = "Inbound"
+  access                      = "Allow"
+  protocol                    = "Tcp"
+  source_port_range           = "*"
+  destination_port_range      = "2222"
+  source_address_prefix       = "*"
+  destination_address_prefix  = "*"
+  resource_group_name         = "${azurerm_resource_group.bosh.name}"
+  network_security_group_name = "${azurerm_network_security_group.cf.name}"
+}
+
+resource "azurerm_network_security_rule" "cf-tcp-router" {
+  name                        = "${var.env_id}-cf-tcp-router"
+  priority                    = 205
+  direction                   = "Inbound"
+  access                      = "Allow"
+  protocol                    = "Tcp"
+  source_port_range           = "*"
+  destination_port_range      = "1024-1123"
+  source_address_prefix       = "*"
+  destination_address_prefix  = "*"
+  resource_group_name         = "${azurerm_resource_group.bosh.name}"
+  network_security_group_name = "${azurerm_network_security_group.cf.name}"
+}
+
+output "cf_security_group" {
+  value = "${azurerm_network_security_group.cf.name}"
+}
+
+output "router_lb_ip" {
+  value = "${azurerm_public_ip.cf-router-lb.ip_address}"
+}
+
+output "router_lb_name" {
+  value = "${azurerm_lb.cf-router.name}"
+}
+
+output "ssh_proxy_lb_ip" {
+  value = "${azurerm_public_ip.cf-ssh-proxy-lb.ip_address}"
+}
+
+output "ssh_proxy_lb_name" {
+  value = "${azurerm_lb.cf-ssh-proxy.name}"
+}
+
+output "tcp_router_lb_ip" {
+  value = "${azurerm_public_ip.cf-tcp-router-lb.ip_address}"
+}
+
+output "tcp_router_lb_name" {
+  value = "${azurerm_lb.cf-tcp-router.name}"
+}
@@ -0,0 +1,135 @@
+resource "azurerm_network_security_group" "concourse" {
+  name                = "${var.env_id}-concourse"
+  location            = "${var.location}"
+  resource_group_name = "${azurerm_resource_group.bosh.name}"
+
+  tags {
+    environment = "${var.env_id}"
+  }
+}
+
+resource "azurerm_network_security_rule" "concourse-http" {
+  name                        = "${var.env_id}-concourse-http"
+  priority                    = 201
+  direction                   = "Inbound"
+  access                      = "Allow"
+  protocol                    = "Tcp"
+  source_port_range           = "*"
+  destination_port_range      = "80"
+  source_address_prefix       = "*"
+  destination_address_prefix  = "*"
+  resource_group_name         = "${azurerm_resource_group.bosh.name}"
+  network_security_group_name = "${azurerm_network_security_group.concourse.name}"
+}
+
+resource "azurerm_network_security_rule" "concourse-https" {
+  name                        = "${var.env_id}-concourse-https"
+  priority                    = 202
+  direction                   = "Inbound"
+  access                      = "Allow"
+  protocol                    = "Tcp"
+  source_port_range           = "*"
+  destination_port_range      = "443"
+  source_address_prefix       = "*"
+  destination_address_prefix  = "*"
+  resource_group_name         = "${azurerm_resource_group.bosh.name}"
+  network_security_group_name = "${azurerm_network_security_group.concourse.name}"
+}
+
+resource "azurerm_network_security_rule" "concourse-tsa" {
+  name                        = "${var.env_id}-concourse-tsa"
+  priority                    = 203
+  direction                   = "Inbound"
+  access                      = "Allow"
+  protocol                    = "Tcp"
+  source_port_range           = "*"
+  destination_port_range      = "2222"
+  source_address_prefix       = "*"
+  destination_address_prefix  = "*"
+  resource_group_name         = "${azurerm_resource_group.bosh.name}"
+  network_security_group_name = "${azurerm_network_security_group.concourse.name}"
+}
+
+resource "azurerm_public_ip" "concourse-lb" {
+  name                         = "${var.env_id}-concourse-lb"
+  location                     = "${var.location}"
+  resource_group_name          = "${azurerm_resource_group.bosh.name}"
+  public_ip_address_allocation = "static"
+
+  tags {
+    environment = "${var.env_id}"
+  }
+}
+
+resource "azurerm_lb" "concourse" {
+  name                = "${var.env_id}-concourse-lb"
+  location            = "${var.location}"
+  resource_group_name = "${azurerm_resource_group.bosh.name}"
+
+  frontend_ip_configuration {
+    name                 = "${var.env_id}-concourse-frontend-ip-configuration"
+    public_ip_address_id = "${azurerm_public_ip.concourse-lb.id}"
+  }
+}
+
+resource "azurerm_lb_backend_address_pool" "concourse" {
+  name                = "${var.env_id}-concourse-backend-pool"
+  resource_group_name = "${azurerm_resource_group.bosh.name}"
+  loadbalancer_id     = "${azurerm_lb.concourse.id}"
+}
+
+resource "azurerm_lb_probe" "concourse" {
+  name                = "${var.env_id}-concourse-probe"
+  resource_group_name = "${azurerm_resource_group.bosh.name}"
+  loadbalancer_id     = "${azurerm_lb.concourse.id}"
+  protocol            = "Tcp"
+  port                = 443
+}
+
+resource "azurerm_lb_rule" "concourse-http" {
+  name                           = "${var.env_id}-concourse-http"
+  resource_group_name            = "${azurerm_resource_group.bosh.name}"
+  loadbalancer_id                = "${azurerm_lb.concourse.id}"
+  protocol                       = "Tcp"
+  frontend_port                  = 80
+  backend_port                   = 80
+  frontend_ip_configuration_name = "${var.env_id}-concourse-frontend-ip-configuration"
+  backend_address_pool_id        = "${azurerm_lb_backend_address_pool.concourse.id}"
+  probe_id                       = "${azurerm_lb_probe.concourse.id}"
+}
+
+resource "azurerm_lb_rule" "concourse-https" {
+  name                           = "${var.env_id}-concourse-https"
+  resource_group_name            = "${azurerm_resource_group.bosh.name}"
+  loadbalancer_id                = "${azurerm_lb.concourse.id}"
+  protocol                       = "Tcp"
+  frontend_port                  = 443
+  backend_port                   = 443
+  frontend_ip_configuration_name = "${var.env_id}-concourse-frontend-ip-configuration"
+  backend_address_pool_id        = "${azurerm_lb_backend_address_pool.concourse.id}"
+  probe_id                       = "${azurerm_lb_probe.concourse.id}"
+}
+
+resource "azurerm_lb_rule" "concourse-tsa" {
+  name                           = "${var.env_id}-concourse-tsa"
+  resource_group_name            = "${azurerm_resource_group.bosh.name}"
+  loadbalancer_id                = "${azurerm_lb.concourse.id}"
+  protocol                       = "Tcp"
+  frontend_port                  = 2222
+  backend_port                   = 2222
+  frontend_ip_configuration_name = "${var.env_id}-concourse-frontend-ip-configuration"
+  backend_address_pool_id        = "${azurerm_lb_backend_address_pool.concourse.id}"
+  probe_id                       = "${azurerm_lb_probe.concourse.id}"
+}
+
+output "concourse_security_group" {
+  value = "${azurerm_network_security_group.concourse.name}"
+}
+
+output "concourse_lb_ip" {
+  value = "${azurerm_public_ip.concourse-lb.ip_address}"
+}
+
+output "concourse_lb_name" {
+  value = "${azurerm_lb.concourse.name}"
+}
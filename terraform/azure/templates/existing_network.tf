@@ -0,0 +1,23 @@
+variable "existing_network_name" {
+  type = "string"
+}
+
+variable "existing_network_resource_group_name" {
+  type = "string"
+}
+
+data "azurerm_virtual_network" "bosh" {
+  name                = "${var.existing_network_name}"
+  resource_group_name = "${var.existing_network_resource_group_name}"
+}
+
+resource "azurerm_subnet" "bosh" {
+  name                 = "${var.env_id}-bosh-sn"
+  address_prefix       = "${var.network_cidr}"
+  resource_group_name  = "${var.existing_network_resource_group_name}"
+  virtual_network_name = "${var.existing_network_name}"
+}
+
+output "bosh_network_name" {
+    value = "${data.azurerm_virtual_network.bosh.name}"
+}
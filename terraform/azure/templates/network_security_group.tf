@@ -26,7 +26,7 @@ resource "azurerm_network_security_rule" "ssh" {
   protocol                   = "Tcp"
   source_port_range          = "*"
   destination_port_range     = "22"
-  source_address_prefix      = "*"
+  source_address_prefixes    = ["${var.allowed_ingress_cidrs}"]
   destination_address_prefix = "*"
   resource_group_name         = "${azurerm_resource_group.bosh.name}"
   network_security_group_name = "${azurerm_network_security_group.bosh.name}"
@@ -40,7 +40,7 @@ resource "azurerm_network_security_rule" "bosh-agent" {
   protocol                   = "Tcp"
   source_port_range          = "*"
   destination_port_range     = "6868"
-  source_address_prefix      = "*"
+  source_address_prefixes    = ["${var.allowed_ingress_cidrs}"]
   destination_address_prefix = "*"
   resource_group_name         = "${azurerm_resource_group.bosh.name}"
   network_security_group_name = "${azurerm_network_security_group.bosh.name}"
@@ -54,7 +54,7 @@ resource "azurerm_network_security_rule" "bosh-director" {
   protocol                   = "Tcp"
   source_port_range          = "*"
   destination_port_range     = "25555"
-  source_address_prefix      = "*"
+  source_address_prefixes    = ["${var.allowed_ingress_cidrs}"]
   destination_address_prefix = "*"
   resource_group_name         = "${azurerm_resource_group.bosh.name}"
   network_security_group_name = "${azurerm_network_security_group.bosh.name}"
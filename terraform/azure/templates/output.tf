@@ -1,7 +1,3 @@
-output "bosh_network_name" {
-    value = "${azurerm_virtual_network.bosh.name}"
-}
-
 output "bosh_subnet_name" {
     value = "${azurerm_subnet.bosh.name}"
 }
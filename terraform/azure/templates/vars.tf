@@ -26,6 +26,16 @@ variable "client_secret" {
 	type = "string"
 }
 
+variable "allowed_ingress_cidrs" {
+	type = "list"
+	default = ["0.0.0.0/0"]
+}
+
+variable "network_cidr" {
+	type = "string"
+	default = "10.0.0.0/16"
+}
+
 provider "azurerm" {
   subscription_id  = "${var.subscription_id}"
   tenant_id        = "${var.tenant_id}"
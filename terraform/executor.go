@@ -283,37 +283,43 @@ func (e Executor) Output(tfState, outputName string) (string, error) {
 	return strings.TrimSuffix(buffer.String(), "\n"), nil
 }
 
-func (e Executor) Outputs(tfState string) (map[string]interface{}, error) {
+func (e Executor) Outputs(tfState string) (Outputs, error) {
 	varsDir, err := e.stateStore.GetVarsDir()
 	if err != nil {
-		return map[string]interface{}{}, fmt.Errorf("Get vars dir: %s", err)
+		return Outputs{}, fmt.Errorf("Get vars dir: %s", err)
 	}
 
 	err = writeFile(filepath.Join(varsDir, "terraform.tfstate"), []byte(tfState), os.ModePerm)
 	if err != nil {
-		return map[string]interface{}{}, fmt.Errorf("Write terraform state to terraform.tfstate: %s", err)
+		return Outputs{}, fmt.Errorf("Write terraform state to terraform.tfstate: %s", err)
 	}
 
 	err = e.cmd.Run(os.Stdout, varsDir, []string{"init"}, false)
 	if err != nil {
-		return map[string]interface{}{}, fmt.Errorf("Run terraform init in vars dir: %s", err)
+		return Outputs{}, fmt.Errorf("Run terraform init in vars dir: %s", err)
 	}
 
 	buffer := bytes.NewBuffer([]byte{})
 	err = e.cmd.Run(buffer, varsDir, []string{"output", "--json"}, true)
 	if err != nil {
-		return map[string]interface{}{}, fmt.Errorf("Run terraform output --json in vars dir: %s", err)
+		return Outputs{}, fmt.Errorf("Run terraform output --json in vars dir: %s", err)
 	}
 
 	tfOutputs := map[string]tfOutput{}
 	err = json.Unmarshal(buffer.Bytes(), &tfOutputs)
 	if err != nil {
-		return map[string]interface{}{}, fmt.Errorf("Unmarshal terraform output: %s", err)
+		return Outputs{}, fmt.Errorf("Unmarshal terraform output: %s", err)
 	}
 
-	outputs := map[string]interface{}{}
+	outputs := Outputs{
+		Map:       map[string]interface{}{},
+		Sensitive: map[string]bool{},
+	}
 	for tfKey, tfValue := range tfOutputs {
-		outputs[tfKey] = tfValue.Value
+		outputs.Map[tfKey] = tfValue.Value
+		if tfValue.Sensitive {
+			outputs.Sensitive[tfKey] = true
+		}
 	}
 
 	return outputs, nil
@@ -742,15 +742,24 @@ resource "some-resource-type" "some-addr" {
 						"sensitive": false,
 						"type": "string",
 						"value": "some-external-ip"
+					},
+					"bosh_vms_private_key": {
+						"sensitive": true,
+						"type": "string",
+						"value": "some-private-key"
 					}
 				}`)
 			}
 			outputs, err := executor.Outputs("some-tf-state")
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(outputs).To(Equal(map[string]interface{}{
-				"director_address": "some-director-address",
-				"external_ip":      "some-external-ip",
+			Expect(outputs.Map).To(Equal(map[string]interface{}{
+				"director_address":     "some-director-address",
+				"external_ip":          "some-external-ip",
+				"bosh_vms_private_key": "some-private-key",
+			}))
+			Expect(outputs.Sensitive).To(Equal(map[string]bool{
+				"bosh_vms_private_key": true,
 			}))
 
 			Expect(cmd.RunCall.Receives.WorkingDirectory).To(Equal(varsDir))
@@ -18,14 +18,35 @@ variable "credentials" {
 	type = "string"
 }
 
+variable "allowed_ingress_cidrs" {
+	type = "list"
+	default = ["0.0.0.0/0"]
+}
+
+variable "network_cidr" {
+	type = "string"
+	default = "10.0.0.0/16"
+}
+
 provider "google" {
 	credentials = "${file("${var.credentials}")}"
 	project = "${var.project_id}"
 	region = "${var.region}"
 }
 
+resource "google_compute_network" "bbl-network" {
+  name		 = "${var.env_id}-network"
+  auto_create_subnetworks = false
+}
+
+# The other templates refer to the network through this data source so that
+# they work unchanged with existing_network.tf.
+data "google_compute_network" "bbl-network" {
+  name = "${google_compute_network.bbl-network.name}"
+}
+
 output "network_name" {
-    value = "${google_compute_network.bbl-network.name}"
+    value = "${data.google_compute_network.bbl-network.name}"
 }
 
 output "subnetwork_name" {
@@ -48,22 +69,17 @@ output "internal_tag_name" {
     value = "${google_compute_firewall.internal.name}"
 }
 
-resource "google_compute_network" "bbl-network" {
-  name		 = "${var.env_id}-network"
-  auto_create_subnetworks = false
-}
-
 resource "google_compute_subnetwork" "bbl-subnet" {
   name			= "${var.env_id}-subnet"
-  ip_cidr_range = "10.0.0.0/16"
-  network		= "${google_compute_network.bbl-network.self_link}"
+  ip_cidr_range = "${var.network_cidr}"
+  network		= "${data.google_compute_network.bbl-network.self_link}"
 }
 
 resource "google_compute_firewall" "external" {
   name    = "${var.env_id}-external"
-  network = "${google_compute_network.bbl-network.name}"
+  network = "${data.google_compute_network.bbl-network.name}"
 
-  source_ranges = ["0.0.0.0/0"]
+  source_ranges = ["${var.allowed_ingress_cidrs}"]
 
   allow {
     ports = ["22", "6868", "25555"]
@@ -75,7 +91,7 @@ resource "google_compute_firewall" "external" {
 
 resource "google_compute_firewall" "bosh-open" {
   name    = "${var.env_id}-bosh-open"
-  network = "${google_compute_network.bbl-network.name}"
+  network = "${data.google_compute_network.bbl-network.name}"
 
   source_tags = ["${var.env_id}-bosh-open"]
 
@@ -89,7 +105,7 @@ resource "google_compute_firewall" "bosh-open" {
 
 resource "google_compute_firewall" "bosh-director" {
   name    = "${var.env_id}-bosh-director"
-  network = "${google_compute_network.bbl-network.name}"
+  network = "${data.google_compute_network.bbl-network.name}"
 
   source_tags = ["${var.env_id}-bosh-director"]
 
@@ -102,7 +118,7 @@ resource "google_compute_firewall" "bosh-director" {
 
 resource "google_compute_firewall" "internal-to-director" {
   name    = "${var.env_id}-internal-to-director"
-  network = "${google_compute_network.bbl-network.name}"
+  network = "${data.google_compute_network.bbl-network.name}"
 
   source_tags = ["${var.env_id}-internal"]
 
@@ -116,7 +132,7 @@ resource "google_compute_firewall" "internal-to-director" {
 
 resource "google_compute_firewall" "jumpbox-to-all" {
   name    = "${var.env_id}-jumpbox-to-all"
-  network = "${google_compute_network.bbl-network.name}"
+  network = "${data.google_compute_network.bbl-network.name}"
 
   source_tags = ["${var.env_id}-jumpbox"]
 
@@ -130,7 +146,7 @@ resource "google_compute_firewall" "jumpbox-to-all" {
 
 resource "google_compute_firewall" "internal" {
   name    = "${var.env_id}-internal"
-  network = "${google_compute_network.bbl-network.name}"
+  network = "${data.google_compute_network.bbl-network.name}"
 
   source_tags = ["${var.env_id}-internal"]
 
@@ -1,6 +1,7 @@
 package gcp
 
 import (
+	"encoding/json"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -39,6 +40,22 @@ func (i InputGenerator) Generate(state storage.State) (map[string]string, error)
 		"system_domain": state.LB.Domain,
 	}
 
+	if state.ExistingNetworkID != "" {
+		input["existing_network_name"] = state.ExistingNetworkID
+	}
+
+	if state.NetworkCIDR != "" {
+		input["network_cidr"] = state.NetworkCIDR
+	}
+
+	if len(state.AllowedIngressCIDRs) > 0 {
+		cidrs, err := json.Marshal(state.AllowedIngressCIDRs)
+		if err != nil {
+			return map[string]string{}, err
+		}
+		input["allowed_ingress_cidrs"] = string(cidrs)
+	}
+
 	if state.LB.Cert != "" && state.LB.Key != "" {
 		certPath := filepath.Join(dir, "cert")
 		err = writeFile(certPath, []byte(state.LB.Cert), os.ModePerm)
@@ -72,6 +72,45 @@ var _ = Describe("InputGenerator", func() {
 		Expect(string(credentials)).To(Equal("some-service-account-key"))
 	})
 
+	Context("when an existing network is provided", func() {
+		BeforeEach(func() {
+			state.ExistingNetworkID = "some-network"
+		})
+
+		It("returns a map containing the existing network name", func() {
+			inputs, err := inputGenerator.Generate(state)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(inputs["existing_network_name"]).To(Equal("some-network"))
+		})
+	})
+
+	Context("when a network cidr is provided", func() {
+		BeforeEach(func() {
+			state.NetworkCIDR = "172.16.0.0/16"
+		})
+
+		It("returns a map containing the network cidr", func() {
+			inputs, err := inputGenerator.Generate(state)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(inputs["network_cidr"]).To(Equal("172.16.0.0/16"))
+		})
+	})
+
+	Context("when allowed ingress cidrs are provided", func() {
+		BeforeEach(func() {
+			state.AllowedIngressCIDRs = []string{"10.0.0.0/8", "192.168.0.0/16"}
+		})
+
+		It("returns a map containing the cidrs as a list", func() {
+			inputs, err := inputGenerator.Generate(state)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(inputs["allowed_ingress_cidrs"]).To(Equal(`["10.0.0.0/8","192.168.0.0/16"]`))
+		})
+	})
+
 	Context("when cert and key are provided", func() {
 		BeforeEach(func() {
 			state.LB.Cert = "some-cert"
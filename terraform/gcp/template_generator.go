@@ -8,12 +8,15 @@ import (
 )
 
 type templates struct {
-	vars         string
-	jumpbox      string
-	boshDirector string
-	cfLB         string
-	cfDNS        string
-	concourseLB  string
+	vars            string
+	network         string
+	existingNetwork string
+	jumpbox         string
+	boshDirector    string
+	cfLB            string
+	cfDNS           string
+	concourseLB     string
+	nat             string
 }
 
 type TemplateGenerator struct{}
@@ -25,7 +28,16 @@ func NewTemplateGenerator() TemplateGenerator {
 func (t TemplateGenerator) Generate(state storage.State) string {
 	tmpls := readTemplates()
 
-	template := strings.Join([]string{tmpls.vars, tmpls.boshDirector, tmpls.jumpbox}, "\n")
+	network := tmpls.network
+	if state.ExistingNetworkID != "" {
+		network = tmpls.existingNetwork
+	}
+
+	template := strings.Join([]string{tmpls.vars, network, tmpls.boshDirector, tmpls.jumpbox}, "\n")
+
+	if state.PrivateDirector {
+		template = strings.Join([]string{template, tmpls.nat}, "\n")
+	}
 
 	switch state.LB.Type {
 	case "concourse":
@@ -89,11 +101,14 @@ func (t TemplateGenerator) GenerateInstanceGroups(zoneList []string) string {
 func readTemplates() templates {
 	tmpls := templates{}
 	tmpls.vars = string(MustAsset("templates/vars.tf"))
+	tmpls.network = string(MustAsset("templates/network.tf"))
+	tmpls.existingNetwork = string(MustAsset("templates/existing_network.tf"))
 	tmpls.jumpbox = string(MustAsset("templates/jumpbox.tf"))
 	tmpls.boshDirector = string(MustAsset("templates/bosh_director.tf"))
 	tmpls.cfLB = string(MustAsset("templates/cf_lb.tf"))
 	tmpls.cfDNS = string(MustAsset("templates/cf_dns.tf"))
 	tmpls.concourseLB = string(MustAsset("templates/concourse_lb.tf"))
+	tmpls.nat = string(MustAsset("templates/nat.tf"))
 
 	return tmpls
 }
@@ -24,11 +24,12 @@ var _ = Describe("TemplateGenerator", func() {
 	})
 
 	Describe("Generate", func() {
-		DescribeTable("generates a terraform template for gcp", func(fixtureFilename, region, lbType, domain string) {
+		DescribeTable("generates a terraform template for gcp", func(fixtureFilename, region, lbType, domain, existingNetworkID string) {
 			expectedTemplate, err := ioutil.ReadFile(fixtureFilename)
 			Expect(err).NotTo(HaveOccurred())
 
 			template := templateGenerator.Generate(storage.State{
+				ExistingNetworkID: existingNetworkID,
 				GCP: storage.GCP{
 					Region: region,
 					Zones:  zones,
@@ -40,11 +41,28 @@ var _ = Describe("TemplateGenerator", func() {
 			})
 			Expect(template).To(Equal(string(expectedTemplate)))
 		},
-			Entry("when no lb type is provided", "fixtures/gcp_template_no_lb.tf", "some-region", "", ""),
-			Entry("when a concourse lb type is provided", "fixtures/gcp_template_concourse_lb.tf", "some-region", "concourse", ""),
-			Entry("when a cf lb type is provided", "fixtures/gcp_template_cf_lb.tf", "some-region", "cf", ""),
-			Entry("when a cf lb type is provided with a domain", "fixtures/gcp_template_cf_lb_dns.tf", "some-region", "cf", "some-domain"),
+			Entry("when no lb type is provided", "fixtures/gcp_template_no_lb.tf", "some-region", "", "", ""),
+			Entry("when a concourse lb type is provided", "fixtures/gcp_template_concourse_lb.tf", "some-region", "concourse", "", ""),
+			Entry("when a cf lb type is provided", "fixtures/gcp_template_cf_lb.tf", "some-region", "cf", "", ""),
+			Entry("when a cf lb type is provided with a domain", "fixtures/gcp_template_cf_lb_dns.tf", "some-region", "cf", "some-domain", ""),
+			Entry("when an existing network is provided", "fixtures/gcp_template_existing_network.tf", "some-region", "", "", "some-network"),
 		)
+
+		Context("when a private director is requested", func() {
+			It("adds a cloud nat for vms without external ips", func() {
+				expectedTemplate, err := ioutil.ReadFile("fixtures/gcp_template_private_director.tf")
+				Expect(err).NotTo(HaveOccurred())
+
+				template := templateGenerator.Generate(storage.State{
+					PrivateDirector: true,
+					GCP: storage.GCP{
+						Region: "some-region",
+						Zones:  zones,
+					},
+				})
+				Expect(template).To(Equal(string(expectedTemplate)))
+			})
+		})
 	})
 
 	Describe("GenerateBackendService", func() {
@@ -4,7 +4,10 @@
 // templates/cf_dns.tf
 // templates/cf_lb.tf
 // templates/concourse_lb.tf
+// templates/existing_network.tf
 // templates/jumpbox.tf
+// templates/nat.tf
+// templates/network.tf
 // templates/vars.tf
 // DO NOT EDIT!
 
@@ -73,7 +76,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _templatesBosh_directorTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x95\xd1\x72\xa2\x30\x14\x86\xaf\xe5\x29\xce\x64\xf6\xb2\xba\x96\x05\xf5\xa6\x4f\xb2\xe3\x64\x02\x1e\x59\xb6\x91\xc3\x84\xa0\x9d\xe9\xf8\xee\x3b\x21\x04\xb0\x2a\x1b\x6d\x9d\x4e\x2f\xa0\xf8\xff\x7f\x4e\xbe\x9c\x03\x54\xeb\xb2\xd6\xc0\x0a\xd4\x07\x52\xaf\xbc\x10\x3b\x64\xf0\x1e\x00\x00\xec\x85\xac\x11\x5e\x80\xfd\x78\xcf\x88\x32\x89\x3c\xa5\x5d\x59\x6b\xe4\xad\x7a\x96\x24\x72\xea\xee\x8d\xf3\xc8\x82\x63\x10\xb8\xcc\xaa\x4e\x6e\x8b\xed\x0d\x4d\xb2\xfd\xf7\x42\x70\x42\xd5\x1f\x4e\x25\x16\x5c\x8b\xcc\x33\x7b\x9b\x2b\x3c\x08\x29\x67\xc6\x3c\x35\xe6\x6b\xc1\x9b\x5c\x61\xaa\x49\x9d\x84\x4f\x7c\x93\x9d\xfb\x42\xfa\xdf\x7a\x57\x26\xf4\x76\x35\x77\x2f\xd4\x0c\x8b\x3d\xcf\x37\xc7\x69\xab\x3d\xf1\xe7\x85\x46\x55\x08\x79\xcf\xae\x9d\x77\x50\x96\xc2\x8a\x6a\x95\x22\xb0\xcb\xa7\xcb\x80\x0d\xce\xd7\xae\x65\xdc\x93\xc9\x79\xb9\x4e\x14\x00\x88\x5a\x13\x4f\x15\x8a\x93\x03\xad\xe0\x05\xb6\x42\x56\x38\xba\x72\xaf\x6f\x17\xb7\x0f\x86\x6b\x4f\xce\xd6\x6e\x35\x01\x40\x5e\xf2\x34\xdf\x28\xae\x44\x91\x35\x3c\x9e\xe7\xb3\xe6\xef\xe7\xf3\xc2\xfc\xde\x66\xb7\x19\x1e\x3d\x5d\xa1\xdc\x72\x99\x17\xaf\xff\x21\xe6\x30\x33\x60\xf8\x66\x41\xf7\x35\x9b\x13\x3a\x2b\xba\x93\xf5\x65\xdd\x3a\x69\x01\x80\x2d\xc7\xee\xd7\x10\xfe\xcd\xdc\x86\xe7\x6c\x6d\x04\x42\x4a\x3a\xb4\x5d\x52\x92\xd2\x56\x14\x86\xec\x09\xd8\x62\xb5\x58\x99\x6b\x18\xc7\x71\xcc\xd6\x56\xa3\x48\x53\x4a\xd2\xd4\xa2\xd3\xd2\x54\x77\x34\x39\x5a\xa8\x0c\xb5\x69\x3c\x9b\x70\xba\x99\x6e\xa4\xd8\xda\x17\x53\x6f\x19\xe7\xd4\xeb\xbe\x02\x94\x47\xfd\x9e\xd0\x56\x51\xf4\xab\xb9\xae\xa2\xe8\x0b\x21\xba\xb7\xc7\x8d\x20\x3b\x9b\x07\xcc\x4e\xfb\x68\xa0\x83\xbd\x7c\x84\x7a\x17\x20\xf7\x0a\xf3\x67\xe3\x1c\x53\x4d\xbe\x88\x2e\x5a\x1e\x48\x6a\xb0\xa9\xab\x9d\x17\x85\xb6\xf7\xc2\x38\x8c\xe7\xf6\x66\xb9\x5c\x7e\x47\xb3\xb5\x5f\x25\x03\xa7\x79\x30\x8a\xf2\x83\xf8\x81\x10\xdd\xc7\x72\x7c\x7a\x3f\xc3\xab\x3b\xa6\xa7\xf1\x99\xba\xb9\x35\x3d\xdb\xf1\x9b\x5a\x70\xc0\x2a\x4f\x77\x3d\x2c\x9f\x61\xbe\xa6\xa9\x37\x77\x0d\xfc\xbf\x00\x00\x00\xff\xff\x79\x21\x66\x2d\xa9\x0a\x00\x00")
+var _templatesBosh_directorTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc5\x55\xdb\x8e\x82\x30\x14\x7c\xd6\xaf\x20\xcd\x3e\xaa\xd9\xb0\xde\x5e\xf6\x4b\x36\xa6\x29\x50\x59\xd6\xd2\x43\x4a\x51\x13\xc3\xbf\x6f\x0b\x2d\xe0\x05\xed\x1a\x5d\x79\xa1\xc2\xcc\xf4\xcc\xf4\x1c\x81\x42\x66\x85\xf4\x10\xa7\x72\x07\x62\x83\x39\x49\x29\xf2\x0e\x43\x4f\x5d\x5b\xc2\x0a\xea\x7d\x7a\xe8\xed\x10\x11\x49\x26\x31\x40\xcc\x28\x0e\x21\x55\x14\x8a\x0d\x65\x12\x04\x6c\x6c\xd7\x9a\x5e\xa2\x61\x39\x1c\x82\x11\xce\x8b\xe0\x86\xf6\x89\x6c\x4b\xa8\x94\xeb\x9f\x17\x84\x03\xc8\xbf\x31\x64\x94\x63\x49\x62\x47\xed\x75\x22\xe8\x8e\x30\x36\xd1\xe4\xb1\x26\xf7\x09\x47\x0a\x19\x4a\x10\x47\xe2\x03\x57\x65\xcb\xbe\xa0\xfe\x53\xa4\x59\x00\xfb\x5e\xdd\x2d\x11\x13\xca\xb7\x38\x89\xca\xb1\xc1\x1e\xf1\x13\x2e\xa9\xe0\x84\xdd\xe3\xda\x72\x3b\x65\x09\x9a\x43\x21\x42\xea\xa1\xde\x63\x40\x2a\x92\xe6\x20\xea\xdd\x34\x7f\x30\x18\x9c\x15\x6c\x30\x0a\x91\x64\x38\x4c\x22\x81\x05\xe1\x71\xc7\x99\x6d\x05\xfd\xae\xd4\x38\xf3\xc0\x68\xb9\xb6\x59\x4e\xd9\x1a\xb3\x84\x6f\x6e\x98\xb0\xce\x95\x05\xba\xaf\xbd\xb7\x06\x74\x68\x67\x0e\x1a\x58\x5b\xdb\x5d\x13\xa0\xe8\x75\x4d\x75\x02\xb9\x12\xf9\x32\x5b\xa9\x7a\x60\x47\x23\x9c\xf0\x58\x15\x9e\x57\x59\xe4\x25\x5a\x69\x4e\xf5\xce\x1c\x68\x06\x42\xd6\x3c\xdf\x47\x23\x0f\xcd\x97\xf3\xa5\xbe\xfb\x33\x75\x29\x78\x85\x11\x20\x21\x04\xa6\x6b\x94\x61\xa6\xab\x2e\xb5\x8e\x24\x22\xa6\x52\xf7\x48\x77\x67\x6b\xb2\xe9\x7e\xa5\xe2\x18\x5f\x4b\xb9\x9e\x5f\x8b\x7b\x58\x80\x0e\x26\x1c\x93\x5b\x4e\xa7\x1f\xd5\x5d\x2d\x1e\x98\xa4\x9d\xf6\x3f\xa6\xd9\xd0\x1c\x12\x6d\xb0\xff\x92\x6a\xc7\xd0\x69\xb2\x77\xa5\x64\xff\x77\xdc\x03\xb2\x8c\xb1\x04\xd7\x9c\x2e\x52\x9e\x1d\x57\xc7\x59\x6f\x0f\x4e\xfd\xba\x0b\xfd\x99\x3f\x7b\xaf\x17\x8b\xc5\xe2\x15\x6d\x67\xbe\x27\x3a\xa1\xea\xc1\xd5\x3c\x4f\xc0\xcf\x4e\xd2\x7e\xeb\xae\x0f\xf3\xea\x11\x5d\x38\xf2\x1e\x13\x67\x23\xe8\xd6\x98\xaf\x6c\xc6\x4e\x60\x49\x98\xb6\x89\xb9\xcc\x76\x1f\xa6\x88\xee\x9a\xff\x5f\xa5\x32\xd5\x98\x72\x0a\x00\x00")
 
 func templatesBosh_directorTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -88,7 +91,7 @@ func templatesBosh_directorTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/bosh_director.tf", size: 2729, mode: os.FileMode(420), modTime: time.Unix(1508261740, 0)}
+	info := bindataFileInfo{name: "templates/bosh_director.tf", size: 2674, mode: os.FileMode(420), modTime: time.Unix(1792369821, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -113,7 +116,7 @@ func templatesCf_dnsTf() (*asset, error) {
 	return a, nil
 }
 
-var _templatesCf_lbTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x59\x4b\x8f\xdb\x36\x17\x5d\x7f\xfe\x15\x84\xf0\x2d\x5a\x20\xf2\xd8\x1e\x37\x75\x17\x59\x15\xdd\xa6\x5d\x74\x17\x0c\x04\x8a\xba\xb2\x09\x73\x44\x96\xa4\xec\x18\x83\xf9\xef\x05\x49\xc9\xd6\x93\x7a\xd8\x41\x31\xc9\xc2\x1a\xf1\xde\x73\xa9\x73\x1f\x3a\x92\x4e\x58\x52\x1c\x33\x40\x81\x52\x2c\x22\x20\x35\x4d\x29\xc1\x1a\x02\xf4\xb6\x40\x48\x5f\x04\xa0\x2f\x28\x50\x5a\xd2\x6c\x1f\x2c\xde\x17\x8b\x5e\x8f\x48\x48\x7a\x32\xbf\x47\xb8\xf4\x7a\xf3\x5c\x8b\x5c\xa3\x40\xf2\x5c\x83\x8c\x62\x4c\x8e\x90\x25\x91\x02\x79\xa2\xa4\x08\x7a\xc2\x2c\xb7\x7e\xff\x7f\xdb\x73\xbe\x67\x10\x11\xfe\x2a\x72\x0d\x4d\xf3\xa5\x43\x09\x59\x1c\x16\x2b\x61\xb9\x92\xe1\x57\x78\xef\x8a\xc8\xe2\x88\x0a\x17\xc7\x17\x69\xcf\x78\x8c\x59\x84\x93\x44\x82\x52\x4b\x92\x86\xe5\x61\xf1\x5b\x07\x57\xea\x10\x09\xc9\xbf\x5f\xc6\xe2\x57\x80\x95\x3a\x84\xd6\xb7\x1b\x5a\x13\x11\x4d\xdb\x7b\x05\x5b\x13\x11\x3a\xe7\x6e\xf0\xb3\x9a\x01\x7a\xee\x21\x81\x48\x48\x0e\x79\x3c\x19\xd1\xb9\xd5\x31\x25\x28\x9e\x4b\x02\x28\x68\x78\xa5\x54\xc2\x19\x33\x16\xa0\xa0\x3c\x0c\x49\xea\xa2\x99\xa4\x23\xf7\xcf\x06\x3c\x61\xb9\x84\xec\x14\xd1\xe4\x3d\x24\x69\xc8\x05\x64\xc1\x02\xa1\x04\x04\x64\x89\x8a\x78\x86\xbe\xa0\x6f\xcd\x00\x19\xe8\x33\x97\xc7\x65\x1c\xb3\xb0\x38\x0e\x5e\x0c\xb8\x3b\xbe\x82\x0f\xbb\x95\x45\xb8\x40\x08\x33\xc6\xcf\x05\x23\x42\x72\xcd\x09\x67\x06\x46\x13\x11\xb8\x93\x5c\x6a\xe5\xb0\xbf\x05\xbb\x55\xf0\x09\x05\xdb\xed\xb3\x0d\xfc\x6e\x00\x1c\x1b\x91\xc4\xd9\x1e\x94\x35\x5a\x2d\xed\xff\xa7\x55\xf0\x62\x0c\x34\x96\x7b\xd0\x91\xc6\x7b\xb7\x7c\x77\xef\xbc\x78\xd3\x50\xef\x8f\x00\x05\xb7\x0e\xa9\xe4\xa2\x23\x0b\xfe\xec\x16\xb0\x29\x97\x67\x2c\x13\x9a\xed\x23\x99\x33\x70\xf0\x07\xad\x45\x78\x5b\x09\xdd\xca\x88\xbc\x1b\x47\xc3\x32\x15\xe5\x7e\x67\xb7\x7c\xc9\x33\xea\x2b\x83\x22\x0d\x26\xa4\x1b\x08\xcb\x72\xe7\x2c\x2e\xba\x5c\x01\x4b\x23\x46\xb3\xa3\xc5\x33\x89\x77\x69\x35\x78\xbb\xd5\x7d\xfc\xa8\xd9\x04\xa9\xff\x80\x21\x55\xa7\x48\x8d\xe3\xc8\xf4\x85\x97\xa4\x56\x0e\x2a\xf5\x53\x46\x68\xf1\xd2\x26\xc6\xda\x3b\x63\x3b\x34\x14\x91\x54\x68\x6a\xa7\x46\x20\x01\x33\x76\x41\x18\x31\x8e\x13\x14\x63\x86\x33\x02\x12\xc5\xb9\x46\x8c\x2a\x0d\x09\xc2\x0a\xe1\x0c\x19\x10\x74\x05\xc9\x25\x8b\x5e\xb1\xe8\xe5\xa6\x58\xaf\x11\x92\x4b\x16\x9a\x73\x55\x4a\x46\x5e\xbd\x6a\x5e\xbe\xf2\x5c\x7f\x3f\x09\xaa\x9b\x85\xd2\x61\x0a\x15\xaa\x9b\x8b\xbb\x09\x41\xa8\x21\x46\x7a\x86\x60\xc3\xca\xe0\x9a\x3f\xab\x58\xfe\xb9\xd7\x52\x49\x41\x01\x71\x23\x34\x12\x12\x52\xfa\xbd\xc5\x65\x47\x15\xe5\x0a\xa4\x61\xe4\x44\x13\x48\xcc\x25\xa0\x42\x43\xa1\x23\x5c\xd0\x93\x3d\x53\x89\x86\x04\xa6\xd2\x36\xc4\x4d\x69\xb9\x30\x29\x65\xf0\x93\x89\xe5\xd1\x64\x3f\xdb\x1d\x54\xe1\xbc\xae\xce\x9c\xd1\x14\xc8\x85\x30\x40\x6f\x8b\xff\x11\x09\x06\x2b\x86\x94\x4b\x88\x12\x50\x5a\x72\xb3\x01\x2d\x73\xb0\x37\x2a\x1f\x73\x45\x2a\x1b\xc5\x58\x24\xd3\x7f\xcf\x28\x26\xb8\xe5\x2f\xc5\x39\xd3\xe5\x4d\xec\x4e\x91\x38\xb6\xa5\x0e\x80\x99\x3e\x44\xe4\x00\xe4\xe8\xf6\x2f\xf2\x98\x51\x12\xba\x85\xb0\x58\xe8\xec\xa8\x9e\x91\xeb\x00\xec\x35\xd9\x39\x55\x0d\x61\xa8\x76\x43\xaf\x8d\xb4\x5b\xed\x56\x66\x55\xc2\x3f\x39\x28\x1d\x09\xac\x0f\x95\x38\x4f\x0e\x27\x18\xcc\x46\x2b\xe8\x63\xae\xab\x9c\xd6\x3d\x1b\x1f\xde\xf7\x48\xe9\x67\x6a\xc2\xb7\xc7\xce\x22\xaa\x3a\x7c\x0c\x19\xe8\x84\xe0\x6e\xe5\xd3\x81\xeb\xe7\xd5\x72\xb3\x5e\x5b\x2d\xb8\xd9\x18\xfb\xe7\x5f\x96\xeb\xdf\xdc\x89\xf5\x67\xeb\x5a\x15\x87\xe8\x81\xf2\xb0\xfd\xf8\x53\x44\x12\x9c\xb3\xa1\x87\xb9\x8a\x69\xfd\x31\xe8\xf6\xec\xd6\x5b\x0a\x35\xdd\x79\xf5\x1c\x98\x22\x37\xbb\x09\x65\xd6\x05\xde\x5f\x63\x57\xeb\x8f\xf3\xb0\xb1\xd9\x6c\x36\xb7\xfa\x1a\x7c\x8c\x18\xc8\x9a\xff\xee\x59\xab\x8e\x99\xa9\x33\x4d\x00\x4a\x51\x9e\x45\x38\x4d\x69\x46\xb5\xbd\x07\x7e\xfd\xf3\xeb\x1f\x03\x79\xed\x12\xcd\xfd\xe9\x1d\xda\x47\x4d\xe8\x4e\x2b\xf0\x5e\x75\x6b\x60\x6c\x3e\x9c\x16\xaf\x26\xef\xef\xdf\xff\x6a\x28\xf4\xc7\xbd\x57\x98\xdf\xb4\x95\xf7\x0b\x23\xba\xb6\xde\x59\x37\xdf\x51\xad\x55\x31\xff\x08\x6d\xb5\x5e\x6d\xb6\xe1\xf3\xe6\xd7\xcf\xbb\xf9\xcd\xd5\x62\xd7\xdf\x5d\xb5\xa1\xd8\xc9\xee\x10\xaf\x33\x14\x83\x27\x8b\x5e\x01\x54\x4f\x67\x9f\x66\xb8\x53\x31\xb4\xe6\xcd\x2c\x56\xbc\x13\xc7\x08\xb8\x0a\x29\x36\xb1\xb6\x1a\xda\xd9\x6d\x31\xd8\x99\xe3\x4f\x0b\x84\xfc\x79\xee\x1c\x64\xbe\x3c\x0c\xf3\x3f\x71\x94\x55\x36\xed\x9d\x65\x95\x26\x78\xc4\x44\x1b\xf1\x36\x73\xfe\x28\x3b\xab\xc9\xc2\xe3\x3c\xf0\xae\xcb\x18\x4c\xab\xcf\x51\x88\x93\xeb\x71\x64\x29\x76\x88\xff\x51\x73\xa7\xb3\x1e\xcf\xaa\x78\xad\x34\xaa\x1a\xaf\xd6\xd3\x6b\xf1\xac\xfc\x35\x68\x5f\x17\x3d\xa0\xf8\x9a\x6f\xbd\x67\xd1\x31\x89\x8d\x1f\x40\xc6\x6e\xf5\x43\xb8\x68\x7e\x01\x98\xdb\x85\xc5\x97\x80\xf6\xc7\x9b\x06\xb0\xb9\x83\x0e\x01\x97\x62\xe3\x8a\x5a\xf1\x9d\xa0\x53\x9c\x73\x3d\x6f\xed\x9c\x39\xab\xab\xe8\x2f\x75\xc6\x44\x91\x31\xf9\x01\x71\xb7\xdd\x16\xea\x62\xaa\xb8\xa8\x31\x3d\x5a\x56\xb4\xc8\xe8\x63\x62\xd2\xd8\x9b\x80\xfa\x40\xed\xdf\x95\x58\x5f\x72\x27\x77\x63\x41\xb1\xbf\x1f\x4d\x06\xef\xef\xc8\x8e\x2f\x68\xff\x06\x00\x00\xff\xff\x43\xa8\x44\x67\xd9\x1d\x00\x00")
+var _templatesCf_lbTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd5\x59\xcb\x6e\xe3\x36\x14\x5d\xd7\x5f\x41\x08\x5d\xb4\xc0\xc8\xb1\x1d\x77\xea\x2e\xba\x2a\xba\x9d\x76\xd1\xdd\x20\x10\x28\x89\xb2\x09\x33\xa2\x4a\x52\xf6\x18\x41\xfe\xbd\x97\xa4\x64\x53\xef\x87\x3d\x28\xe2\x2c\xac\x88\xbc\xe7\x52\xe7\x3e\x78\x28\x9f\xb0\xa0\x38\x64\x04\x79\x52\xb2\x20\x22\x42\xd1\x84\x46\x58\x11\x0f\xbd\x2d\x10\x52\x97\x8c\xa0\xdf\x61\x50\x09\x9a\xee\xbd\xc5\xfb\x62\x71\xea\xb2\x08\x32\x41\x4f\xfa\xfb\x48\x2e\x9d\xd6\x3c\x57\x59\xae\x90\x27\xe0\x82\x88\x20\xc4\xd1\x91\xa4\x71\x20\x89\x38\xd1\xa8\x70\x7a\xc2\x2c\x37\x76\x3f\xbe\xed\x39\xdf\x33\x12\x44\xfc\x15\xac\x48\x7d\xfa\xd2\xa2\xf8\x2c\xf4\x8b\x11\xbf\x1c\x49\xf1\x2b\x79\x6f\xf3\xc8\xc2\x80\x66\xd6\x4f\x9f\xa7\x3d\xe3\x21\x66\x01\x8e\x63\x41\xa4\x5c\x46\x89\x5f\x5e\x16\xdf\x55\x70\x29\x0f\xf0\xf8\xfc\xdb\x65\x2c\xbe\x03\x0c\xb6\xbe\xb1\x6d\x87\x56\x51\x16\x4c\x5b\xbb\x83\x0d\xc6\xbe\x35\x6e\x07\x3f\xcb\x19\xa0\xe7\x0e\x12\x22\x41\xe2\x43\x1e\x4e\x46\xb4\x66\x55\x4c\xb8\xe2\xb9\x88\x20\xcb\x6a\x56\x09\x15\xe4\x8c\x19\xf3\x90\x57\x5e\xfa\x51\x62\xbd\xe9\xa0\x23\xfb\x31\x0e\x21\x55\x97\x24\x3d\x05\x34\x7e\x87\x39\x3e\xcf\x48\xea\xc1\xb4\x98\xc0\x45\x2c\x03\x9e\xc2\xb4\xaf\x5e\x8c\x15\x5e\xd6\xbc\xa4\x44\x9d\xb9\x38\x2e\xc3\x90\xf9\xc5\xb5\xf7\xa2\x3d\xd8\xeb\xab\x87\x91\xb6\x65\x3a\x02\x02\xac\x97\x9f\x0b\x6e\x20\xea\x8a\x47\x9c\x69\x2c\x88\x94\x67\x6f\x72\xa1\xa4\x75\xf0\xd5\xdb\xad\xbc\x4f\xc8\xdb\x6e\x9f\x8d\xf7\x77\x0d\x60\x79\x09\x04\x4e\xf7\x44\x9a\x49\xab\xa5\xf9\x7b\x5a\xc1\x24\x5d\x75\x58\xec\x89\x0a\x14\xde\xdb\xe1\xbb\xab\xe8\xa5\x37\x20\xd5\x4a\x81\xb0\xdc\x6a\xc5\x89\x4a\x4b\x3c\xbc\x31\xb0\x09\x17\x67\x2c\x62\x68\x1f\x81\xc8\x19\xb1\xf0\x07\xa5\x32\xff\x36\xe2\xdb\x91\x11\x19\xa0\x0d\x35\xcb\x34\x2b\xd7\x3b\xbb\xf8\x4b\x9e\x6f\xbe\x6a\x20\x45\x18\xb4\x4b\xdb\x1a\x96\xe5\xca\x81\x68\x7b\x43\x12\x96\x04\x8c\xa6\x47\x83\xa7\x03\x6f\xc3\xaa\xf1\x20\xf2\x77\xf1\x23\x67\x13\x24\xff\x07\x86\x64\x95\x22\x39\x8e\x23\x5d\x17\xbd\x24\x35\x62\xe0\xe4\x4f\xe9\xa1\xc1\x4b\x93\x18\x33\xdf\x4e\x36\xed\x43\x46\x82\x66\x8a\x9a\xfe\xe1\x09\x02\x25\x7d\x41\x18\x31\x8e\x63\x04\xac\xe0\x14\x36\x45\x14\x42\x47\x64\x54\x2a\x12\x23\x2c\x11\x4e\x91\x06\x41\x57\x90\x5c\xb0\xe0\x15\x67\x9d\xdc\x14\xe3\x15\x42\xe0\x9e\xaf\xef\xb9\x94\x8c\x7c\x7a\x59\x7f\x7c\xd9\xf3\xfc\xdd\x24\xc8\x76\x16\x4a\x83\x29\x54\xc8\x76\x2e\xee\x26\x04\x1a\x64\x55\x96\x74\x34\xc1\xda\x2c\x8d\xab\xff\x75\xb1\xfa\xfb\x5e\x43\x2f\x79\x05\xc4\x8d\x50\x20\x9d\x24\xf4\x5b\x83\xcb\x96\x2c\xca\xa1\xe5\x6a\x46\x4e\x34\x06\x9a\x00\x1b\x15\x6a\x0a\x81\x9a\x42\x4f\xe6\x8e\xe3\x0d\x65\x98\x0a\x53\x10\x37\xcd\x65\xdd\x24\x94\x91\x9f\xb4\xaf\x1e\x75\xf6\xb3\x59\x81\x0b\xd7\x6b\x6a\xa7\x33\x9a\x90\xe8\x12\x81\xf0\x7b\x5b\xfc\x00\x7b\xb6\xc6\x0a\x09\x34\x19\x12\xc0\xb3\x28\xc1\xf5\x02\x94\xc8\x89\xd9\xa8\xfa\x98\x2b\x42\x59\x4b\xc6\x22\x98\xfd\x7b\x46\xd1\xc1\x0d\x7f\x09\xce\x99\x2a\x37\xb1\x3b\xe5\xe2\xd8\x92\x3a\x40\x86\xab\x43\x10\x1d\x48\x74\xb4\xeb\xcf\xf2\x90\xd1\xc8\xb7\x03\x7e\x31\xd0\x5a\x51\x1d\x2d\xd7\x02\x98\x67\x32\x7d\xca\x75\xa1\xa9\xb6\x4d\xaf\x89\xb4\x5b\xed\x56\x7a\x54\x90\x7f\x73\xe0\x3f\xc8\xb0\x3a\x38\x7e\x9e\x2c\x8e\x37\x18\x8d\x86\xd3\xc7\x3c\x57\xd9\xad\x3b\x16\x3e\xbc\xee\x91\x22\x50\xe7\x44\xdf\x1a\x5b\x93\xc8\x35\xf8\x68\x82\xd0\x4a\xc2\xdd\xaa\x4f\x11\xae\x9f\x57\xcb\xcd\x7a\x6d\x54\xe1\x66\xa3\xe7\x3f\xff\xb2\x5c\xff\x66\x6f\xac\x3f\x1b\x53\x57\x26\xa2\x07\x0a\xc5\xe6\x91\xa8\xf0\x94\x71\xce\x86\x0e\x78\xce\xd4\xea\xd1\xe8\x76\x9e\xeb\x4c\x8a\x8a\x02\xbd\x5a\x0e\xf4\x93\xdb\xbc\x09\x09\xd7\x06\xde\x9d\x6d\xd7\xd9\x1f\xf1\x00\xb2\x81\xcf\x2d\xd3\x06\x8f\x16\x03\xf1\x7b\x19\xa3\x57\x6c\x9e\xcc\x0c\xa2\x2e\x07\x48\x02\xd8\x55\x03\x9c\x24\x34\xa5\xca\xec\x8b\x5f\xfe\xfa\xf2\xe7\x40\x84\xdb\x84\x74\x77\xa0\x87\xd6\x51\x11\xbf\xd3\x52\xbd\x53\xf1\x6a\x18\x13\x0f\xab\xcf\xdd\xe0\xfd\xf3\xc7\xdf\x35\xd5\xfe\xb8\xb7\x0e\xf3\xcb\xd7\x79\xfb\x30\xa2\x7e\xab\x35\x76\xb3\x1d\x55\x64\xce\xf4\x8f\x55\x60\xeb\xd5\x66\xeb\x3f\x6f\x7e\xfd\xbc\x9b\x5f\x66\x0d\x9e\x5f\xc6\x37\xca\x56\x9e\x87\x18\x9e\xa1\x27\x7a\xe2\xd9\x2b\x8f\xaa\x81\xed\x52\x14\x77\xea\x89\x46\xe7\x99\xc5\x4a\x6f\xef\xd1\xf2\xce\x21\xc5\x04\xd6\x64\x43\x33\xba\x0d\x06\x5b\x63\xfc\x09\xac\x5f\xa6\xb7\xb4\xbe\x38\x0c\xf3\x3f\xb1\xa9\x39\x8b\xee\xed\x6a\x4e\x11\x3c\xa2\xb7\x8d\x78\xeb\x39\xbf\xa9\x9d\xe5\x64\x31\x72\x1e\x78\x13\xa6\x27\x4c\xcb\xcf\x51\x88\x93\xf3\x71\x64\x2a\xb6\x1c\x0d\x46\xf5\x9d\xd6\x7c\x3c\xcb\xe2\xa5\xd3\xa8\x6c\xbc\xce\x9e\x9e\x8b\x10\xb7\xde\x1c\x34\x2f\x93\x1e\x90\x7c\xf5\xb7\xe3\xb3\xe8\x98\xc4\xc6\x77\x20\x43\xbf\x7d\xfc\x0e\x5c\xd4\x7f\x29\x98\x5b\x85\xc5\x2f\x06\xcd\x1f\x79\x6a\xc0\x7a\x07\x1d\x02\x2e\x65\xc7\x15\xd5\xb1\x9d\xa0\x58\xac\x71\x35\x6e\xcd\x98\xd9\x59\xd7\x83\x40\x29\x36\xe6\x28\x8d\xc9\x27\xc7\xdd\x76\x5b\x48\x8c\xa9\x0a\xa3\x42\xf7\x68\x6d\xd1\x60\xa4\x8b\x8e\x49\xbd\x6f\x02\xea\x03\x8f\x02\x6d\xd1\xed\x8b\xf0\xe4\x92\x2c\x28\xee\x2f\x4a\x1d\xc1\xfb\xcb\xb2\xe5\xe7\xb6\xff\x00\xe9\xe4\xf6\x09\x06\x1e\x00\x00")
 
 func templatesCf_lbTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -128,12 +131,12 @@ func templatesCf_lbTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/cf_lb.tf", size: 7641, mode: os.FileMode(420), modTime: time.Unix(1507852860, 0)}
+	info := bindataFileInfo{name: "templates/cf_lb.tf", size: 7686, mode: os.FileMode(420), modTime: time.Unix(1792369657, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesConcourse_lbTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x93\x3f\x8f\xdc\x20\x10\xc5\xeb\xf0\x29\x46\xa3\x94\xc1\x85\x73\x6d\xaa\x28\xed\x25\x45\xba\xe8\x84\x58\x7b\xec\x45\xc7\x31\x08\xf0\x5a\xd1\xc9\xdf\x3d\xc2\x66\xbd\xbe\xdc\x9f\x5d\x69\xb5\x95\x47\xe3\xc7\x63\xf8\x3d\xe0\x21\xf9\x21\x01\x36\xec\x1a\x1e\x42\x24\x95\x74\xe8\x29\x29\xcf\x6c\x11\x9e\xc5\xa7\x83\xb6\x03\xc1\x37\xc0\xcf\xcf\x3d\x73\x6f\x49\x35\xfc\xe4\x87\xf4\x42\x59\x2d\xb5\x9c\x6b\xa7\x9f\x68\x42\x31\x09\xf1\xda\xdd\xee\x94\xf1\xd9\x17\x00\xe0\x7d\x6b\xdd\xb6\x81\x62\xac\xd6\x85\xf2\xd8\x29\xdf\xc5\x3f\x50\xe4\x21\x34\x04\xf8\xdf\xfa\xce\x04\x1a\xb5\xb5\x08\x78\x2c\xe5\xea\xb5\x6c\x9f\xa7\xcc\x43\xcc\xdb\x1f\x74\xa8\xc8\x1d\x94\x69\xa7\x93\x4e\xb2\x27\x87\x59\x4a\x69\xe4\xf0\xf8\xe6\xa4\xe5\x5f\xb5\xdb\x59\x79\xac\x0b\x00\x01\xa0\xad\xe5\xb1\x9c\xd6\x07\x4e\xdc\xb0\xcd\x36\xa9\xf1\xb8\x34\x39\xa4\xb8\x8c\xf1\x07\xef\xee\xbe\xe2\x17\xc0\xba\xae\x6b\x7c\x10\x00\x53\xb6\x28\x94\x93\xee\xe3\x2c\x3a\x1d\xe3\xe1\x43\x04\x05\x14\x6e\xe8\xcb\xb5\xb7\x02\x78\xff\xf4\x1f\x03\x7e\x71\x4b\x70\x93\xfe\x85\xde\x02\x20\x52\x8c\x86\x9d\xd2\x5d\x67\x9c\x49\x7f\xb3\xfe\xfe\xe7\xfd\x8f\x33\xc9\x72\x18\x75\x68\x8d\xeb\x55\x18\x2c\x21\x60\x8c\x7b\x79\xea\xca\xa5\xbb\x4d\xf8\x4c\xca\x31\xee\x71\xe5\xbc\x51\x5f\x78\xdb\x23\xd9\x4e\x59\xe3\x1e\xa7\xec\x92\xf3\x54\x41\xbb\x9e\x66\x97\x39\x4a\x01\x60\xbc\xda\xc6\xff\xfb\xfb\xaf\xd2\x2d\x89\xbc\xbd\xe5\xd5\xaf\xe0\x15\xab\x7d\x4a\x3e\x5e\x45\x6b\x76\xb8\x19\xaf\xfc\x02\x6e\x8c\xeb\x5f\x00\x00\x00\xff\xff\x5c\xc2\x73\xd4\xf2\x04\x00\x00")
+var _templatesConcourse_lbTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x53\x3d\x6f\xc3\x20\x10\x9d\xeb\x5f\x81\x4e\x1d\x8b\x87\x36\x6b\xa7\xaa\x6b\xdb\xa1\x5b\x15\x21\x62\x63\x07\x85\x70\x08\x70\xac\x2a\xf2\x7f\x2f\xd8\xc4\x71\x12\xe5\x43\xaa\xc2\xc2\xe9\x78\xf7\xee\x78\x0f\xb0\xf1\xa6\xf1\x04\x0a\xd4\x05\x36\xd6\x09\xe6\xb9\xad\x85\x67\x06\x51\x01\xd9\x66\x0f\x1b\xae\x1a\x41\x5e\x09\x3c\x6e\x6b\xc4\x5a\x09\x56\xe0\x3a\xd4\x1c\x20\xf3\x21\xa6\x7d\xac\xf9\x5a\x74\x90\x75\x59\x86\x27\xec\x6a\xc1\xa4\x89\xbc\x24\xac\xf3\xd4\xbc\x2c\xad\x70\x2e\x1f\x0b\xe9\x2e\x93\xf6\x81\x3f\x44\xe1\xb4\x10\x04\x8e\xea\x2b\x69\x45\xcb\x55\xb8\x01\xec\x42\x3a\x72\x0d\xed\xe3\x94\x71\x88\xbe\xfd\x86\xdb\x5c\xe8\x0d\x93\x65\xb7\xc7\x51\x34\x42\x43\x84\x0a\xdf\xa2\x5d\x0d\xd0\x92\x7b\x9e\x1f\xb5\x4b\x80\x7c\xb1\x50\x74\x17\x27\x15\x42\x79\x68\x8e\x6d\xba\xb2\xb1\xe8\xb1\x40\x15\xb9\x7c\x61\x60\x48\xa2\xf5\x6e\x98\xe5\x07\x66\xb3\x17\x78\x22\xf0\x1c\x16\xcc\xc3\x71\x17\x29\x92\xd4\x9e\xd7\xae\x07\xed\xef\x32\xbf\xa8\x43\x52\x0b\x26\x16\xd0\x31\x37\xaa\x70\x5e\x82\xcb\x2a\x1f\x3c\x15\x98\x3c\x81\x1b\xb9\x03\xc6\x85\x49\x24\x6a\xc6\xab\x4a\x6a\xe9\x7f\x23\xfe\xe3\xf3\xe3\xfd\x8a\xbd\x68\x5b\x6e\x4b\xa9\x6b\x66\x1b\x15\x0c\x05\xe7\x96\x74\x9f\xa5\x43\x76\x6a\xf3\x15\xab\x43\x3d\x8c\x3a\x4f\xd0\x37\x3e\x79\x27\x54\xc5\x94\xd4\xab\x2e\xb2\x44\x3f\x99\xe5\xba\x16\x3d\x4b\x6f\x65\x48\x4b\xc3\xa6\xf6\x7f\xbf\x7d\xa5\x6c\x72\x84\xdc\xe7\x2b\x9c\x68\xb5\xf4\xde\xb8\x7f\xa9\xd5\x33\xdc\x4d\xaf\xf8\x03\xee\x2c\xd7\x1f\x19\x5a\xec\xf9\xf7\x04\x00\x00")
 
 func templatesConcourse_lbTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -148,7 +151,27 @@ func templatesConcourse_lbTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/concourse_lb.tf", size: 1266, mode: os.FileMode(420), modTime: time.Unix(1507852860, 0)}
+	info := bindataFileInfo{name: "templates/concourse_lb.tf", size: 1271, mode: os.FileMode(420), modTime: time.Unix(1792369657, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesExisting_networkTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6d\xcd\x41\x0e\x83\x20\x10\x05\xd0\x75\xe7\x14\x93\x89\x5b\x7b\x83\x9e\x85\x0c\x75\x42\x48\x11\x08\x8c\xda\x86\x78\x77\xcb\x42\x57\x2e\x7f\x7e\xfe\xfb\x2b\x17\xcf\x36\x08\x92\x7c\x7d\x55\x1f\x9d\x89\xa2\x5b\x2a\x1f\x13\x79\x16\xc2\x06\x0f\xfd\x65\xc1\x17\x52\xd5\xf2\xef\x09\x76\x80\x89\x95\x91\x5c\x4a\x2e\x88\x79\xa7\x39\x2f\x2a\xe7\x90\x90\xac\x0d\xe3\x95\x1a\x20\x76\xab\x13\x43\x5b\xb9\x3c\x6f\xaf\xf6\x0e\x1f\xa8\x78\xf0\x9a\x8f\x00\x00\x00")
+
+func templatesExisting_networkTfBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesExisting_networkTf,
+		"templates/existing_network.tf",
+	)
+}
+
+func templatesExisting_networkTf() (*asset, error) {
+	bytes, err := templatesExisting_networkTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/existing_network.tf", size: 143, mode: os.FileMode(420), modTime: time.Unix(1792360493, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -173,7 +196,47 @@ func templatesJumpboxTf() (*asset, error) {
 	return a, nil
 }
 
-var _templatesVarsTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xd0\x41\x0a\xc3\x20\x10\x05\xd0\x75\x3c\x85\x0c\x59\xb4\x9b\xde\xa0\x67\x29\x36\x99\xca\x14\x99\x91\x89\x08\xad\x78\xf7\x22\x16\xcc\xa6\xa4\x4b\xf9\x4f\x3e\xf3\xb3\x53\x72\xf7\x80\x16\xa2\xca\x13\x97\x74\xa3\x15\x6c\x31\x53\x7a\x45\xb4\x57\x0b\x5b\x52\x62\x0f\xa6\x1a\x33\xac\xa2\x27\xe1\x63\xf7\x16\xc6\x63\x85\x9c\xff\x6a\x5d\x14\x57\xe4\x44\x2e\x6c\xbf\x70\x54\xc9\xb4\xa2\x5a\xf0\x22\x3e\xf4\xf2\xdd\xbf\xc6\xe7\xf2\xa0\x80\x27\x98\x4b\x76\x7a\xd9\x85\x15\xce\x15\xcc\xf4\x1d\xa2\xd3\x46\xc6\x32\x2d\xee\xb7\x8f\xb4\xbf\x6b\xab\xff\x04\x00\x00\xff\xff\x39\xda\x2a\x22\x4d\x01\x00\x00")
+var _templatesNatTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\x50\xc1\x6a\xc3\x30\x0c\xbd\xe7\x2b\x4c\xd8\x35\xfe\x83\x1d\x3a\x28\x63\x2c\x24\xa3\x69\x29\x3b\x09\x25\xd5\x42\xa8\x6b\x05\xc5\xe9\x0e\x25\xff\x5e\x3b\x69\x59\x56\x4a\x37\x1d\x8c\x65\xbd\xf7\xf4\xfc\x84\x3a\xee\xa5\x22\x15\xd7\xcc\xb5\x21\xa8\xf8\xd0\xf6\x8e\x40\xd8\x9f\x12\xab\xd8\xa2\x4b\xae\xcd\x29\x52\xca\xe2\x81\x94\xaf\x67\x15\x3f\x9d\x8e\x28\x9a\xec\x11\x9a\xdd\x90\xcc\x80\x1e\x26\x54\x37\x6c\x67\xb0\xe9\x61\x08\x33\x4b\xee\x9b\x65\x3f\xcd\x76\xe8\x50\xdf\x2c\xbf\x00\x74\x59\x9a\xe4\x7a\xef\xc8\x7c\x81\x69\xec\xde\x4b\x0c\x51\x24\x8f\x8d\x83\x77\x33\x99\xff\xed\xfa\x41\xdd\xfb\xd0\xf8\x93\x51\xf0\x6f\xea\x5d\x1f\xfa\x27\x15\x1d\x3c\x0c\xf3\x6c\xfe\x63\x66\x1e\x1b\x3a\x68\x5a\x40\x63\xb8\x42\xbf\x81\x5b\x77\x2b\xe3\x69\x8b\xcd\x3a\x87\x3c\x4b\x3f\x03\x65\xca\x08\xba\xbe\xbc\xc4\x18\x04\x04\x6d\x4d\x1d\x38\x0e\x21\x8d\x94\x34\x85\x62\xf3\x92\x2d\xd7\xdb\x7c\xf5\x5e\x40\xe8\xdf\x3e\x60\xb5\xc8\x5e\x97\x45\x48\xfb\x0c\xf6\xaf\xad\xda\x26\x02\x00\x00")
+
+func templatesNatTfBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesNatTf,
+		"templates/nat.tf",
+	)
+}
+
+func templatesNatTf() (*asset, error) {
+	bytes, err := templatesNatTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/nat.tf", size: 550, mode: os.FileMode(420), modTime: time.Unix(1792369657, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesNetworkTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x95\x8f\x4d\x8e\xc2\x30\x0c\x85\xd7\xe4\x14\x56\x60\x4b\x6e\x30\xb7\x60\x5f\xb9\xad\x9b\x44\xb4\x31\x4a\x1c\x0a\x42\xbd\xfb\xb8\x4c\x07\xb1\x60\xc3\xca\x7f\xdf\x7b\xf2\xcb\x54\xb8\xe6\x8e\xc0\x7a\x66\x3f\x52\xd3\xf1\x74\xa9\x42\x4d\x22\x99\x39\x9f\x2d\xd8\xb6\x1d\x8f\xaf\xe9\x61\x00\x12\x4e\xb4\xdb\xc1\x0f\xd8\xc3\xe3\x8a\xd9\x51\xba\x36\xb1\x5f\x5e\x90\x22\x58\x85\x9b\x2e\x13\xaa\x53\xa9\xed\x76\x29\xaa\x19\x70\x2c\x64\x16\x63\xf6\x70\x0a\x04\x2c\x81\x32\x08\x4d\x97\x51\xd9\x02\x99\x86\x75\x66\xd0\x3d\x6c\x32\xed\x33\x57\x1f\xb4\xc6\x02\x3d\x0a\xc2\xf6\x74\x59\x39\x14\xf5\x52\xfc\x0e\x4f\xb8\xa6\x2e\x60\xf2\xd4\xc3\x1c\x25\x00\xdd\x62\x91\x98\xfc\x7f\x20\x27\x83\x33\x4f\x8f\x2f\x13\xff\xe5\xfd\xac\x71\x6f\x0a\xb7\xc2\x8b\xd5\x88\xbf\xc8\xef\x82\x05\x5c\x01\x00\x00")
+
+func templatesNetworkTfBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesNetworkTf,
+		"templates/network.tf",
+	)
+}
+
+func templatesNetworkTf() (*asset, error) {
+	bytes, err := templatesNetworkTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/network.tf", size: 348, mode: os.FileMode(420), modTime: time.Unix(1792369660, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesVarsTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\x91\x41\x0a\xc2\x30\x10\x45\xd7\xcd\x29\xc2\xe0\x42\x37\x6a\x37\xee\x3c\x89\x48\x89\xcd\xb4\x8c\x86\xa4\x4c\x62\x45\xa5\x77\x37\x6d\xd5\xb6\x20\x54\xb2\x08\xc9\x7f\xf3\x7f\x32\x53\x2b\x26\x75\x32\x28\xa1\x62\x77\xc6\x3c\x64\xa4\x41\x3e\x45\x12\xee\x15\xca\xbd\x04\x1f\x98\x6c\x09\xa2\x11\xa2\xfe\xb2\x8c\x25\x39\x3b\xcf\x3d\x9c\xc5\x79\x0a\x6d\xfd\x57\x6a\xce\xa8\xd1\x06\x52\xc6\xcf\xc3\xca\x18\x77\x43\x9d\x45\x85\xd1\xfb\x2c\x27\xcd\xd3\x32\x43\x3e\x80\x48\x34\x16\xea\x6a\x42\xbc\x39\xc0\x76\xdd\xad\xcd\x16\x8e\x53\x37\x8b\xe1\xe6\xf8\xd2\xb9\xfc\xcc\x1e\xd9\x40\xfa\xb1\x49\x77\xdd\xa3\x62\x67\x6b\xd2\xc8\x12\x4a\xe7\x4a\xd3\x77\x64\xf4\x99\xb6\x66\xf1\x2c\xc8\xe0\x32\xee\x31\x74\x3d\x12\x1b\x58\x35\xd1\xfe\x3d\x9d\x1e\x6d\x91\x61\x5c\xad\xdc\x0f\x64\x50\xfb\x73\xd3\xc6\xbf\x00\xaa\x62\x4b\x5e\xe2\x01\x00\x00")
 
 func templatesVarsTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -188,7 +251,7 @@ func templatesVarsTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/vars.tf", size: 333, mode: os.FileMode(420), modTime: time.Unix(1507852860, 0)}
+	info := bindataFileInfo{name: "templates/vars.tf", size: 482, mode: os.FileMode(420), modTime: time.Unix(1792369821, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -249,7 +312,10 @@ var _bindata = map[string]func() (*asset, error){
 	"templates/cf_dns.tf": templatesCf_dnsTf,
 	"templates/cf_lb.tf": templatesCf_lbTf,
 	"templates/concourse_lb.tf": templatesConcourse_lbTf,
+	"templates/existing_network.tf": templatesExisting_networkTf,
 	"templates/jumpbox.tf": templatesJumpboxTf,
+	"templates/nat.tf": templatesNatTf,
+	"templates/network.tf": templatesNetworkTf,
 	"templates/vars.tf": templatesVarsTf,
 }
 
@@ -298,7 +364,10 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"cf_dns.tf": &bintree{templatesCf_dnsTf, map[string]*bintree{}},
 		"cf_lb.tf": &bintree{templatesCf_lbTf, map[string]*bintree{}},
 		"concourse_lb.tf": &bintree{templatesConcourse_lbTf, map[string]*bintree{}},
+		"existing_network.tf": &bintree{templatesExisting_networkTf, map[string]*bintree{}},
 		"jumpbox.tf": &bintree{templatesJumpboxTf, map[string]*bintree{}},
+		"nat.tf": &bintree{templatesNatTf, map[string]*bintree{}},
+		"network.tf": &bintree{templatesNetworkTf, map[string]*bintree{}},
 		"vars.tf": &bintree{templatesVarsTf, map[string]*bintree{}},
 	}},
 }}
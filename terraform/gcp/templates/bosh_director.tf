@@ -1,5 +1,5 @@
 output "network_name" {
-    value = "${google_compute_network.bbl-network.name}"
+    value = "${data.google_compute_network.bbl-network.name}"
 }
 
 output "subnetwork_name" {
@@ -22,22 +22,17 @@ output "internal_tag_name" {
     value = "${google_compute_firewall.internal.name}"
 }
 
-resource "google_compute_network" "bbl-network" {
-  name		 = "${var.env_id}-network"
-  auto_create_subnetworks = false
-}
-
 resource "google_compute_subnetwork" "bbl-subnet" {
   name			= "${var.env_id}-subnet"
-  ip_cidr_range = "10.0.0.0/16"
-  network		= "${google_compute_network.bbl-network.self_link}"
+  ip_cidr_range = "${var.network_cidr}"
+  network		= "${data.google_compute_network.bbl-network.self_link}"
 }
 
 resource "google_compute_firewall" "external" {
   name    = "${var.env_id}-external"
-  network = "${google_compute_network.bbl-network.name}"
+  network = "${data.google_compute_network.bbl-network.name}"
 
-  source_ranges = ["0.0.0.0/0"]
+  source_ranges = ["${var.allowed_ingress_cidrs}"]
 
   allow {
     ports = ["22", "6868", "25555"]
@@ -49,7 +44,7 @@ resource "google_compute_firewall" "external" {
 
 resource "google_compute_firewall" "bosh-open" {
   name    = "${var.env_id}-bosh-open"
-  network = "${google_compute_network.bbl-network.name}"
+  network = "${data.google_compute_network.bbl-network.name}"
 
   source_tags = ["${var.env_id}-bosh-open"]
 
@@ -63,7 +58,7 @@ resource "google_compute_firewall" "bosh-open" {
 
 resource "google_compute_firewall" "bosh-director" {
   name    = "${var.env_id}-bosh-director"
-  network = "${google_compute_network.bbl-network.name}"
+  network = "${data.google_compute_network.bbl-network.name}"
 
   source_tags = ["${var.env_id}-bosh-director"]
 
@@ -76,7 +71,7 @@ resource "google_compute_firewall" "bosh-director" {
 
 resource "google_compute_firewall" "internal-to-director" {
   name    = "${var.env_id}-internal-to-director"
-  network = "${google_compute_network.bbl-network.name}"
+  network = "${data.google_compute_network.bbl-network.name}"
 
   source_tags = ["${var.env_id}-internal"]
 
@@ -90,7 +85,7 @@ resource "google_compute_firewall" "internal-to-director" {
 
 resource "google_compute_firewall" "jumpbox-to-all" {
   name    = "${var.env_id}-jumpbox-to-all"
-  network = "${google_compute_network.bbl-network.name}"
+  network = "${data.google_compute_network.bbl-network.name}"
 
   source_tags = ["${var.env_id}-jumpbox"]
 
@@ -104,7 +99,7 @@ resource "google_compute_firewall" "jumpbox-to-all" {
 
 resource "google_compute_firewall" "internal" {
   name    = "${var.env_id}-internal"
-  network = "${google_compute_network.bbl-network.name}"
+  network = "${data.google_compute_network.bbl-network.name}"
 
   source_tags = ["${var.env_id}-internal"]
 
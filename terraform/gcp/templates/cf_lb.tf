@@ -32,8 +32,8 @@ output "credhub_lb_ip" {
 
 resource "google_compute_firewall" "firewall-cf" {
   name       = "${var.env_id}-cf-open"
-  depends_on = ["google_compute_network.bbl-network"]
-  network    = "${google_compute_network.bbl-network.name}"
+  depends_on = ["data.google_compute_network.bbl-network"]
+  network    = "${data.google_compute_network.bbl-network.name}"
 
   allow {
     protocol = "tcp"
@@ -109,8 +109,8 @@ resource "google_compute_http_health_check" "cf-public-health-check" {
 
 resource "google_compute_firewall" "cf-health-check" {
   name       = "${var.env_id}-cf-health-check"
-  depends_on = ["google_compute_network.bbl-network"]
-  network    = "${google_compute_network.bbl-network.name}"
+  depends_on = ["data.google_compute_network.bbl-network"]
+  network    = "${data.google_compute_network.bbl-network.name}"
 
   allow {
     protocol = "tcp"
@@ -131,8 +131,8 @@ resource "google_compute_address" "cf-ssh-proxy" {
 
 resource "google_compute_firewall" "cf-ssh-proxy" {
   name       = "${var.env_id}-cf-ssh-proxy-open"
-  depends_on = ["google_compute_network.bbl-network"]
-  network    = "${google_compute_network.bbl-network.name}"
+  depends_on = ["data.google_compute_network.bbl-network"]
+  network    = "${data.google_compute_network.bbl-network.name}"
 
   allow {
     protocol = "tcp"
@@ -162,8 +162,8 @@ output "tcp_router_target_pool" {
 
 resource "google_compute_firewall" "cf-tcp-router" {
   name       = "${var.env_id}-cf-tcp-router"
-  depends_on = ["google_compute_network.bbl-network"]
-  network    = "${google_compute_network.bbl-network.name}"
+  depends_on = ["data.google_compute_network.bbl-network"]
+  network    = "${data.google_compute_network.bbl-network.name}"
 
   allow {
     protocol = "tcp"
@@ -243,7 +243,7 @@ output "credhub_target_tags" {
 
 resource "google_compute_firewall" "credhub" {
   name    = "${var.env_id}-credhub-open"
-  network = "${google_compute_network.bbl-network.name}"
+  network = "${data.google_compute_network.bbl-network.name}"
   allow {
     protocol = "tcp"
     ports    = ["8844"]
@@ -8,7 +8,7 @@ output "concourse_lb_ip" {
 
 resource "google_compute_firewall" "firewall-concourse" {
   name    = "${var.env_id}-concourse-open"
-  network = "${google_compute_network.bbl-network.name}"
+  network = "${data.google_compute_network.bbl-network.name}"
 
   allow {
     protocol = "tcp"
@@ -0,0 +1,7 @@
+variable "existing_network_name" {
+	type = "string"
+}
+
+data "google_compute_network" "bbl-network" {
+  name = "${var.existing_network_name}"
+}
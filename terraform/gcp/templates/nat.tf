@@ -0,0 +1,13 @@
+resource "google_compute_router" "nat-router" {
+  name    = "${var.env_id}-nat-router"
+  region  = "${var.region}"
+  network = "${data.google_compute_network.bbl-network.self_link}"
+}
+
+resource "google_compute_router_nat" "nat" {
+  name                               = "${var.env_id}-nat"
+  router                             = "${google_compute_router.nat-router.name}"
+  region                             = "${var.region}"
+  nat_ip_allocate_option             = "AUTO_ONLY"
+  source_subnetwork_ip_ranges_to_nat = "ALL_SUBNETWORKS_ALL_IP_RANGES"
+}
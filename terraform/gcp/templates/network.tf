@@ -0,0 +1,10 @@
+resource "google_compute_network" "bbl-network" {
+  name		 = "${var.env_id}-network"
+  auto_create_subnetworks = false
+}
+
+# The other templates refer to the network through this data source so that
+# they work unchanged with existing_network.tf.
+data "google_compute_network" "bbl-network" {
+  name = "${google_compute_network.bbl-network.name}"
+}
@@ -23,7 +23,7 @@ type executor interface {
 	Destroy(inputs map[string]string) (string, error)
 	Init(terraformTemplate, tfState string) error
 	Apply(inputs map[string]string) (string, error)
-	Outputs(string) (map[string]interface{}, error)
+	Outputs(string) (Outputs, error)
 	Output(string, string) (string, error)
 }
 
@@ -0,0 +1,219 @@
+variable "env_id" {
+  type = "string"
+}
+
+variable "auth_url" {
+  type = "string"
+}
+
+variable "availability_zone" {
+  type = "string"
+}
+
+variable "external_network_name" {
+  type = "string"
+}
+
+variable "project_name" {
+  type = "string"
+}
+
+variable "domain_name" {
+  type = "string"
+}
+
+variable "region" {
+  type = "string"
+}
+
+variable "user_name" {
+  type = "string"
+}
+
+variable "password" {
+  type = "string"
+}
+
+variable "allowed_ingress_cidrs" {
+  type    = "list"
+  default = ["0.0.0.0/0"]
+}
+
+provider "openstack" {
+  auth_url    = "${var.auth_url}"
+  tenant_name = "${var.project_name}"
+  domain_name = "${var.domain_name}"
+  region      = "${var.region}"
+  user_name   = "${var.user_name}"
+  password    = "${var.password}"
+}
+
+data "openstack_networking_network_v2" "external" {
+  name = "${var.external_network_name}"
+}
+
+resource "openstack_networking_network_v2" "bosh" {
+  name           = "${var.env_id}-network"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "bosh" {
+  name            = "${var.env_id}-bosh-subnet"
+  network_id      = "${openstack_networking_network_v2.bosh.id}"
+  cidr            = "10.0.0.0/24"
+  gateway_ip      = "10.0.0.1"
+  ip_version      = 4
+  dns_nameservers = ["8.8.8.8"]
+
+  allocation_pools {
+    start = "10.0.0.2"
+    end   = "10.0.0.4"
+  }
+}
+
+resource "openstack_networking_subnet_v2" "internal" {
+  count           = 3
+  name            = "${var.env_id}-internal-subnet-${count.index + 1}"
+  network_id      = "${openstack_networking_network_v2.bosh.id}"
+  cidr            = "${cidrsubnet("10.0.0.0/16", 4, count.index + 1)}"
+  gateway_ip      = "${cidrhost(cidrsubnet("10.0.0.0/16", 4, count.index + 1), 1)}"
+  ip_version      = 4
+  dns_nameservers = ["8.8.8.8"]
+
+  allocation_pools {
+    start = "${cidrhost(cidrsubnet("10.0.0.0/16", 4, count.index + 1), 2)}"
+    end   = "${cidrhost(cidrsubnet("10.0.0.0/16", 4, count.index + 1), 3)}"
+  }
+}
+
+resource "openstack_networking_router_v2" "bosh" {
+  name             = "${var.env_id}-router"
+  admin_state_up   = "true"
+  external_gateway = "${data.openstack_networking_network_v2.external.id}"
+}
+
+resource "openstack_networking_router_interface_v2" "bosh" {
+  router_id = "${openstack_networking_router_v2.bosh.id}"
+  subnet_id = "${openstack_networking_subnet_v2.bosh.id}"
+}
+
+resource "openstack_networking_router_interface_v2" "internal" {
+  count     = 3
+  router_id = "${openstack_networking_router_v2.bosh.id}"
+  subnet_id = "${element(openstack_networking_subnet_v2.internal.*.id, count.index)}"
+}
+
+resource "openstack_networking_secgroup_v2" "jumpbox" {
+  name        = "${var.env_id}-jumpbox"
+  description = "Jumpbox for ${var.env_id}"
+}
+
+resource "openstack_networking_secgroup_rule_v2" "jumpbox_ssh" {
+  count             = "${length(var.allowed_ingress_cidrs)}"
+  direction         = "ingress"
+  ethertype         = "IPv4"
+  protocol          = "tcp"
+  port_range_min    = 22
+  port_range_max    = 22
+  remote_ip_prefix  = "${element(var.allowed_ingress_cidrs, count.index)}"
+  security_group_id = "${openstack_networking_secgroup_v2.jumpbox.id}"
+}
+
+resource "openstack_networking_secgroup_rule_v2" "jumpbox_agent" {
+  count             = "${length(var.allowed_ingress_cidrs)}"
+  direction         = "ingress"
+  ethertype         = "IPv4"
+  protocol          = "tcp"
+  port_range_min    = 6868
+  port_range_max    = 6868
+  remote_ip_prefix  = "${element(var.allowed_ingress_cidrs, count.index)}"
+  security_group_id = "${openstack_networking_secgroup_v2.jumpbox.id}"
+}
+
+resource "openstack_networking_secgroup_v2" "bosh" {
+  name        = "${var.env_id}-bosh"
+  description = "BOSH director and deployed VMs for ${var.env_id}"
+}
+
+resource "openstack_networking_secgroup_rule_v2" "bosh_internal_tcp" {
+  direction         = "ingress"
+  ethertype         = "IPv4"
+  protocol          = "tcp"
+  remote_group_id   = "${openstack_networking_secgroup_v2.bosh.id}"
+  security_group_id = "${openstack_networking_secgroup_v2.bosh.id}"
+}
+
+resource "openstack_networking_secgroup_rule_v2" "bosh_internal_udp" {
+  direction         = "ingress"
+  ethertype         = "IPv4"
+  protocol          = "udp"
+  remote_group_id   = "${openstack_networking_secgroup_v2.bosh.id}"
+  security_group_id = "${openstack_networking_secgroup_v2.bosh.id}"
+}
+
+resource "openstack_networking_secgroup_rule_v2" "bosh_internal_icmp" {
+  direction         = "ingress"
+  ethertype         = "IPv4"
+  protocol          = "icmp"
+  remote_group_id   = "${openstack_networking_secgroup_v2.bosh.id}"
+  security_group_id = "${openstack_networking_secgroup_v2.bosh.id}"
+}
+
+resource "openstack_networking_secgroup_rule_v2" "bosh_from_jumpbox_tcp" {
+  direction         = "ingress"
+  ethertype         = "IPv4"
+  protocol          = "tcp"
+  remote_group_id   = "${openstack_networking_secgroup_v2.jumpbox.id}"
+  security_group_id = "${openstack_networking_secgroup_v2.bosh.id}"
+}
+
+resource "openstack_networking_floatingip_v2" "jumpbox" {
+  pool = "${var.external_network_name}"
+}
+
+resource "tls_private_key" "bosh_vms" {
+  algorithm = "RSA"
+  rsa_bits  = 4096
+}
+
+resource "openstack_compute_keypair_v2" "bosh_vms" {
+  name       = "${var.env_id}-bosh-vms"
+  public_key = "${tls_private_key.bosh_vms.public_key_openssh}"
+}
+
+output "network_id" {
+  value = "${openstack_networking_network_v2.bosh.id}"
+}
+
+output "network_name" {
+  value = "${openstack_networking_network_v2.bosh.name}"
+}
+
+output "jumpbox_security_group" {
+  value = "${openstack_networking_secgroup_v2.jumpbox.name}"
+}
+
+output "bosh_security_group" {
+  value = "${openstack_networking_secgroup_v2.bosh.name}"
+}
+
+output "bosh_vms_key_name" {
+  value = "${openstack_compute_keypair_v2.bosh_vms.name}"
+}
+
+output "bosh_vms_private_key" {
+  value     = "${tls_private_key.bosh_vms.private_key_pem}"
+  sensitive = true
+}
+
+output "external_ip" {
+  value = "${openstack_networking_floatingip_v2.jumpbox.address}"
+}
+
+output "jumpbox_url" {
+  value = "${openstack_networking_floatingip_v2.jumpbox.address}:22"
+}
+
+output "director_address" {
+  value = "https://${openstack_networking_floatingip_v2.jumpbox.address}:25555"
+}
@@ -0,0 +1,38 @@
+package openstack
+
+import (
+	"encoding/json"
+
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+)
+
+type InputGenerator struct {
+}
+
+func NewInputGenerator() InputGenerator {
+	return InputGenerator{}
+}
+
+func (i InputGenerator) Generate(state storage.State) (map[string]string, error) {
+	input := map[string]string{
+		"env_id":                state.EnvID,
+		"auth_url":              state.OpenStack.AuthURL,
+		"availability_zone":     state.OpenStack.AZ,
+		"external_network_name": state.OpenStack.ExternalNetworkName,
+		"project_name":          state.OpenStack.Project,
+		"domain_name":           state.OpenStack.Domain,
+		"region":                state.OpenStack.Region,
+		"user_name":             state.OpenStack.Username,
+		"password":              state.OpenStack.Password,
+	}
+
+	if len(state.AllowedIngressCIDRs) > 0 {
+		cidrs, err := json.Marshal(state.AllowedIngressCIDRs)
+		if err != nil {
+			return map[string]string{}, err
+		}
+		input["allowed_ingress_cidrs"] = string(cidrs)
+	}
+
+	return input, nil
+}
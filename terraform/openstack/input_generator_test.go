@@ -0,0 +1,60 @@
+package openstack_test
+
+import (
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/cloudfoundry/bosh-bootloader/terraform/openstack"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("InputGenerator", func() {
+	var (
+		inputGenerator openstack.InputGenerator
+	)
+
+	BeforeEach(func() {
+		inputGenerator = openstack.NewInputGenerator()
+	})
+
+	It("receives BBL state and returns a map of terraform variables", func() {
+		inputs, err := inputGenerator.Generate(storage.State{
+			IAAS:  "openstack",
+			EnvID: "env-id",
+			OpenStack: storage.OpenStack{
+				AuthURL:             "auth-url",
+				AZ:                  "az",
+				ExternalNetworkName: "external-network-name",
+				Project:             "project",
+				Domain:              "domain",
+				Region:              "region",
+				Username:            "username",
+				Password:            "password",
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(inputs).To(Equal(map[string]string{
+			"env_id":                "env-id",
+			"auth_url":              "auth-url",
+			"availability_zone":     "az",
+			"external_network_name": "external-network-name",
+			"project_name":          "project",
+			"domain_name":           "domain",
+			"region":                "region",
+			"user_name":             "username",
+			"password":              "password",
+		}))
+	})
+
+	Context("when allowed ingress cidrs are provided", func() {
+		It("includes the cidrs as a list", func() {
+			inputs, err := inputGenerator.Generate(storage.State{
+				IAAS:                "openstack",
+				AllowedIngressCIDRs: []string{"10.0.0.0/8", "192.168.0.0/16"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(inputs).To(HaveKeyWithValue("allowed_ingress_cidrs", `["10.0.0.0/8","192.168.0.0/16"]`))
+		})
+	})
+})
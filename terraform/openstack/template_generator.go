@@ -0,0 +1,37 @@
+package openstack
+
+import (
+	"strings"
+
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+)
+
+type templates struct {
+	vars          string
+	network       string
+	securityGroup string
+	jumpbox       string
+	output        string
+}
+
+type TemplateGenerator struct{}
+
+func NewTemplateGenerator() TemplateGenerator {
+	return TemplateGenerator{}
+}
+
+func (t TemplateGenerator) Generate(state storage.State) string {
+	tmpls := readTemplates()
+	return strings.Join([]string{tmpls.vars, tmpls.network, tmpls.securityGroup, tmpls.jumpbox, tmpls.output}, "\n")
+}
+
+func readTemplates() templates {
+	tmpls := templates{}
+	tmpls.vars = string(MustAsset("templates/vars.tf"))
+	tmpls.network = string(MustAsset("templates/network.tf"))
+	tmpls.securityGroup = string(MustAsset("templates/security_group.tf"))
+	tmpls.jumpbox = string(MustAsset("templates/jumpbox.tf"))
+	tmpls.output = string(MustAsset("templates/output.tf"))
+
+	return tmpls
+}
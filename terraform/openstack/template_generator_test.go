@@ -0,0 +1,32 @@
+package openstack_test
+
+import (
+	"io/ioutil"
+
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/cloudfoundry/bosh-bootloader/terraform/openstack"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TemplateGenerator", func() {
+	var (
+		templateGenerator openstack.TemplateGenerator
+	)
+
+	BeforeEach(func() {
+		templateGenerator = openstack.NewTemplateGenerator()
+	})
+
+	Describe("Generate", func() {
+		It("generates a terraform template for openstack", func() {
+			expectedTemplate, err := ioutil.ReadFile("fixtures/openstack_template.tf")
+			Expect(err).NotTo(HaveOccurred())
+
+			template := templateGenerator.Generate(storage.State{
+				EnvID: "openstack-environment",
+			})
+			Expect(template).To(Equal(string(expectedTemplate)))
+		})
+	})
+})
@@ -0,0 +1,329 @@
+// Code generated by go-bindata.
+// sources:
+// templates/jumpbox.tf
+// templates/network.tf
+// templates/output.tf
+// templates/security_group.tf
+// templates/vars.tf
+// DO NOT EDIT!
+
+package openstack
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+func bindataRead(data []byte, name string) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, gz)
+	clErr := gz.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+	if clErr != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+type asset struct {
+	bytes []byte
+	info  os.FileInfo
+}
+
+type bindataFileInfo struct {
+	name    string
+	size    int64
+	mode    os.FileMode
+	modTime time.Time
+}
+
+func (fi bindataFileInfo) Name() string {
+	return fi.name
+}
+func (fi bindataFileInfo) Size() int64 {
+	return fi.size
+}
+func (fi bindataFileInfo) Mode() os.FileMode {
+	return fi.mode
+}
+func (fi bindataFileInfo) ModTime() time.Time {
+	return fi.modTime
+}
+func (fi bindataFileInfo) IsDir() bool {
+	return false
+}
+func (fi bindataFileInfo) Sys() interface{} {
+	return nil
+}
+
+var _templatesJumpboxTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5d\x8f\x41\x4e\xc4\x30\x0c\x45\xf7\x3d\x85\x15\xb1\x9d\x0a\x21\x84\xc4\x82\x05\x57\x80\x03\x58\x6e\x31\xd3\xd0\x24\x8e\x9c\x34\x0c\x1a\xf5\xee\x34\x13\x0d\xd5\xe0\x95\x65\x7d\x3f\x3f\x2b\x27\x59\x74\x64\x30\x12\x39\xa4\x4c\xe3\x8c\x81\xf3\xb7\xe8\x6c\xc3\x11\x3f\x9d\x50\xde\x1a\x1b\xb1\x3c\x18\x30\x5f\x8b\x8f\x83\x9c\x0c\x9c\x3b\x80\x28\xe2\xe0\x05\xcc\xdd\xb9\x90\xf6\x7c\xca\xac\x81\xdc\x75\x1d\x03\x79\x5e\x4d\xb7\x76\x9d\xfe\x1d\xc9\x2e\x61\x54\x5b\x28\x33\xce\xfc\xb3\x11\x07\x49\x13\x16\x9f\x1a\x92\xdc\x51\xd4\xe6\xc9\x57\xee\xdb\xfb\xab\xd9\x66\x9a\x08\x07\x9b\x13\x6c\xb3\xc7\xfb\xe7\xa7\x5b\xe2\xae\x3d\x8a\x8f\x4b\xe3\x46\xb2\xda\x84\x6f\xf1\x55\x09\x5a\xed\xde\xa1\xa0\xfd\x58\x0f\x35\x79\xa8\xc9\xfa\xd9\x32\x38\x3b\x56\x52\xcb\xfd\xd3\xee\xaf\xd4\x7e\x0f\xe2\x45\x24\x4d\x97\x8f\x7f\x01\xe9\xf2\x17\xb0\x56\x01\x00\x00")
+
+func templatesJumpboxTfBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesJumpboxTf,
+		"templates/jumpbox.tf",
+	)
+}
+
+func templatesJumpboxTf() (*asset, error) {
+	bytes, err := templatesJumpboxTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/jumpbox.tf", size: 342, mode: os.FileMode(420), modTime: time.Unix(1792359475, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesNetworkTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x54\xcb\x6e\xc2\x30\x10\xbc\xe7\x2b\x2c\x8b\x03\xb4\x90\x96\x87\xaa\x5e\xfa\x25\x55\x15\x99\x78\x0b\x16\xc1\x8e\x6c\x07\xa8\x50\xfe\xbd\x7e\xc4\x10\x42\x21\x01\x95\xe4\x92\x78\x77\x67\x76\xbc\x63\x53\xa2\x09\xc2\x22\x07\xae\x34\x49\x57\x09\x07\xbd\x15\x72\xc5\xf8\x22\x7c\x26\x9b\x09\x46\x18\x76\x1a\x24\x27\x19\x46\xfb\x08\x21\x4e\xd6\x80\x3e\x10\xee\xed\x37\x44\xc6\x21\x76\xa8\xb0\xe1\x12\x47\x65\x14\x49\x50\xa2\x90\x29\x74\xa1\x98\x0b\xb5\xac\xc1\x1f\x9f\x23\x11\xdf\x24\x8c\x96\xa3\xaa\x0c\x9b\x54\x42\xd7\x8c\x27\x06\x58\x43\x52\xe4\x36\x55\xcb\x02\xba\x70\xab\x62\x6e\xfe\x5a\xa8\xcf\xb9\x6d\xea\xc8\xd7\x5a\xfe\xa0\x80\xd1\x5a\x7e\x8b\xd6\xd8\x62\xc4\x06\xcc\x02\xa4\x8c\xca\x06\xe1\xf8\x35\x76\xef\xcb\x64\x66\x33\x16\x46\xdb\x96\xfc\x24\x2c\x6f\x66\x8c\x6d\x98\xe5\xc9\x06\xa4\x62\x82\x87\xf0\xcc\xac\x52\xae\xdc\x18\x14\x48\x1b\x35\xab\x9f\xf8\x3d\x76\x2f\xfe\x8a\xec\xc6\x65\x99\x48\x89\x36\x65\x49\x2e\x44\xa6\x9c\x7a\x84\x4c\xdb\x52\xd7\x28\x26\xd8\x2d\x03\xa7\x27\xcc\xae\xb1\xf2\xc6\x5d\x66\xbc\xee\xa1\x54\x14\x5c\x9f\x08\x9f\x76\xd9\xff\x00\x52\xcd\x60\xd4\xdb\x3b\xa0\x98\x71\x0a\x3b\xf4\x8c\xc6\xe5\xa3\xc6\x62\x98\xcc\xa2\xa7\xed\x1f\x87\x34\x7e\xc3\x43\x34\x1b\xa2\x46\x1b\x83\xf2\xc2\xec\x3c\xcc\x52\x28\xdd\xbf\x09\x6f\x18\x30\x1f\x34\xf0\xfb\xfb\x9a\xf8\xbe\x6a\x2e\xb9\x1f\x6b\xea\xb1\xba\x58\x4b\x8a\xc2\x58\xa1\xed\x00\x9f\x3b\xc8\xd7\xfd\x71\x79\xa0\xe3\xf5\x61\xb4\x84\x4b\xad\x1a\xa1\xc7\xa1\xe6\xba\x8c\xdb\x7c\x14\x4a\xbd\x97\x3a\x0b\x71\xce\xfe\x26\x29\x34\x25\x85\x38\xbd\x62\xe3\xc3\x66\x9c\xb8\xb8\x3a\x7d\x57\x2b\x0f\x27\xb4\x56\x79\x6f\xcf\x97\x4e\xb8\x3f\xdb\xff\xa6\x03\x32\x58\x03\xd7\xfd\x16\x3d\xa1\x9b\xf8\xc9\xe0\x9c\x98\x6d\xe0\x34\xfe\x02\x09\x2d\x14\x71\xfc\x06\x00\x00")
+
+func templatesNetworkTfBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesNetworkTf,
+		"templates/network.tf",
+	)
+}
+
+func templatesNetworkTf() (*asset, error) {
+	bytes, err := templatesNetworkTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/network.tf", size: 1788, mode: os.FileMode(420), modTime: time.Unix(1792359475, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesOutputTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xad\x91\xdd\x6a\xc3\x30\x0c\x85\xef\xf3\x14\x26\xec\xba\x85\xc0\x6e\x0a\x7b\x16\xe3\xc6\x5a\xab\xc5\x7f\xc8\xb2\xd7\x52\xfa\xee\xb3\xb3\x94\x25\xa5\xdb\x4a\x37\x5d\x09\x71\xce\x77\x84\xe4\x13\x87\xc4\xa2\x75\xc0\xef\x9e\x06\x89\xba\x15\xa7\x46\x88\xac\x4c\x02\xf1\x22\xda\xa7\x93\x0f\xe0\x22\xab\x7e\x90\x93\x08\xdd\xee\xd2\xca\xdc\xad\xb6\x3e\xee\x57\xa8\xcf\x6d\x73\x6e\x1a\x7f\xc5\x73\xca\xc2\x63\xc4\xea\x5c\x32\xdf\x92\x0d\x5b\x7f\x90\x11\xfa\x44\xc8\x47\xb9\x23\x9f\xc2\x7d\xf4\xe2\x19\xd5\x15\x3f\x71\x6e\x24\xd4\xe0\x3f\xe3\xbf\xd9\x7e\x64\x67\x1b\xe5\x00\xc7\xdf\xce\xd2\x7b\x5b\x3c\x50\xa5\x41\x21\x5d\xa8\xd5\xfe\x13\x39\x10\x66\xf5\x69\x9b\xc3\x6b\x8d\x01\x6c\x16\x9a\x2f\xe6\x6c\x28\x03\xd8\x82\x17\x22\x96\x65\x90\x31\xd7\xe5\x98\x12\xcc\x13\xe1\xc0\x40\x4e\x19\x89\x77\xde\xe7\xd5\x78\xc5\xa5\xc1\xc5\x03\x94\xd6\x04\x31\xde\xfe\x72\x22\xf3\x1f\xec\x4d\xd7\x2d\xf0\x1a\x09\x7a\xf6\x24\x27\xc1\x55\xc6\x9e\x39\xc4\xcd\x7a\xfd\x60\xd6\x73\xa9\x1a\xf7\x01\xe4\xd3\xdd\x8d\x57\x03\x00\x00")
+
+func templatesOutputTfBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesOutputTf,
+		"templates/output.tf",
+	)
+}
+
+func templatesOutputTf() (*asset, error) {
+	bytes, err := templatesOutputTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/output.tf", size: 855, mode: os.FileMode(420), modTime: time.Unix(1792359475, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesSecurity_groupTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdd\x94\x4f\x4b\x03\x31\x10\xc5\xef\xfd\x14\x61\xf1\xa0\xa0\x7b\x28\x52\x7a\xf1\xe2\x49\x05\x51\x10\xbc\x86\x34\x99\x6e\x63\x77\x33\x61\x92\xdd\x6e\x29\xfd\xee\x66\xff\x54\x77\x95\x6a\xd1\x16\xb4\xb9\xbe\xc9\xcc\xcb\xfb\xed\x2c\x81\xc3\x9c\x24\xb0\x08\x2d\x18\xe7\x85\x9c\x73\x03\x7e\x81\x34\xd7\x26\xe1\x0e\x64\x42\x98\x5b\x5e\x0c\x23\x16\xbd\xe4\x99\x9d\x60\x19\xb1\xd5\x80\x31\x23\x32\x60\xed\xb9\x62\xd1\xc9\xaa\x10\x14\x83\x29\xb8\x56\xeb\x8b\x4d\x65\xa8\x53\xe0\x24\x69\xeb\x35\x9a\xaa\xee\xae\x51\xd8\x14\x89\xf5\xee\x44\x83\xf5\x60\x40\x3b\xda\xa1\x3c\x85\x9e\x27\xee\xdc\xac\xf1\x25\x31\x37\x9e\x75\x4f\xed\x2e\x05\x93\xf8\xd9\x69\x35\x50\xa4\x29\x2e\x40\xf1\xd0\x31\xcc\x73\x5c\x6a\x45\xee\x6c\x5d\x9b\xd5\x04\xb2\xb6\xda\xb9\xdc\xd6\x55\x3a\xf8\x19\x90\x5f\x5a\xe8\xea\xb7\x8f\xc5\x65\x25\x5a\x42\x8f\x12\xd3\xde\x64\x2f\x6d\xad\x21\x79\x4e\xc2\x24\xc0\x33\x6d\x1a\x6d\x38\xfc\x20\x88\xb2\x23\x10\x64\xe8\x81\x6b\xcb\x2d\xc1\x54\x97\xed\x3b\x20\x85\x0c\x8c\xdf\xfe\x90\xf3\x26\x81\x58\x1b\x05\x65\xf3\xaa\x10\x5b\x4e\xda\x2f\x79\x13\x9e\x56\x4d\xab\xef\x88\xc7\x6d\xb6\xf1\xef\xe9\x88\x24\x78\xfe\x6f\x7c\x46\xe3\xd1\x78\x0b\xa1\x56\xfa\xc7\x8c\x6a\x3c\x13\xdc\x6c\xcd\x97\xdb\x5c\x97\x7d\x5e\xe5\xeb\x87\xa7\x9b\x96\x48\x58\x66\x61\x54\x28\xb0\x29\x2e\x41\xb1\xe7\x7b\xb7\xb7\x0d\xaf\xa6\x87\xf8\x3c\x90\x11\x29\xaf\x78\xd5\x8e\x0f\xf6\x29\xb4\x50\xdf\x38\xb0\x1d\x49\x54\x3e\x1b\x0c\x3f\xa7\xf9\xde\x63\x0f\x51\xe5\xea\x60\x51\x55\xad\x8f\x29\x2a\x2d\xb3\x83\x65\x55\xf7\x3e\x82\xb0\xa6\x84\x19\xdf\xfc\xd0\xff\xee\x1a\xf6\x7e\x88\xfb\x49\xec\x15\xeb\x10\xed\xdc\x25\x09\x00\x00")
+
+func templatesSecurity_groupTfBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSecurity_groupTf,
+		"templates/security_group.tf",
+	)
+}
+
+func templatesSecurity_groupTf() (*asset, error) {
+	bytes, err := templatesSecurity_groupTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/security_group.tf", size: 2341, mode: os.FileMode(420), modTime: time.Unix(1792361261, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesVarsTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\x91\x41\x72\x83\x30\x0c\x45\xf7\x9c\xc2\xe3\xe9\x3a\xcd\x05\x7a\x92\x4e\xc7\xa3\x60\x35\x51\xe3\xc8\x8c\x2c\x9c\xa6\x19\xee\x1e\x03\x85\x90\x9d\x61\xf7\xf5\xf4\x6d\x1e\x19\x84\xe0\x10\xd0\x58\xe4\xec\xc8\x5b\x73\x6f\x8c\xd1\x5b\x87\xe6\xc3\xd8\xa4\x42\x7c\xb4\xcd\xd0\x34\x79\x05\xa1\xd7\x93\xeb\x25\xd4\xa0\x19\x28\xc0\x81\x02\xe9\xcd\xfd\x45\xc6\x8a\x1d\xfc\x55\x14\x86\xe0\x18\xf5\x1a\xe5\xec\x18\x2e\x35\x7b\x9d\xc4\x1f\x6c\xb5\x16\xf7\xf1\x02\xc4\xb5\xb4\xe0\x91\x22\x57\x80\x7d\x42\xa9\xbe\x31\xa4\x54\xbe\xb0\xca\x79\x08\xf1\x8a\xde\x95\x89\x60\x4a\xae\x25\x2f\x69\xb3\x57\x9e\xb2\x1a\x28\xa9\x2d\x91\xc7\x6f\xe8\x83\x96\xe8\xd3\xee\x77\xd3\xfb\xbe\xb7\x5f\x63\x65\xb1\x94\xc9\xa3\x18\x1b\x3b\xe4\xa4\xd0\x9e\xe7\x9a\xe5\xaf\xfe\x57\xbd\xdd\xcb\xe1\xbb\x25\x1c\xc6\x56\x45\x06\x9e\xfd\x3e\x89\xad\xf5\x89\xda\x78\x7d\x52\x9b\x70\x82\x66\x9d\xc6\xbc\x1c\x36\x87\xd3\x7c\xb5\xb8\x9d\xaf\xe1\x84\x2c\xf2\x5e\x2a\x96\x70\x18\xf5\x3d\x00\x6a\x99\xfe\x08\xdb\x02\x00\x00")
+
+func templatesVarsTfBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesVarsTf,
+		"templates/vars.tf",
+	)
+}
+
+func templatesVarsTf() (*asset, error) {
+	bytes, err := templatesVarsTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/vars.tf", size: 731, mode: os.FileMode(420), modTime: time.Unix(1792361261, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+// Asset loads and returns the asset for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func Asset(name string) ([]byte, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
+		}
+		return a.bytes, nil
+	}
+	return nil, fmt.Errorf("Asset %s not found", name)
+}
+
+// MustAsset is like Asset but panics when Asset would return an error.
+// It simplifies safe initialization of global variables.
+func MustAsset(name string) []byte {
+	a, err := Asset(name)
+	if err != nil {
+		panic("asset: Asset(" + name + "): " + err.Error())
+	}
+
+	return a
+}
+
+// AssetInfo loads and returns the asset info for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func AssetInfo(name string) (os.FileInfo, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
+		}
+		return a.info, nil
+	}
+	return nil, fmt.Errorf("AssetInfo %s not found", name)
+}
+
+// AssetNames returns the names of the assets.
+func AssetNames() []string {
+	names := make([]string, 0, len(_bindata))
+	for name := range _bindata {
+		names = append(names, name)
+	}
+	return names
+}
+
+// _bindata is a table, holding each asset generator, mapped to its name.
+var _bindata = map[string]func() (*asset, error){
+	"templates/jumpbox.tf": templatesJumpboxTf,
+	"templates/network.tf": templatesNetworkTf,
+	"templates/output.tf": templatesOutputTf,
+	"templates/security_group.tf": templatesSecurity_groupTf,
+	"templates/vars.tf": templatesVarsTf,
+}
+
+// AssetDir returns the file names below a certain
+// directory embedded in the file by go-bindata.
+// For example if you run go-bindata on data/... and data contains the
+// following hierarchy:
+//     data/
+//       foo.txt
+//       img/
+//         a.png
+//         b.png
+// then AssetDir("data") would return []string{"foo.txt", "img"}
+// AssetDir("data/img") would return []string{"a.png", "b.png"}
+// AssetDir("foo.txt") and AssetDir("notexist") would return an error
+// AssetDir("") will return []string{"data"}.
+func AssetDir(name string) ([]string, error) {
+	node := _bintree
+	if len(name) != 0 {
+		cannonicalName := strings.Replace(name, "\\", "/", -1)
+		pathList := strings.Split(cannonicalName, "/")
+		for _, p := range pathList {
+			node = node.Children[p]
+			if node == nil {
+				return nil, fmt.Errorf("Asset %s not found", name)
+			}
+		}
+	}
+	if node.Func != nil {
+		return nil, fmt.Errorf("Asset %s not found", name)
+	}
+	rv := make([]string, 0, len(node.Children))
+	for childName := range node.Children {
+		rv = append(rv, childName)
+	}
+	return rv, nil
+}
+
+type bintree struct {
+	Func     func() (*asset, error)
+	Children map[string]*bintree
+}
+var _bintree = &bintree{nil, map[string]*bintree{
+	"templates": &bintree{nil, map[string]*bintree{
+		"jumpbox.tf": &bintree{templatesJumpboxTf, map[string]*bintree{}},
+		"network.tf": &bintree{templatesNetworkTf, map[string]*bintree{}},
+		"output.tf": &bintree{templatesOutputTf, map[string]*bintree{}},
+		"security_group.tf": &bintree{templatesSecurity_groupTf, map[string]*bintree{}},
+		"vars.tf": &bintree{templatesVarsTf, map[string]*bintree{}},
+	}},
+}}
+
+// RestoreAsset restores an asset under the given directory
+func RestoreAsset(dir, name string) error {
+	data, err := Asset(name)
+	if err != nil {
+		return err
+	}
+	info, err := AssetInfo(name)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
+	if err != nil {
+		return err
+	}
+	err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// RestoreAssets restores an asset under the given directory recursively
+func RestoreAssets(dir, name string) error {
+	children, err := AssetDir(name)
+	// File
+	if err != nil {
+		return RestoreAsset(dir, name)
+	}
+	// Dir
+	for _, child := range children {
+		err = RestoreAssets(dir, filepath.Join(name, child))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func _filePath(dir, name string) string {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
+}
+
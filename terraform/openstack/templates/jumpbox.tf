@@ -0,0 +1,13 @@
+resource "openstack_networking_floatingip_v2" "jumpbox" {
+  pool = "${var.external_network_name}"
+}
+
+resource "tls_private_key" "bosh_vms" {
+  algorithm = "RSA"
+  rsa_bits  = 4096
+}
+
+resource "openstack_compute_keypair_v2" "bosh_vms" {
+  name       = "${var.env_id}-bosh-vms"
+  public_key = "${tls_private_key.bosh_vms.public_key_openssh}"
+}
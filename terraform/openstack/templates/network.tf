@@ -0,0 +1,54 @@
+data "openstack_networking_network_v2" "external" {
+  name = "${var.external_network_name}"
+}
+
+resource "openstack_networking_network_v2" "bosh" {
+  name           = "${var.env_id}-network"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "bosh" {
+  name            = "${var.env_id}-bosh-subnet"
+  network_id      = "${openstack_networking_network_v2.bosh.id}"
+  cidr            = "10.0.0.0/24"
+  gateway_ip      = "10.0.0.1"
+  ip_version      = 4
+  dns_nameservers = ["8.8.8.8"]
+
+  allocation_pools {
+    start = "10.0.0.2"
+    end   = "10.0.0.4"
+  }
+}
+
+resource "openstack_networking_subnet_v2" "internal" {
+  count           = 3
+  name            = "${var.env_id}-internal-subnet-${count.index + 1}"
+  network_id      = "${openstack_networking_network_v2.bosh.id}"
+  cidr            = "${cidrsubnet("10.0.0.0/16", 4, count.index + 1)}"
+  gateway_ip      = "${cidrhost(cidrsubnet("10.0.0.0/16", 4, count.index + 1), 1)}"
+  ip_version      = 4
+  dns_nameservers = ["8.8.8.8"]
+
+  allocation_pools {
+    start = "${cidrhost(cidrsubnet("10.0.0.0/16", 4, count.index + 1), 2)}"
+    end   = "${cidrhost(cidrsubnet("10.0.0.0/16", 4, count.index + 1), 3)}"
+  }
+}
+
+resource "openstack_networking_router_v2" "bosh" {
+  name             = "${var.env_id}-router"
+  admin_state_up   = "true"
+  external_gateway = "${data.openstack_networking_network_v2.external.id}"
+}
+
+resource "openstack_networking_router_interface_v2" "bosh" {
+  router_id = "${openstack_networking_router_v2.bosh.id}"
+  subnet_id = "${openstack_networking_subnet_v2.bosh.id}"
+}
+
+resource "openstack_networking_router_interface_v2" "internal" {
+  count     = 3
+  router_id = "${openstack_networking_router_v2.bosh.id}"
+  subnet_id = "${element(openstack_networking_subnet_v2.internal.*.id, count.index)}"
+}
@@ -0,0 +1,36 @@
+output "network_id" {
+  value = "${openstack_networking_network_v2.bosh.id}"
+}
+
+output "network_name" {
+  value = "${openstack_networking_network_v2.bosh.name}"
+}
+
+output "jumpbox_security_group" {
+  value = "${openstack_networking_secgroup_v2.jumpbox.name}"
+}
+
+output "bosh_security_group" {
+  value = "${openstack_networking_secgroup_v2.bosh.name}"
+}
+
+output "bosh_vms_key_name" {
+  value = "${openstack_compute_keypair_v2.bosh_vms.name}"
+}
+
+output "bosh_vms_private_key" {
+  value     = "${tls_private_key.bosh_vms.private_key_pem}"
+  sensitive = true
+}
+
+output "external_ip" {
+  value = "${openstack_networking_floatingip_v2.jumpbox.address}"
+}
+
+output "jumpbox_url" {
+  value = "${openstack_networking_floatingip_v2.jumpbox.address}:22"
+}
+
+output "director_address" {
+  value = "https://${openstack_networking_floatingip_v2.jumpbox.address}:25555"
+}
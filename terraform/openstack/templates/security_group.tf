@@ -0,0 +1,63 @@
+resource "openstack_networking_secgroup_v2" "jumpbox" {
+  name        = "${var.env_id}-jumpbox"
+  description = "Jumpbox for ${var.env_id}"
+}
+
+resource "openstack_networking_secgroup_rule_v2" "jumpbox_ssh" {
+  count             = "${length(var.allowed_ingress_cidrs)}"
+  direction         = "ingress"
+  ethertype         = "IPv4"
+  protocol          = "tcp"
+  port_range_min    = 22
+  port_range_max    = 22
+  remote_ip_prefix  = "${element(var.allowed_ingress_cidrs, count.index)}"
+  security_group_id = "${openstack_networking_secgroup_v2.jumpbox.id}"
+}
+
+resource "openstack_networking_secgroup_rule_v2" "jumpbox_agent" {
+  count             = "${length(var.allowed_ingress_cidrs)}"
+  direction         = "ingress"
+  ethertype         = "IPv4"
+  protocol          = "tcp"
+  port_range_min    = 6868
+  port_range_max    = 6868
+  remote_ip_prefix  = "${element(var.allowed_ingress_cidrs, count.index)}"
+  security_group_id = "${openstack_networking_secgroup_v2.jumpbox.id}"
+}
+
+resource "openstack_networking_secgroup_v2" "bosh" {
+  name        = "${var.env_id}-bosh"
+  description = "BOSH director and deployed VMs for ${var.env_id}"
+}
+
+resource "openstack_networking_secgroup_rule_v2" "bosh_internal_tcp" {
+  direction         = "ingress"
+  ethertype         = "IPv4"
+  protocol          = "tcp"
+  remote_group_id   = "${openstack_networking_secgroup_v2.bosh.id}"
+  security_group_id = "${openstack_networking_secgroup_v2.bosh.id}"
+}
+
+resource "openstack_networking_secgroup_rule_v2" "bosh_internal_udp" {
+  direction         = "ingress"
+  ethertype         = "IPv4"
+  protocol          = "udp"
+  remote_group_id   = "${openstack_networking_secgroup_v2.bosh.id}"
+  security_group_id = "${openstack_networking_secgroup_v2.bosh.id}"
+}
+
+resource "openstack_networking_secgroup_rule_v2" "bosh_internal_icmp" {
+  direction         = "ingress"
+  ethertype         = "IPv4"
+  protocol          = "icmp"
+  remote_group_id   = "${openstack_networking_secgroup_v2.bosh.id}"
+  security_group_id = "${openstack_networking_secgroup_v2.bosh.id}"
+}
+
+resource "openstack_networking_secgroup_rule_v2" "bosh_from_jumpbox_tcp" {
+  direction         = "ingress"
+  ethertype         = "IPv4"
+  protocol          = "tcp"
+  remote_group_id   = "${openstack_networking_secgroup_v2.jumpbox.id}"
+  security_group_id = "${openstack_networking_secgroup_v2.bosh.id}"
+}
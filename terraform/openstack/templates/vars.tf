@@ -0,0 +1,49 @@
+variable "env_id" {
+  type = "string"
+}
+
+variable "auth_url" {
+  type = "string"
+}
+
+variable "availability_zone" {
+  type = "string"
+}
+
+variable "external_network_name" {
+  type = "string"
+}
+
+variable "project_name" {
+  type = "string"
+}
+
+variable "domain_name" {
+  type = "string"
+}
+
+variable "region" {
+  type = "string"
+}
+
+variable "user_name" {
+  type = "string"
+}
+
+variable "password" {
+  type = "string"
+}
+
+variable "allowed_ingress_cidrs" {
+  type    = "list"
+  default = ["0.0.0.0/0"]
+}
+
+provider "openstack" {
+  auth_url    = "${var.auth_url}"
+  tenant_name = "${var.project_name}"
+  domain_name = "${var.domain_name}"
+  region      = "${var.region}"
+  user_name   = "${var.user_name}"
+  password    = "${var.password}"
+}
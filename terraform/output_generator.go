@@ -11,10 +11,10 @@ func NewOutputGenerator(executor executor) OutputGenerator {
 }
 
 func (g OutputGenerator) Generate(tfState string) (Outputs, error) {
-	tfOutputs, err := g.executor.Outputs(tfState)
+	outputs, err := g.executor.Outputs(tfState)
 	if err != nil {
 		return Outputs{}, err
 	}
 
-	return Outputs{Map: tfOutputs}, nil
+	return outputs, nil
 }
@@ -14,13 +14,15 @@ var _ = Describe("OutputGenerator", func() {
 	var (
 		executor         *fakes.TerraformExecutor
 		outputGenerator  terraform.OutputGenerator
-		terraformOutputs map[string]interface{}
+		terraformOutputs terraform.Outputs
 	)
 
 	BeforeEach(func() {
 		executor = &fakes.TerraformExecutor{}
-		terraformOutputs = map[string]interface{}{
-			"some-key": "some-value",
+		terraformOutputs = terraform.Outputs{
+			Map: map[string]interface{}{
+				"some-key": "some-value",
+			},
 		}
 		executor.OutputsCall.Returns.Outputs = terraformOutputs
 
@@ -32,7 +34,7 @@ var _ = Describe("OutputGenerator", func() {
 		Expect(err).NotTo(HaveOccurred())
 
 		Expect(executor.OutputsCall.Receives.TFState).To(Equal("some-key: some-value"))
-		Expect(outputs.Map).To(Equal(terraformOutputs))
+		Expect(outputs).To(Equal(terraformOutputs))
 	})
 
 	Context("when executor outputs returns an error", func() {
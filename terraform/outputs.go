@@ -1,7 +1,14 @@
 package terraform
 
 type Outputs struct {
-	Map map[string]interface{}
+	Map       map[string]interface{}
+	Sensitive map[string]bool
+}
+
+// IsSensitive reports whether the output was declared with
+// "sensitive = true" in the terraform template.
+func (o Outputs) IsSensitive(key string) bool {
+	return o.Sensitive[key]
 }
 
 func (o Outputs) GetString(key string) string {
@@ -125,4 +125,22 @@ var _ = Describe("TerraformOutputs", func() {
 			})
 		})
 	})
+
+	Describe("IsSensitive", func() {
+		It("returns true for sensitive outputs", func() {
+			outputs := terraform.Outputs{
+				Map:       map[string]interface{}{"foo": "bar", "key": "secret"},
+				Sensitive: map[string]bool{"key": true},
+			}
+			Expect(outputs.IsSensitive("key")).To(BeTrue())
+			Expect(outputs.IsSensitive("foo")).To(BeFalse())
+		})
+
+		Context("when no sensitivity was recorded", func() {
+			It("returns false", func() {
+				outputs := terraform.Outputs{Map: map[string]interface{}{"foo": "bar"}}
+				Expect(outputs.IsSensitive("foo")).To(BeFalse())
+			})
+		})
+	})
 })
@@ -0,0 +1,37 @@
+package terraform
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ResourceCount returns the number of managed resources recorded in a
+// terraform state file. Data sources are not counted.
+func ResourceCount(tfState string) (int, error) {
+	if tfState == "" {
+		return 0, nil
+	}
+
+	var state struct {
+		Modules []struct {
+			Resources map[string]interface{} `json:"resources"`
+		} `json:"modules"`
+	}
+
+	err := json.Unmarshal([]byte(tfState), &state)
+	if err != nil {
+		return 0, fmt.Errorf("parse terraform state: %s", err)
+	}
+
+	count := 0
+	for _, module := range state.Modules {
+		for name := range module.Resources {
+			if !strings.HasPrefix(name, "data.") {
+				count++
+			}
+		}
+	}
+
+	return count, nil
+}
@@ -0,0 +1,45 @@
+package terraform_test
+
+import (
+	"github.com/cloudfoundry/bosh-bootloader/terraform"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ResourceCount", func() {
+	It("counts the managed resources in every module", func() {
+		count, err := terraform.ResourceCount(`{
+			"version": 3,
+			"modules": [
+				{
+					"path": ["root"],
+					"resources": {
+						"aws_vpc.vpc": {},
+						"aws_subnet.bosh_subnet": {},
+						"data.aws_ami.nat_ami": {}
+					}
+				},
+				{
+					"path": ["root", "child"],
+					"resources": {
+						"aws_eip.jumpbox_eip": {}
+					}
+				}
+			]
+		}`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(count).To(Equal(3))
+	})
+
+	It("returns zero when there is no terraform state", func() {
+		count, err := terraform.ResourceCount("")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(count).To(Equal(0))
+	})
+
+	It("returns an error when the terraform state cannot be parsed", func() {
+		_, err := terraform.ResourceCount("%%%")
+		Expect(err).To(MatchError(ContainSubstring("parse terraform state:")))
+	})
+})
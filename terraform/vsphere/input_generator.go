@@ -0,0 +1,19 @@
+package vsphere
+
+import "github.com/cloudfoundry/bosh-bootloader/storage"
+
+type InputGenerator struct {
+}
+
+func NewInputGenerator() InputGenerator {
+	return InputGenerator{}
+}
+
+func (i InputGenerator) Generate(state storage.State) (map[string]string, error) {
+	return map[string]string{
+		"env_id":          state.EnvID,
+		"network_name":    state.VSphere.Network,
+		"vsphere_subnet":  state.VSphere.Subnet,
+		"vsphere_gateway": state.VSphere.Gateway,
+	}, nil
+}
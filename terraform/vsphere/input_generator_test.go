@@ -0,0 +1,38 @@
+package vsphere_test
+
+import (
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/cloudfoundry/bosh-bootloader/terraform/vsphere"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("InputGenerator", func() {
+	var (
+		inputGenerator vsphere.InputGenerator
+	)
+
+	BeforeEach(func() {
+		inputGenerator = vsphere.NewInputGenerator()
+	})
+
+	It("receives BBL state and returns a map of terraform variables", func() {
+		inputs, err := inputGenerator.Generate(storage.State{
+			IAAS:  "vsphere",
+			EnvID: "env-id",
+			VSphere: storage.VSphere{
+				Network: "network",
+				Subnet:  "10.0.0.0/24",
+				Gateway: "10.0.0.1",
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(inputs).To(Equal(map[string]string{
+			"env_id":          "env-id",
+			"network_name":    "network",
+			"vsphere_subnet":  "10.0.0.0/24",
+			"vsphere_gateway": "10.0.0.1",
+		}))
+	})
+})
@@ -0,0 +1,31 @@
+package vsphere
+
+import (
+	"strings"
+
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+)
+
+type templates struct {
+	vars   string
+	output string
+}
+
+type TemplateGenerator struct{}
+
+func NewTemplateGenerator() TemplateGenerator {
+	return TemplateGenerator{}
+}
+
+func (t TemplateGenerator) Generate(state storage.State) string {
+	tmpls := readTemplates()
+	return strings.Join([]string{tmpls.vars, tmpls.output}, "\n")
+}
+
+func readTemplates() templates {
+	tmpls := templates{}
+	tmpls.vars = string(MustAsset("templates/vars.tf"))
+	tmpls.output = string(MustAsset("templates/output.tf"))
+
+	return tmpls
+}
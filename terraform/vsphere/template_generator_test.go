@@ -0,0 +1,32 @@
+package vsphere_test
+
+import (
+	"io/ioutil"
+
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/cloudfoundry/bosh-bootloader/terraform/vsphere"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TemplateGenerator", func() {
+	var (
+		templateGenerator vsphere.TemplateGenerator
+	)
+
+	BeforeEach(func() {
+		templateGenerator = vsphere.NewTemplateGenerator()
+	})
+
+	Describe("Generate", func() {
+		It("generates a terraform template for vsphere", func() {
+			expectedTemplate, err := ioutil.ReadFile("fixtures/vsphere_template.tf")
+			Expect(err).NotTo(HaveOccurred())
+
+			template := templateGenerator.Generate(storage.State{
+				EnvID: "vsphere-environment",
+			})
+			Expect(template).To(Equal(string(expectedTemplate)))
+		})
+	})
+})
@@ -0,0 +1,260 @@
+// Code generated by go-bindata.
+// sources:
+// templates/output.tf
+// templates/vars.tf
+// DO NOT EDIT!
+
+package vsphere
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+func bindataRead(data []byte, name string) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, gz)
+	clErr := gz.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+	if clErr != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+type asset struct {
+	bytes []byte
+	info  os.FileInfo
+}
+
+type bindataFileInfo struct {
+	name    string
+	size    int64
+	mode    os.FileMode
+	modTime time.Time
+}
+
+func (fi bindataFileInfo) Name() string {
+	return fi.name
+}
+func (fi bindataFileInfo) Size() int64 {
+	return fi.size
+}
+func (fi bindataFileInfo) Mode() os.FileMode {
+	return fi.mode
+}
+func (fi bindataFileInfo) ModTime() time.Time {
+	return fi.modTime
+}
+func (fi bindataFileInfo) IsDir() bool {
+	return false
+}
+func (fi bindataFileInfo) Sys() interface{} {
+	return nil
+}
+
+var _templatesOutputTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xad\xcf\xdd\x0a\x82\x40\x10\x05\xe0\x7b\x9f\x62\x59\xba\x28\x88\x04\xc1\x2e\x84\x9e\x45\x56\x77\xd0\x2d\x73\x97\xd9\x59\x35\xc4\x77\x4f\xa1\x22\x7f\x2a\x8a\xe6\x7a\xce\xc7\x39\xda\x91\x71\xc4\x78\x09\x54\x6b\x3c\xc5\xa5\x38\x03\x67\xad\xc7\x58\x25\x0a\x07\xec\xc0\xf8\xaa\xad\x04\xee\x9e\x1f\x3a\xee\x75\x9e\xa7\x6f\x51\x55\x12\x60\x29\x8a\x38\x55\x12\x17\xb3\x95\x35\x39\x20\xc4\xd6\x25\x3d\xf3\x22\x9d\xd5\x6f\xb3\x99\x20\xa8\xc5\x65\x1c\x3e\xba\xb3\x49\x74\x13\x3f\x10\x65\x66\xc8\xd0\x2a\xd7\x96\xd6\xf3\x26\x5b\x16\x6e\xc6\xa0\x54\x08\x29\x69\xfc\x5d\xdc\x4f\x44\x68\xfe\x54\xed\xbe\xd5\x61\xf1\x35\x14\x05\xc1\xf2\x4c\x21\x25\x82\xb5\x13\x30\x27\x32\x36\xf2\xfd\xcf\x53\xa3\x20\xec\x6f\xb0\xaf\x5f\x2e\x29\xbe\x49\x02\x00\x00")
+
+func templatesOutputTfBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesOutputTf,
+		"templates/output.tf",
+	)
+}
+
+func templatesOutputTf() (*asset, error) {
+	bytes, err := templatesOutputTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/output.tf", size: 585, mode: os.FileMode(420), modTime: time.Unix(1792360030, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesVarsTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x95\xcb\x41\x0a\x80\x20\x10\x05\xd0\xbd\xa7\x18\xbc\x47\x67\x91\x91\x3e\x26\xd5\x24\x3a\x29\x12\xdd\xbd\x56\xed\x82\xda\xbf\x57\x39\x47\xf6\x0b\xc8\x42\xaa\x8b\xa3\xa5\xc3\x10\x69\x4f\xa0\x81\x6c\xd1\x1c\x25\x58\x73\x1a\x53\x1f\x28\xd0\xb6\xe5\xd9\x09\xaf\xf8\xc0\x6b\x49\x13\x32\x5c\xd9\xfd\x3d\x7f\x84\xc0\x8a\xc6\xfd\x6d\x5c\x8f\x1e\x68\xd4\xba\x00\x00\x00")
+
+func templatesVarsTfBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesVarsTf,
+		"templates/vars.tf",
+	)
+}
+
+func templatesVarsTf() (*asset, error) {
+	bytes, err := templatesVarsTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/vars.tf", size: 186, mode: os.FileMode(420), modTime: time.Unix(1792360030, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+// Asset loads and returns the asset for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func Asset(name string) ([]byte, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
+		}
+		return a.bytes, nil
+	}
+	return nil, fmt.Errorf("Asset %s not found", name)
+}
+
+// MustAsset is like Asset but panics when Asset would return an error.
+// It simplifies safe initialization of global variables.
+func MustAsset(name string) []byte {
+	a, err := Asset(name)
+	if err != nil {
+		panic("asset: Asset(" + name + "): " + err.Error())
+	}
+
+	return a
+}
+
+// AssetInfo loads and returns the asset info for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func AssetInfo(name string) (os.FileInfo, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
+		}
+		return a.info, nil
+	}
+	return nil, fmt.Errorf("AssetInfo %s not found", name)
+}
+
+// AssetNames returns the names of the assets.
+func AssetNames() []string {
+	names := make([]string, 0, len(_bindata))
+	for name := range _bindata {
+		names = append(names, name)
+	}
+	return names
+}
+
+// _bindata is a table, holding each asset generator, mapped to its name.
+var _bindata = map[string]func() (*asset, error){
+	"templates/output.tf": templatesOutputTf,
+	"templates/vars.tf": templatesVarsTf,
+}
+
+// AssetDir returns the file names below a certain
+// directory embedded in the file by go-bindata.
+// For example if you run go-bindata on data/... and data contains the
+// following hierarchy:
+//     data/
+//       foo.txt
+//       img/
+//         a.png
+//         b.png
+// then AssetDir("data") would return []string{"foo.txt", "img"}
+// AssetDir("data/img") would return []string{"a.png", "b.png"}
+// AssetDir("foo.txt") and AssetDir("notexist") would return an error
+// AssetDir("") will return []string{"data"}.
+func AssetDir(name string) ([]string, error) {
+	node := _bintree
+	if len(name) != 0 {
+		cannonicalName := strings.Replace(name, "\\", "/", -1)
+		pathList := strings.Split(cannonicalName, "/")
+		for _, p := range pathList {
+			node = node.Children[p]
+			if node == nil {
+				return nil, fmt.Errorf("Asset %s not found", name)
+			}
+		}
+	}
+	if node.Func != nil {
+		return nil, fmt.Errorf("Asset %s not found", name)
+	}
+	rv := make([]string, 0, len(node.Children))
+	for childName := range node.Children {
+		rv = append(rv, childName)
+	}
+	return rv, nil
+}
+
+type bintree struct {
+	Func     func() (*asset, error)
+	Children map[string]*bintree
+}
+var _bintree = &bintree{nil, map[string]*bintree{
+	"templates": &bintree{nil, map[string]*bintree{
+		"output.tf": &bintree{templatesOutputTf, map[string]*bintree{}},
+		"vars.tf": &bintree{templatesVarsTf, map[string]*bintree{}},
+	}},
+}}
+
+// RestoreAsset restores an asset under the given directory
+func RestoreAsset(dir, name string) error {
+	data, err := Asset(name)
+	if err != nil {
+		return err
+	}
+	info, err := AssetInfo(name)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
+	if err != nil {
+		return err
+	}
+	err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// RestoreAssets restores an asset under the given directory recursively
+func RestoreAssets(dir, name string) error {
+	children, err := AssetDir(name)
+	// File
+	if err != nil {
+		return RestoreAsset(dir, name)
+	}
+	// Dir
+	for _, child := range children {
+		err = RestoreAssets(dir, filepath.Join(name, child))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func _filePath(dir, name string) string {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
+}
+
@@ -0,0 +1,31 @@
+output "network_name" {
+  value = "${var.network_name}"
+}
+
+output "internal_cidr" {
+  value = "${var.vsphere_subnet}"
+}
+
+output "internal_gw" {
+  value = "${var.vsphere_gateway}"
+}
+
+output "jumpbox_internal_ip" {
+  value = "${cidrhost(var.vsphere_subnet, 5)}"
+}
+
+output "director_internal_ip" {
+  value = "${cidrhost(var.vsphere_subnet, 6)}"
+}
+
+output "external_ip" {
+  value = "${cidrhost(var.vsphere_subnet, 5)}"
+}
+
+output "jumpbox_url" {
+  value = "${cidrhost(var.vsphere_subnet, 5)}:22"
+}
+
+output "director_address" {
+  value = "https://${cidrhost(var.vsphere_subnet, 6)}:25555"
+}
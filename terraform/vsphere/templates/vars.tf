@@ -0,0 +1,15 @@
+variable "env_id" {
+  type = "string"
+}
+
+variable "network_name" {
+  type = "string"
+}
+
+variable "vsphere_subnet" {
+  type = "string"
+}
+
+variable "vsphere_gateway" {
+  type = "string"
+}